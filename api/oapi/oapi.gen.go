@@ -15,6 +15,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gin-gonic/gin"
@@ -24,9 +25,117 @@ import (
 )
 
 const (
-	BasicAuthScopes = "basicAuth.Scopes"
+	ApiKeyAuthScopes = "apiKeyAuth.Scopes"
+	BasicAuthScopes  = "basicAuth.Scopes"
 )
 
+// Defines values for DetectionListRequestOrder.
+const (
+	Asc  DetectionListRequestOrder = "asc"
+	Desc DetectionListRequestOrder = "desc"
+)
+
+// Defines values for DetectionListRequestSortBy.
+const (
+	Confidence DetectionListRequestSortBy = "confidence"
+	CreatedAt  DetectionListRequestSortBy = "created_at"
+	Id         DetectionListRequestSortBy = "id"
+	Property   DetectionListRequestSortBy = "property"
+	Rank       DetectionListRequestSortBy = "rank"
+)
+
+// Defines values for FewShotSettingsStrategy.
+const (
+	Balanced          FewShotSettingsStrategy = "balanced"
+	RecentCorrections FewShotSettingsStrategy = "recent_corrections"
+)
+
+// Defines values for PropertyTypeKind.
+const (
+	Boolean PropertyTypeKind = "boolean"
+	Enum    PropertyTypeKind = "enum"
+	List    PropertyTypeKind = "list"
+	Number  PropertyTypeKind = "number"
+	String  PropertyTypeKind = "string"
+	Url     PropertyTypeKind = "url"
+)
+
+// Defines values for ShadowDisagreementKind.
+const (
+	Properties ShadowDisagreementKind = "properties"
+	Relevance  ShadowDisagreementKind = "relevance"
+)
+
+// Defines values for TaskStatus.
+const (
+	TaskStatusClaimed   TaskStatus = "claimed"
+	TaskStatusCommitted TaskStatus = "committed"
+	TaskStatusFailed    TaskStatus = "failed"
+	TaskStatusPending   TaskStatus = "pending"
+)
+
+// Defines values for TestResultChangeKind.
+const (
+	Added     TestResultChangeKind = "added"
+	Changed   TestResultChangeKind = "changed"
+	Fixed     TestResultChangeKind = "fixed"
+	Regressed TestResultChangeKind = "regressed"
+	Removed   TestResultChangeKind = "removed"
+)
+
+// Defines values for TestRunStatus.
+const (
+	Completed TestRunStatus = "completed"
+	Running   TestRunStatus = "running"
+)
+
+// Defines values for WebhookDeliveryAttemptStatus.
+const (
+	WebhookDeliveryAttemptStatusDelivered WebhookDeliveryAttemptStatus = "delivered"
+	WebhookDeliveryAttemptStatusFailed    WebhookDeliveryAttemptStatus = "failed"
+	WebhookDeliveryAttemptStatusPending   WebhookDeliveryAttemptStatus = "pending"
+)
+
+// Defines values for PostApiSourcesHackernewsFeedsFeedAddProfilesParamsFeed.
+const (
+	PostApiSourcesHackernewsFeedsFeedAddProfilesParamsFeedNew PostApiSourcesHackernewsFeedsFeedAddProfilesParamsFeed = "new"
+	PostApiSourcesHackernewsFeedsFeedAddProfilesParamsFeedTop PostApiSourcesHackernewsFeedsFeedAddProfilesParamsFeed = "top"
+)
+
+// Defines values for PostApiSourcesHackernewsFeedsFeedRemoveProfilesParamsFeed.
+const (
+	PostApiSourcesHackernewsFeedsFeedRemoveProfilesParamsFeedNew PostApiSourcesHackernewsFeedsFeedRemoveProfilesParamsFeed = "new"
+	PostApiSourcesHackernewsFeedsFeedRemoveProfilesParamsFeedTop PostApiSourcesHackernewsFeedsFeedRemoveProfilesParamsFeed = "top"
+)
+
+// AnalysisTask defines model for AnalysisTask.
+type AnalysisTask struct {
+	ClaimedAt   *string `json:"claimed_at,omitempty"`
+	CommittedAt *string `json:"committed_at,omitempty"`
+	CreatedAt   string  `json:"created_at"`
+
+	// Errors Errors of previous processing attempts.
+	Errors   []string `json:"errors"`
+	FailedAt *string  `json:"failed_at,omitempty"`
+
+	// FailureReason Reason code of a failed task: max_attempts_exceeded or a code of a permanent error (source_item_not_found, toolkit_not_found, profile_not_found, profile_settings_not_found, model_not_configured, invalid_llm_request).
+	FailureReason *string `json:"failure_reason,omitempty"`
+	Id            int     `json:"id"`
+	Priority      int     `json:"priority"`
+	ProfileId     int     `json:"profile_id"`
+
+	// ShadowId Shadow of a shadow task.
+	ShadowId   *int       `json:"shadow_id,omitempty"`
+	ShouldSave bool       `json:"should_save"`
+	Source     string     `json:"source"`
+	SourceId   string     `json:"source_id"`
+	Status     TaskStatus `json:"status"`
+
+	// TestRunId Test run of a test_run task.
+	TestRunId *int   `json:"test_run_id,omitempty"`
+	Type      string `json:"type"`
+}
+
 // AnalysisTaskParameters defines model for AnalysisTaskParameters.
 type AnalysisTaskParameters struct {
 	ProfileId  int    `json:"profile_id"`
@@ -38,36 +147,132 @@ type AnalysisTaskParameters struct {
 // AnalyzeRequest defines model for AnalyzeRequest.
 type AnalyzeRequest struct {
 	ExtractedProperties map[string]string `json:"extracted_properties"`
-	RelevancyFilter     string            `json:"relevancy_filter"`
-	Source              string            `json:"source"`
-	SourceId            string            `json:"source_id"`
+
+	// Model Model to use. If omitted, the default analyzer chain is used.
+	Model           *string                  `json:"model,omitempty"`
+	PropertyTypes   *map[string]PropertyType `json:"property_types,omitempty"`
+	RelevancyFilter string                   `json:"relevancy_filter"`
+	Source          string                   `json:"source"`
+	SourceId        string                   `json:"source_id"`
+}
+
+// AnalyzerLimiter Limits of requests to a model of an LLM provider shared by all workers. Zero limits mean no limit.
+type AnalyzerLimiter struct {
+	// InFlight Number of requests being executed.
+	InFlight       int    `json:"in_flight"`
+	MaxConcurrency int    `json:"max_concurrency"`
+	Model          string `json:"model"`
+
+	// PausedUntil Requests wait until this time as requested by the provider.
+	PausedUntil *string `json:"paused_until,omitempty"`
+	Provider    string  `json:"provider"`
+
+	// RateLimitedTotal Total rate-limited responses.
+	RateLimitedTotal int `json:"rate_limited_total"`
+
+	// RequestsPerMinute Current requests limit, it is lower than the configured one after rate-limited responses.
+	RequestsPerMinute float32 `json:"requests_per_minute"`
+	RequestsTotal     int     `json:"requests_total"`
+
+	// TokensPerMinute Current estimated tokens limit, it is lower than the configured one after rate-limited responses.
+	TokensPerMinute float32 `json:"tokens_per_minute"`
+
+	// TokensTotal Total estimated tokens of requests.
+	TokensTotal int `json:"tokens_total"`
+
+	// Waiting Number of requests waiting for the limits.
+	Waiting int `json:"waiting"`
 }
 
 // Detection defines model for Detection.
 type Detection struct {
-	CreatedAt       string            `json:"created_at"`
-	Id              int               `json:"id"`
-	IsRelevant      bool              `json:"is_relevant"`
-	ProfileId       int               `json:"profile_id"`
-	Properties      map[string]string `json:"properties"`
-	SettingsVersion int               `json:"settings_version"`
-	Source          string            `json:"source"`
-	SourceId        string            `json:"source_id"`
+	// Confidence Confidence of the model in the detected relevance from 0 to 1. Missing for detections made before confidence was recorded.
+	Confidence *float64 `json:"confidence,omitempty"`
+	CreatedAt  string   `json:"created_at"`
+
+	// ExampleIds Ids of tagged detections included in the analyzer input as few-shot examples.
+	ExampleIds *[]int `json:"example_ids,omitempty"`
+	Id         int    `json:"id"`
+	IsRelevant bool   `json:"is_relevant"`
+
+	// Model Model that produced the detection.
+	Model     *string `json:"model,omitempty"`
+	ProfileId int     `json:"profile_id"`
+
+	// Properties Extracted property values typed according to property types.
+	Properties map[string]interface{} `json:"properties"`
+
+	// Rationale Short explanation of the relevance decision.
+	Rationale       *string `json:"rationale,omitempty"`
+	SettingsVersion int     `json:"settings_version"`
+	Source          string  `json:"source"`
+	SourceId        string  `json:"source_id"`
 }
 
 // DetectionFilter defines model for DetectionFilter.
 type DetectionFilter struct {
-	IsRelevant *bool                `json:"is_relevant,omitempty"`
-	Profiles   *[]ProfileFilter     `json:"profiles,omitempty"`
+	IsRelevant *bool `json:"is_relevant,omitempty"`
+
+	// MaxConfidence Maximum confidence (inclusive). Detections without a confidence don't match.
+	MaxConfidence *float64 `json:"max_confidence,omitempty"`
+
+	// MinConfidence Minimum confidence (inclusive). Detections without a confidence don't match.
+	MinConfidence *float64         `json:"min_confidence,omitempty"`
+	Profiles      *[]ProfileFilter `json:"profiles,omitempty"`
+
+	// Properties Extracted property predicates, all of them must match.
+	Properties *[]PropertyFilter    `json:"properties,omitempty"`
 	Sources    *[]string            `json:"sources,omitempty"`
 	Tags       *DetectionTagsFilter `json:"tags,omitempty"`
 }
 
 // DetectionListRequest defines model for DetectionListRequest.
 type DetectionListRequest struct {
-	Filter     *DetectionFilter `json:"filter,omitempty"`
-	LastSeenId *int             `json:"last_seen_id,omitempty"`
-	Limit      *int             `json:"limit,omitempty"`
+	// After Cursor of the last seen detection (`cursor` of a listed detection) to get the next page.
+	After  *string          `json:"after,omitempty"`
+	Filter *DetectionFilter `json:"filter,omitempty"`
+
+	// LastSeenId Id of the last seen detection. Supported only with sorting by id, prefer `after`.
+	LastSeenId *int                       `json:"last_seen_id,omitempty"`
+	Limit      *int                       `json:"limit,omitempty"`
+	Order      *DetectionListRequestOrder `json:"order,omitempty"`
+
+	// Q Full-text search query (web search syntax: "quoted phrases", OR, -excluded) over extracted property values and source posts (title, body and comments).
+	Q *string `json:"q,omitempty"`
+
+	// SortBy Field to sort detections by, ties are broken by id. Defaults to `property` if `sort_property` is set, to `rank` if `q` is set, otherwise to `id`. Sorting by `rank` requires `q`. Detections without a confidence come last when sorting by `confidence`.
+	SortBy *DetectionListRequestSortBy `json:"sort_by,omitempty"`
+
+	// SortProperty Extracted property to sort detections by. Detections without the property come last.
+	SortProperty *string `json:"sort_property,omitempty"`
+}
+
+// DetectionListRequestOrder defines model for DetectionListRequest.Order.
+type DetectionListRequestOrder string
+
+// DetectionListRequestSortBy Field to sort detections by, ties are broken by id. Defaults to `property` if `sort_property` is set, to `rank` if `q` is set, otherwise to `id`. Sorting by `rank` requires `q`. Detections without a confidence come last when sorting by `confidence`.
+type DetectionListRequestSortBy string
+
+// DetectionSearchMatch Full-text search match of a detection, present only if `q` is set.
+type DetectionSearchMatch struct {
+	// Rank Relevance of the detection and its source post to the query, higher is more relevant.
+	Rank float64 `json:"rank"`
+
+	// Snippet Fragment of the post and property values with matches wrapped in <mark></mark>.
+	Snippet string `json:"snippet"`
+}
+
+// DetectionStatistics defines model for DetectionStatistics.
+type DetectionStatistics struct {
+	Irrelevant int `json:"irrelevant"`
+	Relevant   int `json:"relevant"`
+
+	// TaggedCorrect Amount of detections tagged as correctly detected.
+	TaggedCorrect int `json:"tagged_correct"`
+
+	// TaggedWrong Amount of detections tagged as wrongly detected.
+	TaggedWrong int `json:"tagged_wrong"`
+	Total       int `json:"total"`
 }
 
 // DetectionTagUpdateRequest defines model for DetectionTagUpdateRequest.
@@ -88,16 +293,55 @@ type DetectionTagsFilter struct {
 	RelevancyDetectedCorrectly *[]*bool `json:"relevancy_detected_correctly,omitempty"`
 }
 
+// DraftProfileSettings Unsaved profile settings, they are used for posts of all sources.
+type DraftProfileSettings struct {
+	ExtractedProperties map[string]string `json:"extracted_properties"`
+
+	// FewShot Few-shot prompting with human-tagged detections of the profile. Tagged posts are included in the analyzer input as examples with their confirmed relevance.
+	FewShot *FewShotSettings `json:"few_shot,omitempty"`
+
+	// Model Model to use. If omitted, the default analyzer chain is used.
+	Model           *string                  `json:"model,omitempty"`
+	PropertyTypes   *map[string]PropertyType `json:"property_types,omitempty"`
+	RelevancyFilter string                   `json:"relevancy_filter"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	Error string `json:"error"`
 }
 
+// FewShotSettings Few-shot prompting with human-tagged detections of the profile. Tagged posts are included in the analyzer input as examples with their confirmed relevance.
+type FewShotSettings struct {
+	// Examples Maximum number of examples.
+	Examples int `json:"examples"`
+
+	// MaxTokens Token budget of examples in the analyzer input. Examples that don't fit are skipped.
+	MaxTokens int `json:"max_tokens"`
+
+	// Strategy How examples are chosen. `balanced` takes equal numbers of relevant and irrelevant posts, `recent_corrections` takes posts whose relevance was detected incorrectly. The most recently tagged posts are taken first.
+	Strategy FewShotSettingsStrategy `json:"strategy"`
+}
+
+// FewShotSettingsStrategy How examples are chosen. `balanced` takes equal numbers of relevant and irrelevant posts, `recent_corrections` takes posts whose relevance was detected incorrectly. The most recently tagged posts are taken first.
+type FewShotSettingsStrategy string
+
+// HackerNewsFeedSettings defines model for HackerNewsFeedSettings.
+type HackerNewsFeedSettings struct {
+	Feed     string `json:"feed"`
+	Profiles []int  `json:"profiles"`
+}
+
 // ListedDetection defines model for ListedDetection.
 type ListedDetection struct {
-	Detection  Detection        `json:"detection"`
-	SourcePost *json.RawMessage `json:"source_post,omitempty"`
-	Tags       *DetectionTags   `json:"tags,omitempty"`
+	// Cursor Cursor of the detection to pass as `after` to get the next page.
+	Cursor    string    `json:"cursor"`
+	Detection Detection `json:"detection"`
+
+	// Search Full-text search match of a detection, present only if `q` is set.
+	Search     *DetectionSearchMatch `json:"search,omitempty"`
+	SourcePost *json.RawMessage      `json:"source_post,omitempty"`
+	Tags       *DetectionTags        `json:"tags,omitempty"`
 }
 
 // Profile defines model for Profile.
@@ -111,6 +355,15 @@ type Profile struct {
 	UpdatedAt       *string                     `json:"updated_at,omitempty"`
 }
 
+// ProfileAnalyzeRequest defines model for ProfileAnalyzeRequest.
+type ProfileAnalyzeRequest struct {
+	// Source Source of the posts (reddit, hackernews or rss).
+	Source string `json:"source"`
+
+	// SourceIds Ids of the posts in the source, up to 100.
+	SourceIds []string `json:"source_ids"`
+}
+
 // ProfileFilter defines model for ProfileFilter.
 type ProfileFilter struct {
 	ProfileId              int                            `json:"profile_id"`
@@ -133,21 +386,89 @@ type ProfileJumpstartRequest struct {
 type ProfileSettings struct {
 	CreatedAt           *string           `json:"created_at,omitempty"`
 	ExtractedProperties map[string]string `json:"extracted_properties"`
-	RelevancyFilter     string            `json:"relevancy_filter"`
-	UpdatedAt           *string           `json:"updated_at,omitempty"`
-	Version             int               `json:"version"`
+
+	// FewShot Few-shot prompting with human-tagged detections of the profile. Tagged posts are included in the analyzer input as examples with their confirmed relevance.
+	FewShot *FewShotSettings `json:"few_shot,omitempty"`
+
+	// Model Model pinned for the analysis. If omitted, the default analyzer chain is used.
+	Model *string `json:"model,omitempty"`
+
+	// PropertyTypes Types of extracted properties. Properties without a type are optional strings.
+	PropertyTypes   *map[string]PropertyType `json:"property_types,omitempty"`
+	RelevancyFilter string                   `json:"relevancy_filter"`
+	UpdatedAt       *string                  `json:"updated_at,omitempty"`
+	Version         int                      `json:"version"`
+}
+
+// ProfileSettingsDiff defines model for ProfileSettingsDiff.
+type ProfileSettingsDiff struct {
+	// AddedProperties Properties present only in the newer version.
+	AddedProperties map[string]string `json:"added_properties"`
+
+	// ChangedProperties Properties present in both versions with different definitions.
+	ChangedProperties      map[string]PropertyDefinitionChange `json:"changed_properties"`
+	FewShotChanged         bool                                `json:"few_shot_changed"`
+	From                   ProfileSettingsVersion              `json:"from"`
+	ModelChanged           bool                                `json:"model_changed"`
+	PropertyTypesChanged   bool                                `json:"property_types_changed"`
+	RelevancyFilterChanged bool                                `json:"relevancy_filter_changed"`
+
+	// RemovedProperties Properties present only in the older version.
+	RemovedProperties map[string]string      `json:"removed_properties"`
+	To                ProfileSettingsVersion `json:"to"`
+}
+
+// ProfileSettingsRollbackRequest defines model for ProfileSettingsRollbackRequest.
+type ProfileSettingsRollbackRequest struct {
+	// Source Settings source. If omitted, default settings are rolled back.
+	Source *string `json:"source,omitempty"`
+
+	// Version Version to roll back to.
+	Version int `json:"version"`
 }
 
 // ProfileSettingsUpdate defines model for ProfileSettingsUpdate.
 type ProfileSettingsUpdate struct {
 	ExtractedProperties *map[string]*string `json:"extracted_properties,omitempty"`
-	RelevancyFilter     *string             `json:"relevancy_filter,omitempty"`
+
+	// FewShot Few-shot settings to set. Null disables few-shot prompting.
+	FewShot nullable.Nullable[FewShotSettings] `json:"few_shot,omitempty"`
+
+	// Model Model to pin. Null unpins the model and restores the default analyzer chain.
+	Model nullable.Nullable[string] `json:"model,omitempty"`
+
+	// PropertyTypes Replaces all property types if present.
+	PropertyTypes   *map[string]PropertyType `json:"property_types,omitempty"`
+	RelevancyFilter *string                  `json:"relevancy_filter,omitempty"`
+}
+
+// ProfileSettingsVersion defines model for ProfileSettingsVersion.
+type ProfileSettingsVersion struct {
+	CreatedAt           string            `json:"created_at"`
+	ExtractedProperties map[string]string `json:"extracted_properties"`
+
+	// FewShot Few-shot prompting with human-tagged detections of the profile. Tagged posts are included in the analyzer input as examples with their confirmed relevance.
+	FewShot *FewShotSettings `json:"few_shot,omitempty"`
+
+	// Model Model pinned for the analysis. If omitted, the default analyzer chain is used.
+	Model     *string `json:"model,omitempty"`
+	ProfileId int     `json:"profile_id"`
+
+	// PropertyTypes Types of extracted properties. Properties without a type are optional strings.
+	PropertyTypes   *map[string]PropertyType `json:"property_types,omitempty"`
+	RelevancyFilter string                   `json:"relevancy_filter"`
+
+	// Source Settings source. Null means default settings.
+	Source  nullable.Nullable[string] `json:"source"`
+	Version int                       `json:"version"`
 }
 
 // ProfileStatistics defines model for ProfileStatistics.
 type ProfileStatistics struct {
-	AutoTasks   int `json:"auto_tasks"`
-	ManualTasks int `json:"manual_tasks"`
+	Detections DetectionStatistics `json:"detections"`
+	ProfileId  int                 `json:"profile_id"`
+	Sources    []SourceStatistics  `json:"sources"`
+	Tasks      TaskStatistics      `json:"tasks"`
 }
 
 // ProfileUpdate defines model for ProfileUpdate.
@@ -158,3218 +479,13603 @@ type ProfileUpdate struct {
 	SourcesSettings *map[string]*ProfileSettingsUpdate       `json:"sources_settings,omitempty"`
 }
 
-// SourceSettingsVersionsFilter defines model for SourceSettingsVersionsFilter.
-type SourceSettingsVersionsFilter struct {
-	Source   *string `json:"source,omitempty"`
-	Versions []int   `json:"versions"`
+// PropertyDefinitionChange defines model for PropertyDefinitionChange.
+type PropertyDefinitionChange struct {
+	From string `json:"from"`
+	To   string `json:"to"`
 }
 
-// SubredditSettings defines model for SubredditSettings.
-type SubredditSettings struct {
-	Profiles  []int  `json:"profiles"`
-	Subreddit string `json:"subreddit"`
+// PropertyFilter defines model for PropertyFilter.
+type PropertyFilter struct {
+	// Contains Substring the property text must contain (case-insensitive).
+	Contains *string `json:"contains,omitempty"`
+
+	// Equals Typed value the property must be equal to (e.g. true, 7 or "high").
+	Equals *interface{} `json:"equals,omitempty"`
+
+	// Exists Whether the property must be present and non-null.
+	Exists *bool `json:"exists,omitempty"`
+
+	// Gte Minimum value of a numeric property.
+	Gte *float64 `json:"gte,omitempty"`
+	Key string   `json:"key"`
+
+	// Lte Maximum value of a numeric property.
+	Lte *float64 `json:"lte,omitempty"`
+
+	// Regex POSIX regular expression the property text must match.
+	Regex *string `json:"regex,omitempty"`
 }
 
-// PostApiSourcesRedditSubredditsSubredditAddProfilesJSONBody defines parameters for PostApiSourcesRedditSubredditsSubredditAddProfiles.
-type PostApiSourcesRedditSubredditsSubredditAddProfilesJSONBody struct {
-	ProfileIds []int `json:"profile_ids"`
+// PropertyType defines model for PropertyType.
+type PropertyType struct {
+	// Enum Allowed values of an enum property.
+	Enum *[]string        `json:"enum,omitempty"`
+	Kind PropertyTypeKind `json:"kind"`
+
+	// Required Required properties are always extracted, optional properties may be null.
+	Required *bool `json:"required,omitempty"`
 }
 
-// PostApiSourcesRedditSubredditsSubredditRemoveProfilesJSONBody defines parameters for PostApiSourcesRedditSubredditsSubredditRemoveProfiles.
-type PostApiSourcesRedditSubredditsSubredditRemoveProfilesJSONBody struct {
-	ProfileIds []int `json:"profile_ids"`
+// PropertyTypeKind defines model for PropertyType.Kind.
+type PropertyTypeKind string
+
+// RSSFeedProfilesRequest defines model for RSSFeedProfilesRequest.
+type RSSFeedProfilesRequest struct {
+	FeedUrl    string `json:"feed_url"`
+	ProfileIds []int  `json:"profile_ids"`
 }
 
-// GetApiSourcesRedditSubredditsWithProfileParams defines parameters for GetApiSourcesRedditSubredditsWithProfile.
-type GetApiSourcesRedditSubredditsWithProfileParams struct {
-	ProfileId int `form:"profile_id" json:"profile_id"`
+// RSSFeedSettings defines model for RSSFeedSettings.
+type RSSFeedSettings struct {
+	FeedUrl  string `json:"feed_url"`
+	Profiles []int  `json:"profiles"`
 }
 
-// PostApiAnalyzeJSONRequestBody defines body for PostApiAnalyze for application/json ContentType.
-type PostApiAnalyzeJSONRequestBody = AnalyzeRequest
+// SettingsVersionAccuracy Accuracy of detections made with a settings version. Relevant posts are positives.
+type SettingsVersionAccuracy struct {
+	// Accuracy Share of tagged posts with correctly detected relevance. Omitted if no posts were tagged.
+	Accuracy *float64 `json:"accuracy,omitempty"`
 
-// PostApiDetectionsListJSONRequestBody defines body for PostApiDetectionsList for application/json ContentType.
-type PostApiDetectionsListJSONRequestBody = DetectionListRequest
+	// Detections Amount of detections made with the version, including untagged ones.
+	Detections int `json:"detections"`
 
-// PutApiDetectionsTagsJSONRequestBody defines body for PutApiDetectionsTags for application/json ContentType.
-type PutApiDetectionsTagsJSONRequestBody = DetectionTagUpdateRequest
+	// F1 Harmonic mean of precision and recall. Omitted if there are no relevant tagged posts and no tagged posts were detected as relevant.
+	F1             *float64 `json:"f1,omitempty"`
+	FalseNegatives int      `json:"false_negatives"`
+	FalsePositives int      `json:"false_positives"`
 
-// PostApiProfilesJSONRequestBody defines body for PostApiProfiles for application/json ContentType.
-type PostApiProfilesJSONRequestBody = Profile
+	// Precision Omitted if no tagged posts were detected as relevant.
+	Precision *float64 `json:"precision,omitempty"`
 
-// PutApiProfilesProfileIdJSONRequestBody defines body for PutApiProfilesProfileId for application/json ContentType.
-type PutApiProfilesProfileIdJSONRequestBody = ProfileUpdate
+	// Recall Omitted if there are no relevant tagged posts.
+	Recall *float64 `json:"recall,omitempty"`
 
-// PostApiProfilesProfileIdDryJumpstartJSONRequestBody defines body for PostApiProfilesProfileIdDryJumpstart for application/json ContentType.
-type PostApiProfilesProfileIdDryJumpstartJSONRequestBody = ProfileJumpstartRequest
+	// Tagged Amount of detections with a relevancy tag.
+	Tagged        int `json:"tagged"`
+	TrueNegatives int `json:"true_negatives"`
+	TruePositives int `json:"true_positives"`
+	Version       int `json:"version"`
+}
 
-// PostApiProfilesProfileIdJumpstartJSONRequestBody defines body for PostApiProfilesProfileIdJumpstart for application/json ContentType.
-type PostApiProfilesProfileIdJumpstartJSONRequestBody = ProfileJumpstartRequest
+// SettingsVersionStatistics defines model for SettingsVersionStatistics.
+type SettingsVersionStatistics struct {
+	Detections DetectionStatistics `json:"detections"`
+	Version    int                 `json:"version"`
+}
 
-// PostApiSourcesRedditSubredditsSubredditAddProfilesJSONRequestBody defines body for PostApiSourcesRedditSubredditsSubredditAddProfiles for application/json ContentType.
-type PostApiSourcesRedditSubredditsSubredditAddProfilesJSONRequestBody PostApiSourcesRedditSubredditsSubredditAddProfilesJSONBody
+// Shadow defines model for Shadow.
+type Shadow struct {
+	Active    bool            `json:"active"`
+	CreatedAt string          `json:"created_at"`
+	Id        int             `json:"id"`
+	ProfileId int             `json:"profile_id"`
+	Settings  ProfileSettings `json:"settings"`
+	Source    *string         `json:"source,omitempty"`
+	StoppedAt *string         `json:"stopped_at,omitempty"`
+	Summary   ShadowSummary   `json:"summary"`
+}
 
-// PostApiSourcesRedditSubredditsSubredditRemoveProfilesJSONRequestBody defines body for PostApiSourcesRedditSubredditsSubredditRemoveProfiles for application/json ContentType.
-type PostApiSourcesRedditSubredditsSubredditRemoveProfilesJSONRequestBody PostApiSourcesRedditSubredditsSubredditRemoveProfilesJSONBody
+// ShadowDisagreement defines model for ShadowDisagreement.
+type ShadowDisagreement struct {
+	// IsRelevant Relevance detected by the shadow settings.
+	IsRelevant bool `json:"is_relevant"`
 
-// RequestEditorFn  is the function signature for the RequestEditor callback function
-type RequestEditorFn func(ctx context.Context, req *http.Request) error
+	// Kind relevance - the post is relevant for only one of the settings, properties - the post is relevant for both settings but extracted properties differ.
+	Kind ShadowDisagreementKind `json:"kind"`
 
-// Doer performs HTTP requests.
-//
-// The standard http.Client implements this interface.
-type HttpRequestDoer interface {
-	Do(req *http.Request) (*http.Response, error)
+	// MismatchedProperties Properties with different values in the production and shadow detections.
+	MismatchedProperties []string `json:"mismatched_properties"`
+
+	// Model Model that produced the shadow detection.
+	Model      *string   `json:"model,omitempty"`
+	Production Detection `json:"production"`
+
+	// Properties Properties extracted by the shadow settings.
+	Properties map[string]interface{} `json:"properties"`
+	ShadowedAt string                 `json:"shadowed_at"`
+	Source     string                 `json:"source"`
+	SourceId   string                 `json:"source_id"`
 }
 
-// Client which conforms to the OpenAPI3 specification for this service.
-type Client struct {
-	// The endpoint of the server conforming to this interface, with scheme,
-	// https://api.deepmap.com for example. This can contain a path relative
-	// to the server, such as https://api.deepmap.com/dev-test, and all the
-	// paths in the swagger spec will be appended to the server.
-	Server string
+// ShadowDisagreementKind relevance - the post is relevant for only one of the settings, properties - the post is relevant for both settings but extracted properties differ.
+type ShadowDisagreementKind string
 
-	// Doer for performing requests, typically a *http.Client with any
-	// customized settings, such as certificate chains.
-	Client HttpRequestDoer
+// ShadowRequest defines model for ShadowRequest.
+type ShadowRequest struct {
+	// Settings Unsaved profile settings, they are used for posts of all sources.
+	Settings DraftProfileSettings `json:"settings"`
 
-	// A list of callbacks for modifying requests which are generated before sending over
-	// the network.
-	RequestEditors []RequestEditorFn
+	// Source Shadow only posts of a source. If omitted, posts of all sources are shadowed.
+	Source *string `json:"source,omitempty"`
 }
 
-// ClientOption allows setting custom parameters during construction
-type ClientOption func(*Client) error
+// ShadowSummary defines model for ShadowSummary.
+type ShadowSummary struct {
+	// Agreed Number of posts with the same relevance and properties in both detections.
+	Agreed int `json:"agreed"`
 
-// Creates a new Client, with reasonable defaults
-func NewClient(server string, opts ...ClientOption) (*Client, error) {
-	// create a client with sane default values
-	client := Client{
-		Server: server,
-	}
-	// mutate client and add all optional params
-	for _, o := range opts {
-		if err := o(&client); err != nil {
-			return nil, err
-		}
-	}
-	// ensure the server URL always has a trailing slash
-	if !strings.HasSuffix(client.Server, "/") {
-		client.Server += "/"
-	}
-	// create httpClient, if not already present
-	if client.Client == nil {
-		client.Client = &http.Client{}
-	}
-	return &client, nil
+	// Analyzed Number of posts analyzed with the shadow settings.
+	Analyzed int `json:"analyzed"`
+
+	// Failed Number of posts that could not be analyzed with the shadow settings.
+	Failed int `json:"failed"`
+
+	// ProductionRelevant Number of paired posts detected as relevant by the production settings.
+	ProductionRelevant     int `json:"production_relevant"`
+	PropertyDisagreements  int `json:"property_disagreements"`
+	RelevanceDisagreements int `json:"relevance_disagreements"`
+
+	// ShadowRelevant Number of paired posts detected as relevant by the shadow settings.
+	ShadowRelevant int `json:"shadow_relevant"`
+
+	// Unpaired Number of analyzed posts without a production detection yet.
+	Unpaired int `json:"unpaired"`
 }
 
-// WithHTTPClient allows overriding the default Doer, which is
-// automatically created using http.Client. This is useful for tests.
-func WithHTTPClient(doer HttpRequestDoer) ClientOption {
-	return func(c *Client) error {
-		c.Client = doer
-		return nil
-	}
+// SourceSettingsVersionsFilter defines model for SourceSettingsVersionsFilter.
+type SourceSettingsVersionsFilter struct {
+	Source   *string `json:"source,omitempty"`
+	Versions []int   `json:"versions"`
 }
 
-// WithRequestEditorFn allows setting up a callback function, which will be
-// called right before sending the request. This can be used to mutate the request.
-func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
-	return func(c *Client) error {
-		c.RequestEditors = append(c.RequestEditors, fn)
-		return nil
-	}
+// SourceStatistics defines model for SourceStatistics.
+type SourceStatistics struct {
+	Detections       DetectionStatistics         `json:"detections"`
+	SettingsVersions []SettingsVersionStatistics `json:"settings_versions"`
+	Source           string                      `json:"source"`
 }
 
-// The interface specification for the client above.
-type ClientInterface interface {
-	// PostApiAnalyzeWithBody request with any body
-	PostApiAnalyzeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// SubredditScrapeState defines model for SubredditScrapeState.
+type SubredditScrapeState struct {
+	// AvailableAt Time when the next page can be scraped (the end of the cooldown).
+	AvailableAt string  `json:"available_at"`
+	LastError   *string `json:"last_error,omitempty"`
+	LastErrorAt *string `json:"last_error_at,omitempty"`
 
-	PostApiAnalyze(ctx context.Context, body PostApiAnalyzeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// LastFullScanAt Time when all available posts were scanned. Omitted if it never happened.
+	LastFullScanAt *string `json:"last_full_scan_at,omitempty"`
 
-	// PostApiDetectionsListWithBody request with any body
-	PostApiDetectionsListWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// LastScrapedAt Time of the last successfully scraped page. Omitted if the subreddit was never scraped.
+	LastScrapedAt *string `json:"last_scraped_at,omitempty"`
 
-	PostApiDetectionsList(ctx context.Context, body PostApiDetectionsListJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// NextPage Reddit's pagination token of the next page. Empty means scraping from the first page.
+	NextPage  string `json:"next_page"`
+	Subreddit string `json:"subreddit"`
+}
 
-	// PutApiDetectionsTagsWithBody request with any body
-	PutApiDetectionsTagsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// SubredditSettings defines model for SubredditSettings.
+type SubredditSettings struct {
+	Profiles  []int  `json:"profiles"`
+	Subreddit string `json:"subreddit"`
+}
 
-	PutApiDetectionsTags(ctx context.Context, body PutApiDetectionsTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// TaskActionResult defines model for TaskActionResult.
+type TaskActionResult struct {
+	// AffectedTasks Number of tasks affected by the action.
+	AffectedTasks int `json:"affected_tasks"`
+}
 
-	// GetApiProfiles request
-	GetApiProfiles(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+// TaskCancelRequest defines model for TaskCancelRequest.
+type TaskCancelRequest struct {
+	ProfileId int `json:"profile_id"`
 
-	// PostApiProfilesWithBody request with any body
-	PostApiProfilesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Source Cancel only tasks of a source. If omitted, tasks of all sources are canceled.
+	Source *string `json:"source,omitempty"`
 
-	PostApiProfiles(ctx context.Context, body PostApiProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Type Cancel only tasks of a type. If omitted, tasks of all types are canceled.
+	Type *string `json:"type,omitempty"`
+}
 
-	// DeleteApiProfilesProfileId request
-	DeleteApiProfilesProfileId(ctx context.Context, profileId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+// TaskPriorityUpdate defines model for TaskPriorityUpdate.
+type TaskPriorityUpdate struct {
+	// Priority New priority of pending tasks, tasks with higher priority are claimed first.
+	// Default priorities are 100 for manual, 50 for scheduled and 10 for jumpstart tasks.
+	Priority int `json:"priority"`
 
-	// GetApiProfilesProfileId request
-	GetApiProfilesProfileId(ctx context.Context, profileId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Source Update only tasks of a source. If omitted, tasks of all sources are updated.
+	Source *string `json:"source,omitempty"`
 
-	// PutApiProfilesProfileIdWithBody request with any body
-	PutApiProfilesProfileIdWithBody(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Type Update only tasks of a type (scheduled, manual, jumpstart, test_run or shadow). If omitted, tasks of all types are updated.
+	Type *string `json:"type,omitempty"`
+}
 
-	PutApiProfilesProfileId(ctx context.Context, profileId int, body PutApiProfilesProfileIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// TaskPriorityUpdateResult defines model for TaskPriorityUpdateResult.
+type TaskPriorityUpdateResult struct {
+	// UpdatedTasks Number of updated pending tasks.
+	UpdatedTasks int `json:"updated_tasks"`
+}
 
-	// PostApiProfilesProfileIdDryJumpstartWithBody request with any body
-	PostApiProfilesProfileIdDryJumpstartWithBody(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// TaskPurgeRequest defines model for TaskPurgeRequest.
+type TaskPurgeRequest struct {
+	// OlderThanDays Delete tasks committed more than this number of days ago.
+	OlderThanDays int `json:"older_than_days"`
+}
 
-	PostApiProfilesProfileIdDryJumpstart(ctx context.Context, profileId int, body PostApiProfilesProfileIdDryJumpstartJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// TaskSelector Selects tasks for a bulk action. Omitted fields match any task.
+type TaskSelector struct {
+	ProfileId *int    `json:"profile_id,omitempty"`
+	Source    *string `json:"source,omitempty"`
+	TaskIds   *[]int  `json:"task_ids,omitempty"`
+	Type      *string `json:"type,omitempty"`
+}
 
-	// PostApiProfilesProfileIdJumpstartWithBody request with any body
-	PostApiProfilesProfileIdJumpstartWithBody(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// TaskStatistics defines model for TaskStatistics.
+type TaskStatistics struct {
+	// Claimed Amount of tasks being processed right now.
+	Claimed int `json:"claimed"`
 
-	PostApiProfilesProfileIdJumpstart(ctx context.Context, profileId int, body PostApiProfilesProfileIdJumpstartJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Committed Amount of successfully processed tasks.
+	Committed int `json:"committed"`
 
-	// GetApiSourcesRedditSubreddits request
-	GetApiSourcesRedditSubreddits(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Failed Amount of tasks that exceeded max attempts.
+	Failed int `json:"failed"`
 
-	// PostApiSourcesRedditSubredditsSubredditAddProfilesWithBody request with any body
-	PostApiSourcesRedditSubredditsSubredditAddProfilesWithBody(ctx context.Context, subreddit string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Pending Amount of tasks waiting to be claimed.
+	Pending int `json:"pending"`
+}
 
-	PostApiSourcesRedditSubredditsSubredditAddProfiles(ctx context.Context, subreddit string, body PostApiSourcesRedditSubredditsSubredditAddProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// TaskStatus defines model for TaskStatus.
+type TaskStatus string
 
-	// PostApiSourcesRedditSubredditsSubredditRemoveProfilesWithBody request with any body
-	PostApiSourcesRedditSubredditsSubredditRemoveProfilesWithBody(ctx context.Context, subreddit string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// TestCase defines model for TestCase.
+type TestCase struct {
+	CreatedAt string `json:"created_at"`
 
-	PostApiSourcesRedditSubredditsSubredditRemoveProfiles(ctx context.Context, subreddit string, body PostApiSourcesRedditSubredditsSubredditRemoveProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// ExpectedProperties Expected values of extracted properties. Properties that are not listed are not checked.
+	ExpectedProperties map[string]interface{} `json:"expected_properties"`
+	ExpectedRelevant   bool                   `json:"expected_relevant"`
+	Id                 int                    `json:"id"`
+	ProfileId          int                    `json:"profile_id"`
+	Source             string                 `json:"source"`
+	SourceId           string                 `json:"source_id"`
+	UpdatedAt          string                 `json:"updated_at"`
+}
 
-	// GetApiSourcesRedditSubredditsWithProfile request
-	GetApiSourcesRedditSubredditsWithProfile(ctx context.Context, params *GetApiSourcesRedditSubredditsWithProfileParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+// TestCaseUpsert defines model for TestCaseUpsert.
+type TestCaseUpsert struct {
+	// ExpectedProperties Expected values of extracted properties typed as detection properties.
+	// Strings are compared ignoring case and surrounding spaces.
+	ExpectedProperties *map[string]interface{} `json:"expected_properties,omitempty"`
+	ExpectedRelevant   bool                    `json:"expected_relevant"`
+	Source             string                  `json:"source"`
+	SourceId           string                  `json:"source_id"`
+}
 
-	// GetApiStatisticsProfileId request
-	GetApiStatisticsProfileId(ctx context.Context, profileId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+// TestResult defines model for TestResult.
+type TestResult struct {
+	CompletedAt *string `json:"completed_at,omitempty"`
+
+	// Error Error of the analysis if the post could not be analyzed.
+	Error              *string                `json:"error,omitempty"`
+	ExpectedProperties map[string]interface{} `json:"expected_properties"`
+	ExpectedRelevant   bool                   `json:"expected_relevant"`
+
+	// IsRelevant Detected relevance. Omitted until the post is analyzed.
+	IsRelevant           *bool    `json:"is_relevant,omitempty"`
+	MismatchedProperties []string `json:"mismatched_properties"`
+	Model                *string  `json:"model,omitempty"`
+	Passed               bool     `json:"passed"`
+
+	// Properties Extracted property values. Omitted until the post is analyzed.
+	Properties *map[string]interface{} `json:"properties,omitempty"`
+	Source     string                  `json:"source"`
+	SourceId   string                  `json:"source_id"`
+	TestCaseId int                     `json:"test_case_id"`
 }
 
-func (c *Client) PostApiAnalyzeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiAnalyzeRequestWithBody(c.Server, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// TestResultChange defines model for TestResultChange.
+type TestResultChange struct {
+	Base     *TestResult          `json:"base,omitempty"`
+	Kind     TestResultChangeKind `json:"kind"`
+	Source   string               `json:"source"`
+	SourceId string               `json:"source_id"`
+	Target   *TestResult          `json:"target,omitempty"`
 }
 
-func (c *Client) PostApiAnalyze(ctx context.Context, body PostApiAnalyzeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiAnalyzeRequest(c.Server, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// TestResultChangeKind defines model for TestResultChange.Kind.
+type TestResultChangeKind string
+
+// TestRun defines model for TestRun.
+type TestRun struct {
+	CompletedAt *string         `json:"completed_at,omitempty"`
+	CreatedAt   string          `json:"created_at"`
+	Id          int             `json:"id"`
+	ProfileId   int             `json:"profile_id"`
+	Settings    TestRunSettings `json:"settings"`
+	Status      TestRunStatus   `json:"status"`
+
+	// Summary Results of a test run, relevant posts are positives.
+	Summary TestRunSummary `json:"summary"`
 }
 
-func (c *Client) PostApiDetectionsListWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiDetectionsListRequestWithBody(c.Server, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// TestRunStatus defines model for TestRun.Status.
+type TestRunStatus string
+
+// TestRunDiff defines model for TestRunDiff.
+type TestRunDiff struct {
+	Base TestRun `json:"base"`
+
+	// Changes Test cases with changed results, unchanged ones are omitted.
+	Changes []TestResultChange `json:"changes"`
+	Target  TestRun            `json:"target"`
 }
 
-func (c *Client) PostApiDetectionsList(ctx context.Context, body PostApiDetectionsListJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiDetectionsListRequest(c.Server, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// TestRunReport defines model for TestRunReport.
+type TestRunReport struct {
+	Results []TestResult `json:"results"`
+	Run     TestRun      `json:"run"`
 }
 
-func (c *Client) PutApiDetectionsTagsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutApiDetectionsTagsRequestWithBody(c.Server, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// TestRunRequest Draft settings to test. If omitted, the saved profile settings are used.
+type TestRunRequest struct {
+	// Settings Unsaved profile settings, they are used for posts of all sources.
+	Settings *DraftProfileSettings `json:"settings,omitempty"`
 }
 
-func (c *Client) PutApiDetectionsTags(ctx context.Context, body PutApiDetectionsTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutApiDetectionsTagsRequest(c.Server, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// TestRunSettings defines model for TestRunSettings.
+type TestRunSettings struct {
+	DefaultSettings *ProfileSettings `json:"default_settings,omitempty"`
+
+	// Draft Whether the settings were not saved to the profile.
+	Draft           bool                        `json:"draft"`
+	SourcesSettings *map[string]ProfileSettings `json:"sources_settings,omitempty"`
 }
 
-func (c *Client) GetApiProfiles(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetApiProfilesRequest(c.Server)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// TestRunSummary Results of a test run, relevant posts are positives.
+type TestRunSummary struct {
+	// Accuracy Share of analyzed posts with expected relevance. Omitted if no posts were analyzed.
+	Accuracy *float64 `json:"accuracy,omitempty"`
+
+	// Failed Number of test cases that could not be analyzed.
+	Failed         int `json:"failed"`
+	FalseNegatives int `json:"false_negatives"`
+	FalsePositives int `json:"false_positives"`
+
+	// Passed Number of test cases with expected relevance and property values.
+	Passed  int `json:"passed"`
+	Pending int `json:"pending"`
+
+	// Precision Omitted if no posts were detected as relevant.
+	Precision         *float64 `json:"precision,omitempty"`
+	PropertiesChecked int      `json:"properties_checked"`
+	PropertiesMatched int      `json:"properties_matched"`
+
+	// PropertyAccuracy Share of expected property values that were extracted. Omitted if none were checked.
+	PropertyAccuracy *float64 `json:"property_accuracy,omitempty"`
+
+	// Recall Omitted if there are no relevant posts.
+	Recall        *float64 `json:"recall,omitempty"`
+	Total         int      `json:"total"`
+	TrueNegatives int      `json:"true_negatives"`
+	TruePositives int      `json:"true_positives"`
 }
 
-func (c *Client) PostApiProfilesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiProfilesRequestWithBody(c.Server, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// Webhook defines model for Webhook.
+type Webhook struct {
+	Active    bool   `json:"active"`
+	CreatedAt string `json:"created_at"`
+	Id        int    `json:"id"`
+	ProfileId int    `json:"profile_id"`
+
+	// Secret Key of HMAC-SHA256 payload signatures, returned only on creation.
+	// Each request has X-Scout-Timestamp header and X-Scout-Signature header
+	// with "sha256=" followed by a hex encoded HMAC of "<timestamp>.<body>".
+	Secret    *string `json:"secret,omitempty"`
+	UpdatedAt string  `json:"updated_at"`
+	Url       string  `json:"url"`
 }
 
-func (c *Client) PostApiProfiles(ctx context.Context, body PostApiProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiProfilesRequest(c.Server, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// WebhookCreateRequest defines model for WebhookCreateRequest.
+type WebhookCreateRequest struct {
+	// Active Whether events are delivered to the webhook. Defaults to true.
+	Active *bool `json:"active,omitempty"`
+
+	// Secret Key of payload signatures. If omitted, a random one is generated.
+	Secret *string `json:"secret,omitempty"`
+
+	// Url Absolute http(s) URL receiving POST requests with events.
+	Url string `json:"url"`
 }
 
-func (c *Client) DeleteApiProfilesProfileId(ctx context.Context, profileId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteApiProfilesProfileIdRequest(c.Server, profileId)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// WebhookDeliveryAttempt defines model for WebhookDeliveryAttempt.
+type WebhookDeliveryAttempt struct {
+	Attempt     int     `json:"attempt"`
+	CreatedAt   string  `json:"created_at"`
+	DetectionId int     `json:"detection_id"`
+	DurationMs  int     `json:"duration_ms"`
+	Error       *string `json:"error,omitempty"`
+	Event       string  `json:"event"`
+
+	// EventId Id of the outbox event, it is sent in X-Scout-Delivery header.
+	EventId int `json:"event_id"`
+	Id      int `json:"id"`
+
+	// Status Current status of the event.
+	Status WebhookDeliveryAttemptStatus `json:"status"`
+
+	// StatusCode Status code of the response. Omitted if the request failed.
+	StatusCode *int `json:"status_code,omitempty"`
+	WebhookId  int  `json:"webhook_id"`
 }
 
-func (c *Client) GetApiProfilesProfileId(ctx context.Context, profileId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetApiProfilesProfileIdRequest(c.Server, profileId)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// WebhookDeliveryAttemptStatus Current status of the event.
+type WebhookDeliveryAttemptStatus string
+
+// WebhookUpdate defines model for WebhookUpdate.
+type WebhookUpdate struct {
+	Active *bool   `json:"active,omitempty"`
+	Secret *string `json:"secret,omitempty"`
+	Url    *string `json:"url,omitempty"`
 }
 
-func (c *Client) PutApiProfilesProfileIdWithBody(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutApiProfilesProfileIdRequestWithBody(c.Server, profileId, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// GetApiProfilesProfileIdSettingsVersionsParams defines parameters for GetApiProfilesProfileIdSettingsVersions.
+type GetApiProfilesProfileIdSettingsVersionsParams struct {
+	// Source Settings source. If omitted, versions of default settings are returned.
+	Source *string `form:"source,omitempty" json:"source,omitempty"`
 }
 
-func (c *Client) PutApiProfilesProfileId(ctx context.Context, profileId int, body PutApiProfilesProfileIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutApiProfilesProfileIdRequest(c.Server, profileId, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// GetApiProfilesProfileIdSettingsVersionsDiffParams defines parameters for GetApiProfilesProfileIdSettingsVersionsDiff.
+type GetApiProfilesProfileIdSettingsVersionsDiffParams struct {
+	// Source Settings source. If omitted, versions of default settings are compared.
+	Source *string `form:"source,omitempty" json:"source,omitempty"`
+	From   int     `form:"from" json:"from"`
+	To     int     `form:"to" json:"to"`
 }
 
-func (c *Client) PostApiProfilesProfileIdDryJumpstartWithBody(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiProfilesProfileIdDryJumpstartRequestWithBody(c.Server, profileId, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// GetApiProfilesProfileIdShadowsParams defines parameters for GetApiProfilesProfileIdShadows.
+type GetApiProfilesProfileIdShadowsParams struct {
+	// Limit Maximum number of shadows. Defaults to 20.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-func (c *Client) PostApiProfilesProfileIdDryJumpstart(ctx context.Context, profileId int, body PostApiProfilesProfileIdDryJumpstartJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiProfilesProfileIdDryJumpstartRequest(c.Server, profileId, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// GetApiProfilesProfileIdShadowsShadowIdDisagreementsParams defines parameters for GetApiProfilesProfileIdShadowsShadowIdDisagreements.
+type GetApiProfilesProfileIdShadowsShadowIdDisagreementsParams struct {
+	// Limit Maximum number of posts. Defaults to 100.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-func (c *Client) PostApiProfilesProfileIdJumpstartWithBody(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiProfilesProfileIdJumpstartRequestWithBody(c.Server, profileId, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// GetApiProfilesProfileIdTestRunsParams defines parameters for GetApiProfilesProfileIdTestRuns.
+type GetApiProfilesProfileIdTestRunsParams struct {
+	// Limit Maximum number of runs. Defaults to 20.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-func (c *Client) PostApiProfilesProfileIdJumpstart(ctx context.Context, profileId int, body PostApiProfilesProfileIdJumpstartJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiProfilesProfileIdJumpstartRequest(c.Server, profileId, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// GetApiProfilesProfileIdTestRunsTestRunIdDiffParams defines parameters for GetApiProfilesProfileIdTestRunsTestRunIdDiff.
+type GetApiProfilesProfileIdTestRunsTestRunIdDiffParams struct {
+	// Base Id of the base test run.
+	Base int `form:"base" json:"base"`
 }
 
-func (c *Client) GetApiSourcesRedditSubreddits(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetApiSourcesRedditSubredditsRequest(c.Server)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// PostApiSourcesHackernewsFeedsFeedAddProfilesJSONBody defines parameters for PostApiSourcesHackernewsFeedsFeedAddProfiles.
+type PostApiSourcesHackernewsFeedsFeedAddProfilesJSONBody struct {
+	ProfileIds []int `json:"profile_ids"`
 }
 
-func (c *Client) PostApiSourcesRedditSubredditsSubredditAddProfilesWithBody(ctx context.Context, subreddit string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiSourcesRedditSubredditsSubredditAddProfilesRequestWithBody(c.Server, subreddit, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// PostApiSourcesHackernewsFeedsFeedAddProfilesParamsFeed defines parameters for PostApiSourcesHackernewsFeedsFeedAddProfiles.
+type PostApiSourcesHackernewsFeedsFeedAddProfilesParamsFeed string
+
+// PostApiSourcesHackernewsFeedsFeedRemoveProfilesJSONBody defines parameters for PostApiSourcesHackernewsFeedsFeedRemoveProfiles.
+type PostApiSourcesHackernewsFeedsFeedRemoveProfilesJSONBody struct {
+	ProfileIds []int `json:"profile_ids"`
 }
 
-func (c *Client) PostApiSourcesRedditSubredditsSubredditAddProfiles(ctx context.Context, subreddit string, body PostApiSourcesRedditSubredditsSubredditAddProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiSourcesRedditSubredditsSubredditAddProfilesRequest(c.Server, subreddit, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// PostApiSourcesHackernewsFeedsFeedRemoveProfilesParamsFeed defines parameters for PostApiSourcesHackernewsFeedsFeedRemoveProfiles.
+type PostApiSourcesHackernewsFeedsFeedRemoveProfilesParamsFeed string
+
+// GetApiSourcesHackernewsFeedsWithProfileParams defines parameters for GetApiSourcesHackernewsFeedsWithProfile.
+type GetApiSourcesHackernewsFeedsWithProfileParams struct {
+	ProfileId int `form:"profile_id" json:"profile_id"`
 }
 
-func (c *Client) PostApiSourcesRedditSubredditsSubredditRemoveProfilesWithBody(ctx context.Context, subreddit string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiSourcesRedditSubredditsSubredditRemoveProfilesRequestWithBody(c.Server, subreddit, contentType, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// PostApiSourcesRedditSubredditsSubredditAddProfilesJSONBody defines parameters for PostApiSourcesRedditSubredditsSubredditAddProfiles.
+type PostApiSourcesRedditSubredditsSubredditAddProfilesJSONBody struct {
+	ProfileIds []int `json:"profile_ids"`
 }
 
-func (c *Client) PostApiSourcesRedditSubredditsSubredditRemoveProfiles(ctx context.Context, subreddit string, body PostApiSourcesRedditSubredditsSubredditRemoveProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostApiSourcesRedditSubredditsSubredditRemoveProfilesRequest(c.Server, subreddit, body)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
-
-func (c *Client) GetApiSourcesRedditSubredditsWithProfile(ctx context.Context, params *GetApiSourcesRedditSubredditsWithProfileParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetApiSourcesRedditSubredditsWithProfileRequest(c.Server, params)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// PostApiSourcesRedditSubredditsSubredditRemoveProfilesJSONBody defines parameters for PostApiSourcesRedditSubredditsSubredditRemoveProfiles.
+type PostApiSourcesRedditSubredditsSubredditRemoveProfilesJSONBody struct {
+	ProfileIds []int `json:"profile_ids"`
 }
 
-func (c *Client) GetApiStatisticsProfileId(ctx context.Context, profileId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetApiStatisticsProfileIdRequest(c.Server, profileId)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
+// GetApiSourcesRedditSubredditsWithProfileParams defines parameters for GetApiSourcesRedditSubredditsWithProfile.
+type GetApiSourcesRedditSubredditsWithProfileParams struct {
+	ProfileId int `form:"profile_id" json:"profile_id"`
 }
 
-// NewPostApiAnalyzeRequest calls the generic PostApiAnalyze builder with application/json body
-func NewPostApiAnalyzeRequest(server string, body PostApiAnalyzeJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostApiAnalyzeRequestWithBody(server, "application/json", bodyReader)
+// GetApiSourcesRssFeedsWithProfileParams defines parameters for GetApiSourcesRssFeedsWithProfile.
+type GetApiSourcesRssFeedsWithProfileParams struct {
+	ProfileId int `form:"profile_id" json:"profile_id"`
 }
 
-// NewPostApiAnalyzeRequestWithBody generates requests for PostApiAnalyze with any type of body
-func NewPostApiAnalyzeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+// GetApiStatisticsProfileIdAccuracyParams defines parameters for GetApiStatisticsProfileIdAccuracy.
+type GetApiStatisticsProfileIdAccuracyParams struct {
+	Source string `form:"source" json:"source"`
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// Since Only detections created at or after this time are measured.
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
 
-	operationPath := fmt.Sprintf("/api/analyze")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// Until Only detections created before this time are measured.
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// GetApiTasksParams defines parameters for GetApiTasks.
+type GetApiTasksParams struct {
+	Status    *TaskStatus `form:"status,omitempty" json:"status,omitempty"`
+	ProfileId *int        `form:"profile_id,omitempty" json:"profile_id,omitempty"`
+	Source    *string     `form:"source,omitempty" json:"source,omitempty"`
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	// Type Task type (scheduled, manual, jumpstart, test_run or shadow).
+	Type *string `form:"type,omitempty" json:"type,omitempty"`
 
-	req.Header.Add("Content-Type", contentType)
+	// LastSeenId Id of the last seen task, only older tasks are returned.
+	LastSeenId *int `form:"last_seen_id,omitempty" json:"last_seen_id,omitempty"`
 
-	return req, nil
+	// Limit Maximum number of tasks to return. Defaults to 50.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-// NewPostApiDetectionsListRequest calls the generic PostApiDetectionsList builder with application/json body
-func NewPostApiDetectionsListRequest(server string, body PostApiDetectionsListJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostApiDetectionsListRequestWithBody(server, "application/json", bodyReader)
+// GetApiWebhooksWebhookIdDeliveriesParams defines parameters for GetApiWebhooksWebhookIdDeliveries.
+type GetApiWebhooksWebhookIdDeliveriesParams struct {
+	// Limit Maximum number of attempts to return. Defaults to 50.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-// NewPostApiDetectionsListRequestWithBody generates requests for PostApiDetectionsList with any type of body
-func NewPostApiDetectionsListRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+// PostApiAnalyzeJSONRequestBody defines body for PostApiAnalyze for application/json ContentType.
+type PostApiAnalyzeJSONRequestBody = AnalyzeRequest
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+// PostApiDetectionsListJSONRequestBody defines body for PostApiDetectionsList for application/json ContentType.
+type PostApiDetectionsListJSONRequestBody = DetectionListRequest
 
-	operationPath := fmt.Sprintf("/api/detections/list")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// PutApiDetectionsTagsJSONRequestBody defines body for PutApiDetectionsTags for application/json ContentType.
+type PutApiDetectionsTagsJSONRequestBody = DetectionTagUpdateRequest
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// PostApiProfilesJSONRequestBody defines body for PostApiProfiles for application/json ContentType.
+type PostApiProfilesJSONRequestBody = Profile
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+// PutApiProfilesProfileIdJSONRequestBody defines body for PutApiProfilesProfileId for application/json ContentType.
+type PutApiProfilesProfileIdJSONRequestBody = ProfileUpdate
 
-	req.Header.Add("Content-Type", contentType)
+// PostApiProfilesProfileIdAnalyzeJSONRequestBody defines body for PostApiProfilesProfileIdAnalyze for application/json ContentType.
+type PostApiProfilesProfileIdAnalyzeJSONRequestBody = ProfileAnalyzeRequest
 
-	return req, nil
-}
+// PostApiProfilesProfileIdDryJumpstartJSONRequestBody defines body for PostApiProfilesProfileIdDryJumpstart for application/json ContentType.
+type PostApiProfilesProfileIdDryJumpstartJSONRequestBody = ProfileJumpstartRequest
 
-// NewPutApiDetectionsTagsRequest calls the generic PutApiDetectionsTags builder with application/json body
-func NewPutApiDetectionsTagsRequest(server string, body PutApiDetectionsTagsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutApiDetectionsTagsRequestWithBody(server, "application/json", bodyReader)
-}
+// PostApiProfilesProfileIdJumpstartJSONRequestBody defines body for PostApiProfilesProfileIdJumpstart for application/json ContentType.
+type PostApiProfilesProfileIdJumpstartJSONRequestBody = ProfileJumpstartRequest
 
-// NewPutApiDetectionsTagsRequestWithBody generates requests for PutApiDetectionsTags with any type of body
-func NewPutApiDetectionsTagsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+// PostApiProfilesProfileIdSettingsVersionsRollbackJSONRequestBody defines body for PostApiProfilesProfileIdSettingsVersionsRollback for application/json ContentType.
+type PostApiProfilesProfileIdSettingsVersionsRollbackJSONRequestBody = ProfileSettingsRollbackRequest
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+// PostApiProfilesProfileIdShadowsJSONRequestBody defines body for PostApiProfilesProfileIdShadows for application/json ContentType.
+type PostApiProfilesProfileIdShadowsJSONRequestBody = ShadowRequest
 
-	operationPath := fmt.Sprintf("/api/detections/tags")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// PutApiProfilesProfileIdTasksPriorityJSONRequestBody defines body for PutApiProfilesProfileIdTasksPriority for application/json ContentType.
+type PutApiProfilesProfileIdTasksPriorityJSONRequestBody = TaskPriorityUpdate
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// PutApiProfilesProfileIdTestCasesJSONRequestBody defines body for PutApiProfilesProfileIdTestCases for application/json ContentType.
+type PutApiProfilesProfileIdTestCasesJSONRequestBody = TestCaseUpsert
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+// PostApiProfilesProfileIdTestRunsJSONRequestBody defines body for PostApiProfilesProfileIdTestRuns for application/json ContentType.
+type PostApiProfilesProfileIdTestRunsJSONRequestBody = TestRunRequest
 
-	req.Header.Add("Content-Type", contentType)
+// PostApiProfilesProfileIdWebhooksJSONRequestBody defines body for PostApiProfilesProfileIdWebhooks for application/json ContentType.
+type PostApiProfilesProfileIdWebhooksJSONRequestBody = WebhookCreateRequest
 
-	return req, nil
-}
+// PostApiSourcesHackernewsFeedsFeedAddProfilesJSONRequestBody defines body for PostApiSourcesHackernewsFeedsFeedAddProfiles for application/json ContentType.
+type PostApiSourcesHackernewsFeedsFeedAddProfilesJSONRequestBody PostApiSourcesHackernewsFeedsFeedAddProfilesJSONBody
 
-// NewGetApiProfilesRequest generates requests for GetApiProfiles
-func NewGetApiProfilesRequest(server string) (*http.Request, error) {
-	var err error
+// PostApiSourcesHackernewsFeedsFeedRemoveProfilesJSONRequestBody defines body for PostApiSourcesHackernewsFeedsFeedRemoveProfiles for application/json ContentType.
+type PostApiSourcesHackernewsFeedsFeedRemoveProfilesJSONRequestBody PostApiSourcesHackernewsFeedsFeedRemoveProfilesJSONBody
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+// PostApiSourcesRedditSubredditsSubredditAddProfilesJSONRequestBody defines body for PostApiSourcesRedditSubredditsSubredditAddProfiles for application/json ContentType.
+type PostApiSourcesRedditSubredditsSubredditAddProfilesJSONRequestBody PostApiSourcesRedditSubredditsSubredditAddProfilesJSONBody
 
-	operationPath := fmt.Sprintf("/api/profiles")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// PostApiSourcesRedditSubredditsSubredditRemoveProfilesJSONRequestBody defines body for PostApiSourcesRedditSubredditsSubredditRemoveProfiles for application/json ContentType.
+type PostApiSourcesRedditSubredditsSubredditRemoveProfilesJSONRequestBody PostApiSourcesRedditSubredditsSubredditRemoveProfilesJSONBody
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// PostApiSourcesRssFeedsAddProfilesJSONRequestBody defines body for PostApiSourcesRssFeedsAddProfiles for application/json ContentType.
+type PostApiSourcesRssFeedsAddProfilesJSONRequestBody = RSSFeedProfilesRequest
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+// PostApiSourcesRssFeedsRemoveProfilesJSONRequestBody defines body for PostApiSourcesRssFeedsRemoveProfiles for application/json ContentType.
+type PostApiSourcesRssFeedsRemoveProfilesJSONRequestBody = RSSFeedProfilesRequest
 
-	return req, nil
-}
+// PostApiTasksCancelJSONRequestBody defines body for PostApiTasksCancel for application/json ContentType.
+type PostApiTasksCancelJSONRequestBody = TaskCancelRequest
 
-// NewPostApiProfilesRequest calls the generic PostApiProfiles builder with application/json body
-func NewPostApiProfilesRequest(server string, body PostApiProfilesJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostApiProfilesRequestWithBody(server, "application/json", bodyReader)
-}
+// PostApiTasksPurgeJSONRequestBody defines body for PostApiTasksPurge for application/json ContentType.
+type PostApiTasksPurgeJSONRequestBody = TaskPurgeRequest
 
-// NewPostApiProfilesRequestWithBody generates requests for PostApiProfiles with any type of body
-func NewPostApiProfilesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+// PostApiTasksRetryJSONRequestBody defines body for PostApiTasksRetry for application/json ContentType.
+type PostApiTasksRetryJSONRequestBody = TaskSelector
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+// PutApiWebhooksWebhookIdJSONRequestBody defines body for PutApiWebhooksWebhookId for application/json ContentType.
+type PutApiWebhooksWebhookIdJSONRequestBody = WebhookUpdate
 
-	operationPath := fmt.Sprintf("/api/profiles")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
 
-	req.Header.Add("Content-Type", contentType)
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
 
-	return req, nil
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
 }
 
-// NewDeleteApiProfilesProfileIdRequest generates requests for DeleteApiProfilesProfileId
-func NewDeleteApiProfilesProfileIdRequest(server string, profileId int) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileId", runtime.ParamLocationPath, profileId)
-	if err != nil {
-		return nil, err
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
 	}
-
-	operationPath := fmt.Sprintf("/api/profiles/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
 	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
 	}
+	return &client, nil
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
 	}
+}
 
-	return req, nil
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
 }
 
-// NewGetApiProfilesProfileIdRequest generates requests for GetApiProfilesProfileId
-func NewGetApiProfilesProfileIdRequest(server string, profileId int) (*http.Request, error) {
-	var err error
+// The interface specification for the client above.
+type ClientInterface interface {
+	// PostApiAnalyzeWithBody request with any body
+	PostApiAnalyzeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	var pathParam0 string
+	PostApiAnalyze(ctx context.Context, body PostApiAnalyzeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileId", runtime.ParamLocationPath, profileId)
-	if err != nil {
-		return nil, err
-	}
+	// GetApiAnalyzersLimiters request
+	GetApiAnalyzersLimiters(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// PostApiDetectionsListWithBody request with any body
+	PostApiDetectionsListWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	operationPath := fmt.Sprintf("/api/profiles/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	PostApiDetectionsList(ctx context.Context, body PostApiDetectionsListJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	// PutApiDetectionsTagsWithBody request with any body
+	PutApiDetectionsTagsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	PutApiDetectionsTags(ctx context.Context, body PutApiDetectionsTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	return req, nil
-}
+	// GetApiProfiles request
+	GetApiProfiles(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-// NewPutApiProfilesProfileIdRequest calls the generic PutApiProfilesProfileId builder with application/json body
-func NewPutApiProfilesProfileIdRequest(server string, profileId int, body PutApiProfilesProfileIdJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutApiProfilesProfileIdRequestWithBody(server, profileId, "application/json", bodyReader)
-}
+	// PostApiProfilesWithBody request with any body
+	PostApiProfilesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-// NewPutApiProfilesProfileIdRequestWithBody generates requests for PutApiProfilesProfileId with any type of body
-func NewPutApiProfilesProfileIdRequestWithBody(server string, profileId int, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	PostApiProfiles(ctx context.Context, body PostApiProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	var pathParam0 string
+	// DeleteApiProfilesProfileId request
+	DeleteApiProfilesProfileId(ctx context.Context, profileId int, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileId", runtime.ParamLocationPath, profileId)
-	if err != nil {
-		return nil, err
-	}
+	// GetApiProfilesProfileId request
+	GetApiProfilesProfileId(ctx context.Context, profileId int, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// PutApiProfilesProfileIdWithBody request with any body
+	PutApiProfilesProfileIdWithBody(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	operationPath := fmt.Sprintf("/api/profiles/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	PutApiProfilesProfileId(ctx context.Context, profileId int, body PutApiProfilesProfileIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	// PostApiProfilesProfileIdAnalyzeWithBody request with any body
+	PostApiProfilesProfileIdAnalyzeWithBody(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	PostApiProfilesProfileIdAnalyze(ctx context.Context, profileId int, body PostApiProfilesProfileIdAnalyzeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	req.Header.Add("Content-Type", contentType)
+	// PostApiProfilesProfileIdDryJumpstartWithBody request with any body
+	PostApiProfilesProfileIdDryJumpstartWithBody(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	return req, nil
-}
+	PostApiProfilesProfileIdDryJumpstart(ctx context.Context, profileId int, body PostApiProfilesProfileIdDryJumpstartJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-// NewPostApiProfilesProfileIdDryJumpstartRequest calls the generic PostApiProfilesProfileIdDryJumpstart builder with application/json body
-func NewPostApiProfilesProfileIdDryJumpstartRequest(server string, profileId int, body PostApiProfilesProfileIdDryJumpstartJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostApiProfilesProfileIdDryJumpstartRequestWithBody(server, profileId, "application/json", bodyReader)
-}
+	// PostApiProfilesProfileIdJumpstartWithBody request with any body
+	PostApiProfilesProfileIdJumpstartWithBody(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-// NewPostApiProfilesProfileIdDryJumpstartRequestWithBody generates requests for PostApiProfilesProfileIdDryJumpstart with any type of body
-func NewPostApiProfilesProfileIdDryJumpstartRequestWithBody(server string, profileId int, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	PostApiProfilesProfileIdJumpstart(ctx context.Context, profileId int, body PostApiProfilesProfileIdJumpstartJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	var pathParam0 string
+	// GetApiProfilesProfileIdSettingsVersions request
+	GetApiProfilesProfileIdSettingsVersions(ctx context.Context, profileId int, params *GetApiProfilesProfileIdSettingsVersionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileId", runtime.ParamLocationPath, profileId)
-	if err != nil {
-		return nil, err
-	}
+	// GetApiProfilesProfileIdSettingsVersionsDiff request
+	GetApiProfilesProfileIdSettingsVersionsDiff(ctx context.Context, profileId int, params *GetApiProfilesProfileIdSettingsVersionsDiffParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// PostApiProfilesProfileIdSettingsVersionsRollbackWithBody request with any body
+	PostApiProfilesProfileIdSettingsVersionsRollbackWithBody(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	operationPath := fmt.Sprintf("/api/profiles/%s/dry_jumpstart", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	PostApiProfilesProfileIdSettingsVersionsRollback(ctx context.Context, profileId int, body PostApiProfilesProfileIdSettingsVersionsRollbackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	// GetApiProfilesProfileIdShadows request
+	GetApiProfilesProfileIdShadows(ctx context.Context, profileId int, params *GetApiProfilesProfileIdShadowsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	// PostApiProfilesProfileIdShadowsWithBody request with any body
+	PostApiProfilesProfileIdShadowsWithBody(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	req.Header.Add("Content-Type", contentType)
+	PostApiProfilesProfileIdShadows(ctx context.Context, profileId int, body PostApiProfilesProfileIdShadowsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	return req, nil
-}
+	// GetApiProfilesProfileIdShadowsShadowId request
+	GetApiProfilesProfileIdShadowsShadowId(ctx context.Context, profileId int, shadowId int, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-// NewPostApiProfilesProfileIdJumpstartRequest calls the generic PostApiProfilesProfileIdJumpstart builder with application/json body
-func NewPostApiProfilesProfileIdJumpstartRequest(server string, profileId int, body PostApiProfilesProfileIdJumpstartJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostApiProfilesProfileIdJumpstartRequestWithBody(server, profileId, "application/json", bodyReader)
-}
+	// GetApiProfilesProfileIdShadowsShadowIdDisagreements request
+	GetApiProfilesProfileIdShadowsShadowIdDisagreements(ctx context.Context, profileId int, shadowId int, params *GetApiProfilesProfileIdShadowsShadowIdDisagreementsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-// NewPostApiProfilesProfileIdJumpstartRequestWithBody generates requests for PostApiProfilesProfileIdJumpstart with any type of body
-func NewPostApiProfilesProfileIdJumpstartRequestWithBody(server string, profileId int, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	// PostApiProfilesProfileIdShadowsShadowIdStop request
+	PostApiProfilesProfileIdShadowsShadowIdStop(ctx context.Context, profileId int, shadowId int, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	var pathParam0 string
+	// PutApiProfilesProfileIdTasksPriorityWithBody request with any body
+	PutApiProfilesProfileIdTasksPriorityWithBody(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileId", runtime.ParamLocationPath, profileId)
-	if err != nil {
-		return nil, err
-	}
+	PutApiProfilesProfileIdTasksPriority(ctx context.Context, profileId int, body PutApiProfilesProfileIdTasksPriorityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// GetApiProfilesProfileIdTestCases request
+	GetApiProfilesProfileIdTestCases(ctx context.Context, profileId int, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	operationPath := fmt.Sprintf("/api/profiles/%s/jumpstart", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// PutApiProfilesProfileIdTestCasesWithBody request with any body
+	PutApiProfilesProfileIdTestCasesWithBody(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	PutApiProfilesProfileIdTestCases(ctx context.Context, profileId int, body PutApiProfilesProfileIdTestCasesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	// DeleteApiProfilesProfileIdTestCasesTestCaseId request
+	DeleteApiProfilesProfileIdTestCasesTestCaseId(ctx context.Context, profileId int, testCaseId int, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	req.Header.Add("Content-Type", contentType)
+	// GetApiProfilesProfileIdTestRuns request
+	GetApiProfilesProfileIdTestRuns(ctx context.Context, profileId int, params *GetApiProfilesProfileIdTestRunsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	return req, nil
-}
+	// PostApiProfilesProfileIdTestRunsWithBody request with any body
+	PostApiProfilesProfileIdTestRunsWithBody(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-// NewGetApiSourcesRedditSubredditsRequest generates requests for GetApiSourcesRedditSubreddits
-func NewGetApiSourcesRedditSubredditsRequest(server string) (*http.Request, error) {
-	var err error
+	PostApiProfilesProfileIdTestRuns(ctx context.Context, profileId int, body PostApiProfilesProfileIdTestRunsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	// GetApiProfilesProfileIdTestRunsTestRunId request
+	GetApiProfilesProfileIdTestRunsTestRunId(ctx context.Context, profileId int, testRunId int, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	operationPath := fmt.Sprintf("/api/sources/reddit/subreddits")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// GetApiProfilesProfileIdTestRunsTestRunIdDiff request
+	GetApiProfilesProfileIdTestRunsTestRunIdDiff(ctx context.Context, profileId int, testRunId int, params *GetApiProfilesProfileIdTestRunsTestRunIdDiffParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	// GetApiProfilesProfileIdWebhooks request
+	GetApiProfilesProfileIdWebhooks(ctx context.Context, profileId int, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	// PostApiProfilesProfileIdWebhooksWithBody request with any body
+	PostApiProfilesProfileIdWebhooksWithBody(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	return req, nil
-}
+	PostApiProfilesProfileIdWebhooks(ctx context.Context, profileId int, body PostApiProfilesProfileIdWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-// NewPostApiSourcesRedditSubredditsSubredditAddProfilesRequest calls the generic PostApiSourcesRedditSubredditsSubredditAddProfiles builder with application/json body
-func NewPostApiSourcesRedditSubredditsSubredditAddProfilesRequest(server string, subreddit string, body PostApiSourcesRedditSubredditsSubredditAddProfilesJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostApiSourcesRedditSubredditsSubredditAddProfilesRequestWithBody(server, subreddit, "application/json", bodyReader)
-}
+	// GetApiSourcesHackernewsFeeds request
+	GetApiSourcesHackernewsFeeds(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-// NewPostApiSourcesRedditSubredditsSubredditAddProfilesRequestWithBody generates requests for PostApiSourcesRedditSubredditsSubredditAddProfiles with any type of body
-func NewPostApiSourcesRedditSubredditsSubredditAddProfilesRequestWithBody(server string, subreddit string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	// PostApiSourcesHackernewsFeedsFeedAddProfilesWithBody request with any body
+	PostApiSourcesHackernewsFeedsFeedAddProfilesWithBody(ctx context.Context, feed PostApiSourcesHackernewsFeedsFeedAddProfilesParamsFeed, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	var pathParam0 string
+	PostApiSourcesHackernewsFeedsFeedAddProfiles(ctx context.Context, feed PostApiSourcesHackernewsFeedsFeedAddProfilesParamsFeed, body PostApiSourcesHackernewsFeedsFeedAddProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "subreddit", runtime.ParamLocationPath, subreddit)
-	if err != nil {
-		return nil, err
-	}
+	// PostApiSourcesHackernewsFeedsFeedRemoveProfilesWithBody request with any body
+	PostApiSourcesHackernewsFeedsFeedRemoveProfilesWithBody(ctx context.Context, feed PostApiSourcesHackernewsFeedsFeedRemoveProfilesParamsFeed, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	PostApiSourcesHackernewsFeedsFeedRemoveProfiles(ctx context.Context, feed PostApiSourcesHackernewsFeedsFeedRemoveProfilesParamsFeed, body PostApiSourcesHackernewsFeedsFeedRemoveProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	operationPath := fmt.Sprintf("/api/sources/reddit/subreddits/%s/add_profiles", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// GetApiSourcesHackernewsFeedsWithProfile request
+	GetApiSourcesHackernewsFeedsWithProfile(ctx context.Context, params *GetApiSourcesHackernewsFeedsWithProfileParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	// GetApiSourcesRedditScrapeState request
+	GetApiSourcesRedditScrapeState(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	// GetApiSourcesRedditSubreddits request
+	GetApiSourcesRedditSubreddits(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	req.Header.Add("Content-Type", contentType)
+	// PostApiSourcesRedditSubredditsSubredditAddProfilesWithBody request with any body
+	PostApiSourcesRedditSubredditsSubredditAddProfilesWithBody(ctx context.Context, subreddit string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	return req, nil
-}
+	PostApiSourcesRedditSubredditsSubredditAddProfiles(ctx context.Context, subreddit string, body PostApiSourcesRedditSubredditsSubredditAddProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-// NewPostApiSourcesRedditSubredditsSubredditRemoveProfilesRequest calls the generic PostApiSourcesRedditSubredditsSubredditRemoveProfiles builder with application/json body
-func NewPostApiSourcesRedditSubredditsSubredditRemoveProfilesRequest(server string, subreddit string, body PostApiSourcesRedditSubredditsSubredditRemoveProfilesJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	// PostApiSourcesRedditSubredditsSubredditRemoveProfilesWithBody request with any body
+	PostApiSourcesRedditSubredditsSubredditRemoveProfilesWithBody(ctx context.Context, subreddit string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiSourcesRedditSubredditsSubredditRemoveProfiles(ctx context.Context, subreddit string, body PostApiSourcesRedditSubredditsSubredditRemoveProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiSourcesRedditSubredditsWithProfile request
+	GetApiSourcesRedditSubredditsWithProfile(ctx context.Context, params *GetApiSourcesRedditSubredditsWithProfileParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiSourcesRssFeeds request
+	GetApiSourcesRssFeeds(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiSourcesRssFeedsAddProfilesWithBody request with any body
+	PostApiSourcesRssFeedsAddProfilesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiSourcesRssFeedsAddProfiles(ctx context.Context, body PostApiSourcesRssFeedsAddProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiSourcesRssFeedsRemoveProfilesWithBody request with any body
+	PostApiSourcesRssFeedsRemoveProfilesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiSourcesRssFeedsRemoveProfiles(ctx context.Context, body PostApiSourcesRssFeedsRemoveProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiSourcesRssFeedsWithProfile request
+	GetApiSourcesRssFeedsWithProfile(ctx context.Context, params *GetApiSourcesRssFeedsWithProfileParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiStatisticsProfileId request
+	GetApiStatisticsProfileId(ctx context.Context, profileId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiStatisticsProfileIdAccuracy request
+	GetApiStatisticsProfileIdAccuracy(ctx context.Context, profileId int, params *GetApiStatisticsProfileIdAccuracyParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiTasks request
+	GetApiTasks(ctx context.Context, params *GetApiTasksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiTasksCancelWithBody request with any body
+	PostApiTasksCancelWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiTasksCancel(ctx context.Context, body PostApiTasksCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiTasksPurgeWithBody request with any body
+	PostApiTasksPurgeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiTasksPurge(ctx context.Context, body PostApiTasksPurgeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostApiTasksRetryWithBody request with any body
+	PostApiTasksRetryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostApiTasksRetry(ctx context.Context, body PostApiTasksRetryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteApiWebhooksWebhookId request
+	DeleteApiWebhooksWebhookId(ctx context.Context, webhookId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutApiWebhooksWebhookIdWithBody request with any body
+	PutApiWebhooksWebhookIdWithBody(ctx context.Context, webhookId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutApiWebhooksWebhookId(ctx context.Context, webhookId int, body PutApiWebhooksWebhookIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetApiWebhooksWebhookIdDeliveries request
+	GetApiWebhooksWebhookIdDeliveries(ctx context.Context, webhookId int, params *GetApiWebhooksWebhookIdDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) PostApiAnalyzeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAnalyzeRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostApiSourcesRedditSubredditsSubredditRemoveProfilesRequestWithBody(server, subreddit, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostApiSourcesRedditSubredditsSubredditRemoveProfilesRequestWithBody generates requests for PostApiSourcesRedditSubredditsSubredditRemoveProfiles with any type of body
-func NewPostApiSourcesRedditSubredditsSubredditRemoveProfilesRequestWithBody(server string, subreddit string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "subreddit", runtime.ParamLocationPath, subreddit)
+func (c *Client) PostApiAnalyze(ctx context.Context, body PostApiAnalyzeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiAnalyzeRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetApiAnalyzersLimiters(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiAnalyzersLimitersRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/sources/reddit/subreddits/%s/remove_profiles", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostApiDetectionsListWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiDetectionsListRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) PostApiDetectionsList(ctx context.Context, body PostApiDetectionsListJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiDetectionsListRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetApiSourcesRedditSubredditsWithProfileRequest generates requests for GetApiSourcesRedditSubredditsWithProfile
-func NewGetApiSourcesRedditSubredditsWithProfileRequest(server string, params *GetApiSourcesRedditSubredditsWithProfileParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PutApiDetectionsTagsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiDetectionsTagsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/sources/reddit/subreddits_with_profile")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PutApiDetectionsTags(ctx context.Context, body PutApiDetectionsTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiDetectionsTagsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "profile_id", runtime.ParamLocationQuery, params.ProfileId); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetApiProfiles(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiProfilesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetApiStatisticsProfileIdRequest generates requests for GetApiStatisticsProfileId
-func NewGetApiStatisticsProfileIdRequest(server string, profileId int) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileId", runtime.ParamLocationPath, profileId)
+func (c *Client) PostApiProfilesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiProfilesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostApiProfiles(ctx context.Context, body PostApiProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiProfilesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/statistics/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteApiProfilesProfileId(ctx context.Context, profileId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiProfilesProfileIdRequest(c.Server, profileId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetApiProfilesProfileId(ctx context.Context, profileId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiProfilesProfileIdRequest(c.Server, profileId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) PutApiProfilesProfileIdWithBody(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiProfilesProfileIdRequestWithBody(c.Server, profileId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+func (c *Client) PutApiProfilesProfileId(ctx context.Context, profileId int, body PutApiProfilesProfileIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiProfilesProfileIdRequest(c.Server, profileId, body)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return nil
+	return c.Client.Do(req)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+func (c *Client) PostApiProfilesProfileIdAnalyzeWithBody(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiProfilesProfileIdAnalyzeRequestWithBody(c.Server, profileId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+func (c *Client) PostApiProfilesProfileIdAnalyze(ctx context.Context, profileId int, body PostApiProfilesProfileIdAnalyzeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiProfilesProfileIdAnalyzeRequest(c.Server, profileId, body)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+func (c *Client) PostApiProfilesProfileIdDryJumpstartWithBody(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiProfilesProfileIdDryJumpstartRequestWithBody(c.Server, profileId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// PostApiAnalyzeWithBodyWithResponse request with any body
-	PostApiAnalyzeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAnalyzeResponse, error)
+func (c *Client) PostApiProfilesProfileIdDryJumpstart(ctx context.Context, profileId int, body PostApiProfilesProfileIdDryJumpstartJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiProfilesProfileIdDryJumpstartRequest(c.Server, profileId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiProfilesProfileIdJumpstartWithBody(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiProfilesProfileIdJumpstartRequestWithBody(c.Server, profileId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiProfilesProfileIdJumpstart(ctx context.Context, profileId int, body PostApiProfilesProfileIdJumpstartJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiProfilesProfileIdJumpstartRequest(c.Server, profileId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiProfilesProfileIdSettingsVersions(ctx context.Context, profileId int, params *GetApiProfilesProfileIdSettingsVersionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiProfilesProfileIdSettingsVersionsRequest(c.Server, profileId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiProfilesProfileIdSettingsVersionsDiff(ctx context.Context, profileId int, params *GetApiProfilesProfileIdSettingsVersionsDiffParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiProfilesProfileIdSettingsVersionsDiffRequest(c.Server, profileId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiProfilesProfileIdSettingsVersionsRollbackWithBody(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiProfilesProfileIdSettingsVersionsRollbackRequestWithBody(c.Server, profileId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiProfilesProfileIdSettingsVersionsRollback(ctx context.Context, profileId int, body PostApiProfilesProfileIdSettingsVersionsRollbackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiProfilesProfileIdSettingsVersionsRollbackRequest(c.Server, profileId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiProfilesProfileIdShadows(ctx context.Context, profileId int, params *GetApiProfilesProfileIdShadowsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiProfilesProfileIdShadowsRequest(c.Server, profileId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiProfilesProfileIdShadowsWithBody(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiProfilesProfileIdShadowsRequestWithBody(c.Server, profileId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiProfilesProfileIdShadows(ctx context.Context, profileId int, body PostApiProfilesProfileIdShadowsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiProfilesProfileIdShadowsRequest(c.Server, profileId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiProfilesProfileIdShadowsShadowId(ctx context.Context, profileId int, shadowId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiProfilesProfileIdShadowsShadowIdRequest(c.Server, profileId, shadowId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiProfilesProfileIdShadowsShadowIdDisagreements(ctx context.Context, profileId int, shadowId int, params *GetApiProfilesProfileIdShadowsShadowIdDisagreementsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiProfilesProfileIdShadowsShadowIdDisagreementsRequest(c.Server, profileId, shadowId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiProfilesProfileIdShadowsShadowIdStop(ctx context.Context, profileId int, shadowId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiProfilesProfileIdShadowsShadowIdStopRequest(c.Server, profileId, shadowId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiProfilesProfileIdTasksPriorityWithBody(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiProfilesProfileIdTasksPriorityRequestWithBody(c.Server, profileId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiProfilesProfileIdTasksPriority(ctx context.Context, profileId int, body PutApiProfilesProfileIdTasksPriorityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiProfilesProfileIdTasksPriorityRequest(c.Server, profileId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiProfilesProfileIdTestCases(ctx context.Context, profileId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiProfilesProfileIdTestCasesRequest(c.Server, profileId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiProfilesProfileIdTestCasesWithBody(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiProfilesProfileIdTestCasesRequestWithBody(c.Server, profileId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiProfilesProfileIdTestCases(ctx context.Context, profileId int, body PutApiProfilesProfileIdTestCasesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiProfilesProfileIdTestCasesRequest(c.Server, profileId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiProfilesProfileIdTestCasesTestCaseId(ctx context.Context, profileId int, testCaseId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiProfilesProfileIdTestCasesTestCaseIdRequest(c.Server, profileId, testCaseId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiProfilesProfileIdTestRuns(ctx context.Context, profileId int, params *GetApiProfilesProfileIdTestRunsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiProfilesProfileIdTestRunsRequest(c.Server, profileId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiProfilesProfileIdTestRunsWithBody(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiProfilesProfileIdTestRunsRequestWithBody(c.Server, profileId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiProfilesProfileIdTestRuns(ctx context.Context, profileId int, body PostApiProfilesProfileIdTestRunsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiProfilesProfileIdTestRunsRequest(c.Server, profileId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiProfilesProfileIdTestRunsTestRunId(ctx context.Context, profileId int, testRunId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiProfilesProfileIdTestRunsTestRunIdRequest(c.Server, profileId, testRunId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiProfilesProfileIdTestRunsTestRunIdDiff(ctx context.Context, profileId int, testRunId int, params *GetApiProfilesProfileIdTestRunsTestRunIdDiffParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiProfilesProfileIdTestRunsTestRunIdDiffRequest(c.Server, profileId, testRunId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiProfilesProfileIdWebhooks(ctx context.Context, profileId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiProfilesProfileIdWebhooksRequest(c.Server, profileId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiProfilesProfileIdWebhooksWithBody(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiProfilesProfileIdWebhooksRequestWithBody(c.Server, profileId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiProfilesProfileIdWebhooks(ctx context.Context, profileId int, body PostApiProfilesProfileIdWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiProfilesProfileIdWebhooksRequest(c.Server, profileId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiSourcesHackernewsFeeds(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiSourcesHackernewsFeedsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiSourcesHackernewsFeedsFeedAddProfilesWithBody(ctx context.Context, feed PostApiSourcesHackernewsFeedsFeedAddProfilesParamsFeed, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiSourcesHackernewsFeedsFeedAddProfilesRequestWithBody(c.Server, feed, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiSourcesHackernewsFeedsFeedAddProfiles(ctx context.Context, feed PostApiSourcesHackernewsFeedsFeedAddProfilesParamsFeed, body PostApiSourcesHackernewsFeedsFeedAddProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiSourcesHackernewsFeedsFeedAddProfilesRequest(c.Server, feed, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiSourcesHackernewsFeedsFeedRemoveProfilesWithBody(ctx context.Context, feed PostApiSourcesHackernewsFeedsFeedRemoveProfilesParamsFeed, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiSourcesHackernewsFeedsFeedRemoveProfilesRequestWithBody(c.Server, feed, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiSourcesHackernewsFeedsFeedRemoveProfiles(ctx context.Context, feed PostApiSourcesHackernewsFeedsFeedRemoveProfilesParamsFeed, body PostApiSourcesHackernewsFeedsFeedRemoveProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiSourcesHackernewsFeedsFeedRemoveProfilesRequest(c.Server, feed, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiSourcesHackernewsFeedsWithProfile(ctx context.Context, params *GetApiSourcesHackernewsFeedsWithProfileParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiSourcesHackernewsFeedsWithProfileRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiSourcesRedditScrapeState(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiSourcesRedditScrapeStateRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiSourcesRedditSubreddits(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiSourcesRedditSubredditsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiSourcesRedditSubredditsSubredditAddProfilesWithBody(ctx context.Context, subreddit string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiSourcesRedditSubredditsSubredditAddProfilesRequestWithBody(c.Server, subreddit, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiSourcesRedditSubredditsSubredditAddProfiles(ctx context.Context, subreddit string, body PostApiSourcesRedditSubredditsSubredditAddProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiSourcesRedditSubredditsSubredditAddProfilesRequest(c.Server, subreddit, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiSourcesRedditSubredditsSubredditRemoveProfilesWithBody(ctx context.Context, subreddit string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiSourcesRedditSubredditsSubredditRemoveProfilesRequestWithBody(c.Server, subreddit, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiSourcesRedditSubredditsSubredditRemoveProfiles(ctx context.Context, subreddit string, body PostApiSourcesRedditSubredditsSubredditRemoveProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiSourcesRedditSubredditsSubredditRemoveProfilesRequest(c.Server, subreddit, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiSourcesRedditSubredditsWithProfile(ctx context.Context, params *GetApiSourcesRedditSubredditsWithProfileParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiSourcesRedditSubredditsWithProfileRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiSourcesRssFeeds(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiSourcesRssFeedsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiSourcesRssFeedsAddProfilesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiSourcesRssFeedsAddProfilesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiSourcesRssFeedsAddProfiles(ctx context.Context, body PostApiSourcesRssFeedsAddProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiSourcesRssFeedsAddProfilesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiSourcesRssFeedsRemoveProfilesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiSourcesRssFeedsRemoveProfilesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiSourcesRssFeedsRemoveProfiles(ctx context.Context, body PostApiSourcesRssFeedsRemoveProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiSourcesRssFeedsRemoveProfilesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiSourcesRssFeedsWithProfile(ctx context.Context, params *GetApiSourcesRssFeedsWithProfileParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiSourcesRssFeedsWithProfileRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiStatisticsProfileId(ctx context.Context, profileId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiStatisticsProfileIdRequest(c.Server, profileId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiStatisticsProfileIdAccuracy(ctx context.Context, profileId int, params *GetApiStatisticsProfileIdAccuracyParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiStatisticsProfileIdAccuracyRequest(c.Server, profileId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiTasks(ctx context.Context, params *GetApiTasksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiTasksRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiTasksCancelWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiTasksCancelRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiTasksCancel(ctx context.Context, body PostApiTasksCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiTasksCancelRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiTasksPurgeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiTasksPurgeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiTasksPurge(ctx context.Context, body PostApiTasksPurgeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiTasksPurgeRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiTasksRetryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiTasksRetryRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostApiTasksRetry(ctx context.Context, body PostApiTasksRetryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostApiTasksRetryRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteApiWebhooksWebhookId(ctx context.Context, webhookId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiWebhooksWebhookIdRequest(c.Server, webhookId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiWebhooksWebhookIdWithBody(ctx context.Context, webhookId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiWebhooksWebhookIdRequestWithBody(c.Server, webhookId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutApiWebhooksWebhookId(ctx context.Context, webhookId int, body PutApiWebhooksWebhookIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutApiWebhooksWebhookIdRequest(c.Server, webhookId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetApiWebhooksWebhookIdDeliveries(ctx context.Context, webhookId int, params *GetApiWebhooksWebhookIdDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiWebhooksWebhookIdDeliveriesRequest(c.Server, webhookId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewPostApiAnalyzeRequest calls the generic PostApiAnalyze builder with application/json body
+func NewPostApiAnalyzeRequest(server string, body PostApiAnalyzeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiAnalyzeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiAnalyzeRequestWithBody generates requests for PostApiAnalyze with any type of body
+func NewPostApiAnalyzeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/analyze")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiAnalyzersLimitersRequest generates requests for GetApiAnalyzersLimiters
+func NewGetApiAnalyzersLimitersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/analyzers/limiters")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiDetectionsListRequest calls the generic PostApiDetectionsList builder with application/json body
+func NewPostApiDetectionsListRequest(server string, body PostApiDetectionsListJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiDetectionsListRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiDetectionsListRequestWithBody generates requests for PostApiDetectionsList with any type of body
+func NewPostApiDetectionsListRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/detections/list")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPutApiDetectionsTagsRequest calls the generic PutApiDetectionsTags builder with application/json body
+func NewPutApiDetectionsTagsRequest(server string, body PutApiDetectionsTagsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiDetectionsTagsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPutApiDetectionsTagsRequestWithBody generates requests for PutApiDetectionsTags with any type of body
+func NewPutApiDetectionsTagsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/detections/tags")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiProfilesRequest generates requests for GetApiProfiles
+func NewGetApiProfilesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/profiles")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiProfilesRequest calls the generic PostApiProfiles builder with application/json body
+func NewPostApiProfilesRequest(server string, body PostApiProfilesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiProfilesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiProfilesRequestWithBody generates requests for PostApiProfiles with any type of body
+func NewPostApiProfilesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/profiles")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiProfilesProfileIdRequest generates requests for DeleteApiProfilesProfileId
+func NewDeleteApiProfilesProfileIdRequest(server string, profileId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileId", runtime.ParamLocationPath, profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/profiles/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiProfilesProfileIdRequest generates requests for GetApiProfilesProfileId
+func NewGetApiProfilesProfileIdRequest(server string, profileId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileId", runtime.ParamLocationPath, profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/profiles/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiProfilesProfileIdRequest calls the generic PutApiProfilesProfileId builder with application/json body
+func NewPutApiProfilesProfileIdRequest(server string, profileId int, body PutApiProfilesProfileIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiProfilesProfileIdRequestWithBody(server, profileId, "application/json", bodyReader)
+}
+
+// NewPutApiProfilesProfileIdRequestWithBody generates requests for PutApiProfilesProfileId with any type of body
+func NewPutApiProfilesProfileIdRequestWithBody(server string, profileId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileId", runtime.ParamLocationPath, profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/profiles/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiProfilesProfileIdAnalyzeRequest calls the generic PostApiProfilesProfileIdAnalyze builder with application/json body
+func NewPostApiProfilesProfileIdAnalyzeRequest(server string, profileId int, body PostApiProfilesProfileIdAnalyzeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiProfilesProfileIdAnalyzeRequestWithBody(server, profileId, "application/json", bodyReader)
+}
+
+// NewPostApiProfilesProfileIdAnalyzeRequestWithBody generates requests for PostApiProfilesProfileIdAnalyze with any type of body
+func NewPostApiProfilesProfileIdAnalyzeRequestWithBody(server string, profileId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileId", runtime.ParamLocationPath, profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/profiles/%s/analyze", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiProfilesProfileIdDryJumpstartRequest calls the generic PostApiProfilesProfileIdDryJumpstart builder with application/json body
+func NewPostApiProfilesProfileIdDryJumpstartRequest(server string, profileId int, body PostApiProfilesProfileIdDryJumpstartJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiProfilesProfileIdDryJumpstartRequestWithBody(server, profileId, "application/json", bodyReader)
+}
+
+// NewPostApiProfilesProfileIdDryJumpstartRequestWithBody generates requests for PostApiProfilesProfileIdDryJumpstart with any type of body
+func NewPostApiProfilesProfileIdDryJumpstartRequestWithBody(server string, profileId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileId", runtime.ParamLocationPath, profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/profiles/%s/dry_jumpstart", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiProfilesProfileIdJumpstartRequest calls the generic PostApiProfilesProfileIdJumpstart builder with application/json body
+func NewPostApiProfilesProfileIdJumpstartRequest(server string, profileId int, body PostApiProfilesProfileIdJumpstartJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiProfilesProfileIdJumpstartRequestWithBody(server, profileId, "application/json", bodyReader)
+}
+
+// NewPostApiProfilesProfileIdJumpstartRequestWithBody generates requests for PostApiProfilesProfileIdJumpstart with any type of body
+func NewPostApiProfilesProfileIdJumpstartRequestWithBody(server string, profileId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileId", runtime.ParamLocationPath, profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/profiles/%s/jumpstart", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiProfilesProfileIdSettingsVersionsRequest generates requests for GetApiProfilesProfileIdSettingsVersions
+func NewGetApiProfilesProfileIdSettingsVersionsRequest(server string, profileId int, params *GetApiProfilesProfileIdSettingsVersionsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileId", runtime.ParamLocationPath, profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/profiles/%s/settings_versions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Source != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "source", runtime.ParamLocationQuery, *params.Source); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiProfilesProfileIdSettingsVersionsDiffRequest generates requests for GetApiProfilesProfileIdSettingsVersionsDiff
+func NewGetApiProfilesProfileIdSettingsVersionsDiffRequest(server string, profileId int, params *GetApiProfilesProfileIdSettingsVersionsDiffParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileId", runtime.ParamLocationPath, profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/profiles/%s/settings_versions/diff", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Source != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "source", runtime.ParamLocationQuery, *params.Source); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, params.To); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiProfilesProfileIdSettingsVersionsRollbackRequest calls the generic PostApiProfilesProfileIdSettingsVersionsRollback builder with application/json body
+func NewPostApiProfilesProfileIdSettingsVersionsRollbackRequest(server string, profileId int, body PostApiProfilesProfileIdSettingsVersionsRollbackJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiProfilesProfileIdSettingsVersionsRollbackRequestWithBody(server, profileId, "application/json", bodyReader)
+}
+
+// NewPostApiProfilesProfileIdSettingsVersionsRollbackRequestWithBody generates requests for PostApiProfilesProfileIdSettingsVersionsRollback with any type of body
+func NewPostApiProfilesProfileIdSettingsVersionsRollbackRequestWithBody(server string, profileId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileId", runtime.ParamLocationPath, profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/profiles/%s/settings_versions/rollback", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiProfilesProfileIdShadowsRequest generates requests for GetApiProfilesProfileIdShadows
+func NewGetApiProfilesProfileIdShadowsRequest(server string, profileId int, params *GetApiProfilesProfileIdShadowsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileId", runtime.ParamLocationPath, profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/profiles/%s/shadows", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiProfilesProfileIdShadowsRequest calls the generic PostApiProfilesProfileIdShadows builder with application/json body
+func NewPostApiProfilesProfileIdShadowsRequest(server string, profileId int, body PostApiProfilesProfileIdShadowsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiProfilesProfileIdShadowsRequestWithBody(server, profileId, "application/json", bodyReader)
+}
+
+// NewPostApiProfilesProfileIdShadowsRequestWithBody generates requests for PostApiProfilesProfileIdShadows with any type of body
+func NewPostApiProfilesProfileIdShadowsRequestWithBody(server string, profileId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileId", runtime.ParamLocationPath, profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/profiles/%s/shadows", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiProfilesProfileIdShadowsShadowIdRequest generates requests for GetApiProfilesProfileIdShadowsShadowId
+func NewGetApiProfilesProfileIdShadowsShadowIdRequest(server string, profileId int, shadowId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileId", runtime.ParamLocationPath, profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "shadowId", runtime.ParamLocationPath, shadowId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/profiles/%s/shadows/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiProfilesProfileIdShadowsShadowIdDisagreementsRequest generates requests for GetApiProfilesProfileIdShadowsShadowIdDisagreements
+func NewGetApiProfilesProfileIdShadowsShadowIdDisagreementsRequest(server string, profileId int, shadowId int, params *GetApiProfilesProfileIdShadowsShadowIdDisagreementsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileId", runtime.ParamLocationPath, profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "shadowId", runtime.ParamLocationPath, shadowId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/profiles/%s/shadows/%s/disagreements", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiProfilesProfileIdShadowsShadowIdStopRequest generates requests for PostApiProfilesProfileIdShadowsShadowIdStop
+func NewPostApiProfilesProfileIdShadowsShadowIdStopRequest(server string, profileId int, shadowId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileId", runtime.ParamLocationPath, profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "shadowId", runtime.ParamLocationPath, shadowId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/profiles/%s/shadows/%s/stop", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiProfilesProfileIdTasksPriorityRequest calls the generic PutApiProfilesProfileIdTasksPriority builder with application/json body
+func NewPutApiProfilesProfileIdTasksPriorityRequest(server string, profileId int, body PutApiProfilesProfileIdTasksPriorityJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiProfilesProfileIdTasksPriorityRequestWithBody(server, profileId, "application/json", bodyReader)
+}
+
+// NewPutApiProfilesProfileIdTasksPriorityRequestWithBody generates requests for PutApiProfilesProfileIdTasksPriority with any type of body
+func NewPutApiProfilesProfileIdTasksPriorityRequestWithBody(server string, profileId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileId", runtime.ParamLocationPath, profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/profiles/%s/tasks/priority", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiProfilesProfileIdTestCasesRequest generates requests for GetApiProfilesProfileIdTestCases
+func NewGetApiProfilesProfileIdTestCasesRequest(server string, profileId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileId", runtime.ParamLocationPath, profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/profiles/%s/test_cases", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiProfilesProfileIdTestCasesRequest calls the generic PutApiProfilesProfileIdTestCases builder with application/json body
+func NewPutApiProfilesProfileIdTestCasesRequest(server string, profileId int, body PutApiProfilesProfileIdTestCasesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiProfilesProfileIdTestCasesRequestWithBody(server, profileId, "application/json", bodyReader)
+}
+
+// NewPutApiProfilesProfileIdTestCasesRequestWithBody generates requests for PutApiProfilesProfileIdTestCases with any type of body
+func NewPutApiProfilesProfileIdTestCasesRequestWithBody(server string, profileId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileId", runtime.ParamLocationPath, profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/profiles/%s/test_cases", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiProfilesProfileIdTestCasesTestCaseIdRequest generates requests for DeleteApiProfilesProfileIdTestCasesTestCaseId
+func NewDeleteApiProfilesProfileIdTestCasesTestCaseIdRequest(server string, profileId int, testCaseId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileId", runtime.ParamLocationPath, profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "testCaseId", runtime.ParamLocationPath, testCaseId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/profiles/%s/test_cases/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiProfilesProfileIdTestRunsRequest generates requests for GetApiProfilesProfileIdTestRuns
+func NewGetApiProfilesProfileIdTestRunsRequest(server string, profileId int, params *GetApiProfilesProfileIdTestRunsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileId", runtime.ParamLocationPath, profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/profiles/%s/test_runs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiProfilesProfileIdTestRunsRequest calls the generic PostApiProfilesProfileIdTestRuns builder with application/json body
+func NewPostApiProfilesProfileIdTestRunsRequest(server string, profileId int, body PostApiProfilesProfileIdTestRunsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiProfilesProfileIdTestRunsRequestWithBody(server, profileId, "application/json", bodyReader)
+}
+
+// NewPostApiProfilesProfileIdTestRunsRequestWithBody generates requests for PostApiProfilesProfileIdTestRuns with any type of body
+func NewPostApiProfilesProfileIdTestRunsRequestWithBody(server string, profileId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileId", runtime.ParamLocationPath, profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/profiles/%s/test_runs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiProfilesProfileIdTestRunsTestRunIdRequest generates requests for GetApiProfilesProfileIdTestRunsTestRunId
+func NewGetApiProfilesProfileIdTestRunsTestRunIdRequest(server string, profileId int, testRunId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileId", runtime.ParamLocationPath, profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "testRunId", runtime.ParamLocationPath, testRunId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/profiles/%s/test_runs/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiProfilesProfileIdTestRunsTestRunIdDiffRequest generates requests for GetApiProfilesProfileIdTestRunsTestRunIdDiff
+func NewGetApiProfilesProfileIdTestRunsTestRunIdDiffRequest(server string, profileId int, testRunId int, params *GetApiProfilesProfileIdTestRunsTestRunIdDiffParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileId", runtime.ParamLocationPath, profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "testRunId", runtime.ParamLocationPath, testRunId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/profiles/%s/test_runs/%s/diff", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "base", runtime.ParamLocationQuery, params.Base); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiProfilesProfileIdWebhooksRequest generates requests for GetApiProfilesProfileIdWebhooks
+func NewGetApiProfilesProfileIdWebhooksRequest(server string, profileId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileId", runtime.ParamLocationPath, profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/profiles/%s/webhooks", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiProfilesProfileIdWebhooksRequest calls the generic PostApiProfilesProfileIdWebhooks builder with application/json body
+func NewPostApiProfilesProfileIdWebhooksRequest(server string, profileId int, body PostApiProfilesProfileIdWebhooksJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiProfilesProfileIdWebhooksRequestWithBody(server, profileId, "application/json", bodyReader)
+}
+
+// NewPostApiProfilesProfileIdWebhooksRequestWithBody generates requests for PostApiProfilesProfileIdWebhooks with any type of body
+func NewPostApiProfilesProfileIdWebhooksRequestWithBody(server string, profileId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileId", runtime.ParamLocationPath, profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/profiles/%s/webhooks", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiSourcesHackernewsFeedsRequest generates requests for GetApiSourcesHackernewsFeeds
+func NewGetApiSourcesHackernewsFeedsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/sources/hackernews/feeds")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiSourcesHackernewsFeedsFeedAddProfilesRequest calls the generic PostApiSourcesHackernewsFeedsFeedAddProfiles builder with application/json body
+func NewPostApiSourcesHackernewsFeedsFeedAddProfilesRequest(server string, feed PostApiSourcesHackernewsFeedsFeedAddProfilesParamsFeed, body PostApiSourcesHackernewsFeedsFeedAddProfilesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiSourcesHackernewsFeedsFeedAddProfilesRequestWithBody(server, feed, "application/json", bodyReader)
+}
+
+// NewPostApiSourcesHackernewsFeedsFeedAddProfilesRequestWithBody generates requests for PostApiSourcesHackernewsFeedsFeedAddProfiles with any type of body
+func NewPostApiSourcesHackernewsFeedsFeedAddProfilesRequestWithBody(server string, feed PostApiSourcesHackernewsFeedsFeedAddProfilesParamsFeed, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "feed", runtime.ParamLocationPath, feed)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/sources/hackernews/feeds/%s/add_profiles", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiSourcesHackernewsFeedsFeedRemoveProfilesRequest calls the generic PostApiSourcesHackernewsFeedsFeedRemoveProfiles builder with application/json body
+func NewPostApiSourcesHackernewsFeedsFeedRemoveProfilesRequest(server string, feed PostApiSourcesHackernewsFeedsFeedRemoveProfilesParamsFeed, body PostApiSourcesHackernewsFeedsFeedRemoveProfilesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiSourcesHackernewsFeedsFeedRemoveProfilesRequestWithBody(server, feed, "application/json", bodyReader)
+}
+
+// NewPostApiSourcesHackernewsFeedsFeedRemoveProfilesRequestWithBody generates requests for PostApiSourcesHackernewsFeedsFeedRemoveProfiles with any type of body
+func NewPostApiSourcesHackernewsFeedsFeedRemoveProfilesRequestWithBody(server string, feed PostApiSourcesHackernewsFeedsFeedRemoveProfilesParamsFeed, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "feed", runtime.ParamLocationPath, feed)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/sources/hackernews/feeds/%s/remove_profiles", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiSourcesHackernewsFeedsWithProfileRequest generates requests for GetApiSourcesHackernewsFeedsWithProfile
+func NewGetApiSourcesHackernewsFeedsWithProfileRequest(server string, params *GetApiSourcesHackernewsFeedsWithProfileParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/sources/hackernews/feeds_with_profile")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "profile_id", runtime.ParamLocationQuery, params.ProfileId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiSourcesRedditScrapeStateRequest generates requests for GetApiSourcesRedditScrapeState
+func NewGetApiSourcesRedditScrapeStateRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/sources/reddit/scrape_state")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiSourcesRedditSubredditsRequest generates requests for GetApiSourcesRedditSubreddits
+func NewGetApiSourcesRedditSubredditsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/sources/reddit/subreddits")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiSourcesRedditSubredditsSubredditAddProfilesRequest calls the generic PostApiSourcesRedditSubredditsSubredditAddProfiles builder with application/json body
+func NewPostApiSourcesRedditSubredditsSubredditAddProfilesRequest(server string, subreddit string, body PostApiSourcesRedditSubredditsSubredditAddProfilesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiSourcesRedditSubredditsSubredditAddProfilesRequestWithBody(server, subreddit, "application/json", bodyReader)
+}
+
+// NewPostApiSourcesRedditSubredditsSubredditAddProfilesRequestWithBody generates requests for PostApiSourcesRedditSubredditsSubredditAddProfiles with any type of body
+func NewPostApiSourcesRedditSubredditsSubredditAddProfilesRequestWithBody(server string, subreddit string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "subreddit", runtime.ParamLocationPath, subreddit)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/sources/reddit/subreddits/%s/add_profiles", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiSourcesRedditSubredditsSubredditRemoveProfilesRequest calls the generic PostApiSourcesRedditSubredditsSubredditRemoveProfiles builder with application/json body
+func NewPostApiSourcesRedditSubredditsSubredditRemoveProfilesRequest(server string, subreddit string, body PostApiSourcesRedditSubredditsSubredditRemoveProfilesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiSourcesRedditSubredditsSubredditRemoveProfilesRequestWithBody(server, subreddit, "application/json", bodyReader)
+}
+
+// NewPostApiSourcesRedditSubredditsSubredditRemoveProfilesRequestWithBody generates requests for PostApiSourcesRedditSubredditsSubredditRemoveProfiles with any type of body
+func NewPostApiSourcesRedditSubredditsSubredditRemoveProfilesRequestWithBody(server string, subreddit string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "subreddit", runtime.ParamLocationPath, subreddit)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/sources/reddit/subreddits/%s/remove_profiles", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiSourcesRedditSubredditsWithProfileRequest generates requests for GetApiSourcesRedditSubredditsWithProfile
+func NewGetApiSourcesRedditSubredditsWithProfileRequest(server string, params *GetApiSourcesRedditSubredditsWithProfileParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/sources/reddit/subreddits_with_profile")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "profile_id", runtime.ParamLocationQuery, params.ProfileId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiSourcesRssFeedsRequest generates requests for GetApiSourcesRssFeeds
+func NewGetApiSourcesRssFeedsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/sources/rss/feeds")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiSourcesRssFeedsAddProfilesRequest calls the generic PostApiSourcesRssFeedsAddProfiles builder with application/json body
+func NewPostApiSourcesRssFeedsAddProfilesRequest(server string, body PostApiSourcesRssFeedsAddProfilesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiSourcesRssFeedsAddProfilesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiSourcesRssFeedsAddProfilesRequestWithBody generates requests for PostApiSourcesRssFeedsAddProfiles with any type of body
+func NewPostApiSourcesRssFeedsAddProfilesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/sources/rss/feeds/add_profiles")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiSourcesRssFeedsRemoveProfilesRequest calls the generic PostApiSourcesRssFeedsRemoveProfiles builder with application/json body
+func NewPostApiSourcesRssFeedsRemoveProfilesRequest(server string, body PostApiSourcesRssFeedsRemoveProfilesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiSourcesRssFeedsRemoveProfilesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiSourcesRssFeedsRemoveProfilesRequestWithBody generates requests for PostApiSourcesRssFeedsRemoveProfiles with any type of body
+func NewPostApiSourcesRssFeedsRemoveProfilesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/sources/rss/feeds/remove_profiles")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiSourcesRssFeedsWithProfileRequest generates requests for GetApiSourcesRssFeedsWithProfile
+func NewGetApiSourcesRssFeedsWithProfileRequest(server string, params *GetApiSourcesRssFeedsWithProfileParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/sources/rss/feeds_with_profile")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "profile_id", runtime.ParamLocationQuery, params.ProfileId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiStatisticsProfileIdRequest generates requests for GetApiStatisticsProfileId
+func NewGetApiStatisticsProfileIdRequest(server string, profileId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileId", runtime.ParamLocationPath, profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/statistics/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiStatisticsProfileIdAccuracyRequest generates requests for GetApiStatisticsProfileIdAccuracy
+func NewGetApiStatisticsProfileIdAccuracyRequest(server string, profileId int, params *GetApiStatisticsProfileIdAccuracyParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "profileId", runtime.ParamLocationPath, profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/statistics/%s/accuracy", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "source", runtime.ParamLocationQuery, params.Source); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Until != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until", runtime.ParamLocationQuery, *params.Until); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetApiTasksRequest generates requests for GetApiTasks
+func NewGetApiTasksRequest(server string, params *GetApiTasksParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/tasks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ProfileId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "profile_id", runtime.ParamLocationQuery, *params.ProfileId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Source != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "source", runtime.ParamLocationQuery, *params.Source); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Type != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.LastSeenId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "last_seen_id", runtime.ParamLocationQuery, *params.LastSeenId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostApiTasksCancelRequest calls the generic PostApiTasksCancel builder with application/json body
+func NewPostApiTasksCancelRequest(server string, body PostApiTasksCancelJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiTasksCancelRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiTasksCancelRequestWithBody generates requests for PostApiTasksCancel with any type of body
+func NewPostApiTasksCancelRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/tasks/cancel")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiTasksPurgeRequest calls the generic PostApiTasksPurge builder with application/json body
+func NewPostApiTasksPurgeRequest(server string, body PostApiTasksPurgeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiTasksPurgeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiTasksPurgeRequestWithBody generates requests for PostApiTasksPurge with any type of body
+func NewPostApiTasksPurgeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/tasks/purge")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostApiTasksRetryRequest calls the generic PostApiTasksRetry builder with application/json body
+func NewPostApiTasksRetryRequest(server string, body PostApiTasksRetryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostApiTasksRetryRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostApiTasksRetryRequestWithBody generates requests for PostApiTasksRetry with any type of body
+func NewPostApiTasksRetryRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/tasks/retry")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteApiWebhooksWebhookIdRequest generates requests for DeleteApiWebhooksWebhookId
+func NewDeleteApiWebhooksWebhookIdRequest(server string, webhookId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/webhooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutApiWebhooksWebhookIdRequest calls the generic PutApiWebhooksWebhookId builder with application/json body
+func NewPutApiWebhooksWebhookIdRequest(server string, webhookId int, body PutApiWebhooksWebhookIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutApiWebhooksWebhookIdRequestWithBody(server, webhookId, "application/json", bodyReader)
+}
+
+// NewPutApiWebhooksWebhookIdRequestWithBody generates requests for PutApiWebhooksWebhookId with any type of body
+func NewPutApiWebhooksWebhookIdRequestWithBody(server string, webhookId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/webhooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiWebhooksWebhookIdDeliveriesRequest generates requests for GetApiWebhooksWebhookIdDeliveries
+func NewGetApiWebhooksWebhookIdDeliveriesRequest(server string, webhookId int, params *GetApiWebhooksWebhookIdDeliveriesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/webhooks/%s/deliveries", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// PostApiAnalyzeWithBodyWithResponse request with any body
+	PostApiAnalyzeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAnalyzeResponse, error)
+
+	PostApiAnalyzeWithResponse(ctx context.Context, body PostApiAnalyzeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAnalyzeResponse, error)
+
+	// GetApiAnalyzersLimitersWithResponse request
+	GetApiAnalyzersLimitersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiAnalyzersLimitersResponse, error)
+
+	// PostApiDetectionsListWithBodyWithResponse request with any body
+	PostApiDetectionsListWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiDetectionsListResponse, error)
+
+	PostApiDetectionsListWithResponse(ctx context.Context, body PostApiDetectionsListJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiDetectionsListResponse, error)
+
+	// PutApiDetectionsTagsWithBodyWithResponse request with any body
+	PutApiDetectionsTagsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiDetectionsTagsResponse, error)
+
+	PutApiDetectionsTagsWithResponse(ctx context.Context, body PutApiDetectionsTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiDetectionsTagsResponse, error)
+
+	// GetApiProfilesWithResponse request
+	GetApiProfilesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiProfilesResponse, error)
+
+	// PostApiProfilesWithBodyWithResponse request with any body
+	PostApiProfilesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiProfilesResponse, error)
+
+	PostApiProfilesWithResponse(ctx context.Context, body PostApiProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiProfilesResponse, error)
+
+	// DeleteApiProfilesProfileIdWithResponse request
+	DeleteApiProfilesProfileIdWithResponse(ctx context.Context, profileId int, reqEditors ...RequestEditorFn) (*DeleteApiProfilesProfileIdResponse, error)
+
+	// GetApiProfilesProfileIdWithResponse request
+	GetApiProfilesProfileIdWithResponse(ctx context.Context, profileId int, reqEditors ...RequestEditorFn) (*GetApiProfilesProfileIdResponse, error)
+
+	// PutApiProfilesProfileIdWithBodyWithResponse request with any body
+	PutApiProfilesProfileIdWithBodyWithResponse(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiProfilesProfileIdResponse, error)
+
+	PutApiProfilesProfileIdWithResponse(ctx context.Context, profileId int, body PutApiProfilesProfileIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiProfilesProfileIdResponse, error)
+
+	// PostApiProfilesProfileIdAnalyzeWithBodyWithResponse request with any body
+	PostApiProfilesProfileIdAnalyzeWithBodyWithResponse(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdAnalyzeResponse, error)
+
+	PostApiProfilesProfileIdAnalyzeWithResponse(ctx context.Context, profileId int, body PostApiProfilesProfileIdAnalyzeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdAnalyzeResponse, error)
+
+	// PostApiProfilesProfileIdDryJumpstartWithBodyWithResponse request with any body
+	PostApiProfilesProfileIdDryJumpstartWithBodyWithResponse(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdDryJumpstartResponse, error)
+
+	PostApiProfilesProfileIdDryJumpstartWithResponse(ctx context.Context, profileId int, body PostApiProfilesProfileIdDryJumpstartJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdDryJumpstartResponse, error)
+
+	// PostApiProfilesProfileIdJumpstartWithBodyWithResponse request with any body
+	PostApiProfilesProfileIdJumpstartWithBodyWithResponse(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdJumpstartResponse, error)
+
+	PostApiProfilesProfileIdJumpstartWithResponse(ctx context.Context, profileId int, body PostApiProfilesProfileIdJumpstartJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdJumpstartResponse, error)
+
+	// GetApiProfilesProfileIdSettingsVersionsWithResponse request
+	GetApiProfilesProfileIdSettingsVersionsWithResponse(ctx context.Context, profileId int, params *GetApiProfilesProfileIdSettingsVersionsParams, reqEditors ...RequestEditorFn) (*GetApiProfilesProfileIdSettingsVersionsResponse, error)
+
+	// GetApiProfilesProfileIdSettingsVersionsDiffWithResponse request
+	GetApiProfilesProfileIdSettingsVersionsDiffWithResponse(ctx context.Context, profileId int, params *GetApiProfilesProfileIdSettingsVersionsDiffParams, reqEditors ...RequestEditorFn) (*GetApiProfilesProfileIdSettingsVersionsDiffResponse, error)
+
+	// PostApiProfilesProfileIdSettingsVersionsRollbackWithBodyWithResponse request with any body
+	PostApiProfilesProfileIdSettingsVersionsRollbackWithBodyWithResponse(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdSettingsVersionsRollbackResponse, error)
+
+	PostApiProfilesProfileIdSettingsVersionsRollbackWithResponse(ctx context.Context, profileId int, body PostApiProfilesProfileIdSettingsVersionsRollbackJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdSettingsVersionsRollbackResponse, error)
+
+	// GetApiProfilesProfileIdShadowsWithResponse request
+	GetApiProfilesProfileIdShadowsWithResponse(ctx context.Context, profileId int, params *GetApiProfilesProfileIdShadowsParams, reqEditors ...RequestEditorFn) (*GetApiProfilesProfileIdShadowsResponse, error)
+
+	// PostApiProfilesProfileIdShadowsWithBodyWithResponse request with any body
+	PostApiProfilesProfileIdShadowsWithBodyWithResponse(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdShadowsResponse, error)
+
+	PostApiProfilesProfileIdShadowsWithResponse(ctx context.Context, profileId int, body PostApiProfilesProfileIdShadowsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdShadowsResponse, error)
+
+	// GetApiProfilesProfileIdShadowsShadowIdWithResponse request
+	GetApiProfilesProfileIdShadowsShadowIdWithResponse(ctx context.Context, profileId int, shadowId int, reqEditors ...RequestEditorFn) (*GetApiProfilesProfileIdShadowsShadowIdResponse, error)
+
+	// GetApiProfilesProfileIdShadowsShadowIdDisagreementsWithResponse request
+	GetApiProfilesProfileIdShadowsShadowIdDisagreementsWithResponse(ctx context.Context, profileId int, shadowId int, params *GetApiProfilesProfileIdShadowsShadowIdDisagreementsParams, reqEditors ...RequestEditorFn) (*GetApiProfilesProfileIdShadowsShadowIdDisagreementsResponse, error)
+
+	// PostApiProfilesProfileIdShadowsShadowIdStopWithResponse request
+	PostApiProfilesProfileIdShadowsShadowIdStopWithResponse(ctx context.Context, profileId int, shadowId int, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdShadowsShadowIdStopResponse, error)
+
+	// PutApiProfilesProfileIdTasksPriorityWithBodyWithResponse request with any body
+	PutApiProfilesProfileIdTasksPriorityWithBodyWithResponse(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiProfilesProfileIdTasksPriorityResponse, error)
+
+	PutApiProfilesProfileIdTasksPriorityWithResponse(ctx context.Context, profileId int, body PutApiProfilesProfileIdTasksPriorityJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiProfilesProfileIdTasksPriorityResponse, error)
+
+	// GetApiProfilesProfileIdTestCasesWithResponse request
+	GetApiProfilesProfileIdTestCasesWithResponse(ctx context.Context, profileId int, reqEditors ...RequestEditorFn) (*GetApiProfilesProfileIdTestCasesResponse, error)
+
+	// PutApiProfilesProfileIdTestCasesWithBodyWithResponse request with any body
+	PutApiProfilesProfileIdTestCasesWithBodyWithResponse(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiProfilesProfileIdTestCasesResponse, error)
+
+	PutApiProfilesProfileIdTestCasesWithResponse(ctx context.Context, profileId int, body PutApiProfilesProfileIdTestCasesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiProfilesProfileIdTestCasesResponse, error)
+
+	// DeleteApiProfilesProfileIdTestCasesTestCaseIdWithResponse request
+	DeleteApiProfilesProfileIdTestCasesTestCaseIdWithResponse(ctx context.Context, profileId int, testCaseId int, reqEditors ...RequestEditorFn) (*DeleteApiProfilesProfileIdTestCasesTestCaseIdResponse, error)
+
+	// GetApiProfilesProfileIdTestRunsWithResponse request
+	GetApiProfilesProfileIdTestRunsWithResponse(ctx context.Context, profileId int, params *GetApiProfilesProfileIdTestRunsParams, reqEditors ...RequestEditorFn) (*GetApiProfilesProfileIdTestRunsResponse, error)
+
+	// PostApiProfilesProfileIdTestRunsWithBodyWithResponse request with any body
+	PostApiProfilesProfileIdTestRunsWithBodyWithResponse(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdTestRunsResponse, error)
+
+	PostApiProfilesProfileIdTestRunsWithResponse(ctx context.Context, profileId int, body PostApiProfilesProfileIdTestRunsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdTestRunsResponse, error)
+
+	// GetApiProfilesProfileIdTestRunsTestRunIdWithResponse request
+	GetApiProfilesProfileIdTestRunsTestRunIdWithResponse(ctx context.Context, profileId int, testRunId int, reqEditors ...RequestEditorFn) (*GetApiProfilesProfileIdTestRunsTestRunIdResponse, error)
+
+	// GetApiProfilesProfileIdTestRunsTestRunIdDiffWithResponse request
+	GetApiProfilesProfileIdTestRunsTestRunIdDiffWithResponse(ctx context.Context, profileId int, testRunId int, params *GetApiProfilesProfileIdTestRunsTestRunIdDiffParams, reqEditors ...RequestEditorFn) (*GetApiProfilesProfileIdTestRunsTestRunIdDiffResponse, error)
+
+	// GetApiProfilesProfileIdWebhooksWithResponse request
+	GetApiProfilesProfileIdWebhooksWithResponse(ctx context.Context, profileId int, reqEditors ...RequestEditorFn) (*GetApiProfilesProfileIdWebhooksResponse, error)
+
+	// PostApiProfilesProfileIdWebhooksWithBodyWithResponse request with any body
+	PostApiProfilesProfileIdWebhooksWithBodyWithResponse(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdWebhooksResponse, error)
+
+	PostApiProfilesProfileIdWebhooksWithResponse(ctx context.Context, profileId int, body PostApiProfilesProfileIdWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdWebhooksResponse, error)
+
+	// GetApiSourcesHackernewsFeedsWithResponse request
+	GetApiSourcesHackernewsFeedsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiSourcesHackernewsFeedsResponse, error)
+
+	// PostApiSourcesHackernewsFeedsFeedAddProfilesWithBodyWithResponse request with any body
+	PostApiSourcesHackernewsFeedsFeedAddProfilesWithBodyWithResponse(ctx context.Context, feed PostApiSourcesHackernewsFeedsFeedAddProfilesParamsFeed, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiSourcesHackernewsFeedsFeedAddProfilesResponse, error)
+
+	PostApiSourcesHackernewsFeedsFeedAddProfilesWithResponse(ctx context.Context, feed PostApiSourcesHackernewsFeedsFeedAddProfilesParamsFeed, body PostApiSourcesHackernewsFeedsFeedAddProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiSourcesHackernewsFeedsFeedAddProfilesResponse, error)
+
+	// PostApiSourcesHackernewsFeedsFeedRemoveProfilesWithBodyWithResponse request with any body
+	PostApiSourcesHackernewsFeedsFeedRemoveProfilesWithBodyWithResponse(ctx context.Context, feed PostApiSourcesHackernewsFeedsFeedRemoveProfilesParamsFeed, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiSourcesHackernewsFeedsFeedRemoveProfilesResponse, error)
+
+	PostApiSourcesHackernewsFeedsFeedRemoveProfilesWithResponse(ctx context.Context, feed PostApiSourcesHackernewsFeedsFeedRemoveProfilesParamsFeed, body PostApiSourcesHackernewsFeedsFeedRemoveProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiSourcesHackernewsFeedsFeedRemoveProfilesResponse, error)
+
+	// GetApiSourcesHackernewsFeedsWithProfileWithResponse request
+	GetApiSourcesHackernewsFeedsWithProfileWithResponse(ctx context.Context, params *GetApiSourcesHackernewsFeedsWithProfileParams, reqEditors ...RequestEditorFn) (*GetApiSourcesHackernewsFeedsWithProfileResponse, error)
+
+	// GetApiSourcesRedditScrapeStateWithResponse request
+	GetApiSourcesRedditScrapeStateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiSourcesRedditScrapeStateResponse, error)
+
+	// GetApiSourcesRedditSubredditsWithResponse request
+	GetApiSourcesRedditSubredditsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiSourcesRedditSubredditsResponse, error)
+
+	// PostApiSourcesRedditSubredditsSubredditAddProfilesWithBodyWithResponse request with any body
+	PostApiSourcesRedditSubredditsSubredditAddProfilesWithBodyWithResponse(ctx context.Context, subreddit string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiSourcesRedditSubredditsSubredditAddProfilesResponse, error)
+
+	PostApiSourcesRedditSubredditsSubredditAddProfilesWithResponse(ctx context.Context, subreddit string, body PostApiSourcesRedditSubredditsSubredditAddProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiSourcesRedditSubredditsSubredditAddProfilesResponse, error)
+
+	// PostApiSourcesRedditSubredditsSubredditRemoveProfilesWithBodyWithResponse request with any body
+	PostApiSourcesRedditSubredditsSubredditRemoveProfilesWithBodyWithResponse(ctx context.Context, subreddit string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse, error)
+
+	PostApiSourcesRedditSubredditsSubredditRemoveProfilesWithResponse(ctx context.Context, subreddit string, body PostApiSourcesRedditSubredditsSubredditRemoveProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse, error)
+
+	// GetApiSourcesRedditSubredditsWithProfileWithResponse request
+	GetApiSourcesRedditSubredditsWithProfileWithResponse(ctx context.Context, params *GetApiSourcesRedditSubredditsWithProfileParams, reqEditors ...RequestEditorFn) (*GetApiSourcesRedditSubredditsWithProfileResponse, error)
+
+	// GetApiSourcesRssFeedsWithResponse request
+	GetApiSourcesRssFeedsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiSourcesRssFeedsResponse, error)
+
+	// PostApiSourcesRssFeedsAddProfilesWithBodyWithResponse request with any body
+	PostApiSourcesRssFeedsAddProfilesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiSourcesRssFeedsAddProfilesResponse, error)
+
+	PostApiSourcesRssFeedsAddProfilesWithResponse(ctx context.Context, body PostApiSourcesRssFeedsAddProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiSourcesRssFeedsAddProfilesResponse, error)
+
+	// PostApiSourcesRssFeedsRemoveProfilesWithBodyWithResponse request with any body
+	PostApiSourcesRssFeedsRemoveProfilesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiSourcesRssFeedsRemoveProfilesResponse, error)
+
+	PostApiSourcesRssFeedsRemoveProfilesWithResponse(ctx context.Context, body PostApiSourcesRssFeedsRemoveProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiSourcesRssFeedsRemoveProfilesResponse, error)
+
+	// GetApiSourcesRssFeedsWithProfileWithResponse request
+	GetApiSourcesRssFeedsWithProfileWithResponse(ctx context.Context, params *GetApiSourcesRssFeedsWithProfileParams, reqEditors ...RequestEditorFn) (*GetApiSourcesRssFeedsWithProfileResponse, error)
+
+	// GetApiStatisticsProfileIdWithResponse request
+	GetApiStatisticsProfileIdWithResponse(ctx context.Context, profileId int, reqEditors ...RequestEditorFn) (*GetApiStatisticsProfileIdResponse, error)
+
+	// GetApiStatisticsProfileIdAccuracyWithResponse request
+	GetApiStatisticsProfileIdAccuracyWithResponse(ctx context.Context, profileId int, params *GetApiStatisticsProfileIdAccuracyParams, reqEditors ...RequestEditorFn) (*GetApiStatisticsProfileIdAccuracyResponse, error)
+
+	// GetApiTasksWithResponse request
+	GetApiTasksWithResponse(ctx context.Context, params *GetApiTasksParams, reqEditors ...RequestEditorFn) (*GetApiTasksResponse, error)
+
+	// PostApiTasksCancelWithBodyWithResponse request with any body
+	PostApiTasksCancelWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiTasksCancelResponse, error)
+
+	PostApiTasksCancelWithResponse(ctx context.Context, body PostApiTasksCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiTasksCancelResponse, error)
+
+	// PostApiTasksPurgeWithBodyWithResponse request with any body
+	PostApiTasksPurgeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiTasksPurgeResponse, error)
+
+	PostApiTasksPurgeWithResponse(ctx context.Context, body PostApiTasksPurgeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiTasksPurgeResponse, error)
+
+	// PostApiTasksRetryWithBodyWithResponse request with any body
+	PostApiTasksRetryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiTasksRetryResponse, error)
+
+	PostApiTasksRetryWithResponse(ctx context.Context, body PostApiTasksRetryJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiTasksRetryResponse, error)
+
+	// DeleteApiWebhooksWebhookIdWithResponse request
+	DeleteApiWebhooksWebhookIdWithResponse(ctx context.Context, webhookId int, reqEditors ...RequestEditorFn) (*DeleteApiWebhooksWebhookIdResponse, error)
+
+	// PutApiWebhooksWebhookIdWithBodyWithResponse request with any body
+	PutApiWebhooksWebhookIdWithBodyWithResponse(ctx context.Context, webhookId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiWebhooksWebhookIdResponse, error)
+
+	PutApiWebhooksWebhookIdWithResponse(ctx context.Context, webhookId int, body PutApiWebhooksWebhookIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiWebhooksWebhookIdResponse, error)
+
+	// GetApiWebhooksWebhookIdDeliveriesWithResponse request
+	GetApiWebhooksWebhookIdDeliveriesWithResponse(ctx context.Context, webhookId int, params *GetApiWebhooksWebhookIdDeliveriesParams, reqEditors ...RequestEditorFn) (*GetApiWebhooksWebhookIdDeliveriesResponse, error)
+}
+
+type PostApiAnalyzeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Detection
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiAnalyzeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiAnalyzeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiAnalyzersLimitersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AnalyzerLimiter
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiAnalyzersLimitersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiAnalyzersLimitersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiDetectionsListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ListedDetection
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiDetectionsListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiDetectionsListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiDetectionsTagsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DetectionTags
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiDetectionsTagsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiDetectionsTagsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiProfilesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Profile
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiProfilesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiProfilesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiProfilesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Id int `json:"id"`
+	}
+	JSON400 *Error
+	JSON500 *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiProfilesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiProfilesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiProfilesProfileIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiProfilesProfileIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiProfilesProfileIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiProfilesProfileIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Profile
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiProfilesProfileIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiProfilesProfileIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiProfilesProfileIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiProfilesProfileIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiProfilesProfileIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiProfilesProfileIdAnalyzeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiProfilesProfileIdAnalyzeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiProfilesProfileIdAnalyzeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiProfilesProfileIdDryJumpstartResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AnalysisTaskParameters
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiProfilesProfileIdDryJumpstartResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiProfilesProfileIdDryJumpstartResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiProfilesProfileIdJumpstartResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiProfilesProfileIdJumpstartResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiProfilesProfileIdJumpstartResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiProfilesProfileIdSettingsVersionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ProfileSettingsVersion
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiProfilesProfileIdSettingsVersionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiProfilesProfileIdSettingsVersionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiProfilesProfileIdSettingsVersionsDiffResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProfileSettingsDiff
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiProfilesProfileIdSettingsVersionsDiffResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiProfilesProfileIdSettingsVersionsDiffResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiProfilesProfileIdSettingsVersionsRollbackResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProfileSettingsVersion
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiProfilesProfileIdSettingsVersionsRollbackResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiProfilesProfileIdSettingsVersionsRollbackResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiProfilesProfileIdShadowsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Shadow
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiProfilesProfileIdShadowsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiProfilesProfileIdShadowsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiProfilesProfileIdShadowsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Shadow
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiProfilesProfileIdShadowsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiProfilesProfileIdShadowsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiProfilesProfileIdShadowsShadowIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Shadow
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiProfilesProfileIdShadowsShadowIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiProfilesProfileIdShadowsShadowIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiProfilesProfileIdShadowsShadowIdDisagreementsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ShadowDisagreement
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiProfilesProfileIdShadowsShadowIdDisagreementsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiProfilesProfileIdShadowsShadowIdDisagreementsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiProfilesProfileIdShadowsShadowIdStopResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Shadow
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiProfilesProfileIdShadowsShadowIdStopResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiProfilesProfileIdShadowsShadowIdStopResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiProfilesProfileIdTasksPriorityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TaskPriorityUpdateResult
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiProfilesProfileIdTasksPriorityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiProfilesProfileIdTasksPriorityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiProfilesProfileIdTestCasesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TestCase
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiProfilesProfileIdTestCasesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiProfilesProfileIdTestCasesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiProfilesProfileIdTestCasesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TestCase
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiProfilesProfileIdTestCasesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiProfilesProfileIdTestCasesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiProfilesProfileIdTestCasesTestCaseIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiProfilesProfileIdTestCasesTestCaseIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiProfilesProfileIdTestCasesTestCaseIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiProfilesProfileIdTestRunsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TestRun
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiProfilesProfileIdTestRunsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiProfilesProfileIdTestRunsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiProfilesProfileIdTestRunsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TestRun
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiProfilesProfileIdTestRunsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiProfilesProfileIdTestRunsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiProfilesProfileIdTestRunsTestRunIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TestRunReport
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiProfilesProfileIdTestRunsTestRunIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiProfilesProfileIdTestRunsTestRunIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiProfilesProfileIdTestRunsTestRunIdDiffResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TestRunDiff
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiProfilesProfileIdTestRunsTestRunIdDiffResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiProfilesProfileIdTestRunsTestRunIdDiffResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiProfilesProfileIdWebhooksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Webhook
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiProfilesProfileIdWebhooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiProfilesProfileIdWebhooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiProfilesProfileIdWebhooksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Webhook
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiProfilesProfileIdWebhooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiProfilesProfileIdWebhooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiSourcesHackernewsFeedsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]HackerNewsFeedSettings
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiSourcesHackernewsFeedsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiSourcesHackernewsFeedsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiSourcesHackernewsFeedsFeedAddProfilesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiSourcesHackernewsFeedsFeedAddProfilesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiSourcesHackernewsFeedsFeedAddProfilesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiSourcesHackernewsFeedsFeedRemoveProfilesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiSourcesHackernewsFeedsFeedRemoveProfilesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiSourcesHackernewsFeedsFeedRemoveProfilesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiSourcesHackernewsFeedsWithProfileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]HackerNewsFeedSettings
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiSourcesHackernewsFeedsWithProfileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiSourcesHackernewsFeedsWithProfileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiSourcesRedditScrapeStateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]SubredditScrapeState
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiSourcesRedditScrapeStateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiSourcesRedditScrapeStateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiSourcesRedditSubredditsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]SubredditSettings
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiSourcesRedditSubredditsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiSourcesRedditSubredditsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiSourcesRedditSubredditsSubredditAddProfilesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiSourcesRedditSubredditsSubredditAddProfilesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiSourcesRedditSubredditsSubredditAddProfilesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiSourcesRedditSubredditsWithProfileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]SubredditSettings
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiSourcesRedditSubredditsWithProfileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiSourcesRedditSubredditsWithProfileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiSourcesRssFeedsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RSSFeedSettings
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiSourcesRssFeedsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiSourcesRssFeedsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiSourcesRssFeedsAddProfilesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiSourcesRssFeedsAddProfilesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiSourcesRssFeedsAddProfilesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiSourcesRssFeedsRemoveProfilesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiSourcesRssFeedsRemoveProfilesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiSourcesRssFeedsRemoveProfilesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiSourcesRssFeedsWithProfileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RSSFeedSettings
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiSourcesRssFeedsWithProfileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiSourcesRssFeedsWithProfileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiStatisticsProfileIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProfileStatistics
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiStatisticsProfileIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiStatisticsProfileIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiStatisticsProfileIdAccuracyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]SettingsVersionAccuracy
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiStatisticsProfileIdAccuracyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiStatisticsProfileIdAccuracyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiTasksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AnalysisTask
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiTasksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiTasksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiTasksCancelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TaskActionResult
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiTasksCancelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiTasksCancelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiTasksPurgeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TaskActionResult
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiTasksPurgeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiTasksPurgeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostApiTasksRetryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TaskActionResult
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostApiTasksRetryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostApiTasksRetryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiWebhooksWebhookIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiWebhooksWebhookIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiWebhooksWebhookIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutApiWebhooksWebhookIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Webhook
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutApiWebhooksWebhookIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutApiWebhooksWebhookIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiWebhooksWebhookIdDeliveriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]WebhookDeliveryAttempt
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiWebhooksWebhookIdDeliveriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiWebhooksWebhookIdDeliveriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// PostApiAnalyzeWithBodyWithResponse request with arbitrary body returning *PostApiAnalyzeResponse
+func (c *ClientWithResponses) PostApiAnalyzeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAnalyzeResponse, error) {
+	rsp, err := c.PostApiAnalyzeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAnalyzeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiAnalyzeWithResponse(ctx context.Context, body PostApiAnalyzeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAnalyzeResponse, error) {
+	rsp, err := c.PostApiAnalyze(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiAnalyzeResponse(rsp)
+}
+
+// GetApiAnalyzersLimitersWithResponse request returning *GetApiAnalyzersLimitersResponse
+func (c *ClientWithResponses) GetApiAnalyzersLimitersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiAnalyzersLimitersResponse, error) {
+	rsp, err := c.GetApiAnalyzersLimiters(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiAnalyzersLimitersResponse(rsp)
+}
+
+// PostApiDetectionsListWithBodyWithResponse request with arbitrary body returning *PostApiDetectionsListResponse
+func (c *ClientWithResponses) PostApiDetectionsListWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiDetectionsListResponse, error) {
+	rsp, err := c.PostApiDetectionsListWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiDetectionsListResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiDetectionsListWithResponse(ctx context.Context, body PostApiDetectionsListJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiDetectionsListResponse, error) {
+	rsp, err := c.PostApiDetectionsList(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiDetectionsListResponse(rsp)
+}
+
+// PutApiDetectionsTagsWithBodyWithResponse request with arbitrary body returning *PutApiDetectionsTagsResponse
+func (c *ClientWithResponses) PutApiDetectionsTagsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiDetectionsTagsResponse, error) {
+	rsp, err := c.PutApiDetectionsTagsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiDetectionsTagsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiDetectionsTagsWithResponse(ctx context.Context, body PutApiDetectionsTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiDetectionsTagsResponse, error) {
+	rsp, err := c.PutApiDetectionsTags(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiDetectionsTagsResponse(rsp)
+}
+
+// GetApiProfilesWithResponse request returning *GetApiProfilesResponse
+func (c *ClientWithResponses) GetApiProfilesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiProfilesResponse, error) {
+	rsp, err := c.GetApiProfiles(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiProfilesResponse(rsp)
+}
+
+// PostApiProfilesWithBodyWithResponse request with arbitrary body returning *PostApiProfilesResponse
+func (c *ClientWithResponses) PostApiProfilesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiProfilesResponse, error) {
+	rsp, err := c.PostApiProfilesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiProfilesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiProfilesWithResponse(ctx context.Context, body PostApiProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiProfilesResponse, error) {
+	rsp, err := c.PostApiProfiles(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiProfilesResponse(rsp)
+}
+
+// DeleteApiProfilesProfileIdWithResponse request returning *DeleteApiProfilesProfileIdResponse
+func (c *ClientWithResponses) DeleteApiProfilesProfileIdWithResponse(ctx context.Context, profileId int, reqEditors ...RequestEditorFn) (*DeleteApiProfilesProfileIdResponse, error) {
+	rsp, err := c.DeleteApiProfilesProfileId(ctx, profileId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiProfilesProfileIdResponse(rsp)
+}
+
+// GetApiProfilesProfileIdWithResponse request returning *GetApiProfilesProfileIdResponse
+func (c *ClientWithResponses) GetApiProfilesProfileIdWithResponse(ctx context.Context, profileId int, reqEditors ...RequestEditorFn) (*GetApiProfilesProfileIdResponse, error) {
+	rsp, err := c.GetApiProfilesProfileId(ctx, profileId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiProfilesProfileIdResponse(rsp)
+}
+
+// PutApiProfilesProfileIdWithBodyWithResponse request with arbitrary body returning *PutApiProfilesProfileIdResponse
+func (c *ClientWithResponses) PutApiProfilesProfileIdWithBodyWithResponse(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiProfilesProfileIdResponse, error) {
+	rsp, err := c.PutApiProfilesProfileIdWithBody(ctx, profileId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiProfilesProfileIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiProfilesProfileIdWithResponse(ctx context.Context, profileId int, body PutApiProfilesProfileIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiProfilesProfileIdResponse, error) {
+	rsp, err := c.PutApiProfilesProfileId(ctx, profileId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiProfilesProfileIdResponse(rsp)
+}
+
+// PostApiProfilesProfileIdAnalyzeWithBodyWithResponse request with arbitrary body returning *PostApiProfilesProfileIdAnalyzeResponse
+func (c *ClientWithResponses) PostApiProfilesProfileIdAnalyzeWithBodyWithResponse(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdAnalyzeResponse, error) {
+	rsp, err := c.PostApiProfilesProfileIdAnalyzeWithBody(ctx, profileId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiProfilesProfileIdAnalyzeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiProfilesProfileIdAnalyzeWithResponse(ctx context.Context, profileId int, body PostApiProfilesProfileIdAnalyzeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdAnalyzeResponse, error) {
+	rsp, err := c.PostApiProfilesProfileIdAnalyze(ctx, profileId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiProfilesProfileIdAnalyzeResponse(rsp)
+}
+
+// PostApiProfilesProfileIdDryJumpstartWithBodyWithResponse request with arbitrary body returning *PostApiProfilesProfileIdDryJumpstartResponse
+func (c *ClientWithResponses) PostApiProfilesProfileIdDryJumpstartWithBodyWithResponse(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdDryJumpstartResponse, error) {
+	rsp, err := c.PostApiProfilesProfileIdDryJumpstartWithBody(ctx, profileId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiProfilesProfileIdDryJumpstartResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiProfilesProfileIdDryJumpstartWithResponse(ctx context.Context, profileId int, body PostApiProfilesProfileIdDryJumpstartJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdDryJumpstartResponse, error) {
+	rsp, err := c.PostApiProfilesProfileIdDryJumpstart(ctx, profileId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiProfilesProfileIdDryJumpstartResponse(rsp)
+}
+
+// PostApiProfilesProfileIdJumpstartWithBodyWithResponse request with arbitrary body returning *PostApiProfilesProfileIdJumpstartResponse
+func (c *ClientWithResponses) PostApiProfilesProfileIdJumpstartWithBodyWithResponse(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdJumpstartResponse, error) {
+	rsp, err := c.PostApiProfilesProfileIdJumpstartWithBody(ctx, profileId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiProfilesProfileIdJumpstartResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiProfilesProfileIdJumpstartWithResponse(ctx context.Context, profileId int, body PostApiProfilesProfileIdJumpstartJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdJumpstartResponse, error) {
+	rsp, err := c.PostApiProfilesProfileIdJumpstart(ctx, profileId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiProfilesProfileIdJumpstartResponse(rsp)
+}
+
+// GetApiProfilesProfileIdSettingsVersionsWithResponse request returning *GetApiProfilesProfileIdSettingsVersionsResponse
+func (c *ClientWithResponses) GetApiProfilesProfileIdSettingsVersionsWithResponse(ctx context.Context, profileId int, params *GetApiProfilesProfileIdSettingsVersionsParams, reqEditors ...RequestEditorFn) (*GetApiProfilesProfileIdSettingsVersionsResponse, error) {
+	rsp, err := c.GetApiProfilesProfileIdSettingsVersions(ctx, profileId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiProfilesProfileIdSettingsVersionsResponse(rsp)
+}
+
+// GetApiProfilesProfileIdSettingsVersionsDiffWithResponse request returning *GetApiProfilesProfileIdSettingsVersionsDiffResponse
+func (c *ClientWithResponses) GetApiProfilesProfileIdSettingsVersionsDiffWithResponse(ctx context.Context, profileId int, params *GetApiProfilesProfileIdSettingsVersionsDiffParams, reqEditors ...RequestEditorFn) (*GetApiProfilesProfileIdSettingsVersionsDiffResponse, error) {
+	rsp, err := c.GetApiProfilesProfileIdSettingsVersionsDiff(ctx, profileId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiProfilesProfileIdSettingsVersionsDiffResponse(rsp)
+}
+
+// PostApiProfilesProfileIdSettingsVersionsRollbackWithBodyWithResponse request with arbitrary body returning *PostApiProfilesProfileIdSettingsVersionsRollbackResponse
+func (c *ClientWithResponses) PostApiProfilesProfileIdSettingsVersionsRollbackWithBodyWithResponse(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdSettingsVersionsRollbackResponse, error) {
+	rsp, err := c.PostApiProfilesProfileIdSettingsVersionsRollbackWithBody(ctx, profileId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiProfilesProfileIdSettingsVersionsRollbackResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiProfilesProfileIdSettingsVersionsRollbackWithResponse(ctx context.Context, profileId int, body PostApiProfilesProfileIdSettingsVersionsRollbackJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdSettingsVersionsRollbackResponse, error) {
+	rsp, err := c.PostApiProfilesProfileIdSettingsVersionsRollback(ctx, profileId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiProfilesProfileIdSettingsVersionsRollbackResponse(rsp)
+}
+
+// GetApiProfilesProfileIdShadowsWithResponse request returning *GetApiProfilesProfileIdShadowsResponse
+func (c *ClientWithResponses) GetApiProfilesProfileIdShadowsWithResponse(ctx context.Context, profileId int, params *GetApiProfilesProfileIdShadowsParams, reqEditors ...RequestEditorFn) (*GetApiProfilesProfileIdShadowsResponse, error) {
+	rsp, err := c.GetApiProfilesProfileIdShadows(ctx, profileId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiProfilesProfileIdShadowsResponse(rsp)
+}
+
+// PostApiProfilesProfileIdShadowsWithBodyWithResponse request with arbitrary body returning *PostApiProfilesProfileIdShadowsResponse
+func (c *ClientWithResponses) PostApiProfilesProfileIdShadowsWithBodyWithResponse(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdShadowsResponse, error) {
+	rsp, err := c.PostApiProfilesProfileIdShadowsWithBody(ctx, profileId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiProfilesProfileIdShadowsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiProfilesProfileIdShadowsWithResponse(ctx context.Context, profileId int, body PostApiProfilesProfileIdShadowsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdShadowsResponse, error) {
+	rsp, err := c.PostApiProfilesProfileIdShadows(ctx, profileId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiProfilesProfileIdShadowsResponse(rsp)
+}
+
+// GetApiProfilesProfileIdShadowsShadowIdWithResponse request returning *GetApiProfilesProfileIdShadowsShadowIdResponse
+func (c *ClientWithResponses) GetApiProfilesProfileIdShadowsShadowIdWithResponse(ctx context.Context, profileId int, shadowId int, reqEditors ...RequestEditorFn) (*GetApiProfilesProfileIdShadowsShadowIdResponse, error) {
+	rsp, err := c.GetApiProfilesProfileIdShadowsShadowId(ctx, profileId, shadowId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiProfilesProfileIdShadowsShadowIdResponse(rsp)
+}
+
+// GetApiProfilesProfileIdShadowsShadowIdDisagreementsWithResponse request returning *GetApiProfilesProfileIdShadowsShadowIdDisagreementsResponse
+func (c *ClientWithResponses) GetApiProfilesProfileIdShadowsShadowIdDisagreementsWithResponse(ctx context.Context, profileId int, shadowId int, params *GetApiProfilesProfileIdShadowsShadowIdDisagreementsParams, reqEditors ...RequestEditorFn) (*GetApiProfilesProfileIdShadowsShadowIdDisagreementsResponse, error) {
+	rsp, err := c.GetApiProfilesProfileIdShadowsShadowIdDisagreements(ctx, profileId, shadowId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiProfilesProfileIdShadowsShadowIdDisagreementsResponse(rsp)
+}
+
+// PostApiProfilesProfileIdShadowsShadowIdStopWithResponse request returning *PostApiProfilesProfileIdShadowsShadowIdStopResponse
+func (c *ClientWithResponses) PostApiProfilesProfileIdShadowsShadowIdStopWithResponse(ctx context.Context, profileId int, shadowId int, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdShadowsShadowIdStopResponse, error) {
+	rsp, err := c.PostApiProfilesProfileIdShadowsShadowIdStop(ctx, profileId, shadowId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiProfilesProfileIdShadowsShadowIdStopResponse(rsp)
+}
+
+// PutApiProfilesProfileIdTasksPriorityWithBodyWithResponse request with arbitrary body returning *PutApiProfilesProfileIdTasksPriorityResponse
+func (c *ClientWithResponses) PutApiProfilesProfileIdTasksPriorityWithBodyWithResponse(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiProfilesProfileIdTasksPriorityResponse, error) {
+	rsp, err := c.PutApiProfilesProfileIdTasksPriorityWithBody(ctx, profileId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiProfilesProfileIdTasksPriorityResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiProfilesProfileIdTasksPriorityWithResponse(ctx context.Context, profileId int, body PutApiProfilesProfileIdTasksPriorityJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiProfilesProfileIdTasksPriorityResponse, error) {
+	rsp, err := c.PutApiProfilesProfileIdTasksPriority(ctx, profileId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiProfilesProfileIdTasksPriorityResponse(rsp)
+}
+
+// GetApiProfilesProfileIdTestCasesWithResponse request returning *GetApiProfilesProfileIdTestCasesResponse
+func (c *ClientWithResponses) GetApiProfilesProfileIdTestCasesWithResponse(ctx context.Context, profileId int, reqEditors ...RequestEditorFn) (*GetApiProfilesProfileIdTestCasesResponse, error) {
+	rsp, err := c.GetApiProfilesProfileIdTestCases(ctx, profileId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiProfilesProfileIdTestCasesResponse(rsp)
+}
+
+// PutApiProfilesProfileIdTestCasesWithBodyWithResponse request with arbitrary body returning *PutApiProfilesProfileIdTestCasesResponse
+func (c *ClientWithResponses) PutApiProfilesProfileIdTestCasesWithBodyWithResponse(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiProfilesProfileIdTestCasesResponse, error) {
+	rsp, err := c.PutApiProfilesProfileIdTestCasesWithBody(ctx, profileId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiProfilesProfileIdTestCasesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiProfilesProfileIdTestCasesWithResponse(ctx context.Context, profileId int, body PutApiProfilesProfileIdTestCasesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiProfilesProfileIdTestCasesResponse, error) {
+	rsp, err := c.PutApiProfilesProfileIdTestCases(ctx, profileId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiProfilesProfileIdTestCasesResponse(rsp)
+}
+
+// DeleteApiProfilesProfileIdTestCasesTestCaseIdWithResponse request returning *DeleteApiProfilesProfileIdTestCasesTestCaseIdResponse
+func (c *ClientWithResponses) DeleteApiProfilesProfileIdTestCasesTestCaseIdWithResponse(ctx context.Context, profileId int, testCaseId int, reqEditors ...RequestEditorFn) (*DeleteApiProfilesProfileIdTestCasesTestCaseIdResponse, error) {
+	rsp, err := c.DeleteApiProfilesProfileIdTestCasesTestCaseId(ctx, profileId, testCaseId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiProfilesProfileIdTestCasesTestCaseIdResponse(rsp)
+}
+
+// GetApiProfilesProfileIdTestRunsWithResponse request returning *GetApiProfilesProfileIdTestRunsResponse
+func (c *ClientWithResponses) GetApiProfilesProfileIdTestRunsWithResponse(ctx context.Context, profileId int, params *GetApiProfilesProfileIdTestRunsParams, reqEditors ...RequestEditorFn) (*GetApiProfilesProfileIdTestRunsResponse, error) {
+	rsp, err := c.GetApiProfilesProfileIdTestRuns(ctx, profileId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiProfilesProfileIdTestRunsResponse(rsp)
+}
+
+// PostApiProfilesProfileIdTestRunsWithBodyWithResponse request with arbitrary body returning *PostApiProfilesProfileIdTestRunsResponse
+func (c *ClientWithResponses) PostApiProfilesProfileIdTestRunsWithBodyWithResponse(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdTestRunsResponse, error) {
+	rsp, err := c.PostApiProfilesProfileIdTestRunsWithBody(ctx, profileId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiProfilesProfileIdTestRunsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiProfilesProfileIdTestRunsWithResponse(ctx context.Context, profileId int, body PostApiProfilesProfileIdTestRunsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdTestRunsResponse, error) {
+	rsp, err := c.PostApiProfilesProfileIdTestRuns(ctx, profileId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiProfilesProfileIdTestRunsResponse(rsp)
+}
+
+// GetApiProfilesProfileIdTestRunsTestRunIdWithResponse request returning *GetApiProfilesProfileIdTestRunsTestRunIdResponse
+func (c *ClientWithResponses) GetApiProfilesProfileIdTestRunsTestRunIdWithResponse(ctx context.Context, profileId int, testRunId int, reqEditors ...RequestEditorFn) (*GetApiProfilesProfileIdTestRunsTestRunIdResponse, error) {
+	rsp, err := c.GetApiProfilesProfileIdTestRunsTestRunId(ctx, profileId, testRunId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiProfilesProfileIdTestRunsTestRunIdResponse(rsp)
+}
+
+// GetApiProfilesProfileIdTestRunsTestRunIdDiffWithResponse request returning *GetApiProfilesProfileIdTestRunsTestRunIdDiffResponse
+func (c *ClientWithResponses) GetApiProfilesProfileIdTestRunsTestRunIdDiffWithResponse(ctx context.Context, profileId int, testRunId int, params *GetApiProfilesProfileIdTestRunsTestRunIdDiffParams, reqEditors ...RequestEditorFn) (*GetApiProfilesProfileIdTestRunsTestRunIdDiffResponse, error) {
+	rsp, err := c.GetApiProfilesProfileIdTestRunsTestRunIdDiff(ctx, profileId, testRunId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiProfilesProfileIdTestRunsTestRunIdDiffResponse(rsp)
+}
+
+// GetApiProfilesProfileIdWebhooksWithResponse request returning *GetApiProfilesProfileIdWebhooksResponse
+func (c *ClientWithResponses) GetApiProfilesProfileIdWebhooksWithResponse(ctx context.Context, profileId int, reqEditors ...RequestEditorFn) (*GetApiProfilesProfileIdWebhooksResponse, error) {
+	rsp, err := c.GetApiProfilesProfileIdWebhooks(ctx, profileId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiProfilesProfileIdWebhooksResponse(rsp)
+}
+
+// PostApiProfilesProfileIdWebhooksWithBodyWithResponse request with arbitrary body returning *PostApiProfilesProfileIdWebhooksResponse
+func (c *ClientWithResponses) PostApiProfilesProfileIdWebhooksWithBodyWithResponse(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdWebhooksResponse, error) {
+	rsp, err := c.PostApiProfilesProfileIdWebhooksWithBody(ctx, profileId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiProfilesProfileIdWebhooksResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiProfilesProfileIdWebhooksWithResponse(ctx context.Context, profileId int, body PostApiProfilesProfileIdWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdWebhooksResponse, error) {
+	rsp, err := c.PostApiProfilesProfileIdWebhooks(ctx, profileId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiProfilesProfileIdWebhooksResponse(rsp)
+}
+
+// GetApiSourcesHackernewsFeedsWithResponse request returning *GetApiSourcesHackernewsFeedsResponse
+func (c *ClientWithResponses) GetApiSourcesHackernewsFeedsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiSourcesHackernewsFeedsResponse, error) {
+	rsp, err := c.GetApiSourcesHackernewsFeeds(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiSourcesHackernewsFeedsResponse(rsp)
+}
+
+// PostApiSourcesHackernewsFeedsFeedAddProfilesWithBodyWithResponse request with arbitrary body returning *PostApiSourcesHackernewsFeedsFeedAddProfilesResponse
+func (c *ClientWithResponses) PostApiSourcesHackernewsFeedsFeedAddProfilesWithBodyWithResponse(ctx context.Context, feed PostApiSourcesHackernewsFeedsFeedAddProfilesParamsFeed, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiSourcesHackernewsFeedsFeedAddProfilesResponse, error) {
+	rsp, err := c.PostApiSourcesHackernewsFeedsFeedAddProfilesWithBody(ctx, feed, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiSourcesHackernewsFeedsFeedAddProfilesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiSourcesHackernewsFeedsFeedAddProfilesWithResponse(ctx context.Context, feed PostApiSourcesHackernewsFeedsFeedAddProfilesParamsFeed, body PostApiSourcesHackernewsFeedsFeedAddProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiSourcesHackernewsFeedsFeedAddProfilesResponse, error) {
+	rsp, err := c.PostApiSourcesHackernewsFeedsFeedAddProfiles(ctx, feed, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiSourcesHackernewsFeedsFeedAddProfilesResponse(rsp)
+}
+
+// PostApiSourcesHackernewsFeedsFeedRemoveProfilesWithBodyWithResponse request with arbitrary body returning *PostApiSourcesHackernewsFeedsFeedRemoveProfilesResponse
+func (c *ClientWithResponses) PostApiSourcesHackernewsFeedsFeedRemoveProfilesWithBodyWithResponse(ctx context.Context, feed PostApiSourcesHackernewsFeedsFeedRemoveProfilesParamsFeed, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiSourcesHackernewsFeedsFeedRemoveProfilesResponse, error) {
+	rsp, err := c.PostApiSourcesHackernewsFeedsFeedRemoveProfilesWithBody(ctx, feed, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiSourcesHackernewsFeedsFeedRemoveProfilesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiSourcesHackernewsFeedsFeedRemoveProfilesWithResponse(ctx context.Context, feed PostApiSourcesHackernewsFeedsFeedRemoveProfilesParamsFeed, body PostApiSourcesHackernewsFeedsFeedRemoveProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiSourcesHackernewsFeedsFeedRemoveProfilesResponse, error) {
+	rsp, err := c.PostApiSourcesHackernewsFeedsFeedRemoveProfiles(ctx, feed, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiSourcesHackernewsFeedsFeedRemoveProfilesResponse(rsp)
+}
+
+// GetApiSourcesHackernewsFeedsWithProfileWithResponse request returning *GetApiSourcesHackernewsFeedsWithProfileResponse
+func (c *ClientWithResponses) GetApiSourcesHackernewsFeedsWithProfileWithResponse(ctx context.Context, params *GetApiSourcesHackernewsFeedsWithProfileParams, reqEditors ...RequestEditorFn) (*GetApiSourcesHackernewsFeedsWithProfileResponse, error) {
+	rsp, err := c.GetApiSourcesHackernewsFeedsWithProfile(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiSourcesHackernewsFeedsWithProfileResponse(rsp)
+}
+
+// GetApiSourcesRedditScrapeStateWithResponse request returning *GetApiSourcesRedditScrapeStateResponse
+func (c *ClientWithResponses) GetApiSourcesRedditScrapeStateWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiSourcesRedditScrapeStateResponse, error) {
+	rsp, err := c.GetApiSourcesRedditScrapeState(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiSourcesRedditScrapeStateResponse(rsp)
+}
+
+// GetApiSourcesRedditSubredditsWithResponse request returning *GetApiSourcesRedditSubredditsResponse
+func (c *ClientWithResponses) GetApiSourcesRedditSubredditsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiSourcesRedditSubredditsResponse, error) {
+	rsp, err := c.GetApiSourcesRedditSubreddits(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiSourcesRedditSubredditsResponse(rsp)
+}
+
+// PostApiSourcesRedditSubredditsSubredditAddProfilesWithBodyWithResponse request with arbitrary body returning *PostApiSourcesRedditSubredditsSubredditAddProfilesResponse
+func (c *ClientWithResponses) PostApiSourcesRedditSubredditsSubredditAddProfilesWithBodyWithResponse(ctx context.Context, subreddit string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiSourcesRedditSubredditsSubredditAddProfilesResponse, error) {
+	rsp, err := c.PostApiSourcesRedditSubredditsSubredditAddProfilesWithBody(ctx, subreddit, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiSourcesRedditSubredditsSubredditAddProfilesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiSourcesRedditSubredditsSubredditAddProfilesWithResponse(ctx context.Context, subreddit string, body PostApiSourcesRedditSubredditsSubredditAddProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiSourcesRedditSubredditsSubredditAddProfilesResponse, error) {
+	rsp, err := c.PostApiSourcesRedditSubredditsSubredditAddProfiles(ctx, subreddit, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiSourcesRedditSubredditsSubredditAddProfilesResponse(rsp)
+}
+
+// PostApiSourcesRedditSubredditsSubredditRemoveProfilesWithBodyWithResponse request with arbitrary body returning *PostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse
+func (c *ClientWithResponses) PostApiSourcesRedditSubredditsSubredditRemoveProfilesWithBodyWithResponse(ctx context.Context, subreddit string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse, error) {
+	rsp, err := c.PostApiSourcesRedditSubredditsSubredditRemoveProfilesWithBody(ctx, subreddit, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiSourcesRedditSubredditsSubredditRemoveProfilesWithResponse(ctx context.Context, subreddit string, body PostApiSourcesRedditSubredditsSubredditRemoveProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse, error) {
+	rsp, err := c.PostApiSourcesRedditSubredditsSubredditRemoveProfiles(ctx, subreddit, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse(rsp)
+}
+
+// GetApiSourcesRedditSubredditsWithProfileWithResponse request returning *GetApiSourcesRedditSubredditsWithProfileResponse
+func (c *ClientWithResponses) GetApiSourcesRedditSubredditsWithProfileWithResponse(ctx context.Context, params *GetApiSourcesRedditSubredditsWithProfileParams, reqEditors ...RequestEditorFn) (*GetApiSourcesRedditSubredditsWithProfileResponse, error) {
+	rsp, err := c.GetApiSourcesRedditSubredditsWithProfile(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiSourcesRedditSubredditsWithProfileResponse(rsp)
+}
+
+// GetApiSourcesRssFeedsWithResponse request returning *GetApiSourcesRssFeedsResponse
+func (c *ClientWithResponses) GetApiSourcesRssFeedsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiSourcesRssFeedsResponse, error) {
+	rsp, err := c.GetApiSourcesRssFeeds(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiSourcesRssFeedsResponse(rsp)
+}
+
+// PostApiSourcesRssFeedsAddProfilesWithBodyWithResponse request with arbitrary body returning *PostApiSourcesRssFeedsAddProfilesResponse
+func (c *ClientWithResponses) PostApiSourcesRssFeedsAddProfilesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiSourcesRssFeedsAddProfilesResponse, error) {
+	rsp, err := c.PostApiSourcesRssFeedsAddProfilesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiSourcesRssFeedsAddProfilesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiSourcesRssFeedsAddProfilesWithResponse(ctx context.Context, body PostApiSourcesRssFeedsAddProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiSourcesRssFeedsAddProfilesResponse, error) {
+	rsp, err := c.PostApiSourcesRssFeedsAddProfiles(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiSourcesRssFeedsAddProfilesResponse(rsp)
+}
+
+// PostApiSourcesRssFeedsRemoveProfilesWithBodyWithResponse request with arbitrary body returning *PostApiSourcesRssFeedsRemoveProfilesResponse
+func (c *ClientWithResponses) PostApiSourcesRssFeedsRemoveProfilesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiSourcesRssFeedsRemoveProfilesResponse, error) {
+	rsp, err := c.PostApiSourcesRssFeedsRemoveProfilesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiSourcesRssFeedsRemoveProfilesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiSourcesRssFeedsRemoveProfilesWithResponse(ctx context.Context, body PostApiSourcesRssFeedsRemoveProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiSourcesRssFeedsRemoveProfilesResponse, error) {
+	rsp, err := c.PostApiSourcesRssFeedsRemoveProfiles(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiSourcesRssFeedsRemoveProfilesResponse(rsp)
+}
+
+// GetApiSourcesRssFeedsWithProfileWithResponse request returning *GetApiSourcesRssFeedsWithProfileResponse
+func (c *ClientWithResponses) GetApiSourcesRssFeedsWithProfileWithResponse(ctx context.Context, params *GetApiSourcesRssFeedsWithProfileParams, reqEditors ...RequestEditorFn) (*GetApiSourcesRssFeedsWithProfileResponse, error) {
+	rsp, err := c.GetApiSourcesRssFeedsWithProfile(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiSourcesRssFeedsWithProfileResponse(rsp)
+}
+
+// GetApiStatisticsProfileIdWithResponse request returning *GetApiStatisticsProfileIdResponse
+func (c *ClientWithResponses) GetApiStatisticsProfileIdWithResponse(ctx context.Context, profileId int, reqEditors ...RequestEditorFn) (*GetApiStatisticsProfileIdResponse, error) {
+	rsp, err := c.GetApiStatisticsProfileId(ctx, profileId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiStatisticsProfileIdResponse(rsp)
+}
+
+// GetApiStatisticsProfileIdAccuracyWithResponse request returning *GetApiStatisticsProfileIdAccuracyResponse
+func (c *ClientWithResponses) GetApiStatisticsProfileIdAccuracyWithResponse(ctx context.Context, profileId int, params *GetApiStatisticsProfileIdAccuracyParams, reqEditors ...RequestEditorFn) (*GetApiStatisticsProfileIdAccuracyResponse, error) {
+	rsp, err := c.GetApiStatisticsProfileIdAccuracy(ctx, profileId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiStatisticsProfileIdAccuracyResponse(rsp)
+}
+
+// GetApiTasksWithResponse request returning *GetApiTasksResponse
+func (c *ClientWithResponses) GetApiTasksWithResponse(ctx context.Context, params *GetApiTasksParams, reqEditors ...RequestEditorFn) (*GetApiTasksResponse, error) {
+	rsp, err := c.GetApiTasks(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiTasksResponse(rsp)
+}
+
+// PostApiTasksCancelWithBodyWithResponse request with arbitrary body returning *PostApiTasksCancelResponse
+func (c *ClientWithResponses) PostApiTasksCancelWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiTasksCancelResponse, error) {
+	rsp, err := c.PostApiTasksCancelWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiTasksCancelResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiTasksCancelWithResponse(ctx context.Context, body PostApiTasksCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiTasksCancelResponse, error) {
+	rsp, err := c.PostApiTasksCancel(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiTasksCancelResponse(rsp)
+}
+
+// PostApiTasksPurgeWithBodyWithResponse request with arbitrary body returning *PostApiTasksPurgeResponse
+func (c *ClientWithResponses) PostApiTasksPurgeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiTasksPurgeResponse, error) {
+	rsp, err := c.PostApiTasksPurgeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiTasksPurgeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiTasksPurgeWithResponse(ctx context.Context, body PostApiTasksPurgeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiTasksPurgeResponse, error) {
+	rsp, err := c.PostApiTasksPurge(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiTasksPurgeResponse(rsp)
+}
+
+// PostApiTasksRetryWithBodyWithResponse request with arbitrary body returning *PostApiTasksRetryResponse
+func (c *ClientWithResponses) PostApiTasksRetryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiTasksRetryResponse, error) {
+	rsp, err := c.PostApiTasksRetryWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiTasksRetryResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostApiTasksRetryWithResponse(ctx context.Context, body PostApiTasksRetryJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiTasksRetryResponse, error) {
+	rsp, err := c.PostApiTasksRetry(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostApiTasksRetryResponse(rsp)
+}
+
+// DeleteApiWebhooksWebhookIdWithResponse request returning *DeleteApiWebhooksWebhookIdResponse
+func (c *ClientWithResponses) DeleteApiWebhooksWebhookIdWithResponse(ctx context.Context, webhookId int, reqEditors ...RequestEditorFn) (*DeleteApiWebhooksWebhookIdResponse, error) {
+	rsp, err := c.DeleteApiWebhooksWebhookId(ctx, webhookId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiWebhooksWebhookIdResponse(rsp)
+}
+
+// PutApiWebhooksWebhookIdWithBodyWithResponse request with arbitrary body returning *PutApiWebhooksWebhookIdResponse
+func (c *ClientWithResponses) PutApiWebhooksWebhookIdWithBodyWithResponse(ctx context.Context, webhookId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiWebhooksWebhookIdResponse, error) {
+	rsp, err := c.PutApiWebhooksWebhookIdWithBody(ctx, webhookId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiWebhooksWebhookIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutApiWebhooksWebhookIdWithResponse(ctx context.Context, webhookId int, body PutApiWebhooksWebhookIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiWebhooksWebhookIdResponse, error) {
+	rsp, err := c.PutApiWebhooksWebhookId(ctx, webhookId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutApiWebhooksWebhookIdResponse(rsp)
+}
+
+// GetApiWebhooksWebhookIdDeliveriesWithResponse request returning *GetApiWebhooksWebhookIdDeliveriesResponse
+func (c *ClientWithResponses) GetApiWebhooksWebhookIdDeliveriesWithResponse(ctx context.Context, webhookId int, params *GetApiWebhooksWebhookIdDeliveriesParams, reqEditors ...RequestEditorFn) (*GetApiWebhooksWebhookIdDeliveriesResponse, error) {
+	rsp, err := c.GetApiWebhooksWebhookIdDeliveries(ctx, webhookId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiWebhooksWebhookIdDeliveriesResponse(rsp)
+}
+
+// ParsePostApiAnalyzeResponse parses an HTTP response from a PostApiAnalyzeWithResponse call
+func ParsePostApiAnalyzeResponse(rsp *http.Response) (*PostApiAnalyzeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiAnalyzeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Detection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiAnalyzersLimitersResponse parses an HTTP response from a GetApiAnalyzersLimitersWithResponse call
+func ParseGetApiAnalyzersLimitersResponse(rsp *http.Response) (*GetApiAnalyzersLimitersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiAnalyzersLimitersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AnalyzerLimiter
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiDetectionsListResponse parses an HTTP response from a PostApiDetectionsListWithResponse call
+func ParsePostApiDetectionsListResponse(rsp *http.Response) (*PostApiDetectionsListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiDetectionsListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ListedDetection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiDetectionsTagsResponse parses an HTTP response from a PutApiDetectionsTagsWithResponse call
+func ParsePutApiDetectionsTagsResponse(rsp *http.Response) (*PutApiDetectionsTagsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiDetectionsTagsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DetectionTags
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiProfilesResponse parses an HTTP response from a GetApiProfilesWithResponse call
+func ParseGetApiProfilesResponse(rsp *http.Response) (*GetApiProfilesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiProfilesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Profile
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiProfilesResponse parses an HTTP response from a PostApiProfilesWithResponse call
+func ParsePostApiProfilesResponse(rsp *http.Response) (*PostApiProfilesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiProfilesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Id int `json:"id"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiProfilesProfileIdResponse parses an HTTP response from a DeleteApiProfilesProfileIdWithResponse call
+func ParseDeleteApiProfilesProfileIdResponse(rsp *http.Response) (*DeleteApiProfilesProfileIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiProfilesProfileIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiProfilesProfileIdResponse parses an HTTP response from a GetApiProfilesProfileIdWithResponse call
+func ParseGetApiProfilesProfileIdResponse(rsp *http.Response) (*GetApiProfilesProfileIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiProfilesProfileIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Profile
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiProfilesProfileIdResponse parses an HTTP response from a PutApiProfilesProfileIdWithResponse call
+func ParsePutApiProfilesProfileIdResponse(rsp *http.Response) (*PutApiProfilesProfileIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiProfilesProfileIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiProfilesProfileIdAnalyzeResponse parses an HTTP response from a PostApiProfilesProfileIdAnalyzeWithResponse call
+func ParsePostApiProfilesProfileIdAnalyzeResponse(rsp *http.Response) (*PostApiProfilesProfileIdAnalyzeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiProfilesProfileIdAnalyzeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiProfilesProfileIdDryJumpstartResponse parses an HTTP response from a PostApiProfilesProfileIdDryJumpstartWithResponse call
+func ParsePostApiProfilesProfileIdDryJumpstartResponse(rsp *http.Response) (*PostApiProfilesProfileIdDryJumpstartResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiProfilesProfileIdDryJumpstartResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AnalysisTaskParameters
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiProfilesProfileIdJumpstartResponse parses an HTTP response from a PostApiProfilesProfileIdJumpstartWithResponse call
+func ParsePostApiProfilesProfileIdJumpstartResponse(rsp *http.Response) (*PostApiProfilesProfileIdJumpstartResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiProfilesProfileIdJumpstartResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiProfilesProfileIdSettingsVersionsResponse parses an HTTP response from a GetApiProfilesProfileIdSettingsVersionsWithResponse call
+func ParseGetApiProfilesProfileIdSettingsVersionsResponse(rsp *http.Response) (*GetApiProfilesProfileIdSettingsVersionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiProfilesProfileIdSettingsVersionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ProfileSettingsVersion
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiProfilesProfileIdSettingsVersionsDiffResponse parses an HTTP response from a GetApiProfilesProfileIdSettingsVersionsDiffWithResponse call
+func ParseGetApiProfilesProfileIdSettingsVersionsDiffResponse(rsp *http.Response) (*GetApiProfilesProfileIdSettingsVersionsDiffResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiProfilesProfileIdSettingsVersionsDiffResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProfileSettingsDiff
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiProfilesProfileIdSettingsVersionsRollbackResponse parses an HTTP response from a PostApiProfilesProfileIdSettingsVersionsRollbackWithResponse call
+func ParsePostApiProfilesProfileIdSettingsVersionsRollbackResponse(rsp *http.Response) (*PostApiProfilesProfileIdSettingsVersionsRollbackResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiProfilesProfileIdSettingsVersionsRollbackResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProfileSettingsVersion
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiProfilesProfileIdShadowsResponse parses an HTTP response from a GetApiProfilesProfileIdShadowsWithResponse call
+func ParseGetApiProfilesProfileIdShadowsResponse(rsp *http.Response) (*GetApiProfilesProfileIdShadowsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiProfilesProfileIdShadowsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Shadow
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiProfilesProfileIdShadowsResponse parses an HTTP response from a PostApiProfilesProfileIdShadowsWithResponse call
+func ParsePostApiProfilesProfileIdShadowsResponse(rsp *http.Response) (*PostApiProfilesProfileIdShadowsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiProfilesProfileIdShadowsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Shadow
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiProfilesProfileIdShadowsShadowIdResponse parses an HTTP response from a GetApiProfilesProfileIdShadowsShadowIdWithResponse call
+func ParseGetApiProfilesProfileIdShadowsShadowIdResponse(rsp *http.Response) (*GetApiProfilesProfileIdShadowsShadowIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiProfilesProfileIdShadowsShadowIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Shadow
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiProfilesProfileIdShadowsShadowIdDisagreementsResponse parses an HTTP response from a GetApiProfilesProfileIdShadowsShadowIdDisagreementsWithResponse call
+func ParseGetApiProfilesProfileIdShadowsShadowIdDisagreementsResponse(rsp *http.Response) (*GetApiProfilesProfileIdShadowsShadowIdDisagreementsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiProfilesProfileIdShadowsShadowIdDisagreementsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ShadowDisagreement
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiProfilesProfileIdShadowsShadowIdStopResponse parses an HTTP response from a PostApiProfilesProfileIdShadowsShadowIdStopWithResponse call
+func ParsePostApiProfilesProfileIdShadowsShadowIdStopResponse(rsp *http.Response) (*PostApiProfilesProfileIdShadowsShadowIdStopResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiProfilesProfileIdShadowsShadowIdStopResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Shadow
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiProfilesProfileIdTasksPriorityResponse parses an HTTP response from a PutApiProfilesProfileIdTasksPriorityWithResponse call
+func ParsePutApiProfilesProfileIdTasksPriorityResponse(rsp *http.Response) (*PutApiProfilesProfileIdTasksPriorityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiProfilesProfileIdTasksPriorityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TaskPriorityUpdateResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiProfilesProfileIdTestCasesResponse parses an HTTP response from a GetApiProfilesProfileIdTestCasesWithResponse call
+func ParseGetApiProfilesProfileIdTestCasesResponse(rsp *http.Response) (*GetApiProfilesProfileIdTestCasesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiProfilesProfileIdTestCasesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TestCase
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiProfilesProfileIdTestCasesResponse parses an HTTP response from a PutApiProfilesProfileIdTestCasesWithResponse call
+func ParsePutApiProfilesProfileIdTestCasesResponse(rsp *http.Response) (*PutApiProfilesProfileIdTestCasesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiProfilesProfileIdTestCasesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TestCase
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiProfilesProfileIdTestCasesTestCaseIdResponse parses an HTTP response from a DeleteApiProfilesProfileIdTestCasesTestCaseIdWithResponse call
+func ParseDeleteApiProfilesProfileIdTestCasesTestCaseIdResponse(rsp *http.Response) (*DeleteApiProfilesProfileIdTestCasesTestCaseIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiProfilesProfileIdTestCasesTestCaseIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiProfilesProfileIdTestRunsResponse parses an HTTP response from a GetApiProfilesProfileIdTestRunsWithResponse call
+func ParseGetApiProfilesProfileIdTestRunsResponse(rsp *http.Response) (*GetApiProfilesProfileIdTestRunsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiProfilesProfileIdTestRunsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TestRun
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiProfilesProfileIdTestRunsResponse parses an HTTP response from a PostApiProfilesProfileIdTestRunsWithResponse call
+func ParsePostApiProfilesProfileIdTestRunsResponse(rsp *http.Response) (*PostApiProfilesProfileIdTestRunsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiProfilesProfileIdTestRunsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TestRun
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiProfilesProfileIdTestRunsTestRunIdResponse parses an HTTP response from a GetApiProfilesProfileIdTestRunsTestRunIdWithResponse call
+func ParseGetApiProfilesProfileIdTestRunsTestRunIdResponse(rsp *http.Response) (*GetApiProfilesProfileIdTestRunsTestRunIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiProfilesProfileIdTestRunsTestRunIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TestRunReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiProfilesProfileIdTestRunsTestRunIdDiffResponse parses an HTTP response from a GetApiProfilesProfileIdTestRunsTestRunIdDiffWithResponse call
+func ParseGetApiProfilesProfileIdTestRunsTestRunIdDiffResponse(rsp *http.Response) (*GetApiProfilesProfileIdTestRunsTestRunIdDiffResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiProfilesProfileIdTestRunsTestRunIdDiffResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TestRunDiff
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiProfilesProfileIdWebhooksResponse parses an HTTP response from a GetApiProfilesProfileIdWebhooksWithResponse call
+func ParseGetApiProfilesProfileIdWebhooksResponse(rsp *http.Response) (*GetApiProfilesProfileIdWebhooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiProfilesProfileIdWebhooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Webhook
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiProfilesProfileIdWebhooksResponse parses an HTTP response from a PostApiProfilesProfileIdWebhooksWithResponse call
+func ParsePostApiProfilesProfileIdWebhooksResponse(rsp *http.Response) (*PostApiProfilesProfileIdWebhooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiProfilesProfileIdWebhooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Webhook
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiSourcesHackernewsFeedsResponse parses an HTTP response from a GetApiSourcesHackernewsFeedsWithResponse call
+func ParseGetApiSourcesHackernewsFeedsResponse(rsp *http.Response) (*GetApiSourcesHackernewsFeedsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiSourcesHackernewsFeedsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []HackerNewsFeedSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiSourcesHackernewsFeedsFeedAddProfilesResponse parses an HTTP response from a PostApiSourcesHackernewsFeedsFeedAddProfilesWithResponse call
+func ParsePostApiSourcesHackernewsFeedsFeedAddProfilesResponse(rsp *http.Response) (*PostApiSourcesHackernewsFeedsFeedAddProfilesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiSourcesHackernewsFeedsFeedAddProfilesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiSourcesHackernewsFeedsFeedRemoveProfilesResponse parses an HTTP response from a PostApiSourcesHackernewsFeedsFeedRemoveProfilesWithResponse call
+func ParsePostApiSourcesHackernewsFeedsFeedRemoveProfilesResponse(rsp *http.Response) (*PostApiSourcesHackernewsFeedsFeedRemoveProfilesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiSourcesHackernewsFeedsFeedRemoveProfilesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiSourcesHackernewsFeedsWithProfileResponse parses an HTTP response from a GetApiSourcesHackernewsFeedsWithProfileWithResponse call
+func ParseGetApiSourcesHackernewsFeedsWithProfileResponse(rsp *http.Response) (*GetApiSourcesHackernewsFeedsWithProfileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiSourcesHackernewsFeedsWithProfileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []HackerNewsFeedSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiSourcesRedditScrapeStateResponse parses an HTTP response from a GetApiSourcesRedditScrapeStateWithResponse call
+func ParseGetApiSourcesRedditScrapeStateResponse(rsp *http.Response) (*GetApiSourcesRedditScrapeStateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiSourcesRedditScrapeStateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []SubredditScrapeState
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiSourcesRedditSubredditsResponse parses an HTTP response from a GetApiSourcesRedditSubredditsWithResponse call
+func ParseGetApiSourcesRedditSubredditsResponse(rsp *http.Response) (*GetApiSourcesRedditSubredditsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiSourcesRedditSubredditsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []SubredditSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiSourcesRedditSubredditsSubredditAddProfilesResponse parses an HTTP response from a PostApiSourcesRedditSubredditsSubredditAddProfilesWithResponse call
+func ParsePostApiSourcesRedditSubredditsSubredditAddProfilesResponse(rsp *http.Response) (*PostApiSourcesRedditSubredditsSubredditAddProfilesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiSourcesRedditSubredditsSubredditAddProfilesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse parses an HTTP response from a PostApiSourcesRedditSubredditsSubredditRemoveProfilesWithResponse call
+func ParsePostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse(rsp *http.Response) (*PostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiSourcesRedditSubredditsWithProfileResponse parses an HTTP response from a GetApiSourcesRedditSubredditsWithProfileWithResponse call
+func ParseGetApiSourcesRedditSubredditsWithProfileResponse(rsp *http.Response) (*GetApiSourcesRedditSubredditsWithProfileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiSourcesRedditSubredditsWithProfileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []SubredditSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiSourcesRssFeedsResponse parses an HTTP response from a GetApiSourcesRssFeedsWithResponse call
+func ParseGetApiSourcesRssFeedsResponse(rsp *http.Response) (*GetApiSourcesRssFeedsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiSourcesRssFeedsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RSSFeedSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiSourcesRssFeedsAddProfilesResponse parses an HTTP response from a PostApiSourcesRssFeedsAddProfilesWithResponse call
+func ParsePostApiSourcesRssFeedsAddProfilesResponse(rsp *http.Response) (*PostApiSourcesRssFeedsAddProfilesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiSourcesRssFeedsAddProfilesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiSourcesRssFeedsRemoveProfilesResponse parses an HTTP response from a PostApiSourcesRssFeedsRemoveProfilesWithResponse call
+func ParsePostApiSourcesRssFeedsRemoveProfilesResponse(rsp *http.Response) (*PostApiSourcesRssFeedsRemoveProfilesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiSourcesRssFeedsRemoveProfilesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiSourcesRssFeedsWithProfileResponse parses an HTTP response from a GetApiSourcesRssFeedsWithProfileWithResponse call
+func ParseGetApiSourcesRssFeedsWithProfileResponse(rsp *http.Response) (*GetApiSourcesRssFeedsWithProfileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiSourcesRssFeedsWithProfileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RSSFeedSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiStatisticsProfileIdResponse parses an HTTP response from a GetApiStatisticsProfileIdWithResponse call
+func ParseGetApiStatisticsProfileIdResponse(rsp *http.Response) (*GetApiStatisticsProfileIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiStatisticsProfileIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProfileStatistics
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiStatisticsProfileIdAccuracyResponse parses an HTTP response from a GetApiStatisticsProfileIdAccuracyWithResponse call
+func ParseGetApiStatisticsProfileIdAccuracyResponse(rsp *http.Response) (*GetApiStatisticsProfileIdAccuracyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiStatisticsProfileIdAccuracyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []SettingsVersionAccuracy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiTasksResponse parses an HTTP response from a GetApiTasksWithResponse call
+func ParseGetApiTasksResponse(rsp *http.Response) (*GetApiTasksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiTasksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AnalysisTask
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiTasksCancelResponse parses an HTTP response from a PostApiTasksCancelWithResponse call
+func ParsePostApiTasksCancelResponse(rsp *http.Response) (*PostApiTasksCancelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiTasksCancelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TaskActionResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiTasksPurgeResponse parses an HTTP response from a PostApiTasksPurgeWithResponse call
+func ParsePostApiTasksPurgeResponse(rsp *http.Response) (*PostApiTasksPurgeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiTasksPurgeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TaskActionResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostApiTasksRetryResponse parses an HTTP response from a PostApiTasksRetryWithResponse call
+func ParsePostApiTasksRetryResponse(rsp *http.Response) (*PostApiTasksRetryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostApiTasksRetryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TaskActionResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteApiWebhooksWebhookIdResponse parses an HTTP response from a DeleteApiWebhooksWebhookIdWithResponse call
+func ParseDeleteApiWebhooksWebhookIdResponse(rsp *http.Response) (*DeleteApiWebhooksWebhookIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteApiWebhooksWebhookIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutApiWebhooksWebhookIdResponse parses an HTTP response from a PutApiWebhooksWebhookIdWithResponse call
+func ParsePutApiWebhooksWebhookIdResponse(rsp *http.Response) (*PutApiWebhooksWebhookIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutApiWebhooksWebhookIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Webhook
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetApiWebhooksWebhookIdDeliveriesResponse parses an HTTP response from a GetApiWebhooksWebhookIdDeliveriesWithResponse call
+func ParseGetApiWebhooksWebhookIdDeliveriesResponse(rsp *http.Response) (*GetApiWebhooksWebhookIdDeliveriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetApiWebhooksWebhookIdDeliveriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []WebhookDeliveryAttempt
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Analyze a post
+	// (POST /api/analyze)
+	PostApiAnalyze(c *gin.Context)
+	// Get current limits and utilization of LLM providers
+	// (GET /api/analyzers/limiters)
+	GetApiAnalyzersLimiters(c *gin.Context)
+	// List detections
+	// (POST /api/detections/list)
+	PostApiDetectionsList(c *gin.Context)
+	// Detection tag update
+	// (PUT /api/detections/tags)
+	PutApiDetectionsTags(c *gin.Context)
+	// Get all profiles
+	// (GET /api/profiles)
+	GetApiProfiles(c *gin.Context)
+	// Create a new profile
+	// (POST /api/profiles)
+	PostApiProfiles(c *gin.Context)
+	// Delete a profile by ID
+	// (DELETE /api/profiles/{profileId})
+	DeleteApiProfilesProfileId(c *gin.Context, profileId int)
+	// Get a profile by ID
+	// (GET /api/profiles/{profileId})
+	GetApiProfilesProfileId(c *gin.Context, profileId int)
+	// Update a profile by ID
+	// (PUT /api/profiles/{profileId})
+	PutApiProfilesProfileId(c *gin.Context, profileId int)
+	// Request analysis of posts for a profile
+	// (POST /api/profiles/{profileId}/analyze)
+	PostApiProfilesProfileIdAnalyze(c *gin.Context, profileId int)
+	// Dry jumpstart a profile - load tasks to be spawned
+	// (POST /api/profiles/{profileId}/dry_jumpstart)
+	PostApiProfilesProfileIdDryJumpstart(c *gin.Context, profileId int)
+	// Jumpstart a profile - run analysis on old posts
+	// (POST /api/profiles/{profileId}/jumpstart)
+	PostApiProfilesProfileIdJumpstart(c *gin.Context, profileId int)
+	// List versions of profile settings for a source
+	// (GET /api/profiles/{profileId}/settings_versions)
+	GetApiProfilesProfileIdSettingsVersions(c *gin.Context, profileId int, params GetApiProfilesProfileIdSettingsVersionsParams)
+	// Compare two versions of profile settings for a source
+	// (GET /api/profiles/{profileId}/settings_versions/diff)
+	GetApiProfilesProfileIdSettingsVersionsDiff(c *gin.Context, profileId int, params GetApiProfilesProfileIdSettingsVersionsDiffParams)
+	// Create a new version of profile settings from an older version
+	// (POST /api/profiles/{profileId}/settings_versions/rollback)
+	PostApiProfilesProfileIdSettingsVersionsRollback(c *gin.Context, profileId int)
+	// List the latest shadows of a profile
+	// (GET /api/profiles/{profileId}/shadows)
+	GetApiProfilesProfileIdShadows(c *gin.Context, profileId int, params GetApiProfilesProfileIdShadowsParams)
+	// Start evaluating candidate settings of a profile on live traffic
+	// (POST /api/profiles/{profileId}/shadows)
+	PostApiProfilesProfileIdShadows(c *gin.Context, profileId int)
+	// Get a shadow with a summary of its results
+	// (GET /api/profiles/{profileId}/shadows/{shadowId})
+	GetApiProfilesProfileIdShadowsShadowId(c *gin.Context, profileId int, shadowId int)
+	// List posts for which a shadow disagrees with production detections
+	// (GET /api/profiles/{profileId}/shadows/{shadowId}/disagreements)
+	GetApiProfilesProfileIdShadowsShadowIdDisagreements(c *gin.Context, profileId int, shadowId int, params GetApiProfilesProfileIdShadowsShadowIdDisagreementsParams)
+	// Stop analyzing new posts with settings of a shadow, its results are kept
+	// (POST /api/profiles/{profileId}/shadows/{shadowId}/stop)
+	PostApiProfilesProfileIdShadowsShadowIdStop(c *gin.Context, profileId int, shadowId int)
+	// Bump or lower priority of pending analysis tasks of a profile
+	// (PUT /api/profiles/{profileId}/tasks/priority)
+	PutApiProfilesProfileIdTasksPriority(c *gin.Context, profileId int)
+	// List the test suite of a profile
+	// (GET /api/profiles/{profileId}/test_cases)
+	GetApiProfilesProfileIdTestCases(c *gin.Context, profileId int)
+	// Add a post to the test suite of a profile or replace its expected values
+	// (PUT /api/profiles/{profileId}/test_cases)
+	PutApiProfilesProfileIdTestCases(c *gin.Context, profileId int)
+	// Remove a post from the test suite of a profile, results of past runs are kept
+	// (DELETE /api/profiles/{profileId}/test_cases/{testCaseId})
+	DeleteApiProfilesProfileIdTestCasesTestCaseId(c *gin.Context, profileId int, testCaseId int)
+	// List the latest test runs of a profile
+	// (GET /api/profiles/{profileId}/test_runs)
+	GetApiProfilesProfileIdTestRuns(c *gin.Context, profileId int, params GetApiProfilesProfileIdTestRunsParams)
+	// Run the test suite of a profile against draft or saved settings
+	// (POST /api/profiles/{profileId}/test_runs)
+	PostApiProfilesProfileIdTestRuns(c *gin.Context, profileId int)
+	// Get a test run with results of its test cases
+	// (GET /api/profiles/{profileId}/test_runs/{testRunId})
+	GetApiProfilesProfileIdTestRunsTestRunId(c *gin.Context, profileId int, testRunId int)
+	// Compare results of a test run with a base run
+	// (GET /api/profiles/{profileId}/test_runs/{testRunId}/diff)
+	GetApiProfilesProfileIdTestRunsTestRunIdDiff(c *gin.Context, profileId int, testRunId int, params GetApiProfilesProfileIdTestRunsTestRunIdDiffParams)
+	// List webhooks of a profile
+	// (GET /api/profiles/{profileId}/webhooks)
+	GetApiProfilesProfileIdWebhooks(c *gin.Context, profileId int)
+	// Subscribe a webhook to relevant detections of a profile
+	// (POST /api/profiles/{profileId}/webhooks)
+	PostApiProfilesProfileIdWebhooks(c *gin.Context, profileId int)
+	// Get all Hacker News feeds
+	// (GET /api/sources/hackernews/feeds)
+	GetApiSourcesHackernewsFeeds(c *gin.Context)
+	// Add profiles
+	// (POST /api/sources/hackernews/feeds/{feed}/add_profiles)
+	PostApiSourcesHackernewsFeedsFeedAddProfiles(c *gin.Context, feed PostApiSourcesHackernewsFeedsFeedAddProfilesParamsFeed)
+	// Remove profiles
+	// (POST /api/sources/hackernews/feeds/{feed}/remove_profiles)
+	PostApiSourcesHackernewsFeedsFeedRemoveProfiles(c *gin.Context, feed PostApiSourcesHackernewsFeedsFeedRemoveProfilesParamsFeed)
+	// Get all Hacker News feeds by profile
+	// (GET /api/sources/hackernews/feeds_with_profile)
+	GetApiSourcesHackernewsFeedsWithProfile(c *gin.Context, params GetApiSourcesHackernewsFeedsWithProfileParams)
+	// Get scrape progress of all subreddits
+	// (GET /api/sources/reddit/scrape_state)
+	GetApiSourcesRedditScrapeState(c *gin.Context)
+	// Get all subreddits
+	// (GET /api/sources/reddit/subreddits)
+	GetApiSourcesRedditSubreddits(c *gin.Context)
+	// Add profiles
+	// (POST /api/sources/reddit/subreddits/{subreddit}/add_profiles)
+	PostApiSourcesRedditSubredditsSubredditAddProfiles(c *gin.Context, subreddit string)
+	// Remove profiles
+	// (POST /api/sources/reddit/subreddits/{subreddit}/remove_profiles)
+	PostApiSourcesRedditSubredditsSubredditRemoveProfiles(c *gin.Context, subreddit string)
+	// Get all subreddits by profile
+	// (GET /api/sources/reddit/subreddits_with_profile)
+	GetApiSourcesRedditSubredditsWithProfile(c *gin.Context, params GetApiSourcesRedditSubredditsWithProfileParams)
+	// Get all RSS/Atom feeds
+	// (GET /api/sources/rss/feeds)
+	GetApiSourcesRssFeeds(c *gin.Context)
+	// Add profiles to an RSS/Atom feed
+	// (POST /api/sources/rss/feeds/add_profiles)
+	PostApiSourcesRssFeedsAddProfiles(c *gin.Context)
+	// Remove profiles from an RSS/Atom feed
+	// (POST /api/sources/rss/feeds/remove_profiles)
+	PostApiSourcesRssFeedsRemoveProfiles(c *gin.Context)
+	// Get all RSS/Atom feeds by profile
+	// (GET /api/sources/rss/feeds_with_profile)
+	GetApiSourcesRssFeedsWithProfile(c *gin.Context, params GetApiSourcesRssFeedsWithProfileParams)
+	// Get statistics for a profile
+	// (GET /api/statistics/{profileId})
+	GetApiStatisticsProfileId(c *gin.Context, profileId int)
+	// Get accuracy of each settings version of a profile source, measured by relevancy tags
+	// (GET /api/statistics/{profileId}/accuracy)
+	GetApiStatisticsProfileIdAccuracy(c *gin.Context, profileId int, params GetApiStatisticsProfileIdAccuracyParams)
+	// List analysis tasks, newest first
+	// (GET /api/tasks)
+	GetApiTasks(c *gin.Context, params GetApiTasksParams)
+	// Cancel pending tasks of a profile - remove them from the queue
+	// (POST /api/tasks/cancel)
+	PostApiTasksCancel(c *gin.Context)
+	// Delete old committed tasks
+	// (POST /api/tasks/purge)
+	PostApiTasksPurge(c *gin.Context)
+	// Retry failed tasks - reset their errors and return them to the queue
+	// (POST /api/tasks/retry)
+	PostApiTasksRetry(c *gin.Context)
+	// Delete a webhook with its pending events and delivery log
+	// (DELETE /api/webhooks/{webhookId})
+	DeleteApiWebhooksWebhookId(c *gin.Context, webhookId int)
+	// Update a webhook
+	// (PUT /api/webhooks/{webhookId})
+	PutApiWebhooksWebhookId(c *gin.Context, webhookId int)
+	// List the latest delivery attempts of a webhook
+	// (GET /api/webhooks/{webhookId}/deliveries)
+	GetApiWebhooksWebhookIdDeliveries(c *gin.Context, webhookId int, params GetApiWebhooksWebhookIdDeliveriesParams)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandler       func(*gin.Context, error, int)
+}
+
+type MiddlewareFunc func(c *gin.Context)
+
+// PostApiAnalyze operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAnalyze(c *gin.Context) {
+
+	c.Set(ApiKeyAuthScopes, []string{"profiles:manage"})
+
+	c.Set(BasicAuthScopes, []string{"profiles:manage"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiAnalyze(c)
+}
+
+// GetApiAnalyzersLimiters operation middleware
+func (siw *ServerInterfaceWrapper) GetApiAnalyzersLimiters(c *gin.Context) {
+
+	c.Set(ApiKeyAuthScopes, []string{"queue:admin"})
+
+	c.Set(BasicAuthScopes, []string{"queue:admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiAnalyzersLimiters(c)
+}
+
+// PostApiDetectionsList operation middleware
+func (siw *ServerInterfaceWrapper) PostApiDetectionsList(c *gin.Context) {
+
+	c.Set(ApiKeyAuthScopes, []string{"detections:read"})
+
+	c.Set(BasicAuthScopes, []string{"detections:read"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiDetectionsList(c)
+}
+
+// PutApiDetectionsTags operation middleware
+func (siw *ServerInterfaceWrapper) PutApiDetectionsTags(c *gin.Context) {
+
+	c.Set(ApiKeyAuthScopes, []string{"profiles:manage"})
+
+	c.Set(BasicAuthScopes, []string{"profiles:manage"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutApiDetectionsTags(c)
+}
+
+// GetApiProfiles operation middleware
+func (siw *ServerInterfaceWrapper) GetApiProfiles(c *gin.Context) {
+
+	c.Set(ApiKeyAuthScopes, []string{"detections:read"})
+
+	c.Set(BasicAuthScopes, []string{"detections:read"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiProfiles(c)
+}
+
+// PostApiProfiles operation middleware
+func (siw *ServerInterfaceWrapper) PostApiProfiles(c *gin.Context) {
+
+	c.Set(ApiKeyAuthScopes, []string{"profiles:manage"})
+
+	c.Set(BasicAuthScopes, []string{"profiles:manage"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiProfiles(c)
+}
+
+// DeleteApiProfilesProfileId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiProfilesProfileId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "profileId" -------------
+	var profileId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "profileId", c.Param("profileId"), &profileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profileId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"profiles:manage"})
+
+	c.Set(BasicAuthScopes, []string{"profiles:manage"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiProfilesProfileId(c, profileId)
+}
+
+// GetApiProfilesProfileId operation middleware
+func (siw *ServerInterfaceWrapper) GetApiProfilesProfileId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "profileId" -------------
+	var profileId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "profileId", c.Param("profileId"), &profileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profileId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"detections:read"})
+
+	c.Set(BasicAuthScopes, []string{"detections:read"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiProfilesProfileId(c, profileId)
+}
+
+// PutApiProfilesProfileId operation middleware
+func (siw *ServerInterfaceWrapper) PutApiProfilesProfileId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "profileId" -------------
+	var profileId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "profileId", c.Param("profileId"), &profileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profileId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"profiles:manage"})
+
+	c.Set(BasicAuthScopes, []string{"profiles:manage"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutApiProfilesProfileId(c, profileId)
+}
+
+// PostApiProfilesProfileIdAnalyze operation middleware
+func (siw *ServerInterfaceWrapper) PostApiProfilesProfileIdAnalyze(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "profileId" -------------
+	var profileId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "profileId", c.Param("profileId"), &profileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profileId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"profiles:manage"})
+
+	c.Set(BasicAuthScopes, []string{"profiles:manage"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiProfilesProfileIdAnalyze(c, profileId)
+}
+
+// PostApiProfilesProfileIdDryJumpstart operation middleware
+func (siw *ServerInterfaceWrapper) PostApiProfilesProfileIdDryJumpstart(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "profileId" -------------
+	var profileId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "profileId", c.Param("profileId"), &profileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profileId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"detections:read"})
+
+	c.Set(BasicAuthScopes, []string{"detections:read"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiProfilesProfileIdDryJumpstart(c, profileId)
+}
+
+// PostApiProfilesProfileIdJumpstart operation middleware
+func (siw *ServerInterfaceWrapper) PostApiProfilesProfileIdJumpstart(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "profileId" -------------
+	var profileId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "profileId", c.Param("profileId"), &profileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profileId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"profiles:manage"})
+
+	c.Set(BasicAuthScopes, []string{"profiles:manage"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiProfilesProfileIdJumpstart(c, profileId)
+}
+
+// GetApiProfilesProfileIdSettingsVersions operation middleware
+func (siw *ServerInterfaceWrapper) GetApiProfilesProfileIdSettingsVersions(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "profileId" -------------
+	var profileId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "profileId", c.Param("profileId"), &profileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profileId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"detections:read"})
+
+	c.Set(BasicAuthScopes, []string{"detections:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiProfilesProfileIdSettingsVersionsParams
+
+	// ------------- Optional query parameter "source" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "source", c.Request.URL.Query(), &params.Source)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter source: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiProfilesProfileIdSettingsVersions(c, profileId, params)
+}
+
+// GetApiProfilesProfileIdSettingsVersionsDiff operation middleware
+func (siw *ServerInterfaceWrapper) GetApiProfilesProfileIdSettingsVersionsDiff(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "profileId" -------------
+	var profileId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "profileId", c.Param("profileId"), &profileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profileId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"detections:read"})
+
+	c.Set(BasicAuthScopes, []string{"detections:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiProfilesProfileIdSettingsVersionsDiffParams
+
+	// ------------- Optional query parameter "source" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "source", c.Request.URL.Query(), &params.Source)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter source: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := c.Query("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument from is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := c.Query("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument to is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiProfilesProfileIdSettingsVersionsDiff(c, profileId, params)
+}
+
+// PostApiProfilesProfileIdSettingsVersionsRollback operation middleware
+func (siw *ServerInterfaceWrapper) PostApiProfilesProfileIdSettingsVersionsRollback(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "profileId" -------------
+	var profileId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "profileId", c.Param("profileId"), &profileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profileId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"profiles:manage"})
+
+	c.Set(BasicAuthScopes, []string{"profiles:manage"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiProfilesProfileIdSettingsVersionsRollback(c, profileId)
+}
+
+// GetApiProfilesProfileIdShadows operation middleware
+func (siw *ServerInterfaceWrapper) GetApiProfilesProfileIdShadows(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "profileId" -------------
+	var profileId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "profileId", c.Param("profileId"), &profileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profileId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"detections:read"})
+
+	c.Set(BasicAuthScopes, []string{"detections:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiProfilesProfileIdShadowsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiProfilesProfileIdShadows(c, profileId, params)
+}
+
+// PostApiProfilesProfileIdShadows operation middleware
+func (siw *ServerInterfaceWrapper) PostApiProfilesProfileIdShadows(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "profileId" -------------
+	var profileId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "profileId", c.Param("profileId"), &profileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profileId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"profiles:manage"})
+
+	c.Set(BasicAuthScopes, []string{"profiles:manage"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiProfilesProfileIdShadows(c, profileId)
+}
+
+// GetApiProfilesProfileIdShadowsShadowId operation middleware
+func (siw *ServerInterfaceWrapper) GetApiProfilesProfileIdShadowsShadowId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "profileId" -------------
+	var profileId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "profileId", c.Param("profileId"), &profileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profileId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "shadowId" -------------
+	var shadowId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "shadowId", c.Param("shadowId"), &shadowId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter shadowId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"detections:read"})
+
+	c.Set(BasicAuthScopes, []string{"detections:read"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiProfilesProfileIdShadowsShadowId(c, profileId, shadowId)
+}
+
+// GetApiProfilesProfileIdShadowsShadowIdDisagreements operation middleware
+func (siw *ServerInterfaceWrapper) GetApiProfilesProfileIdShadowsShadowIdDisagreements(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "profileId" -------------
+	var profileId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "profileId", c.Param("profileId"), &profileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profileId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "shadowId" -------------
+	var shadowId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "shadowId", c.Param("shadowId"), &shadowId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter shadowId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"detections:read"})
+
+	c.Set(BasicAuthScopes, []string{"detections:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiProfilesProfileIdShadowsShadowIdDisagreementsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiProfilesProfileIdShadowsShadowIdDisagreements(c, profileId, shadowId, params)
+}
+
+// PostApiProfilesProfileIdShadowsShadowIdStop operation middleware
+func (siw *ServerInterfaceWrapper) PostApiProfilesProfileIdShadowsShadowIdStop(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "profileId" -------------
+	var profileId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "profileId", c.Param("profileId"), &profileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profileId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "shadowId" -------------
+	var shadowId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "shadowId", c.Param("shadowId"), &shadowId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter shadowId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"profiles:manage"})
+
+	c.Set(BasicAuthScopes, []string{"profiles:manage"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiProfilesProfileIdShadowsShadowIdStop(c, profileId, shadowId)
+}
+
+// PutApiProfilesProfileIdTasksPriority operation middleware
+func (siw *ServerInterfaceWrapper) PutApiProfilesProfileIdTasksPriority(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "profileId" -------------
+	var profileId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "profileId", c.Param("profileId"), &profileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profileId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"queue:admin"})
+
+	c.Set(BasicAuthScopes, []string{"queue:admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutApiProfilesProfileIdTasksPriority(c, profileId)
+}
+
+// GetApiProfilesProfileIdTestCases operation middleware
+func (siw *ServerInterfaceWrapper) GetApiProfilesProfileIdTestCases(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "profileId" -------------
+	var profileId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "profileId", c.Param("profileId"), &profileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profileId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"detections:read"})
+
+	c.Set(BasicAuthScopes, []string{"detections:read"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiProfilesProfileIdTestCases(c, profileId)
+}
+
+// PutApiProfilesProfileIdTestCases operation middleware
+func (siw *ServerInterfaceWrapper) PutApiProfilesProfileIdTestCases(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "profileId" -------------
+	var profileId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "profileId", c.Param("profileId"), &profileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profileId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"profiles:manage"})
+
+	c.Set(BasicAuthScopes, []string{"profiles:manage"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutApiProfilesProfileIdTestCases(c, profileId)
+}
+
+// DeleteApiProfilesProfileIdTestCasesTestCaseId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiProfilesProfileIdTestCasesTestCaseId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "profileId" -------------
+	var profileId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "profileId", c.Param("profileId"), &profileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profileId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "testCaseId" -------------
+	var testCaseId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "testCaseId", c.Param("testCaseId"), &testCaseId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter testCaseId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"profiles:manage"})
+
+	c.Set(BasicAuthScopes, []string{"profiles:manage"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiProfilesProfileIdTestCasesTestCaseId(c, profileId, testCaseId)
+}
+
+// GetApiProfilesProfileIdTestRuns operation middleware
+func (siw *ServerInterfaceWrapper) GetApiProfilesProfileIdTestRuns(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "profileId" -------------
+	var profileId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "profileId", c.Param("profileId"), &profileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profileId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"detections:read"})
+
+	c.Set(BasicAuthScopes, []string{"detections:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiProfilesProfileIdTestRunsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiProfilesProfileIdTestRuns(c, profileId, params)
+}
+
+// PostApiProfilesProfileIdTestRuns operation middleware
+func (siw *ServerInterfaceWrapper) PostApiProfilesProfileIdTestRuns(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "profileId" -------------
+	var profileId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "profileId", c.Param("profileId"), &profileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profileId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"profiles:manage"})
+
+	c.Set(BasicAuthScopes, []string{"profiles:manage"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiProfilesProfileIdTestRuns(c, profileId)
+}
+
+// GetApiProfilesProfileIdTestRunsTestRunId operation middleware
+func (siw *ServerInterfaceWrapper) GetApiProfilesProfileIdTestRunsTestRunId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "profileId" -------------
+	var profileId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "profileId", c.Param("profileId"), &profileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profileId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "testRunId" -------------
+	var testRunId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "testRunId", c.Param("testRunId"), &testRunId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter testRunId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"detections:read"})
+
+	c.Set(BasicAuthScopes, []string{"detections:read"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiProfilesProfileIdTestRunsTestRunId(c, profileId, testRunId)
+}
+
+// GetApiProfilesProfileIdTestRunsTestRunIdDiff operation middleware
+func (siw *ServerInterfaceWrapper) GetApiProfilesProfileIdTestRunsTestRunIdDiff(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "profileId" -------------
+	var profileId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "profileId", c.Param("profileId"), &profileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profileId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "testRunId" -------------
+	var testRunId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "testRunId", c.Param("testRunId"), &testRunId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter testRunId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"detections:read"})
+
+	c.Set(BasicAuthScopes, []string{"detections:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiProfilesProfileIdTestRunsTestRunIdDiffParams
+
+	// ------------- Required query parameter "base" -------------
+
+	if paramValue := c.Query("base"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument base is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "base", c.Request.URL.Query(), &params.Base)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter base: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiProfilesProfileIdTestRunsTestRunIdDiff(c, profileId, testRunId, params)
+}
+
+// GetApiProfilesProfileIdWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) GetApiProfilesProfileIdWebhooks(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "profileId" -------------
+	var profileId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "profileId", c.Param("profileId"), &profileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profileId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"profiles:manage"})
+
+	c.Set(BasicAuthScopes, []string{"profiles:manage"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiProfilesProfileIdWebhooks(c, profileId)
+}
+
+// PostApiProfilesProfileIdWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) PostApiProfilesProfileIdWebhooks(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "profileId" -------------
+	var profileId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "profileId", c.Param("profileId"), &profileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profileId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"profiles:manage"})
+
+	c.Set(BasicAuthScopes, []string{"profiles:manage"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiProfilesProfileIdWebhooks(c, profileId)
+}
+
+// GetApiSourcesHackernewsFeeds operation middleware
+func (siw *ServerInterfaceWrapper) GetApiSourcesHackernewsFeeds(c *gin.Context) {
+
+	c.Set(ApiKeyAuthScopes, []string{"detections:read"})
+
+	c.Set(BasicAuthScopes, []string{"detections:read"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiSourcesHackernewsFeeds(c)
+}
+
+// PostApiSourcesHackernewsFeedsFeedAddProfiles operation middleware
+func (siw *ServerInterfaceWrapper) PostApiSourcesHackernewsFeedsFeedAddProfiles(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "feed" -------------
+	var feed PostApiSourcesHackernewsFeedsFeedAddProfilesParamsFeed
+
+	err = runtime.BindStyledParameterWithOptions("simple", "feed", c.Param("feed"), &feed, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter feed: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"profiles:manage"})
+
+	c.Set(BasicAuthScopes, []string{"profiles:manage"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiSourcesHackernewsFeedsFeedAddProfiles(c, feed)
+}
+
+// PostApiSourcesHackernewsFeedsFeedRemoveProfiles operation middleware
+func (siw *ServerInterfaceWrapper) PostApiSourcesHackernewsFeedsFeedRemoveProfiles(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "feed" -------------
+	var feed PostApiSourcesHackernewsFeedsFeedRemoveProfilesParamsFeed
+
+	err = runtime.BindStyledParameterWithOptions("simple", "feed", c.Param("feed"), &feed, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter feed: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"profiles:manage"})
+
+	c.Set(BasicAuthScopes, []string{"profiles:manage"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiSourcesHackernewsFeedsFeedRemoveProfiles(c, feed)
+}
+
+// GetApiSourcesHackernewsFeedsWithProfile operation middleware
+func (siw *ServerInterfaceWrapper) GetApiSourcesHackernewsFeedsWithProfile(c *gin.Context) {
+
+	var err error
+
+	c.Set(ApiKeyAuthScopes, []string{"detections:read"})
+
+	c.Set(BasicAuthScopes, []string{"detections:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiSourcesHackernewsFeedsWithProfileParams
+
+	// ------------- Required query parameter "profile_id" -------------
+
+	if paramValue := c.Query("profile_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument profile_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "profile_id", c.Request.URL.Query(), &params.ProfileId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profile_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiSourcesHackernewsFeedsWithProfile(c, params)
+}
+
+// GetApiSourcesRedditScrapeState operation middleware
+func (siw *ServerInterfaceWrapper) GetApiSourcesRedditScrapeState(c *gin.Context) {
+
+	c.Set(ApiKeyAuthScopes, []string{"detections:read"})
+
+	c.Set(BasicAuthScopes, []string{"detections:read"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiSourcesRedditScrapeState(c)
+}
+
+// GetApiSourcesRedditSubreddits operation middleware
+func (siw *ServerInterfaceWrapper) GetApiSourcesRedditSubreddits(c *gin.Context) {
+
+	c.Set(ApiKeyAuthScopes, []string{"detections:read"})
+
+	c.Set(BasicAuthScopes, []string{"detections:read"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiSourcesRedditSubreddits(c)
+}
+
+// PostApiSourcesRedditSubredditsSubredditAddProfiles operation middleware
+func (siw *ServerInterfaceWrapper) PostApiSourcesRedditSubredditsSubredditAddProfiles(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "subreddit" -------------
+	var subreddit string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subreddit", c.Param("subreddit"), &subreddit, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter subreddit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"profiles:manage"})
+
+	c.Set(BasicAuthScopes, []string{"profiles:manage"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiSourcesRedditSubredditsSubredditAddProfiles(c, subreddit)
+}
+
+// PostApiSourcesRedditSubredditsSubredditRemoveProfiles operation middleware
+func (siw *ServerInterfaceWrapper) PostApiSourcesRedditSubredditsSubredditRemoveProfiles(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "subreddit" -------------
+	var subreddit string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subreddit", c.Param("subreddit"), &subreddit, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter subreddit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"profiles:manage"})
+
+	c.Set(BasicAuthScopes, []string{"profiles:manage"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiSourcesRedditSubredditsSubredditRemoveProfiles(c, subreddit)
+}
+
+// GetApiSourcesRedditSubredditsWithProfile operation middleware
+func (siw *ServerInterfaceWrapper) GetApiSourcesRedditSubredditsWithProfile(c *gin.Context) {
+
+	var err error
+
+	c.Set(ApiKeyAuthScopes, []string{"detections:read"})
+
+	c.Set(BasicAuthScopes, []string{"detections:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiSourcesRedditSubredditsWithProfileParams
+
+	// ------------- Required query parameter "profile_id" -------------
+
+	if paramValue := c.Query("profile_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument profile_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "profile_id", c.Request.URL.Query(), &params.ProfileId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profile_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiSourcesRedditSubredditsWithProfile(c, params)
+}
+
+// GetApiSourcesRssFeeds operation middleware
+func (siw *ServerInterfaceWrapper) GetApiSourcesRssFeeds(c *gin.Context) {
+
+	c.Set(ApiKeyAuthScopes, []string{"detections:read"})
+
+	c.Set(BasicAuthScopes, []string{"detections:read"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiSourcesRssFeeds(c)
+}
+
+// PostApiSourcesRssFeedsAddProfiles operation middleware
+func (siw *ServerInterfaceWrapper) PostApiSourcesRssFeedsAddProfiles(c *gin.Context) {
+
+	c.Set(ApiKeyAuthScopes, []string{"profiles:manage"})
+
+	c.Set(BasicAuthScopes, []string{"profiles:manage"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiSourcesRssFeedsAddProfiles(c)
+}
+
+// PostApiSourcesRssFeedsRemoveProfiles operation middleware
+func (siw *ServerInterfaceWrapper) PostApiSourcesRssFeedsRemoveProfiles(c *gin.Context) {
+
+	c.Set(ApiKeyAuthScopes, []string{"profiles:manage"})
+
+	c.Set(BasicAuthScopes, []string{"profiles:manage"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiSourcesRssFeedsRemoveProfiles(c)
+}
+
+// GetApiSourcesRssFeedsWithProfile operation middleware
+func (siw *ServerInterfaceWrapper) GetApiSourcesRssFeedsWithProfile(c *gin.Context) {
+
+	var err error
+
+	c.Set(ApiKeyAuthScopes, []string{"detections:read"})
+
+	c.Set(BasicAuthScopes, []string{"detections:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiSourcesRssFeedsWithProfileParams
+
+	// ------------- Required query parameter "profile_id" -------------
+
+	if paramValue := c.Query("profile_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument profile_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "profile_id", c.Request.URL.Query(), &params.ProfileId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profile_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiSourcesRssFeedsWithProfile(c, params)
+}
+
+// GetApiStatisticsProfileId operation middleware
+func (siw *ServerInterfaceWrapper) GetApiStatisticsProfileId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "profileId" -------------
+	var profileId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "profileId", c.Param("profileId"), &profileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profileId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"detections:read"})
+
+	c.Set(BasicAuthScopes, []string{"detections:read"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiStatisticsProfileId(c, profileId)
+}
+
+// GetApiStatisticsProfileIdAccuracy operation middleware
+func (siw *ServerInterfaceWrapper) GetApiStatisticsProfileIdAccuracy(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "profileId" -------------
+	var profileId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "profileId", c.Param("profileId"), &profileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profileId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"detections:read"})
+
+	c.Set(BasicAuthScopes, []string{"detections:read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiStatisticsProfileIdAccuracyParams
+
+	// ------------- Required query parameter "source" -------------
+
+	if paramValue := c.Query("source"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument source is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "source", c.Request.URL.Query(), &params.Source)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter source: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", c.Request.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter since: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", c.Request.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter until: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiStatisticsProfileIdAccuracy(c, profileId, params)
+}
+
+// GetApiTasks operation middleware
+func (siw *ServerInterfaceWrapper) GetApiTasks(c *gin.Context) {
+
+	var err error
+
+	c.Set(ApiKeyAuthScopes, []string{"queue:admin"})
+
+	c.Set(BasicAuthScopes, []string{"queue:admin"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiTasksParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "profile_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "profile_id", c.Request.URL.Query(), &params.ProfileId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profile_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "source" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "source", c.Request.URL.Query(), &params.Source)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter source: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", c.Request.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter type: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "last_seen_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "last_seen_id", c.Request.URL.Query(), &params.LastSeenId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter last_seen_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiTasks(c, params)
+}
+
+// PostApiTasksCancel operation middleware
+func (siw *ServerInterfaceWrapper) PostApiTasksCancel(c *gin.Context) {
+
+	c.Set(ApiKeyAuthScopes, []string{"queue:admin"})
+
+	c.Set(BasicAuthScopes, []string{"queue:admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiTasksCancel(c)
+}
+
+// PostApiTasksPurge operation middleware
+func (siw *ServerInterfaceWrapper) PostApiTasksPurge(c *gin.Context) {
+
+	c.Set(ApiKeyAuthScopes, []string{"queue:admin"})
+
+	c.Set(BasicAuthScopes, []string{"queue:admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiTasksPurge(c)
+}
+
+// PostApiTasksRetry operation middleware
+func (siw *ServerInterfaceWrapper) PostApiTasksRetry(c *gin.Context) {
+
+	c.Set(ApiKeyAuthScopes, []string{"queue:admin"})
+
+	c.Set(BasicAuthScopes, []string{"queue:admin"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostApiTasksRetry(c)
+}
+
+// DeleteApiWebhooksWebhookId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiWebhooksWebhookId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", c.Param("webhookId"), &webhookId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter webhookId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"profiles:manage"})
+
+	c.Set(BasicAuthScopes, []string{"profiles:manage"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteApiWebhooksWebhookId(c, webhookId)
+}
+
+// PutApiWebhooksWebhookId operation middleware
+func (siw *ServerInterfaceWrapper) PutApiWebhooksWebhookId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", c.Param("webhookId"), &webhookId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter webhookId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"profiles:manage"})
+
+	c.Set(BasicAuthScopes, []string{"profiles:manage"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutApiWebhooksWebhookId(c, webhookId)
+}
+
+// GetApiWebhooksWebhookIdDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetApiWebhooksWebhookIdDeliveries(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", c.Param("webhookId"), &webhookId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter webhookId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(ApiKeyAuthScopes, []string{"profiles:manage"})
+
+	c.Set(BasicAuthScopes, []string{"profiles:manage"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiWebhooksWebhookIdDeliveriesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetApiWebhooksWebhookIdDeliveries(c, webhookId, params)
+}
+
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []MiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
+
+	router.POST(options.BaseURL+"/api/analyze", wrapper.PostApiAnalyze)
+	router.GET(options.BaseURL+"/api/analyzers/limiters", wrapper.GetApiAnalyzersLimiters)
+	router.POST(options.BaseURL+"/api/detections/list", wrapper.PostApiDetectionsList)
+	router.PUT(options.BaseURL+"/api/detections/tags", wrapper.PutApiDetectionsTags)
+	router.GET(options.BaseURL+"/api/profiles", wrapper.GetApiProfiles)
+	router.POST(options.BaseURL+"/api/profiles", wrapper.PostApiProfiles)
+	router.DELETE(options.BaseURL+"/api/profiles/:profileId", wrapper.DeleteApiProfilesProfileId)
+	router.GET(options.BaseURL+"/api/profiles/:profileId", wrapper.GetApiProfilesProfileId)
+	router.PUT(options.BaseURL+"/api/profiles/:profileId", wrapper.PutApiProfilesProfileId)
+	router.POST(options.BaseURL+"/api/profiles/:profileId/analyze", wrapper.PostApiProfilesProfileIdAnalyze)
+	router.POST(options.BaseURL+"/api/profiles/:profileId/dry_jumpstart", wrapper.PostApiProfilesProfileIdDryJumpstart)
+	router.POST(options.BaseURL+"/api/profiles/:profileId/jumpstart", wrapper.PostApiProfilesProfileIdJumpstart)
+	router.GET(options.BaseURL+"/api/profiles/:profileId/settings_versions", wrapper.GetApiProfilesProfileIdSettingsVersions)
+	router.GET(options.BaseURL+"/api/profiles/:profileId/settings_versions/diff", wrapper.GetApiProfilesProfileIdSettingsVersionsDiff)
+	router.POST(options.BaseURL+"/api/profiles/:profileId/settings_versions/rollback", wrapper.PostApiProfilesProfileIdSettingsVersionsRollback)
+	router.GET(options.BaseURL+"/api/profiles/:profileId/shadows", wrapper.GetApiProfilesProfileIdShadows)
+	router.POST(options.BaseURL+"/api/profiles/:profileId/shadows", wrapper.PostApiProfilesProfileIdShadows)
+	router.GET(options.BaseURL+"/api/profiles/:profileId/shadows/:shadowId", wrapper.GetApiProfilesProfileIdShadowsShadowId)
+	router.GET(options.BaseURL+"/api/profiles/:profileId/shadows/:shadowId/disagreements", wrapper.GetApiProfilesProfileIdShadowsShadowIdDisagreements)
+	router.POST(options.BaseURL+"/api/profiles/:profileId/shadows/:shadowId/stop", wrapper.PostApiProfilesProfileIdShadowsShadowIdStop)
+	router.PUT(options.BaseURL+"/api/profiles/:profileId/tasks/priority", wrapper.PutApiProfilesProfileIdTasksPriority)
+	router.GET(options.BaseURL+"/api/profiles/:profileId/test_cases", wrapper.GetApiProfilesProfileIdTestCases)
+	router.PUT(options.BaseURL+"/api/profiles/:profileId/test_cases", wrapper.PutApiProfilesProfileIdTestCases)
+	router.DELETE(options.BaseURL+"/api/profiles/:profileId/test_cases/:testCaseId", wrapper.DeleteApiProfilesProfileIdTestCasesTestCaseId)
+	router.GET(options.BaseURL+"/api/profiles/:profileId/test_runs", wrapper.GetApiProfilesProfileIdTestRuns)
+	router.POST(options.BaseURL+"/api/profiles/:profileId/test_runs", wrapper.PostApiProfilesProfileIdTestRuns)
+	router.GET(options.BaseURL+"/api/profiles/:profileId/test_runs/:testRunId", wrapper.GetApiProfilesProfileIdTestRunsTestRunId)
+	router.GET(options.BaseURL+"/api/profiles/:profileId/test_runs/:testRunId/diff", wrapper.GetApiProfilesProfileIdTestRunsTestRunIdDiff)
+	router.GET(options.BaseURL+"/api/profiles/:profileId/webhooks", wrapper.GetApiProfilesProfileIdWebhooks)
+	router.POST(options.BaseURL+"/api/profiles/:profileId/webhooks", wrapper.PostApiProfilesProfileIdWebhooks)
+	router.GET(options.BaseURL+"/api/sources/hackernews/feeds", wrapper.GetApiSourcesHackernewsFeeds)
+	router.POST(options.BaseURL+"/api/sources/hackernews/feeds/:feed/add_profiles", wrapper.PostApiSourcesHackernewsFeedsFeedAddProfiles)
+	router.POST(options.BaseURL+"/api/sources/hackernews/feeds/:feed/remove_profiles", wrapper.PostApiSourcesHackernewsFeedsFeedRemoveProfiles)
+	router.GET(options.BaseURL+"/api/sources/hackernews/feeds_with_profile", wrapper.GetApiSourcesHackernewsFeedsWithProfile)
+	router.GET(options.BaseURL+"/api/sources/reddit/scrape_state", wrapper.GetApiSourcesRedditScrapeState)
+	router.GET(options.BaseURL+"/api/sources/reddit/subreddits", wrapper.GetApiSourcesRedditSubreddits)
+	router.POST(options.BaseURL+"/api/sources/reddit/subreddits/:subreddit/add_profiles", wrapper.PostApiSourcesRedditSubredditsSubredditAddProfiles)
+	router.POST(options.BaseURL+"/api/sources/reddit/subreddits/:subreddit/remove_profiles", wrapper.PostApiSourcesRedditSubredditsSubredditRemoveProfiles)
+	router.GET(options.BaseURL+"/api/sources/reddit/subreddits_with_profile", wrapper.GetApiSourcesRedditSubredditsWithProfile)
+	router.GET(options.BaseURL+"/api/sources/rss/feeds", wrapper.GetApiSourcesRssFeeds)
+	router.POST(options.BaseURL+"/api/sources/rss/feeds/add_profiles", wrapper.PostApiSourcesRssFeedsAddProfiles)
+	router.POST(options.BaseURL+"/api/sources/rss/feeds/remove_profiles", wrapper.PostApiSourcesRssFeedsRemoveProfiles)
+	router.GET(options.BaseURL+"/api/sources/rss/feeds_with_profile", wrapper.GetApiSourcesRssFeedsWithProfile)
+	router.GET(options.BaseURL+"/api/statistics/:profileId", wrapper.GetApiStatisticsProfileId)
+	router.GET(options.BaseURL+"/api/statistics/:profileId/accuracy", wrapper.GetApiStatisticsProfileIdAccuracy)
+	router.GET(options.BaseURL+"/api/tasks", wrapper.GetApiTasks)
+	router.POST(options.BaseURL+"/api/tasks/cancel", wrapper.PostApiTasksCancel)
+	router.POST(options.BaseURL+"/api/tasks/purge", wrapper.PostApiTasksPurge)
+	router.POST(options.BaseURL+"/api/tasks/retry", wrapper.PostApiTasksRetry)
+	router.DELETE(options.BaseURL+"/api/webhooks/:webhookId", wrapper.DeleteApiWebhooksWebhookId)
+	router.PUT(options.BaseURL+"/api/webhooks/:webhookId", wrapper.PutApiWebhooksWebhookId)
+	router.GET(options.BaseURL+"/api/webhooks/:webhookId/deliveries", wrapper.GetApiWebhooksWebhookIdDeliveries)
+}
+
+type PostApiAnalyzeRequestObject struct {
+	Body *PostApiAnalyzeJSONRequestBody
+}
+
+type PostApiAnalyzeResponseObject interface {
+	VisitPostApiAnalyzeResponse(w http.ResponseWriter) error
+}
+
+type PostApiAnalyze200JSONResponse Detection
+
+func (response PostApiAnalyze200JSONResponse) VisitPostApiAnalyzeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiAnalyze401Response struct {
+}
+
+func (response PostApiAnalyze401Response) VisitPostApiAnalyzeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostApiAnalyze403Response struct {
+}
+
+func (response PostApiAnalyze403Response) VisitPostApiAnalyzeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostApiAnalyze500JSONResponse Error
+
+func (response PostApiAnalyze500JSONResponse) VisitPostApiAnalyzeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAnalyzersLimitersRequestObject struct {
+}
+
+type GetApiAnalyzersLimitersResponseObject interface {
+	VisitGetApiAnalyzersLimitersResponse(w http.ResponseWriter) error
+}
+
+type GetApiAnalyzersLimiters200JSONResponse []AnalyzerLimiter
+
+func (response GetApiAnalyzersLimiters200JSONResponse) VisitGetApiAnalyzersLimitersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiAnalyzersLimiters401Response struct {
+}
+
+func (response GetApiAnalyzersLimiters401Response) VisitGetApiAnalyzersLimitersResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetApiAnalyzersLimiters403Response struct {
+}
+
+func (response GetApiAnalyzersLimiters403Response) VisitGetApiAnalyzersLimitersResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostApiDetectionsListRequestObject struct {
+	Body *PostApiDetectionsListJSONRequestBody
+}
+
+type PostApiDetectionsListResponseObject interface {
+	VisitPostApiDetectionsListResponse(w http.ResponseWriter) error
+}
+
+type PostApiDetectionsList200JSONResponse []ListedDetection
+
+func (response PostApiDetectionsList200JSONResponse) VisitPostApiDetectionsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiDetectionsList400JSONResponse Error
+
+func (response PostApiDetectionsList400JSONResponse) VisitPostApiDetectionsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiDetectionsList401Response struct {
+}
+
+func (response PostApiDetectionsList401Response) VisitPostApiDetectionsListResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostApiDetectionsList403Response struct {
+}
+
+func (response PostApiDetectionsList403Response) VisitPostApiDetectionsListResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostApiDetectionsList500JSONResponse Error
+
+func (response PostApiDetectionsList500JSONResponse) VisitPostApiDetectionsListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiDetectionsTagsRequestObject struct {
+	Body *PutApiDetectionsTagsJSONRequestBody
+}
+
+type PutApiDetectionsTagsResponseObject interface {
+	VisitPutApiDetectionsTagsResponse(w http.ResponseWriter) error
+}
+
+type PutApiDetectionsTags200JSONResponse DetectionTags
+
+func (response PutApiDetectionsTags200JSONResponse) VisitPutApiDetectionsTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiDetectionsTags401Response struct {
+}
+
+func (response PutApiDetectionsTags401Response) VisitPutApiDetectionsTagsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PutApiDetectionsTags403Response struct {
+}
+
+func (response PutApiDetectionsTags403Response) VisitPutApiDetectionsTagsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PutApiDetectionsTags500JSONResponse Error
+
+func (response PutApiDetectionsTags500JSONResponse) VisitPutApiDetectionsTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiProfilesRequestObject struct {
+}
+
+type GetApiProfilesResponseObject interface {
+	VisitGetApiProfilesResponse(w http.ResponseWriter) error
+}
+
+type GetApiProfiles200JSONResponse []Profile
+
+func (response GetApiProfiles200JSONResponse) VisitGetApiProfilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiProfiles401Response struct {
+}
+
+func (response GetApiProfiles401Response) VisitGetApiProfilesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetApiProfiles403Response struct {
+}
+
+func (response GetApiProfiles403Response) VisitGetApiProfilesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetApiProfiles500JSONResponse Error
+
+func (response GetApiProfiles500JSONResponse) VisitGetApiProfilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiProfilesRequestObject struct {
+	Body *PostApiProfilesJSONRequestBody
+}
+
+type PostApiProfilesResponseObject interface {
+	VisitPostApiProfilesResponse(w http.ResponseWriter) error
+}
+
+type PostApiProfiles201JSONResponse struct {
+	Id int `json:"id"`
+}
+
+func (response PostApiProfiles201JSONResponse) VisitPostApiProfilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiProfiles400JSONResponse Error
+
+func (response PostApiProfiles400JSONResponse) VisitPostApiProfilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiProfiles401Response struct {
+}
+
+func (response PostApiProfiles401Response) VisitPostApiProfilesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostApiProfiles403Response struct {
+}
+
+func (response PostApiProfiles403Response) VisitPostApiProfilesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostApiProfiles500JSONResponse Error
+
+func (response PostApiProfiles500JSONResponse) VisitPostApiProfilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiProfilesProfileIdRequestObject struct {
+	ProfileId int `json:"profileId"`
+}
+
+type DeleteApiProfilesProfileIdResponseObject interface {
+	VisitDeleteApiProfilesProfileIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteApiProfilesProfileId204Response struct {
+}
+
+func (response DeleteApiProfilesProfileId204Response) VisitDeleteApiProfilesProfileIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteApiProfilesProfileId401Response struct {
+}
+
+func (response DeleteApiProfilesProfileId401Response) VisitDeleteApiProfilesProfileIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteApiProfilesProfileId403Response struct {
+}
+
+func (response DeleteApiProfilesProfileId403Response) VisitDeleteApiProfilesProfileIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type DeleteApiProfilesProfileId404Response struct {
+}
+
+func (response DeleteApiProfilesProfileId404Response) VisitDeleteApiProfilesProfileIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type DeleteApiProfilesProfileId500JSONResponse Error
+
+func (response DeleteApiProfilesProfileId500JSONResponse) VisitDeleteApiProfilesProfileIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiProfilesProfileIdRequestObject struct {
+	ProfileId int `json:"profileId"`
+}
+
+type GetApiProfilesProfileIdResponseObject interface {
+	VisitGetApiProfilesProfileIdResponse(w http.ResponseWriter) error
+}
+
+type GetApiProfilesProfileId200JSONResponse Profile
+
+func (response GetApiProfilesProfileId200JSONResponse) VisitGetApiProfilesProfileIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiProfilesProfileId401Response struct {
+}
+
+func (response GetApiProfilesProfileId401Response) VisitGetApiProfilesProfileIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetApiProfilesProfileId403Response struct {
+}
+
+func (response GetApiProfilesProfileId403Response) VisitGetApiProfilesProfileIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetApiProfilesProfileId404Response struct {
+}
+
+func (response GetApiProfilesProfileId404Response) VisitGetApiProfilesProfileIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetApiProfilesProfileId500JSONResponse Error
+
+func (response GetApiProfilesProfileId500JSONResponse) VisitGetApiProfilesProfileIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiProfilesProfileIdRequestObject struct {
+	ProfileId int `json:"profileId"`
+	Body      *PutApiProfilesProfileIdJSONRequestBody
+}
+
+type PutApiProfilesProfileIdResponseObject interface {
+	VisitPutApiProfilesProfileIdResponse(w http.ResponseWriter) error
+}
+
+type PutApiProfilesProfileId200Response struct {
+}
+
+func (response PutApiProfilesProfileId200Response) VisitPutApiProfilesProfileIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PutApiProfilesProfileId400JSONResponse Error
+
+func (response PutApiProfilesProfileId400JSONResponse) VisitPutApiProfilesProfileIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiProfilesProfileId401Response struct {
+}
+
+func (response PutApiProfilesProfileId401Response) VisitPutApiProfilesProfileIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PutApiProfilesProfileId403Response struct {
+}
+
+func (response PutApiProfilesProfileId403Response) VisitPutApiProfilesProfileIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PutApiProfilesProfileId404Response struct {
+}
+
+func (response PutApiProfilesProfileId404Response) VisitPutApiProfilesProfileIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PutApiProfilesProfileId500JSONResponse Error
+
+func (response PutApiProfilesProfileId500JSONResponse) VisitPutApiProfilesProfileIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiProfilesProfileIdAnalyzeRequestObject struct {
+	ProfileId int `json:"profileId"`
+	Body      *PostApiProfilesProfileIdAnalyzeJSONRequestBody
+}
+
+type PostApiProfilesProfileIdAnalyzeResponseObject interface {
+	VisitPostApiProfilesProfileIdAnalyzeResponse(w http.ResponseWriter) error
+}
+
+type PostApiProfilesProfileIdAnalyze204Response struct {
+}
+
+func (response PostApiProfilesProfileIdAnalyze204Response) VisitPostApiProfilesProfileIdAnalyzeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostApiProfilesProfileIdAnalyze400JSONResponse Error
+
+func (response PostApiProfilesProfileIdAnalyze400JSONResponse) VisitPostApiProfilesProfileIdAnalyzeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiProfilesProfileIdAnalyze401Response struct {
+}
+
+func (response PostApiProfilesProfileIdAnalyze401Response) VisitPostApiProfilesProfileIdAnalyzeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostApiProfilesProfileIdAnalyze403Response struct {
+}
+
+func (response PostApiProfilesProfileIdAnalyze403Response) VisitPostApiProfilesProfileIdAnalyzeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostApiProfilesProfileIdAnalyze404Response struct {
+}
+
+func (response PostApiProfilesProfileIdAnalyze404Response) VisitPostApiProfilesProfileIdAnalyzeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostApiProfilesProfileIdAnalyze500JSONResponse Error
+
+func (response PostApiProfilesProfileIdAnalyze500JSONResponse) VisitPostApiProfilesProfileIdAnalyzeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiProfilesProfileIdDryJumpstartRequestObject struct {
+	ProfileId int `json:"profileId"`
+	Body      *PostApiProfilesProfileIdDryJumpstartJSONRequestBody
+}
+
+type PostApiProfilesProfileIdDryJumpstartResponseObject interface {
+	VisitPostApiProfilesProfileIdDryJumpstartResponse(w http.ResponseWriter) error
+}
+
+type PostApiProfilesProfileIdDryJumpstart200JSONResponse []AnalysisTaskParameters
+
+func (response PostApiProfilesProfileIdDryJumpstart200JSONResponse) VisitPostApiProfilesProfileIdDryJumpstartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiProfilesProfileIdDryJumpstart401Response struct {
+}
+
+func (response PostApiProfilesProfileIdDryJumpstart401Response) VisitPostApiProfilesProfileIdDryJumpstartResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostApiProfilesProfileIdDryJumpstart403Response struct {
+}
+
+func (response PostApiProfilesProfileIdDryJumpstart403Response) VisitPostApiProfilesProfileIdDryJumpstartResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostApiProfilesProfileIdDryJumpstart404Response struct {
+}
+
+func (response PostApiProfilesProfileIdDryJumpstart404Response) VisitPostApiProfilesProfileIdDryJumpstartResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostApiProfilesProfileIdDryJumpstart500JSONResponse Error
+
+func (response PostApiProfilesProfileIdDryJumpstart500JSONResponse) VisitPostApiProfilesProfileIdDryJumpstartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiProfilesProfileIdJumpstartRequestObject struct {
+	ProfileId int `json:"profileId"`
+	Body      *PostApiProfilesProfileIdJumpstartJSONRequestBody
+}
+
+type PostApiProfilesProfileIdJumpstartResponseObject interface {
+	VisitPostApiProfilesProfileIdJumpstartResponse(w http.ResponseWriter) error
+}
+
+type PostApiProfilesProfileIdJumpstart204Response struct {
+}
+
+func (response PostApiProfilesProfileIdJumpstart204Response) VisitPostApiProfilesProfileIdJumpstartResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostApiProfilesProfileIdJumpstart401Response struct {
+}
+
+func (response PostApiProfilesProfileIdJumpstart401Response) VisitPostApiProfilesProfileIdJumpstartResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostApiProfilesProfileIdJumpstart403Response struct {
+}
+
+func (response PostApiProfilesProfileIdJumpstart403Response) VisitPostApiProfilesProfileIdJumpstartResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostApiProfilesProfileIdJumpstart404Response struct {
+}
+
+func (response PostApiProfilesProfileIdJumpstart404Response) VisitPostApiProfilesProfileIdJumpstartResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostApiProfilesProfileIdJumpstart500JSONResponse Error
+
+func (response PostApiProfilesProfileIdJumpstart500JSONResponse) VisitPostApiProfilesProfileIdJumpstartResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiProfilesProfileIdSettingsVersionsRequestObject struct {
+	ProfileId int `json:"profileId"`
+	Params    GetApiProfilesProfileIdSettingsVersionsParams
+}
+
+type GetApiProfilesProfileIdSettingsVersionsResponseObject interface {
+	VisitGetApiProfilesProfileIdSettingsVersionsResponse(w http.ResponseWriter) error
+}
+
+type GetApiProfilesProfileIdSettingsVersions200JSONResponse []ProfileSettingsVersion
+
+func (response GetApiProfilesProfileIdSettingsVersions200JSONResponse) VisitGetApiProfilesProfileIdSettingsVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiProfilesProfileIdSettingsVersions401Response struct {
+}
+
+func (response GetApiProfilesProfileIdSettingsVersions401Response) VisitGetApiProfilesProfileIdSettingsVersionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetApiProfilesProfileIdSettingsVersions403Response struct {
+}
+
+func (response GetApiProfilesProfileIdSettingsVersions403Response) VisitGetApiProfilesProfileIdSettingsVersionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetApiProfilesProfileIdSettingsVersions500JSONResponse Error
+
+func (response GetApiProfilesProfileIdSettingsVersions500JSONResponse) VisitGetApiProfilesProfileIdSettingsVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiProfilesProfileIdSettingsVersionsDiffRequestObject struct {
+	ProfileId int `json:"profileId"`
+	Params    GetApiProfilesProfileIdSettingsVersionsDiffParams
+}
+
+type GetApiProfilesProfileIdSettingsVersionsDiffResponseObject interface {
+	VisitGetApiProfilesProfileIdSettingsVersionsDiffResponse(w http.ResponseWriter) error
+}
+
+type GetApiProfilesProfileIdSettingsVersionsDiff200JSONResponse ProfileSettingsDiff
+
+func (response GetApiProfilesProfileIdSettingsVersionsDiff200JSONResponse) VisitGetApiProfilesProfileIdSettingsVersionsDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiProfilesProfileIdSettingsVersionsDiff401Response struct {
+}
+
+func (response GetApiProfilesProfileIdSettingsVersionsDiff401Response) VisitGetApiProfilesProfileIdSettingsVersionsDiffResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetApiProfilesProfileIdSettingsVersionsDiff403Response struct {
+}
+
+func (response GetApiProfilesProfileIdSettingsVersionsDiff403Response) VisitGetApiProfilesProfileIdSettingsVersionsDiffResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetApiProfilesProfileIdSettingsVersionsDiff404Response struct {
+}
+
+func (response GetApiProfilesProfileIdSettingsVersionsDiff404Response) VisitGetApiProfilesProfileIdSettingsVersionsDiffResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetApiProfilesProfileIdSettingsVersionsDiff500JSONResponse Error
+
+func (response GetApiProfilesProfileIdSettingsVersionsDiff500JSONResponse) VisitGetApiProfilesProfileIdSettingsVersionsDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiProfilesProfileIdSettingsVersionsRollbackRequestObject struct {
+	ProfileId int `json:"profileId"`
+	Body      *PostApiProfilesProfileIdSettingsVersionsRollbackJSONRequestBody
+}
+
+type PostApiProfilesProfileIdSettingsVersionsRollbackResponseObject interface {
+	VisitPostApiProfilesProfileIdSettingsVersionsRollbackResponse(w http.ResponseWriter) error
+}
+
+type PostApiProfilesProfileIdSettingsVersionsRollback200JSONResponse ProfileSettingsVersion
+
+func (response PostApiProfilesProfileIdSettingsVersionsRollback200JSONResponse) VisitPostApiProfilesProfileIdSettingsVersionsRollbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiProfilesProfileIdSettingsVersionsRollback401Response struct {
+}
+
+func (response PostApiProfilesProfileIdSettingsVersionsRollback401Response) VisitPostApiProfilesProfileIdSettingsVersionsRollbackResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostApiProfilesProfileIdSettingsVersionsRollback403Response struct {
+}
+
+func (response PostApiProfilesProfileIdSettingsVersionsRollback403Response) VisitPostApiProfilesProfileIdSettingsVersionsRollbackResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostApiProfilesProfileIdSettingsVersionsRollback404Response struct {
+}
+
+func (response PostApiProfilesProfileIdSettingsVersionsRollback404Response) VisitPostApiProfilesProfileIdSettingsVersionsRollbackResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostApiProfilesProfileIdSettingsVersionsRollback500JSONResponse Error
+
+func (response PostApiProfilesProfileIdSettingsVersionsRollback500JSONResponse) VisitPostApiProfilesProfileIdSettingsVersionsRollbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiProfilesProfileIdShadowsRequestObject struct {
+	ProfileId int `json:"profileId"`
+	Params    GetApiProfilesProfileIdShadowsParams
+}
+
+type GetApiProfilesProfileIdShadowsResponseObject interface {
+	VisitGetApiProfilesProfileIdShadowsResponse(w http.ResponseWriter) error
+}
+
+type GetApiProfilesProfileIdShadows200JSONResponse []Shadow
+
+func (response GetApiProfilesProfileIdShadows200JSONResponse) VisitGetApiProfilesProfileIdShadowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiProfilesProfileIdShadows401Response struct {
+}
+
+func (response GetApiProfilesProfileIdShadows401Response) VisitGetApiProfilesProfileIdShadowsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetApiProfilesProfileIdShadows403Response struct {
+}
+
+func (response GetApiProfilesProfileIdShadows403Response) VisitGetApiProfilesProfileIdShadowsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetApiProfilesProfileIdShadows500JSONResponse Error
+
+func (response GetApiProfilesProfileIdShadows500JSONResponse) VisitGetApiProfilesProfileIdShadowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiProfilesProfileIdShadowsRequestObject struct {
+	ProfileId int `json:"profileId"`
+	Body      *PostApiProfilesProfileIdShadowsJSONRequestBody
+}
+
+type PostApiProfilesProfileIdShadowsResponseObject interface {
+	VisitPostApiProfilesProfileIdShadowsResponse(w http.ResponseWriter) error
+}
+
+type PostApiProfilesProfileIdShadows200JSONResponse Shadow
+
+func (response PostApiProfilesProfileIdShadows200JSONResponse) VisitPostApiProfilesProfileIdShadowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiProfilesProfileIdShadows400JSONResponse Error
+
+func (response PostApiProfilesProfileIdShadows400JSONResponse) VisitPostApiProfilesProfileIdShadowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiProfilesProfileIdShadows401Response struct {
+}
+
+func (response PostApiProfilesProfileIdShadows401Response) VisitPostApiProfilesProfileIdShadowsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostApiProfilesProfileIdShadows403Response struct {
+}
+
+func (response PostApiProfilesProfileIdShadows403Response) VisitPostApiProfilesProfileIdShadowsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostApiProfilesProfileIdShadows404Response struct {
+}
+
+func (response PostApiProfilesProfileIdShadows404Response) VisitPostApiProfilesProfileIdShadowsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostApiProfilesProfileIdShadows500JSONResponse Error
+
+func (response PostApiProfilesProfileIdShadows500JSONResponse) VisitPostApiProfilesProfileIdShadowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiProfilesProfileIdShadowsShadowIdRequestObject struct {
+	ProfileId int `json:"profileId"`
+	ShadowId  int `json:"shadowId"`
+}
+
+type GetApiProfilesProfileIdShadowsShadowIdResponseObject interface {
+	VisitGetApiProfilesProfileIdShadowsShadowIdResponse(w http.ResponseWriter) error
+}
+
+type GetApiProfilesProfileIdShadowsShadowId200JSONResponse Shadow
+
+func (response GetApiProfilesProfileIdShadowsShadowId200JSONResponse) VisitGetApiProfilesProfileIdShadowsShadowIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiProfilesProfileIdShadowsShadowId401Response struct {
+}
+
+func (response GetApiProfilesProfileIdShadowsShadowId401Response) VisitGetApiProfilesProfileIdShadowsShadowIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetApiProfilesProfileIdShadowsShadowId403Response struct {
+}
+
+func (response GetApiProfilesProfileIdShadowsShadowId403Response) VisitGetApiProfilesProfileIdShadowsShadowIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetApiProfilesProfileIdShadowsShadowId404Response struct {
+}
+
+func (response GetApiProfilesProfileIdShadowsShadowId404Response) VisitGetApiProfilesProfileIdShadowsShadowIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetApiProfilesProfileIdShadowsShadowId500JSONResponse Error
+
+func (response GetApiProfilesProfileIdShadowsShadowId500JSONResponse) VisitGetApiProfilesProfileIdShadowsShadowIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiProfilesProfileIdShadowsShadowIdDisagreementsRequestObject struct {
+	ProfileId int `json:"profileId"`
+	ShadowId  int `json:"shadowId"`
+	Params    GetApiProfilesProfileIdShadowsShadowIdDisagreementsParams
+}
+
+type GetApiProfilesProfileIdShadowsShadowIdDisagreementsResponseObject interface {
+	VisitGetApiProfilesProfileIdShadowsShadowIdDisagreementsResponse(w http.ResponseWriter) error
+}
+
+type GetApiProfilesProfileIdShadowsShadowIdDisagreements200JSONResponse []ShadowDisagreement
+
+func (response GetApiProfilesProfileIdShadowsShadowIdDisagreements200JSONResponse) VisitGetApiProfilesProfileIdShadowsShadowIdDisagreementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiProfilesProfileIdShadowsShadowIdDisagreements401Response struct {
+}
+
+func (response GetApiProfilesProfileIdShadowsShadowIdDisagreements401Response) VisitGetApiProfilesProfileIdShadowsShadowIdDisagreementsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetApiProfilesProfileIdShadowsShadowIdDisagreements403Response struct {
+}
+
+func (response GetApiProfilesProfileIdShadowsShadowIdDisagreements403Response) VisitGetApiProfilesProfileIdShadowsShadowIdDisagreementsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetApiProfilesProfileIdShadowsShadowIdDisagreements404Response struct {
+}
+
+func (response GetApiProfilesProfileIdShadowsShadowIdDisagreements404Response) VisitGetApiProfilesProfileIdShadowsShadowIdDisagreementsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetApiProfilesProfileIdShadowsShadowIdDisagreements500JSONResponse Error
+
+func (response GetApiProfilesProfileIdShadowsShadowIdDisagreements500JSONResponse) VisitGetApiProfilesProfileIdShadowsShadowIdDisagreementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiProfilesProfileIdShadowsShadowIdStopRequestObject struct {
+	ProfileId int `json:"profileId"`
+	ShadowId  int `json:"shadowId"`
+}
+
+type PostApiProfilesProfileIdShadowsShadowIdStopResponseObject interface {
+	VisitPostApiProfilesProfileIdShadowsShadowIdStopResponse(w http.ResponseWriter) error
+}
+
+type PostApiProfilesProfileIdShadowsShadowIdStop200JSONResponse Shadow
+
+func (response PostApiProfilesProfileIdShadowsShadowIdStop200JSONResponse) VisitPostApiProfilesProfileIdShadowsShadowIdStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiProfilesProfileIdShadowsShadowIdStop401Response struct {
+}
+
+func (response PostApiProfilesProfileIdShadowsShadowIdStop401Response) VisitPostApiProfilesProfileIdShadowsShadowIdStopResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostApiProfilesProfileIdShadowsShadowIdStop403Response struct {
+}
+
+func (response PostApiProfilesProfileIdShadowsShadowIdStop403Response) VisitPostApiProfilesProfileIdShadowsShadowIdStopResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostApiProfilesProfileIdShadowsShadowIdStop404Response struct {
+}
+
+func (response PostApiProfilesProfileIdShadowsShadowIdStop404Response) VisitPostApiProfilesProfileIdShadowsShadowIdStopResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostApiProfilesProfileIdShadowsShadowIdStop500JSONResponse Error
+
+func (response PostApiProfilesProfileIdShadowsShadowIdStop500JSONResponse) VisitPostApiProfilesProfileIdShadowsShadowIdStopResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiProfilesProfileIdTasksPriorityRequestObject struct {
+	ProfileId int `json:"profileId"`
+	Body      *PutApiProfilesProfileIdTasksPriorityJSONRequestBody
+}
+
+type PutApiProfilesProfileIdTasksPriorityResponseObject interface {
+	VisitPutApiProfilesProfileIdTasksPriorityResponse(w http.ResponseWriter) error
+}
+
+type PutApiProfilesProfileIdTasksPriority200JSONResponse TaskPriorityUpdateResult
+
+func (response PutApiProfilesProfileIdTasksPriority200JSONResponse) VisitPutApiProfilesProfileIdTasksPriorityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiProfilesProfileIdTasksPriority401Response struct {
+}
+
+func (response PutApiProfilesProfileIdTasksPriority401Response) VisitPutApiProfilesProfileIdTasksPriorityResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PutApiProfilesProfileIdTasksPriority403Response struct {
+}
+
+func (response PutApiProfilesProfileIdTasksPriority403Response) VisitPutApiProfilesProfileIdTasksPriorityResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PutApiProfilesProfileIdTasksPriority404Response struct {
+}
+
+func (response PutApiProfilesProfileIdTasksPriority404Response) VisitPutApiProfilesProfileIdTasksPriorityResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PutApiProfilesProfileIdTasksPriority500JSONResponse Error
+
+func (response PutApiProfilesProfileIdTasksPriority500JSONResponse) VisitPutApiProfilesProfileIdTasksPriorityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiProfilesProfileIdTestCasesRequestObject struct {
+	ProfileId int `json:"profileId"`
+}
+
+type GetApiProfilesProfileIdTestCasesResponseObject interface {
+	VisitGetApiProfilesProfileIdTestCasesResponse(w http.ResponseWriter) error
+}
+
+type GetApiProfilesProfileIdTestCases200JSONResponse []TestCase
+
+func (response GetApiProfilesProfileIdTestCases200JSONResponse) VisitGetApiProfilesProfileIdTestCasesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiProfilesProfileIdTestCases401Response struct {
+}
+
+func (response GetApiProfilesProfileIdTestCases401Response) VisitGetApiProfilesProfileIdTestCasesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetApiProfilesProfileIdTestCases403Response struct {
+}
+
+func (response GetApiProfilesProfileIdTestCases403Response) VisitGetApiProfilesProfileIdTestCasesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetApiProfilesProfileIdTestCases500JSONResponse Error
+
+func (response GetApiProfilesProfileIdTestCases500JSONResponse) VisitGetApiProfilesProfileIdTestCasesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiProfilesProfileIdTestCasesRequestObject struct {
+	ProfileId int `json:"profileId"`
+	Body      *PutApiProfilesProfileIdTestCasesJSONRequestBody
+}
+
+type PutApiProfilesProfileIdTestCasesResponseObject interface {
+	VisitPutApiProfilesProfileIdTestCasesResponse(w http.ResponseWriter) error
+}
+
+type PutApiProfilesProfileIdTestCases200JSONResponse TestCase
+
+func (response PutApiProfilesProfileIdTestCases200JSONResponse) VisitPutApiProfilesProfileIdTestCasesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiProfilesProfileIdTestCases400JSONResponse Error
+
+func (response PutApiProfilesProfileIdTestCases400JSONResponse) VisitPutApiProfilesProfileIdTestCasesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiProfilesProfileIdTestCases401Response struct {
+}
+
+func (response PutApiProfilesProfileIdTestCases401Response) VisitPutApiProfilesProfileIdTestCasesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PutApiProfilesProfileIdTestCases403Response struct {
+}
+
+func (response PutApiProfilesProfileIdTestCases403Response) VisitPutApiProfilesProfileIdTestCasesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PutApiProfilesProfileIdTestCases404Response struct {
+}
+
+func (response PutApiProfilesProfileIdTestCases404Response) VisitPutApiProfilesProfileIdTestCasesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PutApiProfilesProfileIdTestCases500JSONResponse Error
+
+func (response PutApiProfilesProfileIdTestCases500JSONResponse) VisitPutApiProfilesProfileIdTestCasesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiProfilesProfileIdTestCasesTestCaseIdRequestObject struct {
+	ProfileId  int `json:"profileId"`
+	TestCaseId int `json:"testCaseId"`
+}
+
+type DeleteApiProfilesProfileIdTestCasesTestCaseIdResponseObject interface {
+	VisitDeleteApiProfilesProfileIdTestCasesTestCaseIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteApiProfilesProfileIdTestCasesTestCaseId204Response struct {
+}
+
+func (response DeleteApiProfilesProfileIdTestCasesTestCaseId204Response) VisitDeleteApiProfilesProfileIdTestCasesTestCaseIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteApiProfilesProfileIdTestCasesTestCaseId401Response struct {
+}
+
+func (response DeleteApiProfilesProfileIdTestCasesTestCaseId401Response) VisitDeleteApiProfilesProfileIdTestCasesTestCaseIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteApiProfilesProfileIdTestCasesTestCaseId403Response struct {
+}
+
+func (response DeleteApiProfilesProfileIdTestCasesTestCaseId403Response) VisitDeleteApiProfilesProfileIdTestCasesTestCaseIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type DeleteApiProfilesProfileIdTestCasesTestCaseId404Response struct {
+}
+
+func (response DeleteApiProfilesProfileIdTestCasesTestCaseId404Response) VisitDeleteApiProfilesProfileIdTestCasesTestCaseIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type DeleteApiProfilesProfileIdTestCasesTestCaseId500JSONResponse Error
+
+func (response DeleteApiProfilesProfileIdTestCasesTestCaseId500JSONResponse) VisitDeleteApiProfilesProfileIdTestCasesTestCaseIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiProfilesProfileIdTestRunsRequestObject struct {
+	ProfileId int `json:"profileId"`
+	Params    GetApiProfilesProfileIdTestRunsParams
+}
+
+type GetApiProfilesProfileIdTestRunsResponseObject interface {
+	VisitGetApiProfilesProfileIdTestRunsResponse(w http.ResponseWriter) error
+}
+
+type GetApiProfilesProfileIdTestRuns200JSONResponse []TestRun
+
+func (response GetApiProfilesProfileIdTestRuns200JSONResponse) VisitGetApiProfilesProfileIdTestRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiProfilesProfileIdTestRuns401Response struct {
+}
+
+func (response GetApiProfilesProfileIdTestRuns401Response) VisitGetApiProfilesProfileIdTestRunsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetApiProfilesProfileIdTestRuns403Response struct {
+}
+
+func (response GetApiProfilesProfileIdTestRuns403Response) VisitGetApiProfilesProfileIdTestRunsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetApiProfilesProfileIdTestRuns500JSONResponse Error
+
+func (response GetApiProfilesProfileIdTestRuns500JSONResponse) VisitGetApiProfilesProfileIdTestRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiProfilesProfileIdTestRunsRequestObject struct {
+	ProfileId int `json:"profileId"`
+	Body      *PostApiProfilesProfileIdTestRunsJSONRequestBody
+}
+
+type PostApiProfilesProfileIdTestRunsResponseObject interface {
+	VisitPostApiProfilesProfileIdTestRunsResponse(w http.ResponseWriter) error
+}
+
+type PostApiProfilesProfileIdTestRuns200JSONResponse TestRun
+
+func (response PostApiProfilesProfileIdTestRuns200JSONResponse) VisitPostApiProfilesProfileIdTestRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiProfilesProfileIdTestRuns400JSONResponse Error
+
+func (response PostApiProfilesProfileIdTestRuns400JSONResponse) VisitPostApiProfilesProfileIdTestRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
 
-	PostApiAnalyzeWithResponse(ctx context.Context, body PostApiAnalyzeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAnalyzeResponse, error)
+type PostApiProfilesProfileIdTestRuns401Response struct {
+}
 
-	// PostApiDetectionsListWithBodyWithResponse request with any body
-	PostApiDetectionsListWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiDetectionsListResponse, error)
+func (response PostApiProfilesProfileIdTestRuns401Response) VisitPostApiProfilesProfileIdTestRunsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
 
-	PostApiDetectionsListWithResponse(ctx context.Context, body PostApiDetectionsListJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiDetectionsListResponse, error)
+type PostApiProfilesProfileIdTestRuns403Response struct {
+}
 
-	// PutApiDetectionsTagsWithBodyWithResponse request with any body
-	PutApiDetectionsTagsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiDetectionsTagsResponse, error)
+func (response PostApiProfilesProfileIdTestRuns403Response) VisitPostApiProfilesProfileIdTestRunsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
 
-	PutApiDetectionsTagsWithResponse(ctx context.Context, body PutApiDetectionsTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiDetectionsTagsResponse, error)
+type PostApiProfilesProfileIdTestRuns404Response struct {
+}
 
-	// GetApiProfilesWithResponse request
-	GetApiProfilesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiProfilesResponse, error)
+func (response PostApiProfilesProfileIdTestRuns404Response) VisitPostApiProfilesProfileIdTestRunsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostApiProfilesProfileIdTestRuns500JSONResponse Error
+
+func (response PostApiProfilesProfileIdTestRuns500JSONResponse) VisitPostApiProfilesProfileIdTestRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiProfilesProfileIdTestRunsTestRunIdRequestObject struct {
+	ProfileId int `json:"profileId"`
+	TestRunId int `json:"testRunId"`
+}
+
+type GetApiProfilesProfileIdTestRunsTestRunIdResponseObject interface {
+	VisitGetApiProfilesProfileIdTestRunsTestRunIdResponse(w http.ResponseWriter) error
+}
+
+type GetApiProfilesProfileIdTestRunsTestRunId200JSONResponse TestRunReport
+
+func (response GetApiProfilesProfileIdTestRunsTestRunId200JSONResponse) VisitGetApiProfilesProfileIdTestRunsTestRunIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiProfilesProfileIdTestRunsTestRunId401Response struct {
+}
+
+func (response GetApiProfilesProfileIdTestRunsTestRunId401Response) VisitGetApiProfilesProfileIdTestRunsTestRunIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetApiProfilesProfileIdTestRunsTestRunId403Response struct {
+}
+
+func (response GetApiProfilesProfileIdTestRunsTestRunId403Response) VisitGetApiProfilesProfileIdTestRunsTestRunIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetApiProfilesProfileIdTestRunsTestRunId404Response struct {
+}
+
+func (response GetApiProfilesProfileIdTestRunsTestRunId404Response) VisitGetApiProfilesProfileIdTestRunsTestRunIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetApiProfilesProfileIdTestRunsTestRunId500JSONResponse Error
+
+func (response GetApiProfilesProfileIdTestRunsTestRunId500JSONResponse) VisitGetApiProfilesProfileIdTestRunsTestRunIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiProfilesProfileIdTestRunsTestRunIdDiffRequestObject struct {
+	ProfileId int `json:"profileId"`
+	TestRunId int `json:"testRunId"`
+	Params    GetApiProfilesProfileIdTestRunsTestRunIdDiffParams
+}
+
+type GetApiProfilesProfileIdTestRunsTestRunIdDiffResponseObject interface {
+	VisitGetApiProfilesProfileIdTestRunsTestRunIdDiffResponse(w http.ResponseWriter) error
+}
+
+type GetApiProfilesProfileIdTestRunsTestRunIdDiff200JSONResponse TestRunDiff
+
+func (response GetApiProfilesProfileIdTestRunsTestRunIdDiff200JSONResponse) VisitGetApiProfilesProfileIdTestRunsTestRunIdDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiProfilesProfileIdTestRunsTestRunIdDiff401Response struct {
+}
+
+func (response GetApiProfilesProfileIdTestRunsTestRunIdDiff401Response) VisitGetApiProfilesProfileIdTestRunsTestRunIdDiffResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetApiProfilesProfileIdTestRunsTestRunIdDiff403Response struct {
+}
+
+func (response GetApiProfilesProfileIdTestRunsTestRunIdDiff403Response) VisitGetApiProfilesProfileIdTestRunsTestRunIdDiffResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetApiProfilesProfileIdTestRunsTestRunIdDiff404Response struct {
+}
+
+func (response GetApiProfilesProfileIdTestRunsTestRunIdDiff404Response) VisitGetApiProfilesProfileIdTestRunsTestRunIdDiffResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetApiProfilesProfileIdTestRunsTestRunIdDiff500JSONResponse Error
+
+func (response GetApiProfilesProfileIdTestRunsTestRunIdDiff500JSONResponse) VisitGetApiProfilesProfileIdTestRunsTestRunIdDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiProfilesProfileIdWebhooksRequestObject struct {
+	ProfileId int `json:"profileId"`
+}
+
+type GetApiProfilesProfileIdWebhooksResponseObject interface {
+	VisitGetApiProfilesProfileIdWebhooksResponse(w http.ResponseWriter) error
+}
+
+type GetApiProfilesProfileIdWebhooks200JSONResponse []Webhook
+
+func (response GetApiProfilesProfileIdWebhooks200JSONResponse) VisitGetApiProfilesProfileIdWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiProfilesProfileIdWebhooks401Response struct {
+}
+
+func (response GetApiProfilesProfileIdWebhooks401Response) VisitGetApiProfilesProfileIdWebhooksResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetApiProfilesProfileIdWebhooks403Response struct {
+}
+
+func (response GetApiProfilesProfileIdWebhooks403Response) VisitGetApiProfilesProfileIdWebhooksResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetApiProfilesProfileIdWebhooks500JSONResponse Error
+
+func (response GetApiProfilesProfileIdWebhooks500JSONResponse) VisitGetApiProfilesProfileIdWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiProfilesProfileIdWebhooksRequestObject struct {
+	ProfileId int `json:"profileId"`
+	Body      *PostApiProfilesProfileIdWebhooksJSONRequestBody
+}
+
+type PostApiProfilesProfileIdWebhooksResponseObject interface {
+	VisitPostApiProfilesProfileIdWebhooksResponse(w http.ResponseWriter) error
+}
+
+type PostApiProfilesProfileIdWebhooks200JSONResponse Webhook
+
+func (response PostApiProfilesProfileIdWebhooks200JSONResponse) VisitPostApiProfilesProfileIdWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiProfilesProfileIdWebhooks400JSONResponse Error
+
+func (response PostApiProfilesProfileIdWebhooks400JSONResponse) VisitPostApiProfilesProfileIdWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiProfilesProfileIdWebhooks401Response struct {
+}
+
+func (response PostApiProfilesProfileIdWebhooks401Response) VisitPostApiProfilesProfileIdWebhooksResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostApiProfilesProfileIdWebhooks403Response struct {
+}
+
+func (response PostApiProfilesProfileIdWebhooks403Response) VisitPostApiProfilesProfileIdWebhooksResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostApiProfilesProfileIdWebhooks404Response struct {
+}
+
+func (response PostApiProfilesProfileIdWebhooks404Response) VisitPostApiProfilesProfileIdWebhooksResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostApiProfilesProfileIdWebhooks500JSONResponse Error
+
+func (response PostApiProfilesProfileIdWebhooks500JSONResponse) VisitPostApiProfilesProfileIdWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiSourcesHackernewsFeedsRequestObject struct {
+}
+
+type GetApiSourcesHackernewsFeedsResponseObject interface {
+	VisitGetApiSourcesHackernewsFeedsResponse(w http.ResponseWriter) error
+}
+
+type GetApiSourcesHackernewsFeeds200JSONResponse []HackerNewsFeedSettings
+
+func (response GetApiSourcesHackernewsFeeds200JSONResponse) VisitGetApiSourcesHackernewsFeedsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiSourcesHackernewsFeeds401Response struct {
+}
+
+func (response GetApiSourcesHackernewsFeeds401Response) VisitGetApiSourcesHackernewsFeedsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetApiSourcesHackernewsFeeds403Response struct {
+}
+
+func (response GetApiSourcesHackernewsFeeds403Response) VisitGetApiSourcesHackernewsFeedsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetApiSourcesHackernewsFeeds500JSONResponse Error
+
+func (response GetApiSourcesHackernewsFeeds500JSONResponse) VisitGetApiSourcesHackernewsFeedsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiSourcesHackernewsFeedsFeedAddProfilesRequestObject struct {
+	Feed PostApiSourcesHackernewsFeedsFeedAddProfilesParamsFeed `json:"feed"`
+	Body *PostApiSourcesHackernewsFeedsFeedAddProfilesJSONRequestBody
+}
+
+type PostApiSourcesHackernewsFeedsFeedAddProfilesResponseObject interface {
+	VisitPostApiSourcesHackernewsFeedsFeedAddProfilesResponse(w http.ResponseWriter) error
+}
+
+type PostApiSourcesHackernewsFeedsFeedAddProfiles204Response struct {
+}
+
+func (response PostApiSourcesHackernewsFeedsFeedAddProfiles204Response) VisitPostApiSourcesHackernewsFeedsFeedAddProfilesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostApiSourcesHackernewsFeedsFeedAddProfiles401Response struct {
+}
+
+func (response PostApiSourcesHackernewsFeedsFeedAddProfiles401Response) VisitPostApiSourcesHackernewsFeedsFeedAddProfilesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostApiSourcesHackernewsFeedsFeedAddProfiles403Response struct {
+}
+
+func (response PostApiSourcesHackernewsFeedsFeedAddProfiles403Response) VisitPostApiSourcesHackernewsFeedsFeedAddProfilesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostApiSourcesHackernewsFeedsFeedAddProfiles404Response struct {
+}
+
+func (response PostApiSourcesHackernewsFeedsFeedAddProfiles404Response) VisitPostApiSourcesHackernewsFeedsFeedAddProfilesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostApiSourcesHackernewsFeedsFeedAddProfiles500JSONResponse Error
+
+func (response PostApiSourcesHackernewsFeedsFeedAddProfiles500JSONResponse) VisitPostApiSourcesHackernewsFeedsFeedAddProfilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiSourcesHackernewsFeedsFeedRemoveProfilesRequestObject struct {
+	Feed PostApiSourcesHackernewsFeedsFeedRemoveProfilesParamsFeed `json:"feed"`
+	Body *PostApiSourcesHackernewsFeedsFeedRemoveProfilesJSONRequestBody
+}
+
+type PostApiSourcesHackernewsFeedsFeedRemoveProfilesResponseObject interface {
+	VisitPostApiSourcesHackernewsFeedsFeedRemoveProfilesResponse(w http.ResponseWriter) error
+}
+
+type PostApiSourcesHackernewsFeedsFeedRemoveProfiles204Response struct {
+}
+
+func (response PostApiSourcesHackernewsFeedsFeedRemoveProfiles204Response) VisitPostApiSourcesHackernewsFeedsFeedRemoveProfilesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostApiSourcesHackernewsFeedsFeedRemoveProfiles401Response struct {
+}
+
+func (response PostApiSourcesHackernewsFeedsFeedRemoveProfiles401Response) VisitPostApiSourcesHackernewsFeedsFeedRemoveProfilesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostApiSourcesHackernewsFeedsFeedRemoveProfiles403Response struct {
+}
+
+func (response PostApiSourcesHackernewsFeedsFeedRemoveProfiles403Response) VisitPostApiSourcesHackernewsFeedsFeedRemoveProfilesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
 
-	// PostApiProfilesWithBodyWithResponse request with any body
-	PostApiProfilesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiProfilesResponse, error)
+type PostApiSourcesHackernewsFeedsFeedRemoveProfiles404Response struct {
+}
 
-	PostApiProfilesWithResponse(ctx context.Context, body PostApiProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiProfilesResponse, error)
+func (response PostApiSourcesHackernewsFeedsFeedRemoveProfiles404Response) VisitPostApiSourcesHackernewsFeedsFeedRemoveProfilesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
 
-	// DeleteApiProfilesProfileIdWithResponse request
-	DeleteApiProfilesProfileIdWithResponse(ctx context.Context, profileId int, reqEditors ...RequestEditorFn) (*DeleteApiProfilesProfileIdResponse, error)
+type PostApiSourcesHackernewsFeedsFeedRemoveProfiles500JSONResponse Error
 
-	// GetApiProfilesProfileIdWithResponse request
-	GetApiProfilesProfileIdWithResponse(ctx context.Context, profileId int, reqEditors ...RequestEditorFn) (*GetApiProfilesProfileIdResponse, error)
+func (response PostApiSourcesHackernewsFeedsFeedRemoveProfiles500JSONResponse) VisitPostApiSourcesHackernewsFeedsFeedRemoveProfilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	// PutApiProfilesProfileIdWithBodyWithResponse request with any body
-	PutApiProfilesProfileIdWithBodyWithResponse(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiProfilesProfileIdResponse, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	PutApiProfilesProfileIdWithResponse(ctx context.Context, profileId int, body PutApiProfilesProfileIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiProfilesProfileIdResponse, error)
+type GetApiSourcesHackernewsFeedsWithProfileRequestObject struct {
+	Params GetApiSourcesHackernewsFeedsWithProfileParams
+}
 
-	// PostApiProfilesProfileIdDryJumpstartWithBodyWithResponse request with any body
-	PostApiProfilesProfileIdDryJumpstartWithBodyWithResponse(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdDryJumpstartResponse, error)
+type GetApiSourcesHackernewsFeedsWithProfileResponseObject interface {
+	VisitGetApiSourcesHackernewsFeedsWithProfileResponse(w http.ResponseWriter) error
+}
 
-	PostApiProfilesProfileIdDryJumpstartWithResponse(ctx context.Context, profileId int, body PostApiProfilesProfileIdDryJumpstartJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdDryJumpstartResponse, error)
+type GetApiSourcesHackernewsFeedsWithProfile200JSONResponse []HackerNewsFeedSettings
 
-	// PostApiProfilesProfileIdJumpstartWithBodyWithResponse request with any body
-	PostApiProfilesProfileIdJumpstartWithBodyWithResponse(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdJumpstartResponse, error)
+func (response GetApiSourcesHackernewsFeedsWithProfile200JSONResponse) VisitGetApiSourcesHackernewsFeedsWithProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	PostApiProfilesProfileIdJumpstartWithResponse(ctx context.Context, profileId int, body PostApiProfilesProfileIdJumpstartJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdJumpstartResponse, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// GetApiSourcesRedditSubredditsWithResponse request
-	GetApiSourcesRedditSubredditsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiSourcesRedditSubredditsResponse, error)
+type GetApiSourcesHackernewsFeedsWithProfile401Response struct {
+}
 
-	// PostApiSourcesRedditSubredditsSubredditAddProfilesWithBodyWithResponse request with any body
-	PostApiSourcesRedditSubredditsSubredditAddProfilesWithBodyWithResponse(ctx context.Context, subreddit string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiSourcesRedditSubredditsSubredditAddProfilesResponse, error)
+func (response GetApiSourcesHackernewsFeedsWithProfile401Response) VisitGetApiSourcesHackernewsFeedsWithProfileResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
 
-	PostApiSourcesRedditSubredditsSubredditAddProfilesWithResponse(ctx context.Context, subreddit string, body PostApiSourcesRedditSubredditsSubredditAddProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiSourcesRedditSubredditsSubredditAddProfilesResponse, error)
+type GetApiSourcesHackernewsFeedsWithProfile403Response struct {
+}
 
-	// PostApiSourcesRedditSubredditsSubredditRemoveProfilesWithBodyWithResponse request with any body
-	PostApiSourcesRedditSubredditsSubredditRemoveProfilesWithBodyWithResponse(ctx context.Context, subreddit string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse, error)
+func (response GetApiSourcesHackernewsFeedsWithProfile403Response) VisitGetApiSourcesHackernewsFeedsWithProfileResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
 
-	PostApiSourcesRedditSubredditsSubredditRemoveProfilesWithResponse(ctx context.Context, subreddit string, body PostApiSourcesRedditSubredditsSubredditRemoveProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse, error)
+type GetApiSourcesHackernewsFeedsWithProfile500JSONResponse Error
 
-	// GetApiSourcesRedditSubredditsWithProfileWithResponse request
-	GetApiSourcesRedditSubredditsWithProfileWithResponse(ctx context.Context, params *GetApiSourcesRedditSubredditsWithProfileParams, reqEditors ...RequestEditorFn) (*GetApiSourcesRedditSubredditsWithProfileResponse, error)
+func (response GetApiSourcesHackernewsFeedsWithProfile500JSONResponse) VisitGetApiSourcesHackernewsFeedsWithProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	// GetApiStatisticsProfileIdWithResponse request
-	GetApiStatisticsProfileIdWithResponse(ctx context.Context, profileId int, reqEditors ...RequestEditorFn) (*GetApiStatisticsProfileIdResponse, error)
+	return json.NewEncoder(w).Encode(response)
 }
 
-type PostApiAnalyzeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Detection
-	JSON500      *Error
+type GetApiSourcesRedditScrapeStateRequestObject struct {
 }
 
-// Status returns HTTPResponse.Status
-func (r PostApiAnalyzeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type GetApiSourcesRedditScrapeStateResponseObject interface {
+	VisitGetApiSourcesRedditScrapeStateResponse(w http.ResponseWriter) error
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiAnalyzeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+type GetApiSourcesRedditScrapeState200JSONResponse []SubredditScrapeState
 
-type PostApiDetectionsListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]ListedDetection
-	JSON500      *Error
+func (response GetApiSourcesRedditScrapeState200JSONResponse) VisitGetApiSourcesRedditScrapeStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostApiDetectionsListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type GetApiSourcesRedditScrapeState401Response struct {
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiDetectionsListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+func (response GetApiSourcesRedditScrapeState401Response) VisitGetApiSourcesRedditScrapeStateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
 }
 
-type PutApiDetectionsTagsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DetectionTags
-	JSON500      *Error
+type GetApiSourcesRedditScrapeState403Response struct {
 }
 
-// Status returns HTTPResponse.Status
-func (r PutApiDetectionsTagsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+func (response GetApiSourcesRedditScrapeState403Response) VisitGetApiSourcesRedditScrapeStateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutApiDetectionsTagsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type GetApiSourcesRedditScrapeState500JSONResponse Error
+
+func (response GetApiSourcesRedditScrapeState500JSONResponse) VisitGetApiSourcesRedditScrapeStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetApiProfilesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Profile
-	JSON500      *Error
+type GetApiSourcesRedditSubredditsRequestObject struct {
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiProfilesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type GetApiSourcesRedditSubredditsResponseObject interface {
+	VisitGetApiSourcesRedditSubredditsResponse(w http.ResponseWriter) error
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiProfilesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type GetApiSourcesRedditSubreddits200JSONResponse []SubredditSettings
+
+func (response GetApiSourcesRedditSubreddits200JSONResponse) VisitGetApiSourcesRedditSubredditsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type PostApiProfilesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *struct {
-		Id int `json:"id"`
-	}
-	JSON500 *Error
+type GetApiSourcesRedditSubreddits401Response struct {
 }
 
-// Status returns HTTPResponse.Status
-func (r PostApiProfilesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+func (response GetApiSourcesRedditSubreddits401Response) VisitGetApiSourcesRedditSubredditsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiProfilesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type GetApiSourcesRedditSubreddits403Response struct {
 }
 
-type DeleteApiProfilesProfileIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON500      *Error
+func (response GetApiSourcesRedditSubreddits403Response) VisitGetApiSourcesRedditSubredditsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteApiProfilesProfileIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type GetApiSourcesRedditSubreddits500JSONResponse Error
+
+func (response GetApiSourcesRedditSubreddits500JSONResponse) VisitGetApiSourcesRedditSubredditsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteApiProfilesProfileIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type PostApiSourcesRedditSubredditsSubredditAddProfilesRequestObject struct {
+	Subreddit string `json:"subreddit"`
+	Body      *PostApiSourcesRedditSubredditsSubredditAddProfilesJSONRequestBody
 }
 
-type GetApiProfilesProfileIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Profile
-	JSON500      *Error
+type PostApiSourcesRedditSubredditsSubredditAddProfilesResponseObject interface {
+	VisitPostApiSourcesRedditSubredditsSubredditAddProfilesResponse(w http.ResponseWriter) error
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiProfilesProfileIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type PostApiSourcesRedditSubredditsSubredditAddProfiles204Response struct {
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiProfilesProfileIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+func (response PostApiSourcesRedditSubredditsSubredditAddProfiles204Response) VisitPostApiSourcesRedditSubredditsSubredditAddProfilesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-type PutApiProfilesProfileIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON500      *Error
+type PostApiSourcesRedditSubredditsSubredditAddProfiles401Response struct {
 }
 
-// Status returns HTTPResponse.Status
-func (r PutApiProfilesProfileIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+func (response PostApiSourcesRedditSubredditsSubredditAddProfiles401Response) VisitPostApiSourcesRedditSubredditsSubredditAddProfilesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutApiProfilesProfileIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type PostApiSourcesRedditSubredditsSubredditAddProfiles403Response struct {
 }
 
-type PostApiProfilesProfileIdDryJumpstartResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]AnalysisTaskParameters
-	JSON500      *Error
+func (response PostApiSourcesRedditSubredditsSubredditAddProfiles403Response) VisitPostApiSourcesRedditSubredditsSubredditAddProfilesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PostApiProfilesProfileIdDryJumpstartResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type PostApiSourcesRedditSubredditsSubredditAddProfiles404Response struct {
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiProfilesProfileIdDryJumpstartResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+func (response PostApiSourcesRedditSubredditsSubredditAddProfiles404Response) VisitPostApiSourcesRedditSubredditsSubredditAddProfilesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
 }
 
-type PostApiProfilesProfileIdJumpstartResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON500      *Error
+type PostApiSourcesRedditSubredditsSubredditAddProfiles500JSONResponse Error
+
+func (response PostApiSourcesRedditSubredditsSubredditAddProfiles500JSONResponse) VisitPostApiSourcesRedditSubredditsSubredditAddProfilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostApiProfilesProfileIdJumpstartResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type PostApiSourcesRedditSubredditsSubredditRemoveProfilesRequestObject struct {
+	Subreddit string `json:"subreddit"`
+	Body      *PostApiSourcesRedditSubredditsSubredditRemoveProfilesJSONRequestBody
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiProfilesProfileIdJumpstartResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type PostApiSourcesRedditSubredditsSubredditRemoveProfilesResponseObject interface {
+	VisitPostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse(w http.ResponseWriter) error
 }
 
-type GetApiSourcesRedditSubredditsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]SubredditSettings
-	JSON500      *Error
+type PostApiSourcesRedditSubredditsSubredditRemoveProfiles204Response struct {
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiSourcesRedditSubredditsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+func (response PostApiSourcesRedditSubredditsSubredditRemoveProfiles204Response) VisitPostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiSourcesRedditSubredditsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type PostApiSourcesRedditSubredditsSubredditRemoveProfiles401Response struct {
 }
 
-type PostApiSourcesRedditSubredditsSubredditAddProfilesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON500      *Error
+func (response PostApiSourcesRedditSubredditsSubredditRemoveProfiles401Response) VisitPostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PostApiSourcesRedditSubredditsSubredditAddProfilesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type PostApiSourcesRedditSubredditsSubredditRemoveProfiles403Response struct {
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiSourcesRedditSubredditsSubredditAddProfilesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+func (response PostApiSourcesRedditSubredditsSubredditRemoveProfiles403Response) VisitPostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
 }
 
-type PostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON500      *Error
+type PostApiSourcesRedditSubredditsSubredditRemoveProfiles404Response struct {
 }
 
-// Status returns HTTPResponse.Status
-func (r PostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+func (response PostApiSourcesRedditSubredditsSubredditRemoveProfiles404Response) VisitPostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type PostApiSourcesRedditSubredditsSubredditRemoveProfiles500JSONResponse Error
+
+func (response PostApiSourcesRedditSubredditsSubredditRemoveProfiles500JSONResponse) VisitPostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetApiSourcesRedditSubredditsWithProfileResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]SubredditSettings
-	JSON500      *Error
+type GetApiSourcesRedditSubredditsWithProfileRequestObject struct {
+	Params GetApiSourcesRedditSubredditsWithProfileParams
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiSourcesRedditSubredditsWithProfileResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+type GetApiSourcesRedditSubredditsWithProfileResponseObject interface {
+	VisitGetApiSourcesRedditSubredditsWithProfileResponse(w http.ResponseWriter) error
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiSourcesRedditSubredditsWithProfileResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type GetApiSourcesRedditSubredditsWithProfile200JSONResponse []SubredditSettings
+
+func (response GetApiSourcesRedditSubredditsWithProfile200JSONResponse) VisitGetApiSourcesRedditSubredditsWithProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetApiStatisticsProfileIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ProfileStatistics
-	JSON500      *Error
+type GetApiSourcesRedditSubredditsWithProfile401Response struct {
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiStatisticsProfileIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+func (response GetApiSourcesRedditSubredditsWithProfile401Response) VisitGetApiSourcesRedditSubredditsWithProfileResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiStatisticsProfileIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+type GetApiSourcesRedditSubredditsWithProfile403Response struct {
 }
 
-// PostApiAnalyzeWithBodyWithResponse request with arbitrary body returning *PostApiAnalyzeResponse
-func (c *ClientWithResponses) PostApiAnalyzeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiAnalyzeResponse, error) {
-	rsp, err := c.PostApiAnalyzeWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiAnalyzeResponse(rsp)
+func (response GetApiSourcesRedditSubredditsWithProfile403Response) VisitGetApiSourcesRedditSubredditsWithProfileResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
 }
 
-func (c *ClientWithResponses) PostApiAnalyzeWithResponse(ctx context.Context, body PostApiAnalyzeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiAnalyzeResponse, error) {
-	rsp, err := c.PostApiAnalyze(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiAnalyzeResponse(rsp)
+type GetApiSourcesRedditSubredditsWithProfile500JSONResponse Error
+
+func (response GetApiSourcesRedditSubredditsWithProfile500JSONResponse) VisitGetApiSourcesRedditSubredditsWithProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// PostApiDetectionsListWithBodyWithResponse request with arbitrary body returning *PostApiDetectionsListResponse
-func (c *ClientWithResponses) PostApiDetectionsListWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiDetectionsListResponse, error) {
-	rsp, err := c.PostApiDetectionsListWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiDetectionsListResponse(rsp)
+type GetApiSourcesRssFeedsRequestObject struct {
 }
 
-func (c *ClientWithResponses) PostApiDetectionsListWithResponse(ctx context.Context, body PostApiDetectionsListJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiDetectionsListResponse, error) {
-	rsp, err := c.PostApiDetectionsList(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiDetectionsListResponse(rsp)
+type GetApiSourcesRssFeedsResponseObject interface {
+	VisitGetApiSourcesRssFeedsResponse(w http.ResponseWriter) error
 }
 
-// PutApiDetectionsTagsWithBodyWithResponse request with arbitrary body returning *PutApiDetectionsTagsResponse
-func (c *ClientWithResponses) PutApiDetectionsTagsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiDetectionsTagsResponse, error) {
-	rsp, err := c.PutApiDetectionsTagsWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePutApiDetectionsTagsResponse(rsp)
+type GetApiSourcesRssFeeds200JSONResponse []RSSFeedSettings
+
+func (response GetApiSourcesRssFeeds200JSONResponse) VisitGetApiSourcesRssFeedsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (c *ClientWithResponses) PutApiDetectionsTagsWithResponse(ctx context.Context, body PutApiDetectionsTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiDetectionsTagsResponse, error) {
-	rsp, err := c.PutApiDetectionsTags(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePutApiDetectionsTagsResponse(rsp)
+type GetApiSourcesRssFeeds401Response struct {
 }
 
-// GetApiProfilesWithResponse request returning *GetApiProfilesResponse
-func (c *ClientWithResponses) GetApiProfilesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiProfilesResponse, error) {
-	rsp, err := c.GetApiProfiles(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetApiProfilesResponse(rsp)
+func (response GetApiSourcesRssFeeds401Response) VisitGetApiSourcesRssFeedsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
 }
 
-// PostApiProfilesWithBodyWithResponse request with arbitrary body returning *PostApiProfilesResponse
-func (c *ClientWithResponses) PostApiProfilesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiProfilesResponse, error) {
-	rsp, err := c.PostApiProfilesWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiProfilesResponse(rsp)
+type GetApiSourcesRssFeeds403Response struct {
 }
 
-func (c *ClientWithResponses) PostApiProfilesWithResponse(ctx context.Context, body PostApiProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiProfilesResponse, error) {
-	rsp, err := c.PostApiProfiles(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiProfilesResponse(rsp)
+func (response GetApiSourcesRssFeeds403Response) VisitGetApiSourcesRssFeedsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
 }
 
-// DeleteApiProfilesProfileIdWithResponse request returning *DeleteApiProfilesProfileIdResponse
-func (c *ClientWithResponses) DeleteApiProfilesProfileIdWithResponse(ctx context.Context, profileId int, reqEditors ...RequestEditorFn) (*DeleteApiProfilesProfileIdResponse, error) {
-	rsp, err := c.DeleteApiProfilesProfileId(ctx, profileId, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteApiProfilesProfileIdResponse(rsp)
+type GetApiSourcesRssFeeds500JSONResponse Error
+
+func (response GetApiSourcesRssFeeds500JSONResponse) VisitGetApiSourcesRssFeedsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// GetApiProfilesProfileIdWithResponse request returning *GetApiProfilesProfileIdResponse
-func (c *ClientWithResponses) GetApiProfilesProfileIdWithResponse(ctx context.Context, profileId int, reqEditors ...RequestEditorFn) (*GetApiProfilesProfileIdResponse, error) {
-	rsp, err := c.GetApiProfilesProfileId(ctx, profileId, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetApiProfilesProfileIdResponse(rsp)
+type PostApiSourcesRssFeedsAddProfilesRequestObject struct {
+	Body *PostApiSourcesRssFeedsAddProfilesJSONRequestBody
 }
 
-// PutApiProfilesProfileIdWithBodyWithResponse request with arbitrary body returning *PutApiProfilesProfileIdResponse
-func (c *ClientWithResponses) PutApiProfilesProfileIdWithBodyWithResponse(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutApiProfilesProfileIdResponse, error) {
-	rsp, err := c.PutApiProfilesProfileIdWithBody(ctx, profileId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePutApiProfilesProfileIdResponse(rsp)
+type PostApiSourcesRssFeedsAddProfilesResponseObject interface {
+	VisitPostApiSourcesRssFeedsAddProfilesResponse(w http.ResponseWriter) error
 }
 
-func (c *ClientWithResponses) PutApiProfilesProfileIdWithResponse(ctx context.Context, profileId int, body PutApiProfilesProfileIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutApiProfilesProfileIdResponse, error) {
-	rsp, err := c.PutApiProfilesProfileId(ctx, profileId, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePutApiProfilesProfileIdResponse(rsp)
+type PostApiSourcesRssFeedsAddProfiles204Response struct {
+}
+
+func (response PostApiSourcesRssFeedsAddProfiles204Response) VisitPostApiSourcesRssFeedsAddProfilesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-// PostApiProfilesProfileIdDryJumpstartWithBodyWithResponse request with arbitrary body returning *PostApiProfilesProfileIdDryJumpstartResponse
-func (c *ClientWithResponses) PostApiProfilesProfileIdDryJumpstartWithBodyWithResponse(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdDryJumpstartResponse, error) {
-	rsp, err := c.PostApiProfilesProfileIdDryJumpstartWithBody(ctx, profileId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiProfilesProfileIdDryJumpstartResponse(rsp)
+type PostApiSourcesRssFeedsAddProfiles400JSONResponse Error
+
+func (response PostApiSourcesRssFeedsAddProfiles400JSONResponse) VisitPostApiSourcesRssFeedsAddProfilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (c *ClientWithResponses) PostApiProfilesProfileIdDryJumpstartWithResponse(ctx context.Context, profileId int, body PostApiProfilesProfileIdDryJumpstartJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdDryJumpstartResponse, error) {
-	rsp, err := c.PostApiProfilesProfileIdDryJumpstart(ctx, profileId, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiProfilesProfileIdDryJumpstartResponse(rsp)
+type PostApiSourcesRssFeedsAddProfiles401Response struct {
 }
 
-// PostApiProfilesProfileIdJumpstartWithBodyWithResponse request with arbitrary body returning *PostApiProfilesProfileIdJumpstartResponse
-func (c *ClientWithResponses) PostApiProfilesProfileIdJumpstartWithBodyWithResponse(ctx context.Context, profileId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdJumpstartResponse, error) {
-	rsp, err := c.PostApiProfilesProfileIdJumpstartWithBody(ctx, profileId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiProfilesProfileIdJumpstartResponse(rsp)
+func (response PostApiSourcesRssFeedsAddProfiles401Response) VisitPostApiSourcesRssFeedsAddProfilesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
 }
 
-func (c *ClientWithResponses) PostApiProfilesProfileIdJumpstartWithResponse(ctx context.Context, profileId int, body PostApiProfilesProfileIdJumpstartJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiProfilesProfileIdJumpstartResponse, error) {
-	rsp, err := c.PostApiProfilesProfileIdJumpstart(ctx, profileId, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiProfilesProfileIdJumpstartResponse(rsp)
+type PostApiSourcesRssFeedsAddProfiles403Response struct {
 }
 
-// GetApiSourcesRedditSubredditsWithResponse request returning *GetApiSourcesRedditSubredditsResponse
-func (c *ClientWithResponses) GetApiSourcesRedditSubredditsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiSourcesRedditSubredditsResponse, error) {
-	rsp, err := c.GetApiSourcesRedditSubreddits(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetApiSourcesRedditSubredditsResponse(rsp)
+func (response PostApiSourcesRssFeedsAddProfiles403Response) VisitPostApiSourcesRssFeedsAddProfilesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
 }
 
-// PostApiSourcesRedditSubredditsSubredditAddProfilesWithBodyWithResponse request with arbitrary body returning *PostApiSourcesRedditSubredditsSubredditAddProfilesResponse
-func (c *ClientWithResponses) PostApiSourcesRedditSubredditsSubredditAddProfilesWithBodyWithResponse(ctx context.Context, subreddit string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiSourcesRedditSubredditsSubredditAddProfilesResponse, error) {
-	rsp, err := c.PostApiSourcesRedditSubredditsSubredditAddProfilesWithBody(ctx, subreddit, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiSourcesRedditSubredditsSubredditAddProfilesResponse(rsp)
+type PostApiSourcesRssFeedsAddProfiles500JSONResponse Error
+
+func (response PostApiSourcesRssFeedsAddProfiles500JSONResponse) VisitPostApiSourcesRssFeedsAddProfilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (c *ClientWithResponses) PostApiSourcesRedditSubredditsSubredditAddProfilesWithResponse(ctx context.Context, subreddit string, body PostApiSourcesRedditSubredditsSubredditAddProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiSourcesRedditSubredditsSubredditAddProfilesResponse, error) {
-	rsp, err := c.PostApiSourcesRedditSubredditsSubredditAddProfiles(ctx, subreddit, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiSourcesRedditSubredditsSubredditAddProfilesResponse(rsp)
+type PostApiSourcesRssFeedsRemoveProfilesRequestObject struct {
+	Body *PostApiSourcesRssFeedsRemoveProfilesJSONRequestBody
 }
 
-// PostApiSourcesRedditSubredditsSubredditRemoveProfilesWithBodyWithResponse request with arbitrary body returning *PostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse
-func (c *ClientWithResponses) PostApiSourcesRedditSubredditsSubredditRemoveProfilesWithBodyWithResponse(ctx context.Context, subreddit string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse, error) {
-	rsp, err := c.PostApiSourcesRedditSubredditsSubredditRemoveProfilesWithBody(ctx, subreddit, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse(rsp)
+type PostApiSourcesRssFeedsRemoveProfilesResponseObject interface {
+	VisitPostApiSourcesRssFeedsRemoveProfilesResponse(w http.ResponseWriter) error
 }
 
-func (c *ClientWithResponses) PostApiSourcesRedditSubredditsSubredditRemoveProfilesWithResponse(ctx context.Context, subreddit string, body PostApiSourcesRedditSubredditsSubredditRemoveProfilesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse, error) {
-	rsp, err := c.PostApiSourcesRedditSubredditsSubredditRemoveProfiles(ctx, subreddit, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse(rsp)
+type PostApiSourcesRssFeedsRemoveProfiles204Response struct {
 }
 
-// GetApiSourcesRedditSubredditsWithProfileWithResponse request returning *GetApiSourcesRedditSubredditsWithProfileResponse
-func (c *ClientWithResponses) GetApiSourcesRedditSubredditsWithProfileWithResponse(ctx context.Context, params *GetApiSourcesRedditSubredditsWithProfileParams, reqEditors ...RequestEditorFn) (*GetApiSourcesRedditSubredditsWithProfileResponse, error) {
-	rsp, err := c.GetApiSourcesRedditSubredditsWithProfile(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetApiSourcesRedditSubredditsWithProfileResponse(rsp)
+func (response PostApiSourcesRssFeedsRemoveProfiles204Response) VisitPostApiSourcesRssFeedsRemoveProfilesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-// GetApiStatisticsProfileIdWithResponse request returning *GetApiStatisticsProfileIdResponse
-func (c *ClientWithResponses) GetApiStatisticsProfileIdWithResponse(ctx context.Context, profileId int, reqEditors ...RequestEditorFn) (*GetApiStatisticsProfileIdResponse, error) {
-	rsp, err := c.GetApiStatisticsProfileId(ctx, profileId, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetApiStatisticsProfileIdResponse(rsp)
+type PostApiSourcesRssFeedsRemoveProfiles401Response struct {
 }
 
-// ParsePostApiAnalyzeResponse parses an HTTP response from a PostApiAnalyzeWithResponse call
-func ParsePostApiAnalyzeResponse(rsp *http.Response) (*PostApiAnalyzeResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+func (response PostApiSourcesRssFeedsRemoveProfiles401Response) VisitPostApiSourcesRssFeedsRemoveProfilesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
 
-	response := &PostApiAnalyzeResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+type PostApiSourcesRssFeedsRemoveProfiles403Response struct {
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Detection
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+func (response PostApiSourcesRssFeedsRemoveProfiles403Response) VisitPostApiSourcesRssFeedsRemoveProfilesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+type PostApiSourcesRssFeedsRemoveProfiles500JSONResponse Error
 
-	}
+func (response PostApiSourcesRssFeedsRemoveProfiles500JSONResponse) VisitPostApiSourcesRssFeedsRemoveProfilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	return response, nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ParsePostApiDetectionsListResponse parses an HTTP response from a PostApiDetectionsListWithResponse call
-func ParsePostApiDetectionsListResponse(rsp *http.Response) (*PostApiDetectionsListResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type GetApiSourcesRssFeedsWithProfileRequestObject struct {
+	Params GetApiSourcesRssFeedsWithProfileParams
+}
 
-	response := &PostApiDetectionsListResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+type GetApiSourcesRssFeedsWithProfileResponseObject interface {
+	VisitGetApiSourcesRssFeedsWithProfileResponse(w http.ResponseWriter) error
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []ListedDetection
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+type GetApiSourcesRssFeedsWithProfile200JSONResponse []RSSFeedSettings
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+func (response GetApiSourcesRssFeedsWithProfile200JSONResponse) VisitGetApiSourcesRssFeedsWithProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	return response, nil
+type GetApiSourcesRssFeedsWithProfile401Response struct {
 }
 
-// ParsePutApiDetectionsTagsResponse parses an HTTP response from a PutApiDetectionsTagsWithResponse call
-func ParsePutApiDetectionsTagsResponse(rsp *http.Response) (*PutApiDetectionsTagsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+func (response GetApiSourcesRssFeedsWithProfile401Response) VisitGetApiSourcesRssFeedsWithProfileResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
 
-	response := &PutApiDetectionsTagsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+type GetApiSourcesRssFeedsWithProfile403Response struct {
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DetectionTags
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+func (response GetApiSourcesRssFeedsWithProfile403Response) VisitGetApiSourcesRssFeedsWithProfileResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+type GetApiSourcesRssFeedsWithProfile500JSONResponse Error
 
-	}
+func (response GetApiSourcesRssFeedsWithProfile500JSONResponse) VisitGetApiSourcesRssFeedsWithProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	return response, nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ParseGetApiProfilesResponse parses an HTTP response from a GetApiProfilesWithResponse call
-func ParseGetApiProfilesResponse(rsp *http.Response) (*GetApiProfilesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &GetApiProfilesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+type GetApiStatisticsProfileIdRequestObject struct {
+	ProfileId int `json:"profileId"`
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Profile
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+type GetApiStatisticsProfileIdResponseObject interface {
+	VisitGetApiStatisticsProfileIdResponse(w http.ResponseWriter) error
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+type GetApiStatisticsProfileId200JSONResponse ProfileStatistics
 
-	}
+func (response GetApiStatisticsProfileId200JSONResponse) VisitGetApiStatisticsProfileIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	return response, nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ParsePostApiProfilesResponse parses an HTTP response from a PostApiProfilesWithResponse call
-func ParsePostApiProfilesResponse(rsp *http.Response) (*PostApiProfilesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type GetApiStatisticsProfileId401Response struct {
+}
 
-	response := &PostApiProfilesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+func (response GetApiStatisticsProfileId401Response) VisitGetApiStatisticsProfileIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest struct {
-			Id int `json:"id"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+type GetApiStatisticsProfileId403Response struct {
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+func (response GetApiStatisticsProfileId403Response) VisitGetApiStatisticsProfileIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
 
-	}
+type GetApiStatisticsProfileId404Response struct {
+}
 
-	return response, nil
+func (response GetApiStatisticsProfileId404Response) VisitGetApiStatisticsProfileIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
 }
 
-// ParseDeleteApiProfilesProfileIdResponse parses an HTTP response from a DeleteApiProfilesProfileIdWithResponse call
-func ParseDeleteApiProfilesProfileIdResponse(rsp *http.Response) (*DeleteApiProfilesProfileIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type GetApiStatisticsProfileId500JSONResponse Error
 
-	response := &DeleteApiProfilesProfileIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+func (response GetApiStatisticsProfileId500JSONResponse) VisitGetApiStatisticsProfileIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	}
+type GetApiStatisticsProfileIdAccuracyRequestObject struct {
+	ProfileId int `json:"profileId"`
+	Params    GetApiStatisticsProfileIdAccuracyParams
+}
 
-	return response, nil
+type GetApiStatisticsProfileIdAccuracyResponseObject interface {
+	VisitGetApiStatisticsProfileIdAccuracyResponse(w http.ResponseWriter) error
 }
 
-// ParseGetApiProfilesProfileIdResponse parses an HTTP response from a GetApiProfilesProfileIdWithResponse call
-func ParseGetApiProfilesProfileIdResponse(rsp *http.Response) (*GetApiProfilesProfileIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type GetApiStatisticsProfileIdAccuracy200JSONResponse []SettingsVersionAccuracy
 
-	response := &GetApiProfilesProfileIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+func (response GetApiStatisticsProfileIdAccuracy200JSONResponse) VisitGetApiStatisticsProfileIdAccuracyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Profile
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	return json.NewEncoder(w).Encode(response)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+type GetApiStatisticsProfileIdAccuracy401Response struct {
+}
 
-	}
+func (response GetApiStatisticsProfileIdAccuracy401Response) VisitGetApiStatisticsProfileIdAccuracyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
 
-	return response, nil
+type GetApiStatisticsProfileIdAccuracy403Response struct {
 }
 
-// ParsePutApiProfilesProfileIdResponse parses an HTTP response from a PutApiProfilesProfileIdWithResponse call
-func ParsePutApiProfilesProfileIdResponse(rsp *http.Response) (*PutApiProfilesProfileIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+func (response GetApiStatisticsProfileIdAccuracy403Response) VisitGetApiStatisticsProfileIdAccuracyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
 
-	response := &PutApiProfilesProfileIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+type GetApiStatisticsProfileIdAccuracy404Response struct {
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+func (response GetApiStatisticsProfileIdAccuracy404Response) VisitGetApiStatisticsProfileIdAccuracyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
 
-	}
+type GetApiStatisticsProfileIdAccuracy500JSONResponse Error
 
-	return response, nil
+func (response GetApiStatisticsProfileIdAccuracy500JSONResponse) VisitGetApiStatisticsProfileIdAccuracyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ParsePostApiProfilesProfileIdDryJumpstartResponse parses an HTTP response from a PostApiProfilesProfileIdDryJumpstartWithResponse call
-func ParsePostApiProfilesProfileIdDryJumpstartResponse(rsp *http.Response) (*PostApiProfilesProfileIdDryJumpstartResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type GetApiTasksRequestObject struct {
+	Params GetApiTasksParams
+}
 
-	response := &PostApiProfilesProfileIdDryJumpstartResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+type GetApiTasksResponseObject interface {
+	VisitGetApiTasksResponse(w http.ResponseWriter) error
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []AnalysisTaskParameters
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+type GetApiTasks200JSONResponse []AnalysisTask
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+func (response GetApiTasks200JSONResponse) VisitGetApiTasksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	return response, nil
+type GetApiTasks401Response struct {
 }
 
-// ParsePostApiProfilesProfileIdJumpstartResponse parses an HTTP response from a PostApiProfilesProfileIdJumpstartWithResponse call
-func ParsePostApiProfilesProfileIdJumpstartResponse(rsp *http.Response) (*PostApiProfilesProfileIdJumpstartResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+func (response GetApiTasks401Response) VisitGetApiTasksResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
 
-	response := &PostApiProfilesProfileIdJumpstartResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+type GetApiTasks403Response struct {
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+func (response GetApiTasks403Response) VisitGetApiTasksResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
 
-	}
+type GetApiTasks500JSONResponse Error
 
-	return response, nil
+func (response GetApiTasks500JSONResponse) VisitGetApiTasksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ParseGetApiSourcesRedditSubredditsResponse parses an HTTP response from a GetApiSourcesRedditSubredditsWithResponse call
-func ParseGetApiSourcesRedditSubredditsResponse(rsp *http.Response) (*GetApiSourcesRedditSubredditsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type PostApiTasksCancelRequestObject struct {
+	Body *PostApiTasksCancelJSONRequestBody
+}
 
-	response := &GetApiSourcesRedditSubredditsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+type PostApiTasksCancelResponseObject interface {
+	VisitPostApiTasksCancelResponse(w http.ResponseWriter) error
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []SubredditSettings
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+type PostApiTasksCancel200JSONResponse TaskActionResult
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+func (response PostApiTasksCancel200JSONResponse) VisitPostApiTasksCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	return response, nil
+type PostApiTasksCancel401Response struct {
 }
 
-// ParsePostApiSourcesRedditSubredditsSubredditAddProfilesResponse parses an HTTP response from a PostApiSourcesRedditSubredditsSubredditAddProfilesWithResponse call
-func ParsePostApiSourcesRedditSubredditsSubredditAddProfilesResponse(rsp *http.Response) (*PostApiSourcesRedditSubredditsSubredditAddProfilesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+func (response PostApiTasksCancel401Response) VisitPostApiTasksCancelResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
 
-	response := &PostApiSourcesRedditSubredditsSubredditAddProfilesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+type PostApiTasksCancel403Response struct {
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+func (response PostApiTasksCancel403Response) VisitPostApiTasksCancelResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
 
-	}
+type PostApiTasksCancel500JSONResponse Error
 
-	return response, nil
+func (response PostApiTasksCancel500JSONResponse) VisitPostApiTasksCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ParsePostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse parses an HTTP response from a PostApiSourcesRedditSubredditsSubredditRemoveProfilesWithResponse call
-func ParsePostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse(rsp *http.Response) (*PostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type PostApiTasksPurgeRequestObject struct {
+	Body *PostApiTasksPurgeJSONRequestBody
+}
 
-	response := &PostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+type PostApiTasksPurgeResponseObject interface {
+	VisitPostApiTasksPurgeResponse(w http.ResponseWriter) error
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+type PostApiTasksPurge200JSONResponse TaskActionResult
+
+func (response PostApiTasksPurge200JSONResponse) VisitPostApiTasksPurgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
 
-	}
+type PostApiTasksPurge400JSONResponse Error
 
-	return response, nil
+func (response PostApiTasksPurge400JSONResponse) VisitPostApiTasksPurgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ParseGetApiSourcesRedditSubredditsWithProfileResponse parses an HTTP response from a GetApiSourcesRedditSubredditsWithProfileWithResponse call
-func ParseGetApiSourcesRedditSubredditsWithProfileResponse(rsp *http.Response) (*GetApiSourcesRedditSubredditsWithProfileResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type PostApiTasksPurge401Response struct {
+}
 
-	response := &GetApiSourcesRedditSubredditsWithProfileResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+func (response PostApiTasksPurge401Response) VisitPostApiTasksPurgeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []SubredditSettings
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+type PostApiTasksPurge403Response struct {
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+func (response PostApiTasksPurge403Response) VisitPostApiTasksPurgeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
 
-	}
+type PostApiTasksPurge500JSONResponse Error
 
-	return response, nil
+func (response PostApiTasksPurge500JSONResponse) VisitPostApiTasksPurgeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// ParseGetApiStatisticsProfileIdResponse parses an HTTP response from a GetApiStatisticsProfileIdWithResponse call
-func ParseGetApiStatisticsProfileIdResponse(rsp *http.Response) (*GetApiStatisticsProfileIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+type PostApiTasksRetryRequestObject struct {
+	Body *PostApiTasksRetryJSONRequestBody
+}
 
-	response := &GetApiStatisticsProfileIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+type PostApiTasksRetryResponseObject interface {
+	VisitPostApiTasksRetryResponse(w http.ResponseWriter) error
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProfileStatistics
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+type PostApiTasksRetry200JSONResponse TaskActionResult
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+func (response PostApiTasksRetry200JSONResponse) VisitPostApiTasksRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	return response, nil
+type PostApiTasksRetry401Response struct {
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Analyze a post
-	// (POST /api/analyze)
-	PostApiAnalyze(c *gin.Context)
-	// List detections
-	// (POST /api/detections/list)
-	PostApiDetectionsList(c *gin.Context)
-	// Detection tag update
-	// (PUT /api/detections/tags)
-	PutApiDetectionsTags(c *gin.Context)
-	// Get all profiles
-	// (GET /api/profiles)
-	GetApiProfiles(c *gin.Context)
-	// Create a new profile
-	// (POST /api/profiles)
-	PostApiProfiles(c *gin.Context)
-	// Delete a profile by ID
-	// (DELETE /api/profiles/{profileId})
-	DeleteApiProfilesProfileId(c *gin.Context, profileId int)
-	// Get a profile by ID
-	// (GET /api/profiles/{profileId})
-	GetApiProfilesProfileId(c *gin.Context, profileId int)
-	// Update a profile by ID
-	// (PUT /api/profiles/{profileId})
-	PutApiProfilesProfileId(c *gin.Context, profileId int)
-	// Dry jumpstart a profile - load tasks to be spawned
-	// (POST /api/profiles/{profileId}/dry_jumpstart)
-	PostApiProfilesProfileIdDryJumpstart(c *gin.Context, profileId int)
-	// Jumpstart a profile - run analysis on old posts
-	// (POST /api/profiles/{profileId}/jumpstart)
-	PostApiProfilesProfileIdJumpstart(c *gin.Context, profileId int)
-	// Get all subreddits
-	// (GET /api/sources/reddit/subreddits)
-	GetApiSourcesRedditSubreddits(c *gin.Context)
-	// Add profiles
-	// (POST /api/sources/reddit/subreddits/{subreddit}/add_profiles)
-	PostApiSourcesRedditSubredditsSubredditAddProfiles(c *gin.Context, subreddit string)
-	// Remove profiles
-	// (POST /api/sources/reddit/subreddits/{subreddit}/remove_profiles)
-	PostApiSourcesRedditSubredditsSubredditRemoveProfiles(c *gin.Context, subreddit string)
-	// Get all subreddits by profile
-	// (GET /api/sources/reddit/subreddits_with_profile)
-	GetApiSourcesRedditSubredditsWithProfile(c *gin.Context, params GetApiSourcesRedditSubredditsWithProfileParams)
-	// Get statistics for a profile
-	// (GET /api/statistics/{profileId})
-	GetApiStatisticsProfileId(c *gin.Context, profileId int)
+func (response PostApiTasksRetry401Response) VisitPostApiTasksRetryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandler       func(*gin.Context, error, int)
+type PostApiTasksRetry403Response struct {
 }
 
-type MiddlewareFunc func(c *gin.Context)
+func (response PostApiTasksRetry403Response) VisitPostApiTasksRetryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
 
-// PostApiAnalyze operation middleware
-func (siw *ServerInterfaceWrapper) PostApiAnalyze(c *gin.Context) {
+type PostApiTasksRetry500JSONResponse Error
 
-	c.Set(BasicAuthScopes, []string{})
+func (response PostApiTasksRetry500JSONResponse) VisitPostApiTasksRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	siw.Handler.PostApiAnalyze(c)
+type DeleteApiWebhooksWebhookIdRequestObject struct {
+	WebhookId int `json:"webhookId"`
 }
 
-// PostApiDetectionsList operation middleware
-func (siw *ServerInterfaceWrapper) PostApiDetectionsList(c *gin.Context) {
+type DeleteApiWebhooksWebhookIdResponseObject interface {
+	VisitDeleteApiWebhooksWebhookIdResponse(w http.ResponseWriter) error
+}
 
-	c.Set(BasicAuthScopes, []string{})
+type DeleteApiWebhooksWebhookId204Response struct {
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+func (response DeleteApiWebhooksWebhookId204Response) VisitDeleteApiWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
 
-	siw.Handler.PostApiDetectionsList(c)
+type DeleteApiWebhooksWebhookId401Response struct {
 }
 
-// PutApiDetectionsTags operation middleware
-func (siw *ServerInterfaceWrapper) PutApiDetectionsTags(c *gin.Context) {
+func (response DeleteApiWebhooksWebhookId401Response) VisitDeleteApiWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
 
-	c.Set(BasicAuthScopes, []string{})
+type DeleteApiWebhooksWebhookId403Response struct {
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+func (response DeleteApiWebhooksWebhookId403Response) VisitDeleteApiWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
 
-	siw.Handler.PutApiDetectionsTags(c)
+type DeleteApiWebhooksWebhookId404Response struct {
 }
 
-// GetApiProfiles operation middleware
-func (siw *ServerInterfaceWrapper) GetApiProfiles(c *gin.Context) {
+func (response DeleteApiWebhooksWebhookId404Response) VisitDeleteApiWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
 
-	c.Set(BasicAuthScopes, []string{})
+type DeleteApiWebhooksWebhookId500JSONResponse Error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+func (response DeleteApiWebhooksWebhookId500JSONResponse) VisitDeleteApiWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	siw.Handler.GetApiProfiles(c)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// PostApiProfiles operation middleware
-func (siw *ServerInterfaceWrapper) PostApiProfiles(c *gin.Context) {
+type PutApiWebhooksWebhookIdRequestObject struct {
+	WebhookId int `json:"webhookId"`
+	Body      *PutApiWebhooksWebhookIdJSONRequestBody
+}
 
-	c.Set(BasicAuthScopes, []string{})
+type PutApiWebhooksWebhookIdResponseObject interface {
+	VisitPutApiWebhooksWebhookIdResponse(w http.ResponseWriter) error
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+type PutApiWebhooksWebhookId200JSONResponse Webhook
 
-	siw.Handler.PostApiProfiles(c)
-}
+func (response PutApiWebhooksWebhookId200JSONResponse) VisitPutApiWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-// DeleteApiProfilesProfileId operation middleware
-func (siw *ServerInterfaceWrapper) DeleteApiProfilesProfileId(c *gin.Context) {
+	return json.NewEncoder(w).Encode(response)
+}
 
-	var err error
+type PutApiWebhooksWebhookId400JSONResponse Error
 
-	// ------------- Path parameter "profileId" -------------
-	var profileId int
+func (response PutApiWebhooksWebhookId400JSONResponse) VisitPutApiWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-	err = runtime.BindStyledParameterWithOptions("simple", "profileId", c.Param("profileId"), &profileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profileId: %w", err), http.StatusBadRequest)
-		return
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	c.Set(BasicAuthScopes, []string{})
+type PutApiWebhooksWebhookId401Response struct {
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+func (response PutApiWebhooksWebhookId401Response) VisitPutApiWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
 
-	siw.Handler.DeleteApiProfilesProfileId(c, profileId)
+type PutApiWebhooksWebhookId403Response struct {
 }
 
-// GetApiProfilesProfileId operation middleware
-func (siw *ServerInterfaceWrapper) GetApiProfilesProfileId(c *gin.Context) {
+func (response PutApiWebhooksWebhookId403Response) VisitPutApiWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
 
-	var err error
+type PutApiWebhooksWebhookId404Response struct {
+}
 
-	// ------------- Path parameter "profileId" -------------
-	var profileId int
+func (response PutApiWebhooksWebhookId404Response) VisitPutApiWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "profileId", c.Param("profileId"), &profileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profileId: %w", err), http.StatusBadRequest)
-		return
-	}
+type PutApiWebhooksWebhookId500JSONResponse Error
 
-	c.Set(BasicAuthScopes, []string{})
+func (response PutApiWebhooksWebhookId500JSONResponse) VisitPutApiWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	siw.Handler.GetApiProfilesProfileId(c, profileId)
+type GetApiWebhooksWebhookIdDeliveriesRequestObject struct {
+	WebhookId int `json:"webhookId"`
+	Params    GetApiWebhooksWebhookIdDeliveriesParams
 }
 
-// PutApiProfilesProfileId operation middleware
-func (siw *ServerInterfaceWrapper) PutApiProfilesProfileId(c *gin.Context) {
+type GetApiWebhooksWebhookIdDeliveriesResponseObject interface {
+	VisitGetApiWebhooksWebhookIdDeliveriesResponse(w http.ResponseWriter) error
+}
 
-	var err error
+type GetApiWebhooksWebhookIdDeliveries200JSONResponse []WebhookDeliveryAttempt
 
-	// ------------- Path parameter "profileId" -------------
-	var profileId int
+func (response GetApiWebhooksWebhookIdDeliveries200JSONResponse) VisitGetApiWebhooksWebhookIdDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	err = runtime.BindStyledParameterWithOptions("simple", "profileId", c.Param("profileId"), &profileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profileId: %w", err), http.StatusBadRequest)
-		return
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	c.Set(BasicAuthScopes, []string{})
+type GetApiWebhooksWebhookIdDeliveries401Response struct {
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+func (response GetApiWebhooksWebhookIdDeliveries401Response) VisitGetApiWebhooksWebhookIdDeliveriesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
 
-	siw.Handler.PutApiProfilesProfileId(c, profileId)
+type GetApiWebhooksWebhookIdDeliveries403Response struct {
 }
 
-// PostApiProfilesProfileIdDryJumpstart operation middleware
-func (siw *ServerInterfaceWrapper) PostApiProfilesProfileIdDryJumpstart(c *gin.Context) {
+func (response GetApiWebhooksWebhookIdDeliveries403Response) VisitGetApiWebhooksWebhookIdDeliveriesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
 
-	var err error
+type GetApiWebhooksWebhookIdDeliveries404Response struct {
+}
 
-	// ------------- Path parameter "profileId" -------------
-	var profileId int
+func (response GetApiWebhooksWebhookIdDeliveries404Response) VisitGetApiWebhooksWebhookIdDeliveriesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "profileId", c.Param("profileId"), &profileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profileId: %w", err), http.StatusBadRequest)
-		return
-	}
+type GetApiWebhooksWebhookIdDeliveries500JSONResponse Error
 
-	c.Set(BasicAuthScopes, []string{})
+func (response GetApiWebhooksWebhookIdDeliveries500JSONResponse) VisitGetApiWebhooksWebhookIdDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	siw.Handler.PostApiProfilesProfileIdDryJumpstart(c, profileId)
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Analyze a post
+	// (POST /api/analyze)
+	PostApiAnalyze(ctx context.Context, request PostApiAnalyzeRequestObject) (PostApiAnalyzeResponseObject, error)
+	// Get current limits and utilization of LLM providers
+	// (GET /api/analyzers/limiters)
+	GetApiAnalyzersLimiters(ctx context.Context, request GetApiAnalyzersLimitersRequestObject) (GetApiAnalyzersLimitersResponseObject, error)
+	// List detections
+	// (POST /api/detections/list)
+	PostApiDetectionsList(ctx context.Context, request PostApiDetectionsListRequestObject) (PostApiDetectionsListResponseObject, error)
+	// Detection tag update
+	// (PUT /api/detections/tags)
+	PutApiDetectionsTags(ctx context.Context, request PutApiDetectionsTagsRequestObject) (PutApiDetectionsTagsResponseObject, error)
+	// Get all profiles
+	// (GET /api/profiles)
+	GetApiProfiles(ctx context.Context, request GetApiProfilesRequestObject) (GetApiProfilesResponseObject, error)
+	// Create a new profile
+	// (POST /api/profiles)
+	PostApiProfiles(ctx context.Context, request PostApiProfilesRequestObject) (PostApiProfilesResponseObject, error)
+	// Delete a profile by ID
+	// (DELETE /api/profiles/{profileId})
+	DeleteApiProfilesProfileId(ctx context.Context, request DeleteApiProfilesProfileIdRequestObject) (DeleteApiProfilesProfileIdResponseObject, error)
+	// Get a profile by ID
+	// (GET /api/profiles/{profileId})
+	GetApiProfilesProfileId(ctx context.Context, request GetApiProfilesProfileIdRequestObject) (GetApiProfilesProfileIdResponseObject, error)
+	// Update a profile by ID
+	// (PUT /api/profiles/{profileId})
+	PutApiProfilesProfileId(ctx context.Context, request PutApiProfilesProfileIdRequestObject) (PutApiProfilesProfileIdResponseObject, error)
+	// Request analysis of posts for a profile
+	// (POST /api/profiles/{profileId}/analyze)
+	PostApiProfilesProfileIdAnalyze(ctx context.Context, request PostApiProfilesProfileIdAnalyzeRequestObject) (PostApiProfilesProfileIdAnalyzeResponseObject, error)
+	// Dry jumpstart a profile - load tasks to be spawned
+	// (POST /api/profiles/{profileId}/dry_jumpstart)
+	PostApiProfilesProfileIdDryJumpstart(ctx context.Context, request PostApiProfilesProfileIdDryJumpstartRequestObject) (PostApiProfilesProfileIdDryJumpstartResponseObject, error)
+	// Jumpstart a profile - run analysis on old posts
+	// (POST /api/profiles/{profileId}/jumpstart)
+	PostApiProfilesProfileIdJumpstart(ctx context.Context, request PostApiProfilesProfileIdJumpstartRequestObject) (PostApiProfilesProfileIdJumpstartResponseObject, error)
+	// List versions of profile settings for a source
+	// (GET /api/profiles/{profileId}/settings_versions)
+	GetApiProfilesProfileIdSettingsVersions(ctx context.Context, request GetApiProfilesProfileIdSettingsVersionsRequestObject) (GetApiProfilesProfileIdSettingsVersionsResponseObject, error)
+	// Compare two versions of profile settings for a source
+	// (GET /api/profiles/{profileId}/settings_versions/diff)
+	GetApiProfilesProfileIdSettingsVersionsDiff(ctx context.Context, request GetApiProfilesProfileIdSettingsVersionsDiffRequestObject) (GetApiProfilesProfileIdSettingsVersionsDiffResponseObject, error)
+	// Create a new version of profile settings from an older version
+	// (POST /api/profiles/{profileId}/settings_versions/rollback)
+	PostApiProfilesProfileIdSettingsVersionsRollback(ctx context.Context, request PostApiProfilesProfileIdSettingsVersionsRollbackRequestObject) (PostApiProfilesProfileIdSettingsVersionsRollbackResponseObject, error)
+	// List the latest shadows of a profile
+	// (GET /api/profiles/{profileId}/shadows)
+	GetApiProfilesProfileIdShadows(ctx context.Context, request GetApiProfilesProfileIdShadowsRequestObject) (GetApiProfilesProfileIdShadowsResponseObject, error)
+	// Start evaluating candidate settings of a profile on live traffic
+	// (POST /api/profiles/{profileId}/shadows)
+	PostApiProfilesProfileIdShadows(ctx context.Context, request PostApiProfilesProfileIdShadowsRequestObject) (PostApiProfilesProfileIdShadowsResponseObject, error)
+	// Get a shadow with a summary of its results
+	// (GET /api/profiles/{profileId}/shadows/{shadowId})
+	GetApiProfilesProfileIdShadowsShadowId(ctx context.Context, request GetApiProfilesProfileIdShadowsShadowIdRequestObject) (GetApiProfilesProfileIdShadowsShadowIdResponseObject, error)
+	// List posts for which a shadow disagrees with production detections
+	// (GET /api/profiles/{profileId}/shadows/{shadowId}/disagreements)
+	GetApiProfilesProfileIdShadowsShadowIdDisagreements(ctx context.Context, request GetApiProfilesProfileIdShadowsShadowIdDisagreementsRequestObject) (GetApiProfilesProfileIdShadowsShadowIdDisagreementsResponseObject, error)
+	// Stop analyzing new posts with settings of a shadow, its results are kept
+	// (POST /api/profiles/{profileId}/shadows/{shadowId}/stop)
+	PostApiProfilesProfileIdShadowsShadowIdStop(ctx context.Context, request PostApiProfilesProfileIdShadowsShadowIdStopRequestObject) (PostApiProfilesProfileIdShadowsShadowIdStopResponseObject, error)
+	// Bump or lower priority of pending analysis tasks of a profile
+	// (PUT /api/profiles/{profileId}/tasks/priority)
+	PutApiProfilesProfileIdTasksPriority(ctx context.Context, request PutApiProfilesProfileIdTasksPriorityRequestObject) (PutApiProfilesProfileIdTasksPriorityResponseObject, error)
+	// List the test suite of a profile
+	// (GET /api/profiles/{profileId}/test_cases)
+	GetApiProfilesProfileIdTestCases(ctx context.Context, request GetApiProfilesProfileIdTestCasesRequestObject) (GetApiProfilesProfileIdTestCasesResponseObject, error)
+	// Add a post to the test suite of a profile or replace its expected values
+	// (PUT /api/profiles/{profileId}/test_cases)
+	PutApiProfilesProfileIdTestCases(ctx context.Context, request PutApiProfilesProfileIdTestCasesRequestObject) (PutApiProfilesProfileIdTestCasesResponseObject, error)
+	// Remove a post from the test suite of a profile, results of past runs are kept
+	// (DELETE /api/profiles/{profileId}/test_cases/{testCaseId})
+	DeleteApiProfilesProfileIdTestCasesTestCaseId(ctx context.Context, request DeleteApiProfilesProfileIdTestCasesTestCaseIdRequestObject) (DeleteApiProfilesProfileIdTestCasesTestCaseIdResponseObject, error)
+	// List the latest test runs of a profile
+	// (GET /api/profiles/{profileId}/test_runs)
+	GetApiProfilesProfileIdTestRuns(ctx context.Context, request GetApiProfilesProfileIdTestRunsRequestObject) (GetApiProfilesProfileIdTestRunsResponseObject, error)
+	// Run the test suite of a profile against draft or saved settings
+	// (POST /api/profiles/{profileId}/test_runs)
+	PostApiProfilesProfileIdTestRuns(ctx context.Context, request PostApiProfilesProfileIdTestRunsRequestObject) (PostApiProfilesProfileIdTestRunsResponseObject, error)
+	// Get a test run with results of its test cases
+	// (GET /api/profiles/{profileId}/test_runs/{testRunId})
+	GetApiProfilesProfileIdTestRunsTestRunId(ctx context.Context, request GetApiProfilesProfileIdTestRunsTestRunIdRequestObject) (GetApiProfilesProfileIdTestRunsTestRunIdResponseObject, error)
+	// Compare results of a test run with a base run
+	// (GET /api/profiles/{profileId}/test_runs/{testRunId}/diff)
+	GetApiProfilesProfileIdTestRunsTestRunIdDiff(ctx context.Context, request GetApiProfilesProfileIdTestRunsTestRunIdDiffRequestObject) (GetApiProfilesProfileIdTestRunsTestRunIdDiffResponseObject, error)
+	// List webhooks of a profile
+	// (GET /api/profiles/{profileId}/webhooks)
+	GetApiProfilesProfileIdWebhooks(ctx context.Context, request GetApiProfilesProfileIdWebhooksRequestObject) (GetApiProfilesProfileIdWebhooksResponseObject, error)
+	// Subscribe a webhook to relevant detections of a profile
+	// (POST /api/profiles/{profileId}/webhooks)
+	PostApiProfilesProfileIdWebhooks(ctx context.Context, request PostApiProfilesProfileIdWebhooksRequestObject) (PostApiProfilesProfileIdWebhooksResponseObject, error)
+	// Get all Hacker News feeds
+	// (GET /api/sources/hackernews/feeds)
+	GetApiSourcesHackernewsFeeds(ctx context.Context, request GetApiSourcesHackernewsFeedsRequestObject) (GetApiSourcesHackernewsFeedsResponseObject, error)
+	// Add profiles
+	// (POST /api/sources/hackernews/feeds/{feed}/add_profiles)
+	PostApiSourcesHackernewsFeedsFeedAddProfiles(ctx context.Context, request PostApiSourcesHackernewsFeedsFeedAddProfilesRequestObject) (PostApiSourcesHackernewsFeedsFeedAddProfilesResponseObject, error)
+	// Remove profiles
+	// (POST /api/sources/hackernews/feeds/{feed}/remove_profiles)
+	PostApiSourcesHackernewsFeedsFeedRemoveProfiles(ctx context.Context, request PostApiSourcesHackernewsFeedsFeedRemoveProfilesRequestObject) (PostApiSourcesHackernewsFeedsFeedRemoveProfilesResponseObject, error)
+	// Get all Hacker News feeds by profile
+	// (GET /api/sources/hackernews/feeds_with_profile)
+	GetApiSourcesHackernewsFeedsWithProfile(ctx context.Context, request GetApiSourcesHackernewsFeedsWithProfileRequestObject) (GetApiSourcesHackernewsFeedsWithProfileResponseObject, error)
+	// Get scrape progress of all subreddits
+	// (GET /api/sources/reddit/scrape_state)
+	GetApiSourcesRedditScrapeState(ctx context.Context, request GetApiSourcesRedditScrapeStateRequestObject) (GetApiSourcesRedditScrapeStateResponseObject, error)
+	// Get all subreddits
+	// (GET /api/sources/reddit/subreddits)
+	GetApiSourcesRedditSubreddits(ctx context.Context, request GetApiSourcesRedditSubredditsRequestObject) (GetApiSourcesRedditSubredditsResponseObject, error)
+	// Add profiles
+	// (POST /api/sources/reddit/subreddits/{subreddit}/add_profiles)
+	PostApiSourcesRedditSubredditsSubredditAddProfiles(ctx context.Context, request PostApiSourcesRedditSubredditsSubredditAddProfilesRequestObject) (PostApiSourcesRedditSubredditsSubredditAddProfilesResponseObject, error)
+	// Remove profiles
+	// (POST /api/sources/reddit/subreddits/{subreddit}/remove_profiles)
+	PostApiSourcesRedditSubredditsSubredditRemoveProfiles(ctx context.Context, request PostApiSourcesRedditSubredditsSubredditRemoveProfilesRequestObject) (PostApiSourcesRedditSubredditsSubredditRemoveProfilesResponseObject, error)
+	// Get all subreddits by profile
+	// (GET /api/sources/reddit/subreddits_with_profile)
+	GetApiSourcesRedditSubredditsWithProfile(ctx context.Context, request GetApiSourcesRedditSubredditsWithProfileRequestObject) (GetApiSourcesRedditSubredditsWithProfileResponseObject, error)
+	// Get all RSS/Atom feeds
+	// (GET /api/sources/rss/feeds)
+	GetApiSourcesRssFeeds(ctx context.Context, request GetApiSourcesRssFeedsRequestObject) (GetApiSourcesRssFeedsResponseObject, error)
+	// Add profiles to an RSS/Atom feed
+	// (POST /api/sources/rss/feeds/add_profiles)
+	PostApiSourcesRssFeedsAddProfiles(ctx context.Context, request PostApiSourcesRssFeedsAddProfilesRequestObject) (PostApiSourcesRssFeedsAddProfilesResponseObject, error)
+	// Remove profiles from an RSS/Atom feed
+	// (POST /api/sources/rss/feeds/remove_profiles)
+	PostApiSourcesRssFeedsRemoveProfiles(ctx context.Context, request PostApiSourcesRssFeedsRemoveProfilesRequestObject) (PostApiSourcesRssFeedsRemoveProfilesResponseObject, error)
+	// Get all RSS/Atom feeds by profile
+	// (GET /api/sources/rss/feeds_with_profile)
+	GetApiSourcesRssFeedsWithProfile(ctx context.Context, request GetApiSourcesRssFeedsWithProfileRequestObject) (GetApiSourcesRssFeedsWithProfileResponseObject, error)
+	// Get statistics for a profile
+	// (GET /api/statistics/{profileId})
+	GetApiStatisticsProfileId(ctx context.Context, request GetApiStatisticsProfileIdRequestObject) (GetApiStatisticsProfileIdResponseObject, error)
+	// Get accuracy of each settings version of a profile source, measured by relevancy tags
+	// (GET /api/statistics/{profileId}/accuracy)
+	GetApiStatisticsProfileIdAccuracy(ctx context.Context, request GetApiStatisticsProfileIdAccuracyRequestObject) (GetApiStatisticsProfileIdAccuracyResponseObject, error)
+	// List analysis tasks, newest first
+	// (GET /api/tasks)
+	GetApiTasks(ctx context.Context, request GetApiTasksRequestObject) (GetApiTasksResponseObject, error)
+	// Cancel pending tasks of a profile - remove them from the queue
+	// (POST /api/tasks/cancel)
+	PostApiTasksCancel(ctx context.Context, request PostApiTasksCancelRequestObject) (PostApiTasksCancelResponseObject, error)
+	// Delete old committed tasks
+	// (POST /api/tasks/purge)
+	PostApiTasksPurge(ctx context.Context, request PostApiTasksPurgeRequestObject) (PostApiTasksPurgeResponseObject, error)
+	// Retry failed tasks - reset their errors and return them to the queue
+	// (POST /api/tasks/retry)
+	PostApiTasksRetry(ctx context.Context, request PostApiTasksRetryRequestObject) (PostApiTasksRetryResponseObject, error)
+	// Delete a webhook with its pending events and delivery log
+	// (DELETE /api/webhooks/{webhookId})
+	DeleteApiWebhooksWebhookId(ctx context.Context, request DeleteApiWebhooksWebhookIdRequestObject) (DeleteApiWebhooksWebhookIdResponseObject, error)
+	// Update a webhook
+	// (PUT /api/webhooks/{webhookId})
+	PutApiWebhooksWebhookId(ctx context.Context, request PutApiWebhooksWebhookIdRequestObject) (PutApiWebhooksWebhookIdResponseObject, error)
+	// List the latest delivery attempts of a webhook
+	// (GET /api/webhooks/{webhookId}/deliveries)
+	GetApiWebhooksWebhookIdDeliveries(ctx context.Context, request GetApiWebhooksWebhookIdDeliveriesRequestObject) (GetApiWebhooksWebhookIdDeliveriesResponseObject, error)
 }
 
-// PostApiProfilesProfileIdJumpstart operation middleware
-func (siw *ServerInterfaceWrapper) PostApiProfilesProfileIdJumpstart(c *gin.Context) {
+type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
+type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
 
-	var err error
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
 
-	// ------------- Path parameter "profileId" -------------
-	var profileId int
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "profileId", c.Param("profileId"), &profileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profileId: %w", err), http.StatusBadRequest)
+// PostApiAnalyze operation middleware
+func (sh *strictHandler) PostApiAnalyze(ctx *gin.Context) {
+	var request PostApiAnalyzeRequestObject
+
+	var body PostApiAnalyzeJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
 		return
 	}
+	request.Body = &body
 
-	c.Set(BasicAuthScopes, []string{})
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiAnalyze(ctx, request.(PostApiAnalyzeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiAnalyze")
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiAnalyzeResponseObject); ok {
+		if err := validResponse.VisitPostApiAnalyzeResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
 		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
 	}
-
-	siw.Handler.PostApiProfilesProfileIdJumpstart(c, profileId)
 }
 
-// GetApiSourcesRedditSubreddits operation middleware
-func (siw *ServerInterfaceWrapper) GetApiSourcesRedditSubreddits(c *gin.Context) {
+// GetApiAnalyzersLimiters operation middleware
+func (sh *strictHandler) GetApiAnalyzersLimiters(ctx *gin.Context) {
+	var request GetApiAnalyzersLimitersRequestObject
 
-	c.Set(BasicAuthScopes, []string{})
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiAnalyzersLimiters(ctx, request.(GetApiAnalyzersLimitersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiAnalyzersLimiters")
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiAnalyzersLimitersResponseObject); ok {
+		if err := validResponse.VisitGetApiAnalyzersLimitersResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
 		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
 	}
-
-	siw.Handler.GetApiSourcesRedditSubreddits(c)
 }
 
-// PostApiSourcesRedditSubredditsSubredditAddProfiles operation middleware
-func (siw *ServerInterfaceWrapper) PostApiSourcesRedditSubredditsSubredditAddProfiles(c *gin.Context) {
-
-	var err error
-
-	// ------------- Path parameter "subreddit" -------------
-	var subreddit string
+// PostApiDetectionsList operation middleware
+func (sh *strictHandler) PostApiDetectionsList(ctx *gin.Context) {
+	var request PostApiDetectionsListRequestObject
 
-	err = runtime.BindStyledParameterWithOptions("simple", "subreddit", c.Param("subreddit"), &subreddit, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter subreddit: %w", err), http.StatusBadRequest)
+	var body PostApiDetectionsListJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
 		return
 	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiDetectionsList(ctx, request.(PostApiDetectionsListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiDetectionsList")
+	}
 
-	c.Set(BasicAuthScopes, []string{})
+	response, err := handler(ctx, request)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiDetectionsListResponseObject); ok {
+		if err := validResponse.VisitPostApiDetectionsListResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
 		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
 	}
-
-	siw.Handler.PostApiSourcesRedditSubredditsSubredditAddProfiles(c, subreddit)
 }
 
-// PostApiSourcesRedditSubredditsSubredditRemoveProfiles operation middleware
-func (siw *ServerInterfaceWrapper) PostApiSourcesRedditSubredditsSubredditRemoveProfiles(c *gin.Context) {
-
-	var err error
-
-	// ------------- Path parameter "subreddit" -------------
-	var subreddit string
+// PutApiDetectionsTags operation middleware
+func (sh *strictHandler) PutApiDetectionsTags(ctx *gin.Context) {
+	var request PutApiDetectionsTagsRequestObject
 
-	err = runtime.BindStyledParameterWithOptions("simple", "subreddit", c.Param("subreddit"), &subreddit, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter subreddit: %w", err), http.StatusBadRequest)
+	var body PutApiDetectionsTagsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
 		return
 	}
+	request.Body = &body
 
-	c.Set(BasicAuthScopes, []string{})
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutApiDetectionsTags(ctx, request.(PutApiDetectionsTagsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutApiDetectionsTags")
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutApiDetectionsTagsResponseObject); ok {
+		if err := validResponse.VisitPutApiDetectionsTagsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
 		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
 	}
-
-	siw.Handler.PostApiSourcesRedditSubredditsSubredditRemoveProfiles(c, subreddit)
 }
 
-// GetApiSourcesRedditSubredditsWithProfile operation middleware
-func (siw *ServerInterfaceWrapper) GetApiSourcesRedditSubredditsWithProfile(c *gin.Context) {
-
-	var err error
+// GetApiProfiles operation middleware
+func (sh *strictHandler) GetApiProfiles(ctx *gin.Context) {
+	var request GetApiProfilesRequestObject
 
-	c.Set(BasicAuthScopes, []string{})
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiProfiles(ctx, request.(GetApiProfilesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiProfiles")
+	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetApiSourcesRedditSubredditsWithProfileParams
+	response, err := handler(ctx, request)
 
-	// ------------- Required query parameter "profile_id" -------------
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiProfilesResponseObject); ok {
+		if err := validResponse.VisitGetApiProfilesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	if paramValue := c.Query("profile_id"); paramValue != "" {
+// PostApiProfiles operation middleware
+func (sh *strictHandler) PostApiProfiles(ctx *gin.Context) {
+	var request PostApiProfilesRequestObject
 
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument profile_id is required, but not found"), http.StatusBadRequest)
+	var body PostApiProfilesJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
 		return
 	}
+	request.Body = &body
 
-	err = runtime.BindQueryParameter("form", true, true, "profile_id", c.Request.URL.Query(), &params.ProfileId)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profile_id: %w", err), http.StatusBadRequest)
-		return
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiProfiles(ctx, request.(PostApiProfilesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiProfiles")
 	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiProfilesResponseObject); ok {
+		if err := validResponse.VisitPostApiProfilesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
 		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
 	}
-
-	siw.Handler.GetApiSourcesRedditSubredditsWithProfile(c, params)
 }
 
-// GetApiStatisticsProfileId operation middleware
-func (siw *ServerInterfaceWrapper) GetApiStatisticsProfileId(c *gin.Context) {
-
-	var err error
+// DeleteApiProfilesProfileId operation middleware
+func (sh *strictHandler) DeleteApiProfilesProfileId(ctx *gin.Context, profileId int) {
+	var request DeleteApiProfilesProfileIdRequestObject
 
-	// ------------- Path parameter "profileId" -------------
-	var profileId int
+	request.ProfileId = profileId
 
-	err = runtime.BindStyledParameterWithOptions("simple", "profileId", c.Param("profileId"), &profileId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profileId: %w", err), http.StatusBadRequest)
-		return
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApiProfilesProfileId(ctx, request.(DeleteApiProfilesProfileIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApiProfilesProfileId")
 	}
 
-	c.Set(BasicAuthScopes, []string{})
+	response, err := handler(ctx, request)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteApiProfilesProfileIdResponseObject); ok {
+		if err := validResponse.VisitDeleteApiProfilesProfileIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
 		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
 	}
-
-	siw.Handler.GetApiStatisticsProfileId(c, profileId)
 }
 
-// GinServerOptions provides options for the Gin server.
-type GinServerOptions struct {
-	BaseURL      string
-	Middlewares  []MiddlewareFunc
-	ErrorHandler func(*gin.Context, error, int)
-}
+// GetApiProfilesProfileId operation middleware
+func (sh *strictHandler) GetApiProfilesProfileId(ctx *gin.Context, profileId int) {
+	var request GetApiProfilesProfileIdRequestObject
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router gin.IRouter, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, GinServerOptions{})
-}
+	request.ProfileId = profileId
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
-	errorHandler := options.ErrorHandler
-	if errorHandler == nil {
-		errorHandler = func(c *gin.Context, err error, statusCode int) {
-			c.JSON(statusCode, gin.H{"msg": err.Error()})
-		}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiProfilesProfileId(ctx, request.(GetApiProfilesProfileIdRequestObject))
 	}
-
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandler:       errorHandler,
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiProfilesProfileId")
 	}
 
-	router.POST(options.BaseURL+"/api/analyze", wrapper.PostApiAnalyze)
-	router.POST(options.BaseURL+"/api/detections/list", wrapper.PostApiDetectionsList)
-	router.PUT(options.BaseURL+"/api/detections/tags", wrapper.PutApiDetectionsTags)
-	router.GET(options.BaseURL+"/api/profiles", wrapper.GetApiProfiles)
-	router.POST(options.BaseURL+"/api/profiles", wrapper.PostApiProfiles)
-	router.DELETE(options.BaseURL+"/api/profiles/:profileId", wrapper.DeleteApiProfilesProfileId)
-	router.GET(options.BaseURL+"/api/profiles/:profileId", wrapper.GetApiProfilesProfileId)
-	router.PUT(options.BaseURL+"/api/profiles/:profileId", wrapper.PutApiProfilesProfileId)
-	router.POST(options.BaseURL+"/api/profiles/:profileId/dry_jumpstart", wrapper.PostApiProfilesProfileIdDryJumpstart)
-	router.POST(options.BaseURL+"/api/profiles/:profileId/jumpstart", wrapper.PostApiProfilesProfileIdJumpstart)
-	router.GET(options.BaseURL+"/api/sources/reddit/subreddits", wrapper.GetApiSourcesRedditSubreddits)
-	router.POST(options.BaseURL+"/api/sources/reddit/subreddits/:subreddit/add_profiles", wrapper.PostApiSourcesRedditSubredditsSubredditAddProfiles)
-	router.POST(options.BaseURL+"/api/sources/reddit/subreddits/:subreddit/remove_profiles", wrapper.PostApiSourcesRedditSubredditsSubredditRemoveProfiles)
-	router.GET(options.BaseURL+"/api/sources/reddit/subreddits_with_profile", wrapper.GetApiSourcesRedditSubredditsWithProfile)
-	router.GET(options.BaseURL+"/api/statistics/:profileId", wrapper.GetApiStatisticsProfileId)
-}
-
-type PostApiAnalyzeRequestObject struct {
-	Body *PostApiAnalyzeJSONRequestBody
-}
+	response, err := handler(ctx, request)
 
-type PostApiAnalyzeResponseObject interface {
-	VisitPostApiAnalyzeResponse(w http.ResponseWriter) error
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiProfilesProfileIdResponseObject); ok {
+		if err := validResponse.VisitGetApiProfilesProfileIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostApiAnalyze200JSONResponse Detection
-
-func (response PostApiAnalyze200JSONResponse) VisitPostApiAnalyzeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// PutApiProfilesProfileId operation middleware
+func (sh *strictHandler) PutApiProfilesProfileId(ctx *gin.Context, profileId int) {
+	var request PutApiProfilesProfileIdRequestObject
 
-type PostApiAnalyze500JSONResponse Error
+	request.ProfileId = profileId
 
-func (response PostApiAnalyze500JSONResponse) VisitPostApiAnalyzeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var body PutApiProfilesProfileIdJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutApiProfilesProfileId(ctx, request.(PutApiProfilesProfileIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutApiProfilesProfileId")
+	}
 
-type PostApiDetectionsListRequestObject struct {
-	Body *PostApiDetectionsListJSONRequestBody
-}
+	response, err := handler(ctx, request)
 
-type PostApiDetectionsListResponseObject interface {
-	VisitPostApiDetectionsListResponse(w http.ResponseWriter) error
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutApiProfilesProfileIdResponseObject); ok {
+		if err := validResponse.VisitPutApiProfilesProfileIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostApiDetectionsList200JSONResponse []ListedDetection
-
-func (response PostApiDetectionsList200JSONResponse) VisitPostApiDetectionsListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// PostApiProfilesProfileIdAnalyze operation middleware
+func (sh *strictHandler) PostApiProfilesProfileIdAnalyze(ctx *gin.Context, profileId int) {
+	var request PostApiProfilesProfileIdAnalyzeRequestObject
 
-type PostApiDetectionsList401Response struct {
-}
+	request.ProfileId = profileId
 
-func (response PostApiDetectionsList401Response) VisitPostApiDetectionsListResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	var body PostApiProfilesProfileIdAnalyzeJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-type PostApiDetectionsList500JSONResponse Error
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiProfilesProfileIdAnalyze(ctx, request.(PostApiProfilesProfileIdAnalyzeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiProfilesProfileIdAnalyze")
+	}
 
-func (response PostApiDetectionsList500JSONResponse) VisitPostApiDetectionsListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiProfilesProfileIdAnalyzeResponseObject); ok {
+		if err := validResponse.VisitPostApiProfilesProfileIdAnalyzeResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PutApiDetectionsTagsRequestObject struct {
-	Body *PutApiDetectionsTagsJSONRequestBody
-}
+// PostApiProfilesProfileIdDryJumpstart operation middleware
+func (sh *strictHandler) PostApiProfilesProfileIdDryJumpstart(ctx *gin.Context, profileId int) {
+	var request PostApiProfilesProfileIdDryJumpstartRequestObject
 
-type PutApiDetectionsTagsResponseObject interface {
-	VisitPutApiDetectionsTagsResponse(w http.ResponseWriter) error
-}
+	request.ProfileId = profileId
 
-type PutApiDetectionsTags200JSONResponse DetectionTags
+	var body PostApiProfilesProfileIdDryJumpstartJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-func (response PutApiDetectionsTags200JSONResponse) VisitPutApiDetectionsTagsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiProfilesProfileIdDryJumpstart(ctx, request.(PostApiProfilesProfileIdDryJumpstartRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiProfilesProfileIdDryJumpstart")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(ctx, request)
 
-type PutApiDetectionsTags401Response struct {
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiProfilesProfileIdDryJumpstartResponseObject); ok {
+		if err := validResponse.VisitPostApiProfilesProfileIdDryJumpstartResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response PutApiDetectionsTags401Response) VisitPutApiDetectionsTagsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+// PostApiProfilesProfileIdJumpstart operation middleware
+func (sh *strictHandler) PostApiProfilesProfileIdJumpstart(ctx *gin.Context, profileId int) {
+	var request PostApiProfilesProfileIdJumpstartRequestObject
 
-type PutApiDetectionsTags500JSONResponse Error
+	request.ProfileId = profileId
 
-func (response PutApiDetectionsTags500JSONResponse) VisitPutApiDetectionsTagsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var body PostApiProfilesProfileIdJumpstartJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiProfilesProfileIdJumpstart(ctx, request.(PostApiProfilesProfileIdJumpstartRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiProfilesProfileIdJumpstart")
+	}
 
-type GetApiProfilesRequestObject struct {
-}
+	response, err := handler(ctx, request)
 
-type GetApiProfilesResponseObject interface {
-	VisitGetApiProfilesResponse(w http.ResponseWriter) error
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiProfilesProfileIdJumpstartResponseObject); ok {
+		if err := validResponse.VisitPostApiProfilesProfileIdJumpstartResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetApiProfiles200JSONResponse []Profile
+// GetApiProfilesProfileIdSettingsVersions operation middleware
+func (sh *strictHandler) GetApiProfilesProfileIdSettingsVersions(ctx *gin.Context, profileId int, params GetApiProfilesProfileIdSettingsVersionsParams) {
+	var request GetApiProfilesProfileIdSettingsVersionsRequestObject
 
-func (response GetApiProfiles200JSONResponse) VisitGetApiProfilesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.ProfileId = profileId
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiProfilesProfileIdSettingsVersions(ctx, request.(GetApiProfilesProfileIdSettingsVersionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiProfilesProfileIdSettingsVersions")
+	}
 
-type GetApiProfiles401Response struct {
-}
+	response, err := handler(ctx, request)
 
-func (response GetApiProfiles401Response) VisitGetApiProfilesResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiProfilesProfileIdSettingsVersionsResponseObject); ok {
+		if err := validResponse.VisitGetApiProfilesProfileIdSettingsVersionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetApiProfiles500JSONResponse Error
+// GetApiProfilesProfileIdSettingsVersionsDiff operation middleware
+func (sh *strictHandler) GetApiProfilesProfileIdSettingsVersionsDiff(ctx *gin.Context, profileId int, params GetApiProfilesProfileIdSettingsVersionsDiffParams) {
+	var request GetApiProfilesProfileIdSettingsVersionsDiffRequestObject
 
-func (response GetApiProfiles500JSONResponse) VisitGetApiProfilesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.ProfileId = profileId
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiProfilesProfileIdSettingsVersionsDiff(ctx, request.(GetApiProfilesProfileIdSettingsVersionsDiffRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiProfilesProfileIdSettingsVersionsDiff")
+	}
 
-type PostApiProfilesRequestObject struct {
-	Body *PostApiProfilesJSONRequestBody
-}
+	response, err := handler(ctx, request)
 
-type PostApiProfilesResponseObject interface {
-	VisitPostApiProfilesResponse(w http.ResponseWriter) error
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiProfilesProfileIdSettingsVersionsDiffResponseObject); ok {
+		if err := validResponse.VisitGetApiProfilesProfileIdSettingsVersionsDiffResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostApiProfiles201JSONResponse struct {
-	Id int `json:"id"`
-}
+// PostApiProfilesProfileIdSettingsVersionsRollback operation middleware
+func (sh *strictHandler) PostApiProfilesProfileIdSettingsVersionsRollback(ctx *gin.Context, profileId int) {
+	var request PostApiProfilesProfileIdSettingsVersionsRollbackRequestObject
 
-func (response PostApiProfiles201JSONResponse) VisitPostApiProfilesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	request.ProfileId = profileId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PostApiProfilesProfileIdSettingsVersionsRollbackJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-type PostApiProfiles500JSONResponse Error
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiProfilesProfileIdSettingsVersionsRollback(ctx, request.(PostApiProfilesProfileIdSettingsVersionsRollbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiProfilesProfileIdSettingsVersionsRollback")
+	}
 
-func (response PostApiProfiles500JSONResponse) VisitPostApiProfilesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiProfilesProfileIdSettingsVersionsRollbackResponseObject); ok {
+		if err := validResponse.VisitPostApiProfilesProfileIdSettingsVersionsRollbackResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteApiProfilesProfileIdRequestObject struct {
-	ProfileId int `json:"profileId"`
-}
+// GetApiProfilesProfileIdShadows operation middleware
+func (sh *strictHandler) GetApiProfilesProfileIdShadows(ctx *gin.Context, profileId int, params GetApiProfilesProfileIdShadowsParams) {
+	var request GetApiProfilesProfileIdShadowsRequestObject
 
-type DeleteApiProfilesProfileIdResponseObject interface {
-	VisitDeleteApiProfilesProfileIdResponse(w http.ResponseWriter) error
-}
+	request.ProfileId = profileId
+	request.Params = params
 
-type DeleteApiProfilesProfileId204Response struct {
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiProfilesProfileIdShadows(ctx, request.(GetApiProfilesProfileIdShadowsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiProfilesProfileIdShadows")
+	}
 
-func (response DeleteApiProfilesProfileId204Response) VisitDeleteApiProfilesProfileIdResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	response, err := handler(ctx, request)
 
-type DeleteApiProfilesProfileId404Response struct {
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiProfilesProfileIdShadowsResponseObject); ok {
+		if err := validResponse.VisitGetApiProfilesProfileIdShadowsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response DeleteApiProfilesProfileId404Response) VisitDeleteApiProfilesProfileIdResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+// PostApiProfilesProfileIdShadows operation middleware
+func (sh *strictHandler) PostApiProfilesProfileIdShadows(ctx *gin.Context, profileId int) {
+	var request PostApiProfilesProfileIdShadowsRequestObject
 
-type DeleteApiProfilesProfileId500JSONResponse Error
+	request.ProfileId = profileId
 
-func (response DeleteApiProfilesProfileId500JSONResponse) VisitDeleteApiProfilesProfileIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var body PostApiProfilesProfileIdShadowsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiProfilesProfileIdShadows(ctx, request.(PostApiProfilesProfileIdShadowsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiProfilesProfileIdShadows")
+	}
 
-type GetApiProfilesProfileIdRequestObject struct {
-	ProfileId int `json:"profileId"`
-}
+	response, err := handler(ctx, request)
 
-type GetApiProfilesProfileIdResponseObject interface {
-	VisitGetApiProfilesProfileIdResponse(w http.ResponseWriter) error
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiProfilesProfileIdShadowsResponseObject); ok {
+		if err := validResponse.VisitPostApiProfilesProfileIdShadowsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetApiProfilesProfileId200JSONResponse Profile
+// GetApiProfilesProfileIdShadowsShadowId operation middleware
+func (sh *strictHandler) GetApiProfilesProfileIdShadowsShadowId(ctx *gin.Context, profileId int, shadowId int) {
+	var request GetApiProfilesProfileIdShadowsShadowIdRequestObject
 
-func (response GetApiProfilesProfileId200JSONResponse) VisitGetApiProfilesProfileIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.ProfileId = profileId
+	request.ShadowId = shadowId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiProfilesProfileIdShadowsShadowId(ctx, request.(GetApiProfilesProfileIdShadowsShadowIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiProfilesProfileIdShadowsShadowId")
+	}
 
-type GetApiProfilesProfileId404Response struct {
-}
+	response, err := handler(ctx, request)
 
-func (response GetApiProfilesProfileId404Response) VisitGetApiProfilesProfileIdResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiProfilesProfileIdShadowsShadowIdResponseObject); ok {
+		if err := validResponse.VisitGetApiProfilesProfileIdShadowsShadowIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetApiProfilesProfileId500JSONResponse Error
-
-func (response GetApiProfilesProfileId500JSONResponse) VisitGetApiProfilesProfileIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// GetApiProfilesProfileIdShadowsShadowIdDisagreements operation middleware
+func (sh *strictHandler) GetApiProfilesProfileIdShadowsShadowIdDisagreements(ctx *gin.Context, profileId int, shadowId int, params GetApiProfilesProfileIdShadowsShadowIdDisagreementsParams) {
+	var request GetApiProfilesProfileIdShadowsShadowIdDisagreementsRequestObject
 
-type PutApiProfilesProfileIdRequestObject struct {
-	ProfileId int `json:"profileId"`
-	Body      *PutApiProfilesProfileIdJSONRequestBody
-}
+	request.ProfileId = profileId
+	request.ShadowId = shadowId
+	request.Params = params
 
-type PutApiProfilesProfileIdResponseObject interface {
-	VisitPutApiProfilesProfileIdResponse(w http.ResponseWriter) error
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiProfilesProfileIdShadowsShadowIdDisagreements(ctx, request.(GetApiProfilesProfileIdShadowsShadowIdDisagreementsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiProfilesProfileIdShadowsShadowIdDisagreements")
+	}
 
-type PutApiProfilesProfileId200Response struct {
-}
+	response, err := handler(ctx, request)
 
-func (response PutApiProfilesProfileId200Response) VisitPutApiProfilesProfileIdResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiProfilesProfileIdShadowsShadowIdDisagreementsResponseObject); ok {
+		if err := validResponse.VisitGetApiProfilesProfileIdShadowsShadowIdDisagreementsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PutApiProfilesProfileId404Response struct {
-}
+// PostApiProfilesProfileIdShadowsShadowIdStop operation middleware
+func (sh *strictHandler) PostApiProfilesProfileIdShadowsShadowIdStop(ctx *gin.Context, profileId int, shadowId int) {
+	var request PostApiProfilesProfileIdShadowsShadowIdStopRequestObject
 
-func (response PutApiProfilesProfileId404Response) VisitPutApiProfilesProfileIdResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	request.ProfileId = profileId
+	request.ShadowId = shadowId
 
-type PutApiProfilesProfileId500JSONResponse Error
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiProfilesProfileIdShadowsShadowIdStop(ctx, request.(PostApiProfilesProfileIdShadowsShadowIdStopRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiProfilesProfileIdShadowsShadowIdStop")
+	}
 
-func (response PutApiProfilesProfileId500JSONResponse) VisitPutApiProfilesProfileIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiProfilesProfileIdShadowsShadowIdStopResponseObject); ok {
+		if err := validResponse.VisitPostApiProfilesProfileIdShadowsShadowIdStopResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostApiProfilesProfileIdDryJumpstartRequestObject struct {
-	ProfileId int `json:"profileId"`
-	Body      *PostApiProfilesProfileIdDryJumpstartJSONRequestBody
-}
+// PutApiProfilesProfileIdTasksPriority operation middleware
+func (sh *strictHandler) PutApiProfilesProfileIdTasksPriority(ctx *gin.Context, profileId int) {
+	var request PutApiProfilesProfileIdTasksPriorityRequestObject
 
-type PostApiProfilesProfileIdDryJumpstartResponseObject interface {
-	VisitPostApiProfilesProfileIdDryJumpstartResponse(w http.ResponseWriter) error
-}
+	request.ProfileId = profileId
 
-type PostApiProfilesProfileIdDryJumpstart200JSONResponse []AnalysisTaskParameters
+	var body PutApiProfilesProfileIdTasksPriorityJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-func (response PostApiProfilesProfileIdDryJumpstart200JSONResponse) VisitPostApiProfilesProfileIdDryJumpstartResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutApiProfilesProfileIdTasksPriority(ctx, request.(PutApiProfilesProfileIdTasksPriorityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutApiProfilesProfileIdTasksPriority")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(ctx, request)
 
-type PostApiProfilesProfileIdDryJumpstart404Response struct {
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutApiProfilesProfileIdTasksPriorityResponseObject); ok {
+		if err := validResponse.VisitPutApiProfilesProfileIdTasksPriorityResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response PostApiProfilesProfileIdDryJumpstart404Response) VisitPostApiProfilesProfileIdDryJumpstartResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+// GetApiProfilesProfileIdTestCases operation middleware
+func (sh *strictHandler) GetApiProfilesProfileIdTestCases(ctx *gin.Context, profileId int) {
+	var request GetApiProfilesProfileIdTestCasesRequestObject
 
-type PostApiProfilesProfileIdDryJumpstart500JSONResponse Error
+	request.ProfileId = profileId
 
-func (response PostApiProfilesProfileIdDryJumpstart500JSONResponse) VisitPostApiProfilesProfileIdDryJumpstartResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiProfilesProfileIdTestCases(ctx, request.(GetApiProfilesProfileIdTestCasesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiProfilesProfileIdTestCases")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(ctx, request)
 
-type PostApiProfilesProfileIdJumpstartRequestObject struct {
-	ProfileId int `json:"profileId"`
-	Body      *PostApiProfilesProfileIdJumpstartJSONRequestBody
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiProfilesProfileIdTestCasesResponseObject); ok {
+		if err := validResponse.VisitGetApiProfilesProfileIdTestCasesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostApiProfilesProfileIdJumpstartResponseObject interface {
-	VisitPostApiProfilesProfileIdJumpstartResponse(w http.ResponseWriter) error
-}
+// PutApiProfilesProfileIdTestCases operation middleware
+func (sh *strictHandler) PutApiProfilesProfileIdTestCases(ctx *gin.Context, profileId int) {
+	var request PutApiProfilesProfileIdTestCasesRequestObject
 
-type PostApiProfilesProfileIdJumpstart204Response struct {
-}
+	request.ProfileId = profileId
 
-func (response PostApiProfilesProfileIdJumpstart204Response) VisitPostApiProfilesProfileIdJumpstartResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	var body PutApiProfilesProfileIdTestCasesJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-type PostApiProfilesProfileIdJumpstart404Response struct {
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutApiProfilesProfileIdTestCases(ctx, request.(PutApiProfilesProfileIdTestCasesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutApiProfilesProfileIdTestCases")
+	}
 
-func (response PostApiProfilesProfileIdJumpstart404Response) VisitPostApiProfilesProfileIdJumpstartResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutApiProfilesProfileIdTestCasesResponseObject); ok {
+		if err := validResponse.VisitPutApiProfilesProfileIdTestCasesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostApiProfilesProfileIdJumpstart500JSONResponse Error
+// DeleteApiProfilesProfileIdTestCasesTestCaseId operation middleware
+func (sh *strictHandler) DeleteApiProfilesProfileIdTestCasesTestCaseId(ctx *gin.Context, profileId int, testCaseId int) {
+	var request DeleteApiProfilesProfileIdTestCasesTestCaseIdRequestObject
 
-func (response PostApiProfilesProfileIdJumpstart500JSONResponse) VisitPostApiProfilesProfileIdJumpstartResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.ProfileId = profileId
+	request.TestCaseId = testCaseId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApiProfilesProfileIdTestCasesTestCaseId(ctx, request.(DeleteApiProfilesProfileIdTestCasesTestCaseIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApiProfilesProfileIdTestCasesTestCaseId")
+	}
 
-type GetApiSourcesRedditSubredditsRequestObject struct {
-}
+	response, err := handler(ctx, request)
 
-type GetApiSourcesRedditSubredditsResponseObject interface {
-	VisitGetApiSourcesRedditSubredditsResponse(w http.ResponseWriter) error
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteApiProfilesProfileIdTestCasesTestCaseIdResponseObject); ok {
+		if err := validResponse.VisitDeleteApiProfilesProfileIdTestCasesTestCaseIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetApiSourcesRedditSubreddits200JSONResponse []SubredditSettings
+// GetApiProfilesProfileIdTestRuns operation middleware
+func (sh *strictHandler) GetApiProfilesProfileIdTestRuns(ctx *gin.Context, profileId int, params GetApiProfilesProfileIdTestRunsParams) {
+	var request GetApiProfilesProfileIdTestRunsRequestObject
 
-func (response GetApiSourcesRedditSubreddits200JSONResponse) VisitGetApiSourcesRedditSubredditsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.ProfileId = profileId
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiProfilesProfileIdTestRuns(ctx, request.(GetApiProfilesProfileIdTestRunsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiProfilesProfileIdTestRuns")
+	}
 
-type GetApiSourcesRedditSubreddits401Response struct {
-}
+	response, err := handler(ctx, request)
 
-func (response GetApiSourcesRedditSubreddits401Response) VisitGetApiSourcesRedditSubredditsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiProfilesProfileIdTestRunsResponseObject); ok {
+		if err := validResponse.VisitGetApiProfilesProfileIdTestRunsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetApiSourcesRedditSubreddits500JSONResponse Error
+// PostApiProfilesProfileIdTestRuns operation middleware
+func (sh *strictHandler) PostApiProfilesProfileIdTestRuns(ctx *gin.Context, profileId int) {
+	var request PostApiProfilesProfileIdTestRunsRequestObject
 
-func (response GetApiSourcesRedditSubreddits500JSONResponse) VisitGetApiSourcesRedditSubredditsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.ProfileId = profileId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PostApiProfilesProfileIdTestRunsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-type PostApiSourcesRedditSubredditsSubredditAddProfilesRequestObject struct {
-	Subreddit string `json:"subreddit"`
-	Body      *PostApiSourcesRedditSubredditsSubredditAddProfilesJSONRequestBody
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiProfilesProfileIdTestRuns(ctx, request.(PostApiProfilesProfileIdTestRunsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiProfilesProfileIdTestRuns")
+	}
 
-type PostApiSourcesRedditSubredditsSubredditAddProfilesResponseObject interface {
-	VisitPostApiSourcesRedditSubredditsSubredditAddProfilesResponse(w http.ResponseWriter) error
-}
+	response, err := handler(ctx, request)
 
-type PostApiSourcesRedditSubredditsSubredditAddProfiles204Response struct {
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiProfilesProfileIdTestRunsResponseObject); ok {
+		if err := validResponse.VisitPostApiProfilesProfileIdTestRunsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response PostApiSourcesRedditSubredditsSubredditAddProfiles204Response) VisitPostApiSourcesRedditSubredditsSubredditAddProfilesResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+// GetApiProfilesProfileIdTestRunsTestRunId operation middleware
+func (sh *strictHandler) GetApiProfilesProfileIdTestRunsTestRunId(ctx *gin.Context, profileId int, testRunId int) {
+	var request GetApiProfilesProfileIdTestRunsTestRunIdRequestObject
 
-type PostApiSourcesRedditSubredditsSubredditAddProfiles401Response struct {
-}
+	request.ProfileId = profileId
+	request.TestRunId = testRunId
 
-func (response PostApiSourcesRedditSubredditsSubredditAddProfiles401Response) VisitPostApiSourcesRedditSubredditsSubredditAddProfilesResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiProfilesProfileIdTestRunsTestRunId(ctx, request.(GetApiProfilesProfileIdTestRunsTestRunIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiProfilesProfileIdTestRunsTestRunId")
+	}
 
-type PostApiSourcesRedditSubredditsSubredditAddProfiles404Response struct {
-}
+	response, err := handler(ctx, request)
 
-func (response PostApiSourcesRedditSubredditsSubredditAddProfiles404Response) VisitPostApiSourcesRedditSubredditsSubredditAddProfilesResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiProfilesProfileIdTestRunsTestRunIdResponseObject); ok {
+		if err := validResponse.VisitGetApiProfilesProfileIdTestRunsTestRunIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostApiSourcesRedditSubredditsSubredditAddProfiles500JSONResponse Error
+// GetApiProfilesProfileIdTestRunsTestRunIdDiff operation middleware
+func (sh *strictHandler) GetApiProfilesProfileIdTestRunsTestRunIdDiff(ctx *gin.Context, profileId int, testRunId int, params GetApiProfilesProfileIdTestRunsTestRunIdDiffParams) {
+	var request GetApiProfilesProfileIdTestRunsTestRunIdDiffRequestObject
+
+	request.ProfileId = profileId
+	request.TestRunId = testRunId
+	request.Params = params
 
-func (response PostApiSourcesRedditSubredditsSubredditAddProfiles500JSONResponse) VisitPostApiSourcesRedditSubredditsSubredditAddProfilesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiProfilesProfileIdTestRunsTestRunIdDiff(ctx, request.(GetApiProfilesProfileIdTestRunsTestRunIdDiffRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiProfilesProfileIdTestRunsTestRunIdDiff")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(ctx, request)
 
-type PostApiSourcesRedditSubredditsSubredditRemoveProfilesRequestObject struct {
-	Subreddit string `json:"subreddit"`
-	Body      *PostApiSourcesRedditSubredditsSubredditRemoveProfilesJSONRequestBody
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiProfilesProfileIdTestRunsTestRunIdDiffResponseObject); ok {
+		if err := validResponse.VisitGetApiProfilesProfileIdTestRunsTestRunIdDiffResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostApiSourcesRedditSubredditsSubredditRemoveProfilesResponseObject interface {
-	VisitPostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse(w http.ResponseWriter) error
-}
+// GetApiProfilesProfileIdWebhooks operation middleware
+func (sh *strictHandler) GetApiProfilesProfileIdWebhooks(ctx *gin.Context, profileId int) {
+	var request GetApiProfilesProfileIdWebhooksRequestObject
 
-type PostApiSourcesRedditSubredditsSubredditRemoveProfiles204Response struct {
-}
+	request.ProfileId = profileId
 
-func (response PostApiSourcesRedditSubredditsSubredditRemoveProfiles204Response) VisitPostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiProfilesProfileIdWebhooks(ctx, request.(GetApiProfilesProfileIdWebhooksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiProfilesProfileIdWebhooks")
+	}
 
-type PostApiSourcesRedditSubredditsSubredditRemoveProfiles401Response struct {
-}
+	response, err := handler(ctx, request)
 
-func (response PostApiSourcesRedditSubredditsSubredditRemoveProfiles401Response) VisitPostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiProfilesProfileIdWebhooksResponseObject); ok {
+		if err := validResponse.VisitGetApiProfilesProfileIdWebhooksResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostApiSourcesRedditSubredditsSubredditRemoveProfiles404Response struct {
-}
+// PostApiProfilesProfileIdWebhooks operation middleware
+func (sh *strictHandler) PostApiProfilesProfileIdWebhooks(ctx *gin.Context, profileId int) {
+	var request PostApiProfilesProfileIdWebhooksRequestObject
 
-func (response PostApiSourcesRedditSubredditsSubredditRemoveProfiles404Response) VisitPostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	request.ProfileId = profileId
 
-type PostApiSourcesRedditSubredditsSubredditRemoveProfiles500JSONResponse Error
+	var body PostApiProfilesProfileIdWebhooksJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-func (response PostApiSourcesRedditSubredditsSubredditRemoveProfiles500JSONResponse) VisitPostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiProfilesProfileIdWebhooks(ctx, request.(PostApiProfilesProfileIdWebhooksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiProfilesProfileIdWebhooks")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(ctx, request)
 
-type GetApiSourcesRedditSubredditsWithProfileRequestObject struct {
-	Params GetApiSourcesRedditSubredditsWithProfileParams
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiProfilesProfileIdWebhooksResponseObject); ok {
+		if err := validResponse.VisitPostApiProfilesProfileIdWebhooksResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetApiSourcesRedditSubredditsWithProfileResponseObject interface {
-	VisitGetApiSourcesRedditSubredditsWithProfileResponse(w http.ResponseWriter) error
-}
+// GetApiSourcesHackernewsFeeds operation middleware
+func (sh *strictHandler) GetApiSourcesHackernewsFeeds(ctx *gin.Context) {
+	var request GetApiSourcesHackernewsFeedsRequestObject
 
-type GetApiSourcesRedditSubredditsWithProfile200JSONResponse []SubredditSettings
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiSourcesHackernewsFeeds(ctx, request.(GetApiSourcesHackernewsFeedsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiSourcesHackernewsFeeds")
+	}
 
-func (response GetApiSourcesRedditSubredditsWithProfile200JSONResponse) VisitGetApiSourcesRedditSubredditsWithProfileResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiSourcesHackernewsFeedsResponseObject); ok {
+		if err := validResponse.VisitGetApiSourcesHackernewsFeedsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetApiSourcesRedditSubredditsWithProfile401Response struct {
-}
+// PostApiSourcesHackernewsFeedsFeedAddProfiles operation middleware
+func (sh *strictHandler) PostApiSourcesHackernewsFeedsFeedAddProfiles(ctx *gin.Context, feed PostApiSourcesHackernewsFeedsFeedAddProfilesParamsFeed) {
+	var request PostApiSourcesHackernewsFeedsFeedAddProfilesRequestObject
 
-func (response GetApiSourcesRedditSubredditsWithProfile401Response) VisitGetApiSourcesRedditSubredditsWithProfileResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	request.Feed = feed
 
-type GetApiSourcesRedditSubredditsWithProfile500JSONResponse Error
+	var body PostApiSourcesHackernewsFeedsFeedAddProfilesJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-func (response GetApiSourcesRedditSubredditsWithProfile500JSONResponse) VisitGetApiSourcesRedditSubredditsWithProfileResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiSourcesHackernewsFeedsFeedAddProfiles(ctx, request.(PostApiSourcesHackernewsFeedsFeedAddProfilesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiSourcesHackernewsFeedsFeedAddProfiles")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(ctx, request)
 
-type GetApiStatisticsProfileIdRequestObject struct {
-	ProfileId int `json:"profileId"`
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiSourcesHackernewsFeedsFeedAddProfilesResponseObject); ok {
+		if err := validResponse.VisitPostApiSourcesHackernewsFeedsFeedAddProfilesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetApiStatisticsProfileIdResponseObject interface {
-	VisitGetApiStatisticsProfileIdResponse(w http.ResponseWriter) error
-}
+// PostApiSourcesHackernewsFeedsFeedRemoveProfiles operation middleware
+func (sh *strictHandler) PostApiSourcesHackernewsFeedsFeedRemoveProfiles(ctx *gin.Context, feed PostApiSourcesHackernewsFeedsFeedRemoveProfilesParamsFeed) {
+	var request PostApiSourcesHackernewsFeedsFeedRemoveProfilesRequestObject
 
-type GetApiStatisticsProfileId200JSONResponse ProfileStatistics
+	request.Feed = feed
 
-func (response GetApiStatisticsProfileId200JSONResponse) VisitGetApiStatisticsProfileIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var body PostApiSourcesHackernewsFeedsFeedRemoveProfilesJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiSourcesHackernewsFeedsFeedRemoveProfiles(ctx, request.(PostApiSourcesHackernewsFeedsFeedRemoveProfilesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiSourcesHackernewsFeedsFeedRemoveProfiles")
+	}
 
-type GetApiStatisticsProfileId401Response struct {
-}
+	response, err := handler(ctx, request)
 
-func (response GetApiStatisticsProfileId401Response) VisitGetApiStatisticsProfileIdResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiSourcesHackernewsFeedsFeedRemoveProfilesResponseObject); ok {
+		if err := validResponse.VisitPostApiSourcesHackernewsFeedsFeedRemoveProfilesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetApiStatisticsProfileId404Response struct {
-}
+// GetApiSourcesHackernewsFeedsWithProfile operation middleware
+func (sh *strictHandler) GetApiSourcesHackernewsFeedsWithProfile(ctx *gin.Context, params GetApiSourcesHackernewsFeedsWithProfileParams) {
+	var request GetApiSourcesHackernewsFeedsWithProfileRequestObject
 
-func (response GetApiStatisticsProfileId404Response) VisitGetApiStatisticsProfileIdResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	request.Params = params
 
-type GetApiStatisticsProfileId500JSONResponse Error
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiSourcesHackernewsFeedsWithProfile(ctx, request.(GetApiSourcesHackernewsFeedsWithProfileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiSourcesHackernewsFeedsWithProfile")
+	}
 
-func (response GetApiStatisticsProfileId500JSONResponse) VisitGetApiStatisticsProfileIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiSourcesHackernewsFeedsWithProfileResponseObject); ok {
+		if err := validResponse.VisitGetApiSourcesHackernewsFeedsWithProfileResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Analyze a post
-	// (POST /api/analyze)
-	PostApiAnalyze(ctx context.Context, request PostApiAnalyzeRequestObject) (PostApiAnalyzeResponseObject, error)
-	// List detections
-	// (POST /api/detections/list)
-	PostApiDetectionsList(ctx context.Context, request PostApiDetectionsListRequestObject) (PostApiDetectionsListResponseObject, error)
-	// Detection tag update
-	// (PUT /api/detections/tags)
-	PutApiDetectionsTags(ctx context.Context, request PutApiDetectionsTagsRequestObject) (PutApiDetectionsTagsResponseObject, error)
-	// Get all profiles
-	// (GET /api/profiles)
-	GetApiProfiles(ctx context.Context, request GetApiProfilesRequestObject) (GetApiProfilesResponseObject, error)
-	// Create a new profile
-	// (POST /api/profiles)
-	PostApiProfiles(ctx context.Context, request PostApiProfilesRequestObject) (PostApiProfilesResponseObject, error)
-	// Delete a profile by ID
-	// (DELETE /api/profiles/{profileId})
-	DeleteApiProfilesProfileId(ctx context.Context, request DeleteApiProfilesProfileIdRequestObject) (DeleteApiProfilesProfileIdResponseObject, error)
-	// Get a profile by ID
-	// (GET /api/profiles/{profileId})
-	GetApiProfilesProfileId(ctx context.Context, request GetApiProfilesProfileIdRequestObject) (GetApiProfilesProfileIdResponseObject, error)
-	// Update a profile by ID
-	// (PUT /api/profiles/{profileId})
-	PutApiProfilesProfileId(ctx context.Context, request PutApiProfilesProfileIdRequestObject) (PutApiProfilesProfileIdResponseObject, error)
-	// Dry jumpstart a profile - load tasks to be spawned
-	// (POST /api/profiles/{profileId}/dry_jumpstart)
-	PostApiProfilesProfileIdDryJumpstart(ctx context.Context, request PostApiProfilesProfileIdDryJumpstartRequestObject) (PostApiProfilesProfileIdDryJumpstartResponseObject, error)
-	// Jumpstart a profile - run analysis on old posts
-	// (POST /api/profiles/{profileId}/jumpstart)
-	PostApiProfilesProfileIdJumpstart(ctx context.Context, request PostApiProfilesProfileIdJumpstartRequestObject) (PostApiProfilesProfileIdJumpstartResponseObject, error)
-	// Get all subreddits
-	// (GET /api/sources/reddit/subreddits)
-	GetApiSourcesRedditSubreddits(ctx context.Context, request GetApiSourcesRedditSubredditsRequestObject) (GetApiSourcesRedditSubredditsResponseObject, error)
-	// Add profiles
-	// (POST /api/sources/reddit/subreddits/{subreddit}/add_profiles)
-	PostApiSourcesRedditSubredditsSubredditAddProfiles(ctx context.Context, request PostApiSourcesRedditSubredditsSubredditAddProfilesRequestObject) (PostApiSourcesRedditSubredditsSubredditAddProfilesResponseObject, error)
-	// Remove profiles
-	// (POST /api/sources/reddit/subreddits/{subreddit}/remove_profiles)
-	PostApiSourcesRedditSubredditsSubredditRemoveProfiles(ctx context.Context, request PostApiSourcesRedditSubredditsSubredditRemoveProfilesRequestObject) (PostApiSourcesRedditSubredditsSubredditRemoveProfilesResponseObject, error)
-	// Get all subreddits by profile
-	// (GET /api/sources/reddit/subreddits_with_profile)
-	GetApiSourcesRedditSubredditsWithProfile(ctx context.Context, request GetApiSourcesRedditSubredditsWithProfileRequestObject) (GetApiSourcesRedditSubredditsWithProfileResponseObject, error)
-	// Get statistics for a profile
-	// (GET /api/statistics/{profileId})
-	GetApiStatisticsProfileId(ctx context.Context, request GetApiStatisticsProfileIdRequestObject) (GetApiStatisticsProfileIdResponseObject, error)
+// GetApiSourcesRedditScrapeState operation middleware
+func (sh *strictHandler) GetApiSourcesRedditScrapeState(ctx *gin.Context) {
+	var request GetApiSourcesRedditScrapeStateRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiSourcesRedditScrapeState(ctx, request.(GetApiSourcesRedditScrapeStateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiSourcesRedditScrapeState")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiSourcesRedditScrapeStateResponseObject); ok {
+		if err := validResponse.VisitGetApiSourcesRedditScrapeStateResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
-type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+// GetApiSourcesRedditSubreddits operation middleware
+func (sh *strictHandler) GetApiSourcesRedditSubreddits(ctx *gin.Context) {
+	var request GetApiSourcesRedditSubredditsRequestObject
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiSourcesRedditSubreddits(ctx, request.(GetApiSourcesRedditSubredditsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiSourcesRedditSubreddits")
+	}
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiSourcesRedditSubredditsResponseObject); ok {
+		if err := validResponse.VisitGetApiSourcesRedditSubredditsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// PostApiAnalyze operation middleware
-func (sh *strictHandler) PostApiAnalyze(ctx *gin.Context) {
-	var request PostApiAnalyzeRequestObject
+// PostApiSourcesRedditSubredditsSubredditAddProfiles operation middleware
+func (sh *strictHandler) PostApiSourcesRedditSubredditsSubredditAddProfiles(ctx *gin.Context, subreddit string) {
+	var request PostApiSourcesRedditSubredditsSubredditAddProfilesRequestObject
 
-	var body PostApiAnalyzeJSONRequestBody
+	request.Subreddit = subreddit
+
+	var body PostApiSourcesRedditSubredditsSubredditAddProfilesJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -3378,10 +14084,10 @@ func (sh *strictHandler) PostApiAnalyze(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostApiAnalyze(ctx, request.(PostApiAnalyzeRequestObject))
+		return sh.ssi.PostApiSourcesRedditSubredditsSubredditAddProfiles(ctx, request.(PostApiSourcesRedditSubredditsSubredditAddProfilesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostApiAnalyze")
+		handler = middleware(handler, "PostApiSourcesRedditSubredditsSubredditAddProfiles")
 	}
 
 	response, err := handler(ctx, request)
@@ -3389,8 +14095,8 @@ func (sh *strictHandler) PostApiAnalyze(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostApiAnalyzeResponseObject); ok {
-		if err := validResponse.VisitPostApiAnalyzeResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostApiSourcesRedditSubredditsSubredditAddProfilesResponseObject); ok {
+		if err := validResponse.VisitPostApiSourcesRedditSubredditsSubredditAddProfilesResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3398,11 +14104,13 @@ func (sh *strictHandler) PostApiAnalyze(ctx *gin.Context) {
 	}
 }
 
-// PostApiDetectionsList operation middleware
-func (sh *strictHandler) PostApiDetectionsList(ctx *gin.Context) {
-	var request PostApiDetectionsListRequestObject
+// PostApiSourcesRedditSubredditsSubredditRemoveProfiles operation middleware
+func (sh *strictHandler) PostApiSourcesRedditSubredditsSubredditRemoveProfiles(ctx *gin.Context, subreddit string) {
+	var request PostApiSourcesRedditSubredditsSubredditRemoveProfilesRequestObject
 
-	var body PostApiDetectionsListJSONRequestBody
+	request.Subreddit = subreddit
+
+	var body PostApiSourcesRedditSubredditsSubredditRemoveProfilesJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -3411,10 +14119,10 @@ func (sh *strictHandler) PostApiDetectionsList(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostApiDetectionsList(ctx, request.(PostApiDetectionsListRequestObject))
+		return sh.ssi.PostApiSourcesRedditSubredditsSubredditRemoveProfiles(ctx, request.(PostApiSourcesRedditSubredditsSubredditRemoveProfilesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostApiDetectionsList")
+		handler = middleware(handler, "PostApiSourcesRedditSubredditsSubredditRemoveProfiles")
 	}
 
 	response, err := handler(ctx, request)
@@ -3422,8 +14130,8 @@ func (sh *strictHandler) PostApiDetectionsList(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostApiDetectionsListResponseObject); ok {
-		if err := validResponse.VisitPostApiDetectionsListResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostApiSourcesRedditSubredditsSubredditRemoveProfilesResponseObject); ok {
+		if err := validResponse.VisitPostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3431,23 +14139,17 @@ func (sh *strictHandler) PostApiDetectionsList(ctx *gin.Context) {
 	}
 }
 
-// PutApiDetectionsTags operation middleware
-func (sh *strictHandler) PutApiDetectionsTags(ctx *gin.Context) {
-	var request PutApiDetectionsTagsRequestObject
+// GetApiSourcesRedditSubredditsWithProfile operation middleware
+func (sh *strictHandler) GetApiSourcesRedditSubredditsWithProfile(ctx *gin.Context, params GetApiSourcesRedditSubredditsWithProfileParams) {
+	var request GetApiSourcesRedditSubredditsWithProfileRequestObject
 
-	var body PutApiDetectionsTagsJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PutApiDetectionsTags(ctx, request.(PutApiDetectionsTagsRequestObject))
+		return sh.ssi.GetApiSourcesRedditSubredditsWithProfile(ctx, request.(GetApiSourcesRedditSubredditsWithProfileRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutApiDetectionsTags")
+		handler = middleware(handler, "GetApiSourcesRedditSubredditsWithProfile")
 	}
 
 	response, err := handler(ctx, request)
@@ -3455,8 +14157,8 @@ func (sh *strictHandler) PutApiDetectionsTags(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PutApiDetectionsTagsResponseObject); ok {
-		if err := validResponse.VisitPutApiDetectionsTagsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetApiSourcesRedditSubredditsWithProfileResponseObject); ok {
+		if err := validResponse.VisitGetApiSourcesRedditSubredditsWithProfileResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3464,15 +14166,15 @@ func (sh *strictHandler) PutApiDetectionsTags(ctx *gin.Context) {
 	}
 }
 
-// GetApiProfiles operation middleware
-func (sh *strictHandler) GetApiProfiles(ctx *gin.Context) {
-	var request GetApiProfilesRequestObject
+// GetApiSourcesRssFeeds operation middleware
+func (sh *strictHandler) GetApiSourcesRssFeeds(ctx *gin.Context) {
+	var request GetApiSourcesRssFeedsRequestObject
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetApiProfiles(ctx, request.(GetApiProfilesRequestObject))
+		return sh.ssi.GetApiSourcesRssFeeds(ctx, request.(GetApiSourcesRssFeedsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetApiProfiles")
+		handler = middleware(handler, "GetApiSourcesRssFeeds")
 	}
 
 	response, err := handler(ctx, request)
@@ -3480,8 +14182,8 @@ func (sh *strictHandler) GetApiProfiles(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetApiProfilesResponseObject); ok {
-		if err := validResponse.VisitGetApiProfilesResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetApiSourcesRssFeedsResponseObject); ok {
+		if err := validResponse.VisitGetApiSourcesRssFeedsResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3489,11 +14191,11 @@ func (sh *strictHandler) GetApiProfiles(ctx *gin.Context) {
 	}
 }
 
-// PostApiProfiles operation middleware
-func (sh *strictHandler) PostApiProfiles(ctx *gin.Context) {
-	var request PostApiProfilesRequestObject
+// PostApiSourcesRssFeedsAddProfiles operation middleware
+func (sh *strictHandler) PostApiSourcesRssFeedsAddProfiles(ctx *gin.Context) {
+	var request PostApiSourcesRssFeedsAddProfilesRequestObject
 
-	var body PostApiProfilesJSONRequestBody
+	var body PostApiSourcesRssFeedsAddProfilesJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -3502,10 +14204,10 @@ func (sh *strictHandler) PostApiProfiles(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostApiProfiles(ctx, request.(PostApiProfilesRequestObject))
+		return sh.ssi.PostApiSourcesRssFeedsAddProfiles(ctx, request.(PostApiSourcesRssFeedsAddProfilesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostApiProfiles")
+		handler = middleware(handler, "PostApiSourcesRssFeedsAddProfiles")
 	}
 
 	response, err := handler(ctx, request)
@@ -3513,8 +14215,8 @@ func (sh *strictHandler) PostApiProfiles(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostApiProfilesResponseObject); ok {
-		if err := validResponse.VisitPostApiProfilesResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostApiSourcesRssFeedsAddProfilesResponseObject); ok {
+		if err := validResponse.VisitPostApiSourcesRssFeedsAddProfilesResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3522,17 +14224,23 @@ func (sh *strictHandler) PostApiProfiles(ctx *gin.Context) {
 	}
 }
 
-// DeleteApiProfilesProfileId operation middleware
-func (sh *strictHandler) DeleteApiProfilesProfileId(ctx *gin.Context, profileId int) {
-	var request DeleteApiProfilesProfileIdRequestObject
+// PostApiSourcesRssFeedsRemoveProfiles operation middleware
+func (sh *strictHandler) PostApiSourcesRssFeedsRemoveProfiles(ctx *gin.Context) {
+	var request PostApiSourcesRssFeedsRemoveProfilesRequestObject
 
-	request.ProfileId = profileId
+	var body PostApiSourcesRssFeedsRemoveProfilesJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteApiProfilesProfileId(ctx, request.(DeleteApiProfilesProfileIdRequestObject))
+		return sh.ssi.PostApiSourcesRssFeedsRemoveProfiles(ctx, request.(PostApiSourcesRssFeedsRemoveProfilesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteApiProfilesProfileId")
+		handler = middleware(handler, "PostApiSourcesRssFeedsRemoveProfiles")
 	}
 
 	response, err := handler(ctx, request)
@@ -3540,8 +14248,8 @@ func (sh *strictHandler) DeleteApiProfilesProfileId(ctx *gin.Context, profileId
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(DeleteApiProfilesProfileIdResponseObject); ok {
-		if err := validResponse.VisitDeleteApiProfilesProfileIdResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostApiSourcesRssFeedsRemoveProfilesResponseObject); ok {
+		if err := validResponse.VisitPostApiSourcesRssFeedsRemoveProfilesResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3549,17 +14257,17 @@ func (sh *strictHandler) DeleteApiProfilesProfileId(ctx *gin.Context, profileId
 	}
 }
 
-// GetApiProfilesProfileId operation middleware
-func (sh *strictHandler) GetApiProfilesProfileId(ctx *gin.Context, profileId int) {
-	var request GetApiProfilesProfileIdRequestObject
+// GetApiSourcesRssFeedsWithProfile operation middleware
+func (sh *strictHandler) GetApiSourcesRssFeedsWithProfile(ctx *gin.Context, params GetApiSourcesRssFeedsWithProfileParams) {
+	var request GetApiSourcesRssFeedsWithProfileRequestObject
 
-	request.ProfileId = profileId
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetApiProfilesProfileId(ctx, request.(GetApiProfilesProfileIdRequestObject))
+		return sh.ssi.GetApiSourcesRssFeedsWithProfile(ctx, request.(GetApiSourcesRssFeedsWithProfileRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetApiProfilesProfileId")
+		handler = middleware(handler, "GetApiSourcesRssFeedsWithProfile")
 	}
 
 	response, err := handler(ctx, request)
@@ -3567,8 +14275,8 @@ func (sh *strictHandler) GetApiProfilesProfileId(ctx *gin.Context, profileId int
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetApiProfilesProfileIdResponseObject); ok {
-		if err := validResponse.VisitGetApiProfilesProfileIdResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetApiSourcesRssFeedsWithProfileResponseObject); ok {
+		if err := validResponse.VisitGetApiSourcesRssFeedsWithProfileResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3576,25 +14284,17 @@ func (sh *strictHandler) GetApiProfilesProfileId(ctx *gin.Context, profileId int
 	}
 }
 
-// PutApiProfilesProfileId operation middleware
-func (sh *strictHandler) PutApiProfilesProfileId(ctx *gin.Context, profileId int) {
-	var request PutApiProfilesProfileIdRequestObject
+// GetApiStatisticsProfileId operation middleware
+func (sh *strictHandler) GetApiStatisticsProfileId(ctx *gin.Context, profileId int) {
+	var request GetApiStatisticsProfileIdRequestObject
 
 	request.ProfileId = profileId
 
-	var body PutApiProfilesProfileIdJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
-
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PutApiProfilesProfileId(ctx, request.(PutApiProfilesProfileIdRequestObject))
+		return sh.ssi.GetApiStatisticsProfileId(ctx, request.(GetApiStatisticsProfileIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutApiProfilesProfileId")
+		handler = middleware(handler, "GetApiStatisticsProfileId")
 	}
 
 	response, err := handler(ctx, request)
@@ -3602,8 +14302,8 @@ func (sh *strictHandler) PutApiProfilesProfileId(ctx *gin.Context, profileId int
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PutApiProfilesProfileIdResponseObject); ok {
-		if err := validResponse.VisitPutApiProfilesProfileIdResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetApiStatisticsProfileIdResponseObject); ok {
+		if err := validResponse.VisitGetApiStatisticsProfileIdResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3611,25 +14311,45 @@ func (sh *strictHandler) PutApiProfilesProfileId(ctx *gin.Context, profileId int
 	}
 }
 
-// PostApiProfilesProfileIdDryJumpstart operation middleware
-func (sh *strictHandler) PostApiProfilesProfileIdDryJumpstart(ctx *gin.Context, profileId int) {
-	var request PostApiProfilesProfileIdDryJumpstartRequestObject
+// GetApiStatisticsProfileIdAccuracy operation middleware
+func (sh *strictHandler) GetApiStatisticsProfileIdAccuracy(ctx *gin.Context, profileId int, params GetApiStatisticsProfileIdAccuracyParams) {
+	var request GetApiStatisticsProfileIdAccuracyRequestObject
 
 	request.ProfileId = profileId
+	request.Params = params
 
-	var body PostApiProfilesProfileIdDryJumpstartJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiStatisticsProfileIdAccuracy(ctx, request.(GetApiStatisticsProfileIdAccuracyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiStatisticsProfileIdAccuracy")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
 		ctx.Error(err)
-		return
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiStatisticsProfileIdAccuracyResponseObject); ok {
+		if err := validResponse.VisitGetApiStatisticsProfileIdAccuracyResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
 	}
-	request.Body = &body
+}
+
+// GetApiTasks operation middleware
+func (sh *strictHandler) GetApiTasks(ctx *gin.Context, params GetApiTasksParams) {
+	var request GetApiTasksRequestObject
+
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostApiProfilesProfileIdDryJumpstart(ctx, request.(PostApiProfilesProfileIdDryJumpstartRequestObject))
+		return sh.ssi.GetApiTasks(ctx, request.(GetApiTasksRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostApiProfilesProfileIdDryJumpstart")
+		handler = middleware(handler, "GetApiTasks")
 	}
 
 	response, err := handler(ctx, request)
@@ -3637,8 +14357,8 @@ func (sh *strictHandler) PostApiProfilesProfileIdDryJumpstart(ctx *gin.Context,
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostApiProfilesProfileIdDryJumpstartResponseObject); ok {
-		if err := validResponse.VisitPostApiProfilesProfileIdDryJumpstartResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetApiTasksResponseObject); ok {
+		if err := validResponse.VisitGetApiTasksResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3646,13 +14366,11 @@ func (sh *strictHandler) PostApiProfilesProfileIdDryJumpstart(ctx *gin.Context,
 	}
 }
 
-// PostApiProfilesProfileIdJumpstart operation middleware
-func (sh *strictHandler) PostApiProfilesProfileIdJumpstart(ctx *gin.Context, profileId int) {
-	var request PostApiProfilesProfileIdJumpstartRequestObject
-
-	request.ProfileId = profileId
+// PostApiTasksCancel operation middleware
+func (sh *strictHandler) PostApiTasksCancel(ctx *gin.Context) {
+	var request PostApiTasksCancelRequestObject
 
-	var body PostApiProfilesProfileIdJumpstartJSONRequestBody
+	var body PostApiTasksCancelJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -3661,10 +14379,10 @@ func (sh *strictHandler) PostApiProfilesProfileIdJumpstart(ctx *gin.Context, pro
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostApiProfilesProfileIdJumpstart(ctx, request.(PostApiProfilesProfileIdJumpstartRequestObject))
+		return sh.ssi.PostApiTasksCancel(ctx, request.(PostApiTasksCancelRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostApiProfilesProfileIdJumpstart")
+		handler = middleware(handler, "PostApiTasksCancel")
 	}
 
 	response, err := handler(ctx, request)
@@ -3672,8 +14390,8 @@ func (sh *strictHandler) PostApiProfilesProfileIdJumpstart(ctx *gin.Context, pro
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostApiProfilesProfileIdJumpstartResponseObject); ok {
-		if err := validResponse.VisitPostApiProfilesProfileIdJumpstartResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostApiTasksCancelResponseObject); ok {
+		if err := validResponse.VisitPostApiTasksCancelResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3681,15 +14399,23 @@ func (sh *strictHandler) PostApiProfilesProfileIdJumpstart(ctx *gin.Context, pro
 	}
 }
 
-// GetApiSourcesRedditSubreddits operation middleware
-func (sh *strictHandler) GetApiSourcesRedditSubreddits(ctx *gin.Context) {
-	var request GetApiSourcesRedditSubredditsRequestObject
+// PostApiTasksPurge operation middleware
+func (sh *strictHandler) PostApiTasksPurge(ctx *gin.Context) {
+	var request PostApiTasksPurgeRequestObject
+
+	var body PostApiTasksPurgeJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetApiSourcesRedditSubreddits(ctx, request.(GetApiSourcesRedditSubredditsRequestObject))
+		return sh.ssi.PostApiTasksPurge(ctx, request.(PostApiTasksPurgeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetApiSourcesRedditSubreddits")
+		handler = middleware(handler, "PostApiTasksPurge")
 	}
 
 	response, err := handler(ctx, request)
@@ -3697,8 +14423,8 @@ func (sh *strictHandler) GetApiSourcesRedditSubreddits(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetApiSourcesRedditSubredditsResponseObject); ok {
-		if err := validResponse.VisitGetApiSourcesRedditSubredditsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostApiTasksPurgeResponseObject); ok {
+		if err := validResponse.VisitPostApiTasksPurgeResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3706,13 +14432,11 @@ func (sh *strictHandler) GetApiSourcesRedditSubreddits(ctx *gin.Context) {
 	}
 }
 
-// PostApiSourcesRedditSubredditsSubredditAddProfiles operation middleware
-func (sh *strictHandler) PostApiSourcesRedditSubredditsSubredditAddProfiles(ctx *gin.Context, subreddit string) {
-	var request PostApiSourcesRedditSubredditsSubredditAddProfilesRequestObject
-
-	request.Subreddit = subreddit
+// PostApiTasksRetry operation middleware
+func (sh *strictHandler) PostApiTasksRetry(ctx *gin.Context) {
+	var request PostApiTasksRetryRequestObject
 
-	var body PostApiSourcesRedditSubredditsSubredditAddProfilesJSONRequestBody
+	var body PostApiTasksRetryJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -3721,10 +14445,10 @@ func (sh *strictHandler) PostApiSourcesRedditSubredditsSubredditAddProfiles(ctx
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostApiSourcesRedditSubredditsSubredditAddProfiles(ctx, request.(PostApiSourcesRedditSubredditsSubredditAddProfilesRequestObject))
+		return sh.ssi.PostApiTasksRetry(ctx, request.(PostApiTasksRetryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostApiSourcesRedditSubredditsSubredditAddProfiles")
+		handler = middleware(handler, "PostApiTasksRetry")
 	}
 
 	response, err := handler(ctx, request)
@@ -3732,8 +14456,8 @@ func (sh *strictHandler) PostApiSourcesRedditSubredditsSubredditAddProfiles(ctx
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostApiSourcesRedditSubredditsSubredditAddProfilesResponseObject); ok {
-		if err := validResponse.VisitPostApiSourcesRedditSubredditsSubredditAddProfilesResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostApiTasksRetryResponseObject); ok {
+		if err := validResponse.VisitPostApiTasksRetryResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3741,25 +14465,17 @@ func (sh *strictHandler) PostApiSourcesRedditSubredditsSubredditAddProfiles(ctx
 	}
 }
 
-// PostApiSourcesRedditSubredditsSubredditRemoveProfiles operation middleware
-func (sh *strictHandler) PostApiSourcesRedditSubredditsSubredditRemoveProfiles(ctx *gin.Context, subreddit string) {
-	var request PostApiSourcesRedditSubredditsSubredditRemoveProfilesRequestObject
-
-	request.Subreddit = subreddit
+// DeleteApiWebhooksWebhookId operation middleware
+func (sh *strictHandler) DeleteApiWebhooksWebhookId(ctx *gin.Context, webhookId int) {
+	var request DeleteApiWebhooksWebhookIdRequestObject
 
-	var body PostApiSourcesRedditSubredditsSubredditRemoveProfilesJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
+	request.WebhookId = webhookId
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostApiSourcesRedditSubredditsSubredditRemoveProfiles(ctx, request.(PostApiSourcesRedditSubredditsSubredditRemoveProfilesRequestObject))
+		return sh.ssi.DeleteApiWebhooksWebhookId(ctx, request.(DeleteApiWebhooksWebhookIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostApiSourcesRedditSubredditsSubredditRemoveProfiles")
+		handler = middleware(handler, "DeleteApiWebhooksWebhookId")
 	}
 
 	response, err := handler(ctx, request)
@@ -3767,8 +14483,8 @@ func (sh *strictHandler) PostApiSourcesRedditSubredditsSubredditRemoveProfiles(c
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostApiSourcesRedditSubredditsSubredditRemoveProfilesResponseObject); ok {
-		if err := validResponse.VisitPostApiSourcesRedditSubredditsSubredditRemoveProfilesResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(DeleteApiWebhooksWebhookIdResponseObject); ok {
+		if err := validResponse.VisitDeleteApiWebhooksWebhookIdResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3776,17 +14492,25 @@ func (sh *strictHandler) PostApiSourcesRedditSubredditsSubredditRemoveProfiles(c
 	}
 }
 
-// GetApiSourcesRedditSubredditsWithProfile operation middleware
-func (sh *strictHandler) GetApiSourcesRedditSubredditsWithProfile(ctx *gin.Context, params GetApiSourcesRedditSubredditsWithProfileParams) {
-	var request GetApiSourcesRedditSubredditsWithProfileRequestObject
+// PutApiWebhooksWebhookId operation middleware
+func (sh *strictHandler) PutApiWebhooksWebhookId(ctx *gin.Context, webhookId int) {
+	var request PutApiWebhooksWebhookIdRequestObject
 
-	request.Params = params
+	request.WebhookId = webhookId
+
+	var body PutApiWebhooksWebhookIdJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetApiSourcesRedditSubredditsWithProfile(ctx, request.(GetApiSourcesRedditSubredditsWithProfileRequestObject))
+		return sh.ssi.PutApiWebhooksWebhookId(ctx, request.(PutApiWebhooksWebhookIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetApiSourcesRedditSubredditsWithProfile")
+		handler = middleware(handler, "PutApiWebhooksWebhookId")
 	}
 
 	response, err := handler(ctx, request)
@@ -3794,8 +14518,8 @@ func (sh *strictHandler) GetApiSourcesRedditSubredditsWithProfile(ctx *gin.Conte
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetApiSourcesRedditSubredditsWithProfileResponseObject); ok {
-		if err := validResponse.VisitGetApiSourcesRedditSubredditsWithProfileResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PutApiWebhooksWebhookIdResponseObject); ok {
+		if err := validResponse.VisitPutApiWebhooksWebhookIdResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3803,17 +14527,18 @@ func (sh *strictHandler) GetApiSourcesRedditSubredditsWithProfile(ctx *gin.Conte
 	}
 }
 
-// GetApiStatisticsProfileId operation middleware
-func (sh *strictHandler) GetApiStatisticsProfileId(ctx *gin.Context, profileId int) {
-	var request GetApiStatisticsProfileIdRequestObject
+// GetApiWebhooksWebhookIdDeliveries operation middleware
+func (sh *strictHandler) GetApiWebhooksWebhookIdDeliveries(ctx *gin.Context, webhookId int, params GetApiWebhooksWebhookIdDeliveriesParams) {
+	var request GetApiWebhooksWebhookIdDeliveriesRequestObject
 
-	request.ProfileId = profileId
+	request.WebhookId = webhookId
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetApiStatisticsProfileId(ctx, request.(GetApiStatisticsProfileIdRequestObject))
+		return sh.ssi.GetApiWebhooksWebhookIdDeliveries(ctx, request.(GetApiWebhooksWebhookIdDeliveriesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetApiStatisticsProfileId")
+		handler = middleware(handler, "GetApiWebhooksWebhookIdDeliveries")
 	}
 
 	response, err := handler(ctx, request)
@@ -3821,8 +14546,8 @@ func (sh *strictHandler) GetApiStatisticsProfileId(ctx *gin.Context, profileId i
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetApiStatisticsProfileIdResponseObject); ok {
-		if err := validResponse.VisitGetApiStatisticsProfileIdResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetApiWebhooksWebhookIdDeliveriesResponseObject); ok {
+		if err := validResponse.VisitGetApiWebhooksWebhookIdDeliveriesResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3833,38 +14558,164 @@ func (sh *strictHandler) GetApiStatisticsProfileId(ctx *gin.Context, profileId i
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xaW2/bvhX/KgS3p0GNnbXdg9/SZetSbECQtOtDFxi0eGyzpUmVpJJ6gb/7H6Som0lJ",
-	"dpxLk/bNliie24+/c5FucSpXmRQgjMaTW6zTJayI+3kiCF9rpj8S/e2cKLICA8rdyZTMQBkG5b854zBl",
-	"1P4z6wzwBDNhYAEKbxKslzLndKrJNTQWzKTkQIRbIHOVNu9po5hY1LfaW5d3NwlW8D1nCiiefCl3aT6T",
-	"NHVrK3KVlNvJ2VdIjRXmDP4/XMD3HLQJDYUfRpHUAJ22rxNKmWFSEH7euh5YE0hUwOGaiHQ9nTNuQPX4",
-	"4EHcE8hP4kbGvHUKBlJrduioVAGxOxAT1boLKExPvUImDpQhpN1XWDQYw8RCT69BaW9hBNf3EpcCmcPY",
-	"3Vap7a6W7UkzAL2h+2eFurbrdo1EsdjAyv34s4I5nuA/jWpKGXk+GZ0XD3iBtdOJUmRdu6y9YVekqocM",
-	"WQxKroz9SBa6lL/p88q/mTadJFAf1J2E1gZzos1UA4hO+HK2Yk4ihTnJucGT43ESrOtV/SNZfMooMd0k",
-	"RsulnWqUTm0/V1NFsQPQaSqVgtTwtb0vcs7JjAOeGJVDEmAmVHvrILQU81pcDdh6Bz2H9doS0XVGhgRt",
-	"o7iUmOAfrxbylb/6F3s5hHZMq38oJSN6QHm5n2eKZTGHWrgD7aFz2ry1E+obHJhJ3eQQL7bthK9aiqML",
-	"cvMf0Jos4E4nuxtPUaM9H4XGktSwrkJlIK/5YzstuXpHTrwsl/ekRkFWfZlGt2R2Jb49dQl8ljtm6TA/",
-	"ltac1knp0p4wdJ2xwdKywNh2ctw9LV26DUqr/+sf78pSWza2E3SXKj1mf8hXmTZEmZ6SM+U5hSnhCghd",
-	"T0lRotJWligIl4JOFcuKc4o/L8EsQSEjkd8D+T1QuQeyR1Mf4SSC9K+lZtMMFJNtea/H29L+JW/Qiog1",
-	"omStrcyFRDOSfkNMIMNWYC95sUfobI5sskjKK4hwHugST4tRme7RAyRsugN02ThVe5W4T9Qq9J7QBPeU",
-	"s1vQrqvMQ1qELS8WlUmsWDisz+ooPQ5zZh8qDDFMG5ZGcEFyI6eG6G86zlgrInLCu1dsxaG1PGnu3uPv",
-	"2s+7p7YDc5cX+QSZqpYclpeBg3rpPvBXT4MXTTSRUrovffRmiMt8psB6p5uCoj3YkA4J1uXOO8wMqqWN",
-	"ju8q5mkNaa6YWV/aIBUqzYhm6UluliF1v7O3EMnNEoRhKbGX0Q0zS5RrUBZBiAiKMqL1jVQuu7p9LWTt",
-	"ozWJL43J8MZqwMRchpIuU5kbxDQiyEjJ0Vwq5AtDsUBlj4tSKQwIY9OVlikjHK2AMnL0P8uAhhnLLX6v",
-	"k/Mz3GBSfHw0Phpbv8oMBMkYnuDX7lKCM2KWzhUjkrGRz0Uucr4mttF0xp9RPMHnUpuTjPkpFC5CAdq8",
-	"k9Q1FF5Jd26yjHu/jWzxXE/uhk7P1oxr0w655U93QWdS6CKOfx2P7016o0HYbLaLCGt/XZzoPE1B63nO",
-	"uYPt23tUo+ikIiqcCWPxx5EGdQ0KgV9oT81qRdQaT8opISKuonA3XXyrfkOPOPO1XF+cK19o24A9ULij",
-	"M40HCPpOxfZ2oxkSZBCQE2R9ieQc1e61z70ZH4eH/ZOwlCIVswXyzwQZa3jLgAhmqqlLHoNM3kaMa3gf",
-	"GDHBKOmpuMJ394HnqwXIkAXypW/AG88LKTGbarg0M/4CIjh5DxYn5+WyxzjU5SBlr8NcGfLsAvQeTNFN",
-	"NizopflWMO7/uFbu3+VwHu8lduuVAN2hXWE0Vh6Gmb5QGvkm+ufN9X93CiKCBNyUIQ+P4+jW/zqjmwLK",
-	"HIr+q42IU3e9gYnz8jFXLNZvWL/cYmZVtAVkOUab4Kyxuh3ppOGLIEJXAQzehOetjEiheoxFex4S0qC5",
-	"zMXPRqXWFFumeTVna3R2alXcgTqfKDLjh+GFeNgUGMXg+mVE2/FyGOqeaurxQ/1g7F/OQHYu0OKR7S6h",
-	"nh0cCo+EiOij7hFV62k1+B7s3wL8nKp1NdB/vlgK3kk8VbfY8fnRXnWmG5UiI9EMkM7IjSiqyeeYzdQa",
-	"Vdhs4PoV4pLQuKG9YD8A6L8syntQU7nzZfDnhyjUVC6KAZ1mGkmBJPcvEWus+Sn/qJgbj6oJ8lDPWkzm",
-	"9UUx866fegymCWfte5FMw8Zn2842bRgM5ei2+r0ZEepe01VziV4q6Yhy9euE0ka/PMwtzRcUg9xSveQ4",
-	"gFq6Pk846EVQc5t4+3x3YtKIUHrXsdgzJK4TShuzmf2grGAlr+H+0Hzh9vsN6HsGdBGmXwfSBYz2QfX0",
-	"hpllieO7Jd7PzCzLGUIcuN9zUOugzCu/K3+0IcjvDL9vhre9cDDL1NU3LdvTzD7sVA+9lHlZ49OeiL/r",
-	"u73Ds5dJQxZINUrclxSkgaPGRyAu7I3PP75c2dAVWxeYyBX3H29MRiMuU8KXUpvJ27+Nj/HmavNHAAAA",
-	"//9xiWqJlTQAAA==",
+	"H4sIAAAAAAAC/+x9a3MbN7LoX0Hx3qpj3xo9vLvZW6Wq+0Ebb058Ntm4ROdk68YuGpxpkohmgAmAkcx1",
+	"+b+fajzmiXmQkigp5pfE4swAjUZ3o1/o/jyLRZYLDlyr2cXnmYo3kFHzz0tO061i6h1V1/h3LkUOUjMw",
+	"T+OUsgySBdX4l97mMLuYKS0ZX8++RDhqxrQeeEECHXgMUgppJkpAxZLlmgk+u5j93fxOxIrkEm6YKBTJ",
+	"pYhBKcbXhGoNWa7V6SyaMQ2ZCo7tfqBS0i3+vaIs7YcEnxYSFhKoErwL0ZX5ncQiAQSLEjsc0VRdX5CM",
+	"flp4qBbwKQZIICFCElr7IgeZUdwCYpZNXihRyBgWuIQFF3qxEgVPIqKFSK+Zrv+US7FiKYR+UqA142tV",
+	"f5aJBFLzQyz4iq0LCUlEGL+hKUsWaZotJPxegNIvT9/zWdTFBktqSGJcwxok/p5LJiTT276nFqS+r9WG",
+	"JuLWPW6id24eWTzZ1wxmT2dRcBxRpMlC0RuoTbQUIgXKzQsGscF99jhPwk811YUhp/8tYTW7mP2vs4px",
+	"zhzXnCGrzO2bSGeg9EIWPLiud6A0kQW3K/OvDqzN/tIB7Us0wy1jEpLZxa+4P+7V2p6U4JcIqC+3sT1N",
+	"HJZ82ODXDyV4YvkbxBqhqwuLt1TSDDRI1RUb45TwMDvYQtNuaOhd8L/hyrJLd6HwSUsaI8aav9MkYUgC",
+	"NH3b+L1PSlUzGt7tEtKP+DPRghQKTsmbFRFW8EZEb4AksKJFqgm14EoSbyjjhCl8PTkNsbiDd7vAJ4Mw",
+	"D7GCe3P7DocPLUdCCjeUx9vFiqUa5MCWPshud+aPwns2sPnyB5YxB3pzU8wDc0w5eapwh6iVv/gz5eSH",
+	"H35EWX3DEpAo2iQkZLklNE3JrZDXINUp+f8gBUntYBlQTrj783QWtQiO8cUqZeuN7kLzzyJbgmxAswQ8",
+	"L+ETxIVu0EGNF/HsigWPCymBxz2ivaTKLh1RJLFFwTVLQ8emg+SWMk3MS0RvmCKaZUCo8qBapCApe1z1",
+	"Ea15GIREUg0LgzZIFlpoGoDnHf5M8M0T9yaRoHLBFagwejwuFznIRcZ4oaE77LcGebpCvBk8IkwjD6bi",
+	"FiTRG8rNCqszmQgOhK40yHGQuNndBkTlGrtQa3ENfBrMoDTLUOgT+9GDwu7gGtydDkA1ig7vERIXEsEU",
+	"lnDvkpWQZkWW7ULjtsRLSXueG8K0EcJ9l8miGiNX8Hf2toWwII2HJNdr0BBbHHQ0etzBBHgcIonyGaIM",
+	"sWMlGePulMFRzR5boQpkJUVGzlHqvTolPzKlPGoTD4EiGU2ALGElpKMfO8Ot4f5YyMRKppWQGdWzi1ki",
+	"imUKIeoZsyY+0Sw3J3vApHiTGELSdL2GpA4e43FaoLruVlmeoYznhUYZtYLbE7URmrgJgoZHR4erLI8+",
+	"LYiphcOkDmtBw7rAhmqUlkkRQ1LbHyZ4n+wc1MmmKDBaFhC1bTV/nBKvUpAbmhagCM6REBrjHiNdaFG9",
+	"gs/qXFfTGKidF0JWgpC4C3lKuXnLk2lFkAnETPWhoLSVbkAqxx1dRNyPNmLVy3EFtA1Skywa2zKqnpds",
+	"/12pa7W0h1GKs3KqV0L8SD+xrMjqfPzCMJBiN/DylLyu+OqW6Y1ABqq/nAj+H5pkVMebiTyfMT4MEeMH",
+	"hsjtn0WolwIjSjJ+4DYlICCamzTKXrmEhMVUg4qMHmmZICNZoWormQqaGbMfNku5zcWO+lo0XY9OXW7M",
+	"O7pWfv4vQ1T9A1O61xIzqkhQy1FCejmRUqWJAuCVrCQvPsbmnY/WRk+Z0UbL5y9RbK1Bm+85fNIkp2sI",
+	"ipfKwpm07grnCNYCwQr6EN4kA+CfknmR50Jqo5GlW0PkRAlplJzlljDjK4IVSPLR4OhjWIcySoWd3NiS",
+	"s4tX56H38LyWjfcMuLNoBrzIUPJR85f58UMAS793V/hdkaYnGnGrgMp4Q34vQG7Ji1tY+l/Ulmv66YK8",
+	"n/1eCMMNG0kVqPeziPx0FZET+GSP8ZdE3IAk0HsqUZ4QS9QkF0or8kIznUJEliLZmqfo1cQtexk+RITU",
+	"i+U2sAoGKeqsBvt1DWO5jYhmOLUEspSo0dmdQelkkGjsxo8e0o+ErchHM0/tJ0UU6Mi8KCm/ti/9Xj0Q",
+	"egPylikwr7Dk4ymZV2TgvnEnlMIvx2VjLDJHdLcb4HWq+li99fG0tvcsaZ5SlZ/BKK/8Gh+Xnwbpo7Hu",
+	"SfIwiPLg6pyBaT8rVxfY5kE5NDck+SMK2gm0bASylS0lgIYlFXBtebaxk12b3+AtYFp7ncdJh0qkIREz",
+	"repkjkjClwxnRWTD1huQOGMmZKk/6YknoOIszyHggvhO0nVmlmVBMjMjNG0mNFLKYAb/kDTPrQL+vjg/",
+	"/3OcUXlt/gX277Pqh/Be1RUvR2UexkE9Cb24TGkWB3yYTAZUpYZjYOiptTMWsZAS4gCmLjNRWDzVqNZ+",
+	"hCaH+y7dlkZXj7vYTnMrBV/vPIn5anyKPi9DC++liVrprjUUdjDSgn1wm97R9c95QnW/H7ZcX69541WS",
+	"5neVe9AjYVGiHp/zIk0pcoEzfdoac1dOtLDSAMxBMbbWPeAch6s1RZ+FMDZRWwf0M0azTydrceJ+/T/4",
+	"c1cxDEIl6Uo7DXnujKEuJf/M0UWf+KgX8WaTcX9vzcFaKEiM68Ge6ihw09SJQNUVqvfvuV/B7QLdBGMK",
+	"4HdwO98IXS72q3T6t4X2Hdz0JljcpWTwPw/PbF8Ljdvep+5p5/1CuRRZji/Zc21TZJSfdD1N/lC0NHxK",
+	"3tk3LL0iCY+7orwHyk6kN8CkVdhkVvfL2bBum+Dtp/1GPS+9pXVHV2afzi6+OTfWuP3jVV8owTosQ75d",
+	"o/YWCdpStSnCaz0lf/fPjZ/LWukrpg2e1DWe7YbEhwFSWlIN64Aa+b24rUDAMeONUMBPycclTRGFyUei",
+	"6TUoAr8XNHW4cb5oe6ZZNas84uw+RuSjhBi49mITd94PZXf6Fmeq9so4QkvXKuOluD0l74wHVmlih0y3",
+	"/vCuSAYH5mTFpNJ2070m7pdhTuQ2QAHFu80WnlpqSGxscIhjvqfxNch/wq36DiCpM06TFFcA4fh70LUy",
+	"5mBtQW4Grw0VAvQHY+QPecmNS2DMnVBp3OjapEohizore7rbIKmDMclzgF9Z62LyJ3WbpXJkIh3VUOwQ",
+	"1DzLf1OCn17R2x9BKbqGvdw7/WrRLPK4Du2T0wu6+0NjzfpyB0aiBO7YLBNnJnrv6sd1n47JaTbkN1aN",
+	"Ofc8eduwdHBW5En/8kNOagN15FE6sA1jyRCV47zltje/121CRV5IwPVHZGNkBodbRYQkUvV6XZwDfSC0",
+	"Uw7ujhT7TUSK3ASozs93SRgbTS5QQ6jqU69HE2Ps6O2owHRfs8W1J5D/dp/3uXe7Ic5aZKIPlIFl/1eR",
+	"5UpTqQcSZoyKs6CpBJpsF+7YTxoexVCY6ZcNaHRZaEHcGMSN4VUHdyzWyKcmFH7zkGFclonmfH8+jwLq",
+	"QUb5liR0a5xza0GWNL42pMUy42Nz0xrFHO3EyP9iDI82LGFXa3BOS8T7z/Clf4P6z+TR2OpzMZdyxrmz",
+	"BEu9UjH1hO2nlqKMQ1sNueXpZKBOSTVNzWeL4Bh1UOQWGGJh7omwTsnJGjxGotlABLUlVKrA5l2MvBb9",
+	"vmarVUArSJL9CbS5CzU0N1213Gl0mBbjlhZEcryhfD0Zmink8hpWjJuvvzVjTwKacbIUeuNBdYZjwlYr",
+	"MNk/STlomFY8ly7cesIqF6aB7Ki7uKOpZOfhCZqMN/xum8zG3s7EzYGoRqTJCNVosS8a21YQ7ogZbwAh",
+	"bdQHtrsX9VGX24LIDHLCBA6/EmmKJ+7u2qYbwKl/TbnvZb7XaozQlCJNMQGRxtdBqV+Tdc2pHPLxqMYx",
+	"zAhEiwnJZH7ICYiwnu+QM/puDs0e1/bEE5um6U+r2cWvO57dH6I+H1q5I1rgv0/JP4s0JQlTCGMtB6v0",
+	"tSGWm2uY4EjNGXcjFzxnXNWS3NCbI0FpIUEN6AfdaR9JT7iCPKUxKKsLNvKqMKDo5M++CsAEHfK/K7Y4",
+	"qpL7qJJT0vG+BlVzsiQ3jJsB5aojyCex5XSlNWAJz6I9VNnRlD3PUwOB6CqCMN3bVw03gdRCKV4TfAyN",
+	"OboZYOp68kUqP8zQJtSwUEHs5xnAbHV8Tvcg3tFF6KZ8BIdgNXM3Kh1CUNiu6PrrnXrflcRi3MdYaaIf",
+	"BoDoc5jFgmvKQgGlebG0MzazekzqjcmEdJ+SFzFVcMK4Aq6YNlmhIYFs4jyhwJXJYjZ5K82ZzCRLcAEi",
+	"LcgLOF2fEiN7yP9Fb+b7GebZvJ+9PLXnHlM6MEHp2QoN7s0I1E644Cco48IerrUeyI614JskJF5kIFlc",
+	"TjUx5+catkESSPVAmvDdp5Wwhk/dCd7+NH/zLyJhXaQUk/0QTVYVD9NCmRU7TKu4yCEqNcdoh0ZtyK2T",
+	"fJPi3ZnEZzzZ22D4agMF03NqrxlPqtl+9W+6iF/kcRbVUjJSpvQsmhUyDWbaVSsPXdvCJzV1wJz5NL1F",
+	"f2h51EWVFlB7M6NbJN0+Um3jHNcVQvrVfI5hRCfiVK8huAK8fybToYiiDxzcJaho5miOOAD2cPRzDN57",
+	"BjYMaUuRv4zjQtI4ECz3T1qZZOZij/En0cp8894NctWIixvyyYUVwIF0HNo7+XyDn1ZXd1wQHaftZsnV",
+	"UiDIT1ZDR2OIC/8ZSHADTRRATe1rQn5dhRWURQ4dkcvtwOOq4G4lgvddOly9CoQHqMwEZ7G9IWpLI9i7",
+	"Ls50jWmaNlaNp4rV1bmoshYaaLTHSgu1+FWJUKrKT22CwQScrWiqYMFhTc1uh0nYvlSSRJ8V5NbYxUdz",
+	"eyeuYOqhg7gcnHIctxPnsp9MpC3HbKX1gROGKUjLYnQHzDsjG7CPn7+hqrv1dWbrEkAH6C4hTZBiD2pJ",
+	"3REbQfBNtYt7zXAYcCkM24H7Z0QM3ZnTIs/7gVVFllG5HbU5DZrm7uVgHkPwXl2V2FBNNWqU28leM0XX",
+	"EiADrkev0vXl5pdSyF1vd8VN6m6L7v56Va85aJUxdlIl17NKuBk/lQk3CF5mW1SJshX4Q9+bYJH/iCwL",
+	"HfQNuQBSM+OshK95uIc0z4wpm/zf9gH2hlNaYSunVLNS4U+K6uqDw3HFeLsp2jveum3P1uftcxDulOS1",
+	"753cGuKq7RulwIoB7DsDTPtwBTsM7TcQNngjNkxJzRX0s3h/cGmiKAxmrw+5NC3yDZdWeerBUFUojd1m",
+	"v7qljZuz5Sr6MTCvxG+L2NYSIBkqqVBTxA1d0aye1Vq79cMspxrR0mTK7hnUzAcamti/WYOgl7IbaidL",
+	"pwxvGD3G6kCEC+OK2XPCipIHDoza7NQa3gaIkA5bK5Tihd4oADaSkNQONDV4qSmGKS/b5d/vqiahtOB2",
+	"vKEZm0lhtQBGDW9V/u4W9ISorR9zVpJRDZbIs0w/Fnv3IkwlXQwH+Xgo428gat4XHbmT62EwSbATN7h/",
+	"Bf0OiZO9ZkTvpfgdTrhm9GJSSuW8WNoc2XksaW7QFgpj3FBmgl7uqG75rlkG9u5uIw2dxJSjRFNm5IS8",
+	"wKfAy3vmsRBpIm552FFubqv33a6pP+7THswbqyJNFyqmfARwPP3KRdbte/yWQ9LweDBNONyAJBua58B7",
+	"Qq9mfrf2/tkbd+6LOAalEOZtiTWT0N/ytxDld81c67DAuA+CsOCeLHCkkBGB4/yHwomYq3diLl940Kpr",
+	"BeTvWa63LjRqpjN1eKTIzIvmekj/BYQS5gkEXb5aBz1qkuEwNff6Rfdyfe4N/aBnFAOTl4Zfr0CZFOFu",
+	"uYmVvR1ZBjr7DiLzAvHv+3OOtm2F3jOnOVEftN/icZP26rPTQsCB+y5mWKuv2oX06qvV45a+Gpsxeuhf",
+	"b/Pp0+LLA5PqbT4+ZX+MuQ+1b12Bzb5Icr0oaosI4Jb4p0YLAm4LISHUHnh7ZdDexy9fNouwtXfLy12u",
+	"VoR/ycdkXp2fG8s9o7ygaUS+sX/iuZYUKWpZPCGv7I9lFrydu1H9dQI1WAzcjRpcXvEOxNAzK75MXpTL",
+	"jEoElIuMqoKrQjrN8uUk+ukHskM+bu+nEU+fNHHzjQsT92KTkiZIkeYEvcAWct1/vcdkry6wFOACb0V0",
+	"wXwNKWhwOC1LQ9sKE66CIFO1W6Y4CqHrKfmS7bn7VjCHFGIdurlnnygH3crUZl4W6bWXxOVBvmKQJsqV",
+	"7cDrGL5Y774StUvmVF3vHJAcKg0cRsWAnu1Ey1DUweLJFhB1tbchIRIrFhIubsOGWbnpQyM3lKlq6F5S",
+	"7rfZ29Aam70sv53RT41S4d1xHReND+yLRmqBarPD3gSy9RNEJcZrlmOFrA8DW1ioetB/5wErqnsHSn9L",
+	"FeyRr5nD5HTNcIVAO0AtFWI04dBspQ2vaV+Ty/8ZbyC+buC/QlsJ7HCdu71DJPtWFt/5VmY4A7HuKe0u",
+	"NbxXrYJMNUiCdOfI5OdcgQxe4DsMNfjqkarmpanRyns+N8hz+p7IclNZma25wJ9JTJX1QapCSqyKjz+q",
+	"nMbQ1Hp2ppwHdH13IejboD49AvGQwljDhZ5+C96s9GnO3qQ1caKgJzScR7c7gezNxUMxuNcDKSG+EHUV",
+	"BAssqTZRb7xqj6iS7lbQVmrk3tP9FWXdEQfVluwt+YwOjuzYI1RbnNF4/c6Sry885HA+zF996bBLd4oO",
+	"pjaXo4Ry91bsk3MUr6XRfqprUv5iVXWbqqdK3r7bQeUa9C7gT47a9WKz4HuIqkdOcnCAN4J6HXVMFpw7",
+	"dcwvJ7xb05Ic/JzTsxzKhh9VusPkLAc3W/gq7WQaL3h121X1NEBBdvYpe5bKiTTEpSJScP+T4M76dtb5",
+	"5CK2HY4N3keYTPZFN0fVYKMcpFruAFqvIBch/cktfHJcoilI2suSBd93TfhpVIIzuJLSIdA6YTH43bi4",
+	"p0Hp7u2ocOm2smhb17a+W/T9S/9S+p3P91AVJkFohtP7y7WbCAZqUxY3rjinrwwW1EEOV0OmRSh2XQME",
+	"UsshaEcwDHFVzY+ILHhE5H1nBgdivcTrA5Myguuaz6T81rEcAl2Jvf5Egj5nx70lz5aK5QQwe5AWqt46",
+	"6ky5Qx7v/STwVhS0cM6CsZ4HC6cmjlzGnECMJQ477RCQEMzKSnu3RZIc7POag+Mhs5V3SlMe6DVzL6nG",
+	"PdVkK4dX6eZyZH0/GcVBWgkSRkgA/gLLjRDXTyR7N5ahmsz/ABN7+v7Hy29P5t9f/umbv5KcblNBE6LY",
+	"mlNdSFAolHUhua8iLzgxYKJn/D3/O403voMO2VBF/nUyj0WhTzBQrjTNcrIBmoA00sI/nPvR3cP33MiY",
+	"9zO1oX/65q//7/2MrIS7JIUdscgGPhHgsUggMeAi2O9ntgS09jOZP+HU/ool2+0P72c9rQxH6tiE7+GM",
+	"Kt6FuV1TZhbv4mBzNPOt+aS/nUFJQGFtAm6Au7MzgZTdgKzUiFs7Q7O6PHJCj2IxTDhdYmlqeJRIyhOR",
+	"maxjpsgaOMjeEJ/Dd8vdvlQiLTSQjdb5C/WS/Hz1A5EQA7tBt93bn+bvag2czEFllj8en2tefetswmuL",
+	"uu2lDRMEtqF6EIh2jBUaHKtJnRS22c2iLwTUn2VjEND/ZKSRhCj0UnyyWPSdvny5IM++HjeOe8OHfq8w",
+	"Kk3lcNMx+9yDY+CotxGopH5J3NUJEDSwzXgLlB2Bo9lO5jux6g2UXco6+Tteytm5etqNWeKZ5s+yDiu/",
+	"J42Po3Z1cPNa3az35NcklVHL3lH3PlffK2EwXVR2zQcFcSGZ3s7R5nCT5uwfsL0sdKBnwuXbN+QatsQt",
+	"y7L4RxWbhM2cXcNWuWfY1CIWOSjfycIeHZzg+qi9ASDBB4wYt20QHDT+G5NzeVFLRb6QQKuWuuoio5yu",
+	"gQiJ7RIKuKBJxrg9XxjCaznCl868mP3r5PLtm5N/wLYiF7tcRNuSKhaH1/03fERooTfANYst/GbxlJu+",
+	"WRiENFlcsYQE36Gpcj34Iv+CIht6YysCKoOaWWT7O5udxRkqqFC+zr7gDjG+EgFOMShnCs01IdJ6Jze+",
+	"rhTHWHDtpIUSMaMpySBh1J3ATKdQjnX59k2tcMbF7NXp+ek5okXkwGnOZhezP5ufUK/TG0MqZzRnZ85I",
+	"wr99ldpyj98keMdBKH2ZM1eUtOqd9zeRbH3lACcjaZ6nDr1nv7n2ztYcHjOWWyVPvzS52xUd8sLEAP+n",
+	"8/N7m712F+RLt9aY7a3hLN96UB3R+5fzV6Eq+khrQjLMYTYv/bn70ndCLlmSALfOG8+bKY0xja3iO0Ns",
+	"OMo397hiW8s9sNo3XIM01WZAmv4+7sVK1phiVHUp8+usxdCzD1+iz3V+DL3xoeat9R1gMWtcKCva6sQp",
+	"1ZntxWh7IK8hQKb/CTUqleoH//4dyWaS77DdwLabvd3B86WRnXhM+pU1WkGqRpAQF2RLHR2U5IY3vSay",
+	"AxvefNrY7P8ETWKnn7hmvGjOFJql7N9lu8F6P19VUUR1mJwhAkfFVsnaCmuRP5D0CnZOewAZNokY2zXX",
+	"dyLGCr2WiA4icUy7/NrUtnnSUbo6VmrpTwFm677RYDikiMbOBpipbNtThHipaLKSKTX/wKzU6UX0WDqB",
+	"q6vf2cvyBaLpukwYPeoHD6EfhHBdkXH9QsOAbuAL3BxEJXCT7SZ9y4UcCeeeRB/qGq5uZ4nZQX2hQST3",
+	"L95KspgizF7tNG0rYWqiz6Tr1vgSqraMQJdug66MO6Ca0A5vH3nlvoSs9ZQTikXXPZq7Qvbss/vXm+SL",
+	"xWcK1vnV5Cd7SaHGUW/9Z8YFIWkG1p779bN19qBbonL15LW3m3wS1bDboe8PHSb6S7DchSEhC/qjntl/",
+	"GYIPw9grzKn9w5/uuA/2yrhZ+HJL3rzGRU84zR+JrM4f5kgIE4IELRncHEn1aegTXTodsJoOT6cPprX4",
+	"qreTDbEwrfSbSl+PGnGU+9WF0w4/DakcoXhF11/vqqgmSRWsxwtmNsxEqHJ3WN1dOOz/2LwKvISVkDbW",
+	"I2xSIb74nqOf0jYDrXmr8EObXpiya2g1Iq2uB9ssIBO6GbQ8SkFRRVyeqbzYJ6jzlz029GDC42d+zcUt",
+	"9/3ehSTMiRPerGp0lCaHlyaOzKprVX4z3CXkSRbNWSK3i/Ji+2h0ocOzr+W27M33fBm3017wsWIZl24v",
+	"zaX5Cpc7OdXclWVzo1jl9JZDcmTPw2vOr+W2VhajOvNPiMl6C+7SIKfegUu/WhYdoMMSnUcr89GPsv8K",
+	"8okseO1w49jlzmsbg4wSrNW2i2elXfPuIbkm2qnNnF+RDV2HWs65bOtTn1JmQ8sllNUlyzZIZd7dhwOG",
+	"iwJNG6cfdO3WAIoImZh85bJAGYdbwJc5HN3m9xpdrxNi5wKe1T8dqe3Iq2eJuzZ6F4Y1V0+fD9P6KhN3",
+	"YNroc/BL1yNpt6WFBtJit2EO4EButOwN5Su4ot4xkCXoWwDelRhP4bCft4D6ajTkby3ZE30rHlCeSNfv",
+	"dXetuS1VfOfY56tE9/XAPXCqUW+r4Q5hlrxRa6X75LT158rA9xtA94sPMjDqY5Q3+1SPcbIpq7i77u4+",
+	"O+Tp77vSVU5RB3zzptyfzvsOeJMVPHvQA3VarWoD9o5auF3qUfc+mO5tq0ebK/Yl8ld1h2/UEyky123x",
+	"US1K43sQe55litBUiUBTgpjyhJkAVsnXeiNFsd60whOmpGw9JtTzNQ2VqliBq+6xbRZgM2BUxezf8wpT",
+	"hHGlgSYmrkVyCTdMFIrY22gORR6M2jJd/6BdIlQHEC4PpAA025Ic+Lz3YiVwvtvN6ffDHTBCHqBQIUnR",
+	"CIEdnYOH1zLmxjEIWOyCaluCsbtRNfmHrkK820u0pKsVi6fpGWef7T9clt8eKsfcff/AqkdgMFXN/ERM",
+	"9DGOfxJqu5U9X1cmlzsNfXNV+xTZh2nla6ftzDBnnZ4+d2Cf1+0GNs+BlyaYBDYdpmEQvDp/JhZBfU+m",
+	"WAeNPey1DNoN7o5C4XHsiSpp5HbD4k0lJTxbq7b2HbxcN1VYoN69hzOuKSXmOMjxoJ2iWhsr5+k5zZ4V",
+	"o92DEityZ1OjCmtunFQ1BZt6rKWyqH4kG1v4GnI9wm4mq+Ss3jhml0xtzDpSvrHIMzRyA011Dmzp9nZm",
+	"CV52sO89hau0fwRDc+/6DH8rshxNfawYJ4N9lcoslFqboGnplWXV8Z3VYt8vQT3lez6TKw3jSnZMYyxL",
+	"iB7dx/fuPjbIVQXT0HUd73JgHIxGH+CwaPYjOfRBUfJElzbK0ubOM/6oXtGSDY8n0iOUiUoSVyKqvAQS",
+	"Zlw8viTkKY3BqI3Q7IUz+ZA6+6wdYe5927mUCe/KkQ5vqOn63Pd9nbriz6d6obqC8Ou5DIMtTTyzlJ6m",
+	"HnaJSrvK1KG19eOnG1mu+eNeKt1VwR87OQFBf26ZCbWWIDuqkGZnj8kJj5CcUEP/9PSEUt+oN47qSTQo",
+	"m+8rauo5V/CdvueYC4A3CJgiZQ+dqvO3rhoU0FqfBjxHXYXeHdICDsHXD6gF11qxPIIWbFvIhJVg3L8n",
+	"kRuQNDvStHJmsHI9F49nsR51ZdQACj6oIdM1ZVxpt5VCeuOq3t5nwplvNeSrgu+RKODlxDs/wuMoxn7q",
+	"JxLCaPa1GhIF0r3yRBRsBOnryh7wJ7qNWtR0aKZVQ/ztzkt73fvpMNQBLv7cH1NF/X0UllRBie0+3dw1",
+	"j3tabNx3G2ekX9+RqR/xAo4MtVPzOUKGFGUxlq7v2k/sbBP/4r977lEOt5DdLFSPtcqSgViCPoY87k0t",
+	"NEZpieY+Q3SanXcwWr1/Oy/YHOrA1l7JIV3acI+CFVejRmMd0yMENXnfgUWCPrg5eFvx+tHMO3AiTbFE",
+	"IJZAqN8G9GCWLWSa1cgCYXrX7vRsQ+NrkBxu1dkKIBk7uOb2s+/Lr74zHx3iZLFz/tPNGeivOuGgsWMQ",
+	"HITY5R5PmHsuuR1A8RjNnX3G/305o0mycISqRlMjw5SI/7lMklo57/EjCicfPJ182zQOt7NopkUe6JF2",
+	"lxOrWcK7aoTY7GId6PrZpPtmke/6MOFq3/tXDVKuJN8xVevxA+P+lelsJk2Q8D44zYYbj8z2wMxmN+zI",
+	"bk8kuj6d4xZoz3pG20uz+oXpjUNzD3u1vGGNNrpPzTlwVOGepwqHFZp7LQgJScL0mYolzWGhNNUTSf3K",
+	"fDg3383NZwe5ylUsZWfinShQ+RGIXbPptnvMz71fOnSozaVYS1DWik3TCveqnxCrV3Yhw+qrw1LhXkKw",
+	"joYj1d2v9NuJxM4+l//ez35tU1/5r12t2BKOKef+0Wo9qtFP1GodZrE9bddeLtvDgj0y2tFi/cNYrB1u",
+	"28NkbTPXH8tmPWpqz0BTGzZQ1U6xrSt1wKDW1Xy+vyvkaj4/u9QiO/pBHoa+2vjtJaz9NH9HZ01F/yGy",
+	"HhyR+VnuqXVEvzp9wBQERD/5+eqHI/E/hHZOtCCUN/lgiA321c4dJ3SU8efEDE9A5f16FNmylPJU0txH",
+	"rVV/xBDMUeF4NgpHWKnVVDOlWdzpyD1E0eVHf5S2ydWKgkW/yqfHHspPK6pTbUxPd8YwfZ/ROC4kjbc1",
+	"Qm/VWiwnJ5qu8UoFVSQWUkKs063Lh8RfJRAkYJILxTS7AXuNEX/hsKbml4gkodFupeDr9lgrmqrWYPan",
+	"crRT8jN3g7S6x8ai4DjOstCGCjKgqpDhS7e9jHzpMXOA+z59DXmmu0E7l31+4um2jhef+kzN5US60iCJ",
+	"3jBFNMvAYK3EUl+bIMZbXYJWQmZUzy5mCdVwggPNov0hc02CdwOq4JqluwN1GA9XswFJSVBTVAP37sQ+",
+	"ZCJNHqfcwFEAey2jtmGAhQbau9a8LWw5PCrJG1USl2Meb1E01rwipijciCJiSitO06LxICjULJqIaxx5",
+	"bj/plVcNxfwO4m4H8YZgEXyLvCibTUSuDXlUNd+MiL8Naq5km8qXL/tkiZl0JyiqG5UpVZooAG7qR0RE",
+	"oJCz/Wjwh2ndE3GQBQ4yBZNjZVjKDrB21mZFlm+efEWWeqviPRoUH22ou5atNDfbmpUpI1/XZsWk0i0J",
+	"dRZTHkM66psyoupb++7D1Wm1EzxW3RGqri/NgTFQntXV/qykg8UfJEfSvSvp2s0neQPFjfP3xLkVEUdZ",
+	"pUeZMdt0nRdyDdPI+q159QGrD+P4T5iof0oTEovMdiatkbYrZHfwKIb0qDpy1N04ytZjJKK7v21ukaDl",
+	"dhq3XJlXH45b5pBCrD36nhanfGeqgAV0Q1+J04miI+HejXANjZFVHdso+xWYUnLMTa8I5YnbAnskNHfB",
+	"k7i/6H/22f1rahlTf7X/F//ZJK/Sbe3t+64y6iB5sjVGPXxfS0aZk7DVrXNTH4VpVaoxcIPwG0pNADuQ",
+	"yS1JxXqkqPbhKe/Bqks8Ts+FCWUl+jssfJ1FI7423rWEWfHu8IFx5riXjTZt6PDu6+rLB+TiCc4lqjVk",
+	"uX7G/iWHU4fR7aVd0G6eplIMl+h4OoWAj4wZKBkc2LBVg20H4elMb2czC7I8WMh0djHbaJ1fnJ2lIqbp",
+	"Rih98c1fz1/Nvnz48j8DAPj8ASDPJgEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
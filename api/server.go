@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -11,12 +12,27 @@ import (
 	"github.com/samber/lo"
 
 	"github.com/rishenco/scout/api/oapi"
+	"github.com/rishenco/scout/internal/llm"
+	"github.com/rishenco/scout/internal/sources/hackernews"
 	"github.com/rishenco/scout/internal/sources/reddit"
+	"github.com/rishenco/scout/internal/sources/rss"
 	"github.com/rishenco/scout/pkg/models"
 	"github.com/rishenco/scout/pkg/nullable"
 )
 
-const defaultDetectionListQueryLimit = 10
+const (
+	defaultDetectionListQueryLimit    = 10
+	defaultWebhookDeliveriesListLimit = 50
+	defaultTaskListLimit              = 50
+	defaultTestRunListLimit           = 20
+	defaultShadowListLimit            = 20
+	defaultShadowDisagreementsLimit   = 100
+)
+
+type authenticator interface {
+	Authenticate(c *gin.Context)
+	Authorize(scopesKey string) gin.HandlerFunc
+}
 
 type scout interface {
 	Analyze(
@@ -49,6 +65,85 @@ type scout interface {
 		jumpstartPeriod *int,
 		limit *int,
 	) ([]models.AnalysisParameters, error)
+	RequestAnalysis(ctx context.Context, profileID int64, source string, sourceIDs []string) (found bool, err error)
+	GetProfileStatistics(
+		ctx context.Context,
+		profileID int64,
+	) (statistics models.ProfileStatistics, found bool, err error)
+	GetSettingsVersionsAccuracy(
+		ctx context.Context,
+		filter models.AccuracyFilter,
+	) (accuracy []models.SettingsVersionAccuracy, found bool, err error)
+	GetProfileSettingsVersions(
+		ctx context.Context,
+		profileID int64,
+		source *string,
+	) ([]models.ProfileSettingsVersion, error)
+	DiffProfileSettingsVersions(
+		ctx context.Context,
+		profileID int64,
+		source *string,
+		fromVersion int64,
+		toVersion int64,
+	) (diff models.ProfileSettingsDiff, found bool, err error)
+	RollbackProfileSettings(
+		ctx context.Context,
+		profileID int64,
+		source *string,
+		version int64,
+	) (newVersion models.ProfileSettingsVersion, found bool, err error)
+	UpdateTaskPriority(
+		ctx context.Context,
+		update models.TaskPriorityUpdate,
+	) (updated int64, found bool, err error)
+	ListTasks(ctx context.Context, query models.TaskQuery) ([]models.AnalysisTaskRecord, error)
+	RetryFailedTasks(ctx context.Context, selector models.TaskSelector) (retried int64, err error)
+	CancelPendingTasks(ctx context.Context, selector models.TaskSelector) (canceled int64, err error)
+	PurgeCommittedTasks(ctx context.Context, committedBefore time.Time) (purged int64, err error)
+	ListWebhooks(ctx context.Context, profileID int64) ([]models.Webhook, error)
+	CreateWebhook(ctx context.Context, webhook models.Webhook) (created models.Webhook, found bool, err error)
+	UpdateWebhook(ctx context.Context, update models.WebhookUpdate) (webhook models.Webhook, found bool, err error)
+	DeleteWebhook(ctx context.Context, webhookID int64) (found bool, err error)
+	ListWebhookDeliveries(
+		ctx context.Context,
+		webhookID int64,
+		limit int64,
+	) (deliveries []models.WebhookDeliveryLogEntry, found bool, err error)
+	ListTestCases(ctx context.Context, profileID int64) ([]models.TestCase, error)
+	UpsertTestCase(ctx context.Context, testCase models.TestCase) (upserted models.TestCase, found bool, err error)
+	DeleteTestCase(ctx context.Context, profileID int64, testCaseID int64) (found bool, err error)
+	StartTestRun(
+		ctx context.Context,
+		profileID int64,
+		draftSettings *models.ProfileSettings,
+	) (run models.TestRun, found bool, err error)
+	ListTestRuns(ctx context.Context, profileID int64, limit int64) ([]models.TestRun, error)
+	GetTestRun(
+		ctx context.Context,
+		profileID int64,
+		runID int64,
+	) (run models.TestRun, results []models.TestResult, found bool, err error)
+	DiffTestRuns(
+		ctx context.Context,
+		profileID int64,
+		baseRunID int64,
+		targetRunID int64,
+	) (diff models.TestRunDiff, found bool, err error)
+	StartShadow(
+		ctx context.Context,
+		profileID int64,
+		source *string,
+		settings models.ProfileSettings,
+	) (shadow models.Shadow, found bool, err error)
+	ListShadows(ctx context.Context, profileID int64, limit int64) ([]models.Shadow, error)
+	GetShadow(ctx context.Context, profileID int64, shadowID int64) (shadow models.Shadow, found bool, err error)
+	StopShadow(ctx context.Context, profileID int64, shadowID int64) (shadow models.Shadow, found bool, err error)
+	ListShadowDisagreements(
+		ctx context.Context,
+		profileID int64,
+		shadowID int64,
+		limit int64,
+	) (results []models.ShadowResult, found bool, err error)
 }
 
 type redditToolkit interface {
@@ -56,33 +151,72 @@ type redditToolkit interface {
 	GetAllSubredditSettingsWithProfileID(ctx context.Context, profileID int64) ([]reddit.SubredditSettings, error)
 	AddProfilesToSubreddit(ctx context.Context, subreddit string, profileIDs []int64) error
 	RemoveProfilesFromSubreddit(ctx context.Context, subreddit string, profileIDs []int64) error
+	GetAllSubredditScrapeStates(ctx context.Context) ([]reddit.SubredditScrapeState, error)
+}
+
+type hackernewsToolkit interface {
+	GetAllFeedSettings(ctx context.Context) ([]hackernews.FeedSettings, error)
+	GetAllFeedSettingsWithProfileID(ctx context.Context, profileID int64) ([]hackernews.FeedSettings, error)
+	AddProfilesToFeed(ctx context.Context, feed string, profileIDs []int64) error
+	RemoveProfilesFromFeed(ctx context.Context, feed string, profileIDs []int64) error
+}
+
+type rssToolkit interface {
+	GetAllFeedSettings(ctx context.Context) ([]rss.FeedSettings, error)
+	GetAllFeedSettingsWithProfileID(ctx context.Context, profileID int64) ([]rss.FeedSettings, error)
+	AddProfilesToFeed(ctx context.Context, feedURL string, profileIDs []int64) error
+	RemoveProfilesFromFeed(ctx context.Context, feedURL string, profileIDs []int64) error
+}
+
+type analyzerLimiters interface {
+	Stats() []llm.LimiterStats
 }
 
 var _ oapi.StrictServerInterface = &Server{}
 
 type Server struct {
-	scout         scout
-	redditToolkit redditToolkit
+	scout             scout
+	redditToolkit     redditToolkit
+	hackernewsToolkit hackernewsToolkit
+	rssToolkit        rssToolkit
+	analyzerLimiters  analyzerLimiters
 
 	logger zerolog.Logger
 }
 
-func NewServer(scout scout, redditToolkit redditToolkit, logger zerolog.Logger) *Server {
+func NewServer(
+	scout scout,
+	redditToolkit redditToolkit,
+	hackernewsToolkit hackernewsToolkit,
+	rssToolkit rssToolkit,
+	analyzerLimiters analyzerLimiters,
+	logger zerolog.Logger,
+) *Server {
 	return &Server{
-		scout:         scout,
-		redditToolkit: redditToolkit,
-		logger:        logger,
+		scout:             scout,
+		redditToolkit:     redditToolkit,
+		hackernewsToolkit: hackernewsToolkit,
+		rssToolkit:        rssToolkit,
+		analyzerLimiters:  analyzerLimiters,
+		logger:            logger,
 	}
 }
 
-func NewGinEngine(server *Server, middlewares ...gin.HandlerFunc) *gin.Engine {
+// NewGinEngine registers API handlers behind the authenticator.
+//
+// Middlewares are applied to all routes, including public ones registered by the caller.
+func NewGinEngine(server *Server, authenticator authenticator, middlewares ...gin.HandlerFunc) *gin.Engine {
 	ginEngine := gin.New()
 
 	strictHandler := oapi.NewStrictHandler(server, nil)
 
 	ginEngine.Use(middlewares...)
 
-	oapi.RegisterHandlers(ginEngine, strictHandler)
+	oapi.RegisterHandlersWithOptions(ginEngine.Group("", authenticator.Authenticate), strictHandler, oapi.GinServerOptions{
+		BaseURL:      "",
+		Middlewares:  []oapi.MiddlewareFunc{oapi.MiddlewareFunc(authenticator.Authorize(oapi.ApiKeyAuthScopes))},
+		ErrorHandler: nil,
+	})
 
 	return ginEngine
 }
@@ -144,6 +278,50 @@ func (s *Server) GetApiProfilesProfileId(
 	return oapi.GetApiProfilesProfileId200JSONResponse(profileFromModel(profile)), nil
 }
 
+// GetApiSourcesHackernewsFeeds implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) GetApiSourcesHackernewsFeeds(
+	ctx context.Context,
+	request oapi.GetApiSourcesHackernewsFeedsRequestObject,
+) (oapi.GetApiSourcesHackernewsFeedsResponseObject, error) {
+	allFeedSettings, err := s.hackernewsToolkit.GetAllFeedSettings(ctx)
+	if err != nil {
+		//nolint:nilerr // error is passed to response
+		return oapi.GetApiSourcesHackernewsFeeds500JSONResponse{Error: err.Error()}, nil
+	}
+
+	oapiFeedSettings := make([]oapi.HackerNewsFeedSettings, 0, len(allFeedSettings))
+
+	for _, feedSettings := range allFeedSettings {
+		oapiFeedSettings = append(oapiFeedSettings, hackerNewsFeedSettingsFromModel(feedSettings))
+	}
+
+	return oapi.GetApiSourcesHackernewsFeeds200JSONResponse(oapiFeedSettings), nil
+}
+
+// GetApiSourcesHackernewsFeedsWithProfile implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) GetApiSourcesHackernewsFeedsWithProfile(
+	ctx context.Context,
+	request oapi.GetApiSourcesHackernewsFeedsWithProfileRequestObject,
+) (oapi.GetApiSourcesHackernewsFeedsWithProfileResponseObject, error) {
+	feedSettings, err := s.hackernewsToolkit.GetAllFeedSettingsWithProfileID(ctx, int64(request.Params.ProfileId))
+	if err != nil {
+		//nolint:nilerr // error is passed to response
+		return oapi.GetApiSourcesHackernewsFeedsWithProfile500JSONResponse{Error: err.Error()}, nil
+	}
+
+	oapiFeedSettings := make([]oapi.HackerNewsFeedSettings, 0, len(feedSettings))
+
+	for _, settings := range feedSettings {
+		oapiFeedSettings = append(oapiFeedSettings, hackerNewsFeedSettingsFromModel(settings))
+	}
+
+	return oapi.GetApiSourcesHackernewsFeedsWithProfile200JSONResponse(oapiFeedSettings), nil
+}
+
 // GetApiSourcesRedditSubreddits implements oapi.StrictServerInterface.
 //
 //nolint:revive,staticcheck // naming is dictated by oapi-codegen
@@ -188,6 +366,46 @@ func (s *Server) GetApiSourcesRedditSubredditsWithProfile(
 	return oapi.GetApiSourcesRedditSubredditsWithProfile200JSONResponse(oapiSubredditSettings), nil
 }
 
+// GetApiSourcesRedditScrapeState implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) GetApiSourcesRedditScrapeState(
+	ctx context.Context,
+	request oapi.GetApiSourcesRedditScrapeStateRequestObject,
+) (oapi.GetApiSourcesRedditScrapeStateResponseObject, error) {
+	states, err := s.redditToolkit.GetAllSubredditScrapeStates(ctx)
+	if err != nil {
+		//nolint:nilerr // error is passed to response
+		return oapi.GetApiSourcesRedditScrapeState500JSONResponse{Error: err.Error()}, nil
+	}
+
+	oapiStates := make([]oapi.SubredditScrapeState, 0, len(states))
+
+	for _, state := range states {
+		oapiStates = append(oapiStates, subredditScrapeStateFromModel(state))
+	}
+
+	return oapi.GetApiSourcesRedditScrapeState200JSONResponse(oapiStates), nil
+}
+
+// GetApiAnalyzersLimiters implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) GetApiAnalyzersLimiters(
+	_ context.Context,
+	_ oapi.GetApiAnalyzersLimitersRequestObject,
+) (oapi.GetApiAnalyzersLimitersResponseObject, error) {
+	stats := s.analyzerLimiters.Stats()
+
+	oapiLimiters := make([]oapi.AnalyzerLimiter, 0, len(stats))
+
+	for _, limiterStats := range stats {
+		oapiLimiters = append(oapiLimiters, analyzerLimiterFromModel(limiterStats))
+	}
+
+	return oapi.GetApiAnalyzersLimiters200JSONResponse(oapiLimiters), nil
+}
+
 // PostApiAnalyze implements oapi.StrictServerInterface.
 //
 //nolint:revive,staticcheck // naming is dictated by oapi-codegen
@@ -203,6 +421,8 @@ func (s *Server) PostApiAnalyze(
 			ProfileID:           -1,
 			RelevancyFilter:     request.Body.RelevancyFilter,
 			ExtractedProperties: request.Body.ExtractedProperties,
+			PropertyTypes:       propertyTypesFromOapi(lo.FromPtr(request.Body.PropertyTypes)),
+			Model:               request.Body.Model,
 		},
 		// Do not save the detection
 		false,
@@ -222,12 +442,19 @@ func (s *Server) PostApiDetectionsList(
 	ctx context.Context,
 	request oapi.PostApiDetectionsListRequestObject,
 ) (oapi.PostApiDetectionsListResponseObject, error) {
-	query := detectionQueryFromOapi(*request.Body)
-
-	query.Order = models.DetectionOrderDesc
+	query, err := detectionQueryFromOapi(*request.Body)
+	if err != nil {
+		//nolint:nilerr // error is passed to response
+		return oapi.PostApiDetectionsList400JSONResponse{Error: err.Error()}, nil
+	}
 
 	detections, err := s.scout.ListDetections(ctx, query)
 	if err != nil {
+		if errors.Is(err, models.ErrInvalidDetectionQuery) {
+			//nolint:nilerr // error is passed to response
+			return oapi.PostApiDetectionsList400JSONResponse{Error: err.Error()}, nil
+		}
+
 		//nolint:nilerr // error is passed to response
 		return oapi.PostApiDetectionsList500JSONResponse{Error: err.Error()}, nil
 	}
@@ -272,11 +499,22 @@ func (s *Server) PostApiDetectionsList(
 	result := make([]oapi.ListedDetection, 0, len(detections))
 
 	for _, detection := range detections {
+		cursor, err := models.NewDetectionCursor(detection, query.Sort)
+		if err != nil {
+			//nolint:nilerr // error is passed to response
+			return oapi.PostApiDetectionsList500JSONResponse{Error: err.Error()}, nil
+		}
+
 		oapiDetection := oapi.ListedDetection{
+			Cursor: cursor.Encode(),
 			Detection: oapi.Detection{
 				CreatedAt:       detection.CreatedAt.Format(time.RFC3339),
 				Id:              int(detection.ID),
 				IsRelevant:      detection.IsRelevant,
+				Confidence:      detection.Confidence,
+				Rationale:       detection.Rationale,
+				Model:           detection.Model,
+				ExampleIds:      exampleIDsFromModel(detection.ExampleIDs),
 				ProfileId:       int(detection.ProfileID),
 				Properties:      detection.Properties,
 				SettingsVersion: int(detection.SettingsVersion),
@@ -291,6 +529,13 @@ func (s *Server) PostApiDetectionsList(
 			oapiDetection.SourcePost = lo.ToPtr(json.RawMessage(post.JSON))
 		}
 
+		if detection.Search != nil {
+			oapiDetection.Search = &oapi.DetectionSearchMatch{
+				Rank:    detection.Search.Rank,
+				Snippet: detection.Search.Snippet,
+			}
+		}
+
 		if tags, ok := detectionTagsIndex[detection.ID]; ok {
 			oapiDetection.Tags = &oapi.DetectionTags{
 				RelevancyDetectedCorrectly: tags.RelevancyDetectedCorrectly,
@@ -335,6 +580,11 @@ func (s *Server) PostApiProfiles(
 ) (oapi.PostApiProfilesResponseObject, error) {
 	id, err := s.scout.CreateProfile(ctx, profileFromOapi(*request.Body))
 	if err != nil {
+		if errors.Is(err, models.ErrInvalidPropertyType) || errors.Is(err, models.ErrInvalidFewShotSettings) {
+			//nolint:nilerr // error is passed to response
+			return oapi.PostApiProfiles400JSONResponse{Error: err.Error()}, nil
+		}
+
 		//nolint:nilerr // error is passed to response
 		return oapi.PostApiProfiles500JSONResponse{Error: err.Error()}, nil
 	}
@@ -355,6 +605,11 @@ func (s *Server) PutApiProfilesProfileId(
 
 	err := s.scout.UpdateProfile(ctx, update)
 	if err != nil {
+		if errors.Is(err, models.ErrInvalidPropertyType) || errors.Is(err, models.ErrInvalidFewShotSettings) {
+			//nolint:nilerr // error is passed to response
+			return oapi.PutApiProfilesProfileId400JSONResponse{Error: err.Error()}, nil
+		}
+
 		//nolint:nilerr // error is passed to response
 		return oapi.PutApiProfilesProfileId500JSONResponse{Error: err.Error()}, nil
 	}
@@ -362,6 +617,126 @@ func (s *Server) PutApiProfilesProfileId(
 	return oapi.PutApiProfilesProfileId200Response{}, nil
 }
 
+// PostApiSourcesHackernewsFeedsFeedAddProfiles implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) PostApiSourcesHackernewsFeedsFeedAddProfiles(
+	ctx context.Context,
+	request oapi.PostApiSourcesHackernewsFeedsFeedAddProfilesRequestObject,
+) (oapi.PostApiSourcesHackernewsFeedsFeedAddProfilesResponseObject, error) {
+	ids := lo.Map(request.Body.ProfileIds, func(id int, _ int) int64 { return int64(id) })
+
+	err := s.hackernewsToolkit.AddProfilesToFeed(ctx, string(request.Feed), ids)
+	if err != nil {
+		//nolint:nilerr // error is passed to response
+		return oapi.PostApiSourcesHackernewsFeedsFeedAddProfiles500JSONResponse{Error: err.Error()}, nil
+	}
+
+	return oapi.PostApiSourcesHackernewsFeedsFeedAddProfiles204Response{}, nil
+}
+
+// PostApiSourcesHackernewsFeedsFeedRemoveProfiles implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) PostApiSourcesHackernewsFeedsFeedRemoveProfiles(
+	ctx context.Context,
+	request oapi.PostApiSourcesHackernewsFeedsFeedRemoveProfilesRequestObject,
+) (oapi.PostApiSourcesHackernewsFeedsFeedRemoveProfilesResponseObject, error) {
+	ids := lo.Map(request.Body.ProfileIds, func(id int, _ int) int64 { return int64(id) })
+
+	err := s.hackernewsToolkit.RemoveProfilesFromFeed(ctx, string(request.Feed), ids)
+	if err != nil {
+		//nolint:nilerr // error is passed to response
+		return oapi.PostApiSourcesHackernewsFeedsFeedRemoveProfiles500JSONResponse{Error: err.Error()}, nil
+	}
+
+	return oapi.PostApiSourcesHackernewsFeedsFeedRemoveProfiles204Response{}, nil
+}
+
+// GetApiSourcesRssFeeds implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) GetApiSourcesRssFeeds(
+	ctx context.Context,
+	request oapi.GetApiSourcesRssFeedsRequestObject,
+) (oapi.GetApiSourcesRssFeedsResponseObject, error) {
+	allFeedSettings, err := s.rssToolkit.GetAllFeedSettings(ctx)
+	if err != nil {
+		//nolint:nilerr // error is passed to response
+		return oapi.GetApiSourcesRssFeeds500JSONResponse{Error: err.Error()}, nil
+	}
+
+	oapiFeedSettings := make([]oapi.RSSFeedSettings, 0, len(allFeedSettings))
+
+	for _, feedSettings := range allFeedSettings {
+		oapiFeedSettings = append(oapiFeedSettings, rssFeedSettingsFromModel(feedSettings))
+	}
+
+	return oapi.GetApiSourcesRssFeeds200JSONResponse(oapiFeedSettings), nil
+}
+
+// GetApiSourcesRssFeedsWithProfile implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) GetApiSourcesRssFeedsWithProfile(
+	ctx context.Context,
+	request oapi.GetApiSourcesRssFeedsWithProfileRequestObject,
+) (oapi.GetApiSourcesRssFeedsWithProfileResponseObject, error) {
+	feedSettings, err := s.rssToolkit.GetAllFeedSettingsWithProfileID(ctx, int64(request.Params.ProfileId))
+	if err != nil {
+		//nolint:nilerr // error is passed to response
+		return oapi.GetApiSourcesRssFeedsWithProfile500JSONResponse{Error: err.Error()}, nil
+	}
+
+	oapiFeedSettings := make([]oapi.RSSFeedSettings, 0, len(feedSettings))
+
+	for _, settings := range feedSettings {
+		oapiFeedSettings = append(oapiFeedSettings, rssFeedSettingsFromModel(settings))
+	}
+
+	return oapi.GetApiSourcesRssFeedsWithProfile200JSONResponse(oapiFeedSettings), nil
+}
+
+// PostApiSourcesRssFeedsAddProfiles implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) PostApiSourcesRssFeedsAddProfiles(
+	ctx context.Context,
+	request oapi.PostApiSourcesRssFeedsAddProfilesRequestObject,
+) (oapi.PostApiSourcesRssFeedsAddProfilesResponseObject, error) {
+	ids := lo.Map(request.Body.ProfileIds, func(id int, _ int) int64 { return int64(id) })
+
+	err := s.rssToolkit.AddProfilesToFeed(ctx, request.Body.FeedUrl, ids)
+	if errors.Is(err, rss.ErrInvalidFeedURL) {
+		return oapi.PostApiSourcesRssFeedsAddProfiles400JSONResponse{Error: err.Error()}, nil
+	}
+
+	if err != nil {
+		//nolint:nilerr // error is passed to response
+		return oapi.PostApiSourcesRssFeedsAddProfiles500JSONResponse{Error: err.Error()}, nil
+	}
+
+	return oapi.PostApiSourcesRssFeedsAddProfiles204Response{}, nil
+}
+
+// PostApiSourcesRssFeedsRemoveProfiles implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) PostApiSourcesRssFeedsRemoveProfiles(
+	ctx context.Context,
+	request oapi.PostApiSourcesRssFeedsRemoveProfilesRequestObject,
+) (oapi.PostApiSourcesRssFeedsRemoveProfilesResponseObject, error) {
+	ids := lo.Map(request.Body.ProfileIds, func(id int, _ int) int64 { return int64(id) })
+
+	err := s.rssToolkit.RemoveProfilesFromFeed(ctx, request.Body.FeedUrl, ids)
+	if err != nil {
+		//nolint:nilerr // error is passed to response
+		return oapi.PostApiSourcesRssFeedsRemoveProfiles500JSONResponse{Error: err.Error()}, nil
+	}
+
+	return oapi.PostApiSourcesRssFeedsRemoveProfiles204Response{}, nil
+}
+
 // PostApiSourcesRedditSubredditsSubredditAddProfiles implements oapi.StrictServerInterface.
 //
 //nolint:revive,staticcheck // naming is dictated by oapi-codegen
@@ -428,6 +803,31 @@ func (s *Server) PostApiProfilesProfileIdJumpstart(
 	return oapi.PostApiProfilesProfileIdJumpstart204Response{}, nil
 }
 
+// PostApiProfilesProfileIdAnalyze implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) PostApiProfilesProfileIdAnalyze(
+	ctx context.Context,
+	request oapi.PostApiProfilesProfileIdAnalyzeRequestObject,
+) (oapi.PostApiProfilesProfileIdAnalyzeResponseObject, error) {
+	found, err := s.scout.RequestAnalysis(ctx, int64(request.ProfileId), request.Body.Source, request.Body.SourceIds)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidAnalysisRequest) {
+			//nolint:nilerr // error is passed to response
+			return oapi.PostApiProfilesProfileIdAnalyze400JSONResponse{Error: err.Error()}, nil
+		}
+
+		//nolint:nilerr // error is passed to response
+		return oapi.PostApiProfilesProfileIdAnalyze500JSONResponse{Error: err.Error()}, nil
+	}
+
+	if !found {
+		return oapi.PostApiProfilesProfileIdAnalyze404Response{}, nil
+	}
+
+	return oapi.PostApiProfilesProfileIdAnalyze204Response{}, nil
+}
+
 // PostApiProfilesProfileIdDryJumpstart implements oapi.StrictServerInterface.
 //
 //nolint:revive,staticcheck // naming is dictated by oapi-codegen
@@ -468,65 +868,1209 @@ func (s *Server) PostApiProfilesProfileIdDryJumpstart(
 	return oapi.PostApiProfilesProfileIdDryJumpstart200JSONResponse(tasksOapi), nil
 }
 
-// GetApiStatisticsProfileId implements oapi.StrictServerInterface.
-func (s *Server) GetApiStatisticsProfileId(ctx context.Context, request oapi.GetApiStatisticsProfileIdRequestObject) (oapi.GetApiStatisticsProfileIdResponseObject, error) {
-	panic("unimplemented")
+// GetApiProfilesProfileIdSettingsVersions implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) GetApiProfilesProfileIdSettingsVersions(
+	ctx context.Context,
+	request oapi.GetApiProfilesProfileIdSettingsVersionsRequestObject,
+) (oapi.GetApiProfilesProfileIdSettingsVersionsResponseObject, error) {
+	versions, err := s.scout.GetProfileSettingsVersions(ctx, int64(request.ProfileId), request.Params.Source)
+	if err != nil {
+		//nolint:nilerr // error is passed to response
+		return oapi.GetApiProfilesProfileIdSettingsVersions500JSONResponse{Error: err.Error()}, nil
+	}
+
+	oapiVersions := lo.Map(versions, func(version models.ProfileSettingsVersion, _ int) oapi.ProfileSettingsVersion {
+		return profileSettingsVersionFromModel(version)
+	})
+
+	return oapi.GetApiProfilesProfileIdSettingsVersions200JSONResponse(oapiVersions), nil
 }
 
-func profileFromModel(profile models.Profile) oapi.Profile {
-	oapiProfile := oapi.Profile{
-		CreatedAt:       lo.ToPtr(profile.CreatedAt.Format(time.RFC3339)),
-		Id:              int(profile.ID),
-		Name:            profile.Name,
-		Active:          profile.Active,
-		DefaultSettings: nil,
-		SourcesSettings: &map[string]oapi.ProfileSettings{},
-		UpdatedAt:       lo.ToPtr(profile.UpdatedAt.Format(time.RFC3339)),
+// GetApiProfilesProfileIdSettingsVersionsDiff implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) GetApiProfilesProfileIdSettingsVersionsDiff(
+	ctx context.Context,
+	request oapi.GetApiProfilesProfileIdSettingsVersionsDiffRequestObject,
+) (oapi.GetApiProfilesProfileIdSettingsVersionsDiffResponseObject, error) {
+	diff, found, err := s.scout.DiffProfileSettingsVersions(
+		ctx,
+		int64(request.ProfileId),
+		request.Params.Source,
+		int64(request.Params.From),
+		int64(request.Params.To),
+	)
+	if err != nil {
+		//nolint:nilerr // error is passed to response
+		return oapi.GetApiProfilesProfileIdSettingsVersionsDiff500JSONResponse{Error: err.Error()}, nil
 	}
 
-	if profile.DefaultSettings != nil {
-		oapiProfile.DefaultSettings = lo.ToPtr(profileSettingsFromModel(*profile.DefaultSettings))
+	if !found {
+		return oapi.GetApiProfilesProfileIdSettingsVersionsDiff404Response{}, nil
 	}
 
-	for source, settings := range profile.SourcesSettings {
-		(*oapiProfile.SourcesSettings)[source] = profileSettingsFromModel(settings)
+	changedProperties := make(map[string]oapi.PropertyDefinitionChange, len(diff.ChangedProperties))
+
+	for property, change := range diff.ChangedProperties {
+		changedProperties[property] = oapi.PropertyDefinitionChange{
+			From: change.From,
+			To:   change.To,
+		}
 	}
 
-	return oapiProfile
+	return oapi.GetApiProfilesProfileIdSettingsVersionsDiff200JSONResponse{
+		From:                   profileSettingsVersionFromModel(diff.From),
+		To:                     profileSettingsVersionFromModel(diff.To),
+		RelevancyFilterChanged: diff.RelevancyFilterChanged,
+		ModelChanged:           diff.ModelChanged,
+		FewShotChanged:         diff.FewShotChanged,
+		PropertyTypesChanged:   diff.PropertyTypesChanged,
+		AddedProperties:        diff.AddedProperties,
+		RemovedProperties:      diff.RemovedProperties,
+		ChangedProperties:      changedProperties,
+	}, nil
 }
 
-func profileSettingsFromModel(settings models.ProfileSettings) oapi.ProfileSettings {
-	return oapi.ProfileSettings{
-		ExtractedProperties: settings.ExtractedProperties,
-		RelevancyFilter:     settings.RelevancyFilter,
-		CreatedAt:           lo.ToPtr(settings.CreatedAt.Format(time.RFC3339)),
-		UpdatedAt:           lo.ToPtr(settings.UpdatedAt.Format(time.RFC3339)),
+// PostApiProfilesProfileIdSettingsVersionsRollback implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) PostApiProfilesProfileIdSettingsVersionsRollback(
+	ctx context.Context,
+	request oapi.PostApiProfilesProfileIdSettingsVersionsRollbackRequestObject,
+) (oapi.PostApiProfilesProfileIdSettingsVersionsRollbackResponseObject, error) {
+	newVersion, found, err := s.scout.RollbackProfileSettings(
+		ctx,
+		int64(request.ProfileId),
+		request.Body.Source,
+		int64(request.Body.Version),
+	)
+	if err != nil {
+		//nolint:nilerr // error is passed to response
+		return oapi.PostApiProfilesProfileIdSettingsVersionsRollback500JSONResponse{Error: err.Error()}, nil
 	}
-}
 
-func subredditSettingsFromModel(settings reddit.SubredditSettings) oapi.SubredditSettings {
-	return oapi.SubredditSettings{
-		Subreddit: settings.Subreddit,
-		Profiles:  lo.Map(settings.Profiles, func(id int64, _ int) int { return int(id) }),
+	if !found {
+		return oapi.PostApiProfilesProfileIdSettingsVersionsRollback404Response{}, nil
 	}
+
+	return oapi.PostApiProfilesProfileIdSettingsVersionsRollback200JSONResponse(
+		profileSettingsVersionFromModel(newVersion),
+	), nil
 }
 
-func detectionTagsFromModel(tags models.DetectionTags) oapi.DetectionTags {
-	return oapi.DetectionTags{
-		RelevancyDetectedCorrectly: tags.RelevancyDetectedCorrectly,
+// GetApiStatisticsProfileId implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) GetApiStatisticsProfileId(
+	ctx context.Context,
+	request oapi.GetApiStatisticsProfileIdRequestObject,
+) (oapi.GetApiStatisticsProfileIdResponseObject, error) {
+	statistics, found, err := s.scout.GetProfileStatistics(ctx, int64(request.ProfileId))
+	if err != nil {
+		//nolint:nilerr // error is passed to response
+		return oapi.GetApiStatisticsProfileId500JSONResponse{Error: err.Error()}, nil
 	}
-}
 
-func detectionFromModel(detection models.Detection) oapi.Detection {
-	return oapi.Detection{
-		IsRelevant: detection.IsRelevant,
-		Properties: detection.Properties,
+	if !found {
+		return oapi.GetApiStatisticsProfileId404Response{}, nil
 	}
+
+	return oapi.GetApiStatisticsProfileId200JSONResponse(profileStatisticsFromModel(statistics)), nil
 }
 
-func profileFromOapi(profile oapi.Profile) models.Profile {
-	modelProfile := models.Profile{
-		ID:              int64(profile.Id),
+// GetApiStatisticsProfileIdAccuracy implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) GetApiStatisticsProfileIdAccuracy(
+	ctx context.Context,
+	request oapi.GetApiStatisticsProfileIdAccuracyRequestObject,
+) (oapi.GetApiStatisticsProfileIdAccuracyResponseObject, error) {
+	accuracy, found, err := s.scout.GetSettingsVersionsAccuracy(ctx, models.AccuracyFilter{
+		ProfileID: int64(request.ProfileId),
+		Source:    request.Params.Source,
+		Since:     request.Params.Since,
+		Until:     request.Params.Until,
+	})
+	if err != nil {
+		//nolint:nilerr // error is passed to response
+		return oapi.GetApiStatisticsProfileIdAccuracy500JSONResponse{Error: err.Error()}, nil
+	}
+
+	if !found {
+		return oapi.GetApiStatisticsProfileIdAccuracy404Response{}, nil
+	}
+
+	return oapi.GetApiStatisticsProfileIdAccuracy200JSONResponse(
+		lo.Map(accuracy, func(version models.SettingsVersionAccuracy, _ int) oapi.SettingsVersionAccuracy {
+			return settingsVersionAccuracyFromModel(version)
+		}),
+	), nil
+}
+
+// PutApiProfilesProfileIdTasksPriority implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) PutApiProfilesProfileIdTasksPriority(
+	ctx context.Context,
+	request oapi.PutApiProfilesProfileIdTasksPriorityRequestObject,
+) (oapi.PutApiProfilesProfileIdTasksPriorityResponseObject, error) {
+	update := models.TaskPriorityUpdate{
+		ProfileID: int64(request.ProfileId),
+		Priority:  request.Body.Priority,
+		Type:      request.Body.Type,
+		Source:    request.Body.Source,
+	}
+
+	updated, found, err := s.scout.UpdateTaskPriority(ctx, update)
+	if err != nil {
+		//nolint:nilerr // error is passed to response
+		return oapi.PutApiProfilesProfileIdTasksPriority500JSONResponse{Error: err.Error()}, nil
+	}
+
+	if !found {
+		return oapi.PutApiProfilesProfileIdTasksPriority404Response{}, nil
+	}
+
+	return oapi.PutApiProfilesProfileIdTasksPriority200JSONResponse{UpdatedTasks: int(updated)}, nil
+}
+
+// GetApiTasks implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) GetApiTasks(
+	ctx context.Context,
+	request oapi.GetApiTasksRequestObject,
+) (oapi.GetApiTasksResponseObject, error) {
+	query := models.TaskQuery{
+		LastSeenID: nil,
+		Limit:      defaultTaskListLimit,
+		Status:     nil,
+		ProfileID:  nil,
+		Source:     request.Params.Source,
+		Type:       request.Params.Type,
+	}
+
+	if request.Params.LastSeenId != nil {
+		query.LastSeenID = lo.ToPtr(int64(*request.Params.LastSeenId))
+	}
+
+	if request.Params.Limit != nil {
+		query.Limit = int64(*request.Params.Limit)
+	}
+
+	if request.Params.Status != nil {
+		query.Status = lo.ToPtr(models.TaskStatus(*request.Params.Status))
+	}
+
+	if request.Params.ProfileId != nil {
+		query.ProfileID = lo.ToPtr(int64(*request.Params.ProfileId))
+	}
+
+	tasks, err := s.scout.ListTasks(ctx, query)
+	if err != nil {
+		//nolint:nilerr // error is passed to response
+		return oapi.GetApiTasks500JSONResponse{Error: err.Error()}, nil
+	}
+
+	return oapi.GetApiTasks200JSONResponse(lo.Map(tasks, func(task models.AnalysisTaskRecord, _ int) oapi.AnalysisTask {
+		return analysisTaskFromModel(task)
+	})), nil
+}
+
+// PostApiTasksRetry implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) PostApiTasksRetry(
+	ctx context.Context,
+	request oapi.PostApiTasksRetryRequestObject,
+) (oapi.PostApiTasksRetryResponseObject, error) {
+	selector := models.TaskSelector{
+		TaskIDs:   nil,
+		ProfileID: nil,
+		Source:    request.Body.Source,
+		Type:      request.Body.Type,
+	}
+
+	if request.Body.TaskIds != nil {
+		selector.TaskIDs = lo.Map(*request.Body.TaskIds, func(id int, _ int) int64 {
+			return int64(id)
+		})
+	}
+
+	if request.Body.ProfileId != nil {
+		selector.ProfileID = lo.ToPtr(int64(*request.Body.ProfileId))
+	}
+
+	retried, err := s.scout.RetryFailedTasks(ctx, selector)
+	if err != nil {
+		//nolint:nilerr // error is passed to response
+		return oapi.PostApiTasksRetry500JSONResponse{Error: err.Error()}, nil
+	}
+
+	return oapi.PostApiTasksRetry200JSONResponse{AffectedTasks: int(retried)}, nil
+}
+
+// PostApiTasksCancel implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) PostApiTasksCancel(
+	ctx context.Context,
+	request oapi.PostApiTasksCancelRequestObject,
+) (oapi.PostApiTasksCancelResponseObject, error) {
+	selector := models.TaskSelector{
+		TaskIDs:   nil,
+		ProfileID: lo.ToPtr(int64(request.Body.ProfileId)),
+		Source:    request.Body.Source,
+		Type:      request.Body.Type,
+	}
+
+	canceled, err := s.scout.CancelPendingTasks(ctx, selector)
+	if err != nil {
+		//nolint:nilerr // error is passed to response
+		return oapi.PostApiTasksCancel500JSONResponse{Error: err.Error()}, nil
+	}
+
+	return oapi.PostApiTasksCancel200JSONResponse{AffectedTasks: int(canceled)}, nil
+}
+
+// PostApiTasksPurge implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) PostApiTasksPurge(
+	ctx context.Context,
+	request oapi.PostApiTasksPurgeRequestObject,
+) (oapi.PostApiTasksPurgeResponseObject, error) {
+	if request.Body.OlderThanDays < 0 {
+		return oapi.PostApiTasksPurge400JSONResponse{Error: "older_than_days must not be negative"}, nil
+	}
+
+	committedBefore := time.Now().AddDate(0, 0, -request.Body.OlderThanDays)
+
+	purged, err := s.scout.PurgeCommittedTasks(ctx, committedBefore)
+	if err != nil {
+		//nolint:nilerr // error is passed to response
+		return oapi.PostApiTasksPurge500JSONResponse{Error: err.Error()}, nil
+	}
+
+	return oapi.PostApiTasksPurge200JSONResponse{AffectedTasks: int(purged)}, nil
+}
+
+// GetApiProfilesProfileIdWebhooks implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) GetApiProfilesProfileIdWebhooks(
+	ctx context.Context,
+	request oapi.GetApiProfilesProfileIdWebhooksRequestObject,
+) (oapi.GetApiProfilesProfileIdWebhooksResponseObject, error) {
+	webhooks, err := s.scout.ListWebhooks(ctx, int64(request.ProfileId))
+	if err != nil {
+		//nolint:nilerr // error is passed to response
+		return oapi.GetApiProfilesProfileIdWebhooks500JSONResponse{Error: err.Error()}, nil
+	}
+
+	return oapi.GetApiProfilesProfileIdWebhooks200JSONResponse(lo.Map(
+		webhooks,
+		func(webhook models.Webhook, _ int) oapi.Webhook {
+			return webhookFromModel(webhook, false)
+		},
+	)), nil
+}
+
+// PostApiProfilesProfileIdWebhooks implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) PostApiProfilesProfileIdWebhooks(
+	ctx context.Context,
+	request oapi.PostApiProfilesProfileIdWebhooksRequestObject,
+) (oapi.PostApiProfilesProfileIdWebhooksResponseObject, error) {
+	webhook := models.Webhook{
+		ProfileID: int64(request.ProfileId),
+		URL:       request.Body.Url,
+		Secret:    lo.FromPtr(request.Body.Secret),
+		Active:    lo.FromPtrOr(request.Body.Active, true),
+	}
+
+	created, found, err := s.scout.CreateWebhook(ctx, webhook)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidWebhook) {
+			//nolint:nilerr // error is passed to response
+			return oapi.PostApiProfilesProfileIdWebhooks400JSONResponse{Error: err.Error()}, nil
+		}
+
+		//nolint:nilerr // error is passed to response
+		return oapi.PostApiProfilesProfileIdWebhooks500JSONResponse{Error: err.Error()}, nil
+	}
+
+	if !found {
+		return oapi.PostApiProfilesProfileIdWebhooks404Response{}, nil
+	}
+
+	return oapi.PostApiProfilesProfileIdWebhooks200JSONResponse(webhookFromModel(created, true)), nil
+}
+
+// PutApiWebhooksWebhookId implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) PutApiWebhooksWebhookId(
+	ctx context.Context,
+	request oapi.PutApiWebhooksWebhookIdRequestObject,
+) (oapi.PutApiWebhooksWebhookIdResponseObject, error) {
+	update := models.WebhookUpdate{
+		WebhookID: int64(request.WebhookId),
+		URL:       request.Body.Url,
+		Secret:    request.Body.Secret,
+		Active:    request.Body.Active,
+	}
+
+	webhook, found, err := s.scout.UpdateWebhook(ctx, update)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidWebhook) {
+			//nolint:nilerr // error is passed to response
+			return oapi.PutApiWebhooksWebhookId400JSONResponse{Error: err.Error()}, nil
+		}
+
+		//nolint:nilerr // error is passed to response
+		return oapi.PutApiWebhooksWebhookId500JSONResponse{Error: err.Error()}, nil
+	}
+
+	if !found {
+		return oapi.PutApiWebhooksWebhookId404Response{}, nil
+	}
+
+	return oapi.PutApiWebhooksWebhookId200JSONResponse(webhookFromModel(webhook, false)), nil
+}
+
+// DeleteApiWebhooksWebhookId implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) DeleteApiWebhooksWebhookId(
+	ctx context.Context,
+	request oapi.DeleteApiWebhooksWebhookIdRequestObject,
+) (oapi.DeleteApiWebhooksWebhookIdResponseObject, error) {
+	found, err := s.scout.DeleteWebhook(ctx, int64(request.WebhookId))
+	if err != nil {
+		//nolint:nilerr // error is passed to response
+		return oapi.DeleteApiWebhooksWebhookId500JSONResponse{Error: err.Error()}, nil
+	}
+
+	if !found {
+		return oapi.DeleteApiWebhooksWebhookId404Response{}, nil
+	}
+
+	return oapi.DeleteApiWebhooksWebhookId204Response{}, nil
+}
+
+// GetApiWebhooksWebhookIdDeliveries implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) GetApiWebhooksWebhookIdDeliveries(
+	ctx context.Context,
+	request oapi.GetApiWebhooksWebhookIdDeliveriesRequestObject,
+) (oapi.GetApiWebhooksWebhookIdDeliveriesResponseObject, error) {
+	limit := int64(defaultWebhookDeliveriesListLimit)
+	if request.Params.Limit != nil {
+		limit = int64(*request.Params.Limit)
+	}
+
+	deliveries, found, err := s.scout.ListWebhookDeliveries(ctx, int64(request.WebhookId), limit)
+	if err != nil {
+		//nolint:nilerr // error is passed to response
+		return oapi.GetApiWebhooksWebhookIdDeliveries500JSONResponse{Error: err.Error()}, nil
+	}
+
+	if !found {
+		return oapi.GetApiWebhooksWebhookIdDeliveries404Response{}, nil
+	}
+
+	return oapi.GetApiWebhooksWebhookIdDeliveries200JSONResponse(lo.Map(
+		deliveries,
+		func(entry models.WebhookDeliveryLogEntry, _ int) oapi.WebhookDeliveryAttempt {
+			return webhookDeliveryAttemptFromModel(entry)
+		},
+	)), nil
+}
+
+// GetApiProfilesProfileIdTestCases implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) GetApiProfilesProfileIdTestCases(
+	ctx context.Context,
+	request oapi.GetApiProfilesProfileIdTestCasesRequestObject,
+) (oapi.GetApiProfilesProfileIdTestCasesResponseObject, error) {
+	testCases, err := s.scout.ListTestCases(ctx, int64(request.ProfileId))
+	if err != nil {
+		//nolint:nilerr // error is passed to response
+		return oapi.GetApiProfilesProfileIdTestCases500JSONResponse{Error: err.Error()}, nil
+	}
+
+	return oapi.GetApiProfilesProfileIdTestCases200JSONResponse(lo.Map(
+		testCases,
+		func(testCase models.TestCase, _ int) oapi.TestCase {
+			return testCaseFromModel(testCase)
+		},
+	)), nil
+}
+
+// PutApiProfilesProfileIdTestCases implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) PutApiProfilesProfileIdTestCases(
+	ctx context.Context,
+	request oapi.PutApiProfilesProfileIdTestCasesRequestObject,
+) (oapi.PutApiProfilesProfileIdTestCasesResponseObject, error) {
+	testCase := models.TestCase{
+		ProfileID:          int64(request.ProfileId),
+		Source:             request.Body.Source,
+		SourceID:           request.Body.SourceId,
+		ExpectedRelevant:   request.Body.ExpectedRelevant,
+		ExpectedProperties: lo.FromPtr(request.Body.ExpectedProperties),
+	}
+
+	upserted, found, err := s.scout.UpsertTestCase(ctx, testCase)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidTestCase) {
+			//nolint:nilerr // error is passed to response
+			return oapi.PutApiProfilesProfileIdTestCases400JSONResponse{Error: err.Error()}, nil
+		}
+
+		//nolint:nilerr // error is passed to response
+		return oapi.PutApiProfilesProfileIdTestCases500JSONResponse{Error: err.Error()}, nil
+	}
+
+	if !found {
+		return oapi.PutApiProfilesProfileIdTestCases404Response{}, nil
+	}
+
+	return oapi.PutApiProfilesProfileIdTestCases200JSONResponse(testCaseFromModel(upserted)), nil
+}
+
+// DeleteApiProfilesProfileIdTestCasesTestCaseId implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) DeleteApiProfilesProfileIdTestCasesTestCaseId(
+	ctx context.Context,
+	request oapi.DeleteApiProfilesProfileIdTestCasesTestCaseIdRequestObject,
+) (oapi.DeleteApiProfilesProfileIdTestCasesTestCaseIdResponseObject, error) {
+	found, err := s.scout.DeleteTestCase(ctx, int64(request.ProfileId), int64(request.TestCaseId))
+	if err != nil {
+		//nolint:nilerr // error is passed to response
+		return oapi.DeleteApiProfilesProfileIdTestCasesTestCaseId500JSONResponse{Error: err.Error()}, nil
+	}
+
+	if !found {
+		return oapi.DeleteApiProfilesProfileIdTestCasesTestCaseId404Response{}, nil
+	}
+
+	return oapi.DeleteApiProfilesProfileIdTestCasesTestCaseId204Response{}, nil
+}
+
+// GetApiProfilesProfileIdTestRuns implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) GetApiProfilesProfileIdTestRuns(
+	ctx context.Context,
+	request oapi.GetApiProfilesProfileIdTestRunsRequestObject,
+) (oapi.GetApiProfilesProfileIdTestRunsResponseObject, error) {
+	limit := int64(defaultTestRunListLimit)
+	if request.Params.Limit != nil {
+		limit = int64(*request.Params.Limit)
+	}
+
+	runs, err := s.scout.ListTestRuns(ctx, int64(request.ProfileId), limit)
+	if err != nil {
+		//nolint:nilerr // error is passed to response
+		return oapi.GetApiProfilesProfileIdTestRuns500JSONResponse{Error: err.Error()}, nil
+	}
+
+	return oapi.GetApiProfilesProfileIdTestRuns200JSONResponse(lo.Map(
+		runs,
+		func(run models.TestRun, _ int) oapi.TestRun {
+			return testRunFromModel(run)
+		},
+	)), nil
+}
+
+// PostApiProfilesProfileIdTestRuns implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) PostApiProfilesProfileIdTestRuns(
+	ctx context.Context,
+	request oapi.PostApiProfilesProfileIdTestRunsRequestObject,
+) (oapi.PostApiProfilesProfileIdTestRunsResponseObject, error) {
+	var draftSettings *models.ProfileSettings
+
+	if request.Body.Settings != nil {
+		draftSettings = lo.ToPtr(draftSettingsFromOapi(int64(request.ProfileId), *request.Body.Settings))
+	}
+
+	run, found, err := s.scout.StartTestRun(ctx, int64(request.ProfileId), draftSettings)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidTestRun) ||
+			errors.Is(err, models.ErrInvalidPropertyType) ||
+			errors.Is(err, models.ErrInvalidFewShotSettings) {
+			//nolint:nilerr // error is passed to response
+			return oapi.PostApiProfilesProfileIdTestRuns400JSONResponse{Error: err.Error()}, nil
+		}
+
+		//nolint:nilerr // error is passed to response
+		return oapi.PostApiProfilesProfileIdTestRuns500JSONResponse{Error: err.Error()}, nil
+	}
+
+	if !found {
+		return oapi.PostApiProfilesProfileIdTestRuns404Response{}, nil
+	}
+
+	return oapi.PostApiProfilesProfileIdTestRuns200JSONResponse(testRunFromModel(run)), nil
+}
+
+// GetApiProfilesProfileIdTestRunsTestRunId implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) GetApiProfilesProfileIdTestRunsTestRunId(
+	ctx context.Context,
+	request oapi.GetApiProfilesProfileIdTestRunsTestRunIdRequestObject,
+) (oapi.GetApiProfilesProfileIdTestRunsTestRunIdResponseObject, error) {
+	run, results, found, err := s.scout.GetTestRun(ctx, int64(request.ProfileId), int64(request.TestRunId))
+	if err != nil {
+		//nolint:nilerr // error is passed to response
+		return oapi.GetApiProfilesProfileIdTestRunsTestRunId500JSONResponse{Error: err.Error()}, nil
+	}
+
+	if !found {
+		return oapi.GetApiProfilesProfileIdTestRunsTestRunId404Response{}, nil
+	}
+
+	return oapi.GetApiProfilesProfileIdTestRunsTestRunId200JSONResponse{
+		Run: testRunFromModel(run),
+		Results: lo.Map(results, func(result models.TestResult, _ int) oapi.TestResult {
+			return testResultFromModel(result)
+		}),
+	}, nil
+}
+
+// GetApiProfilesProfileIdTestRunsTestRunIdDiff implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) GetApiProfilesProfileIdTestRunsTestRunIdDiff(
+	ctx context.Context,
+	request oapi.GetApiProfilesProfileIdTestRunsTestRunIdDiffRequestObject,
+) (oapi.GetApiProfilesProfileIdTestRunsTestRunIdDiffResponseObject, error) {
+	diff, found, err := s.scout.DiffTestRuns(
+		ctx,
+		int64(request.ProfileId),
+		int64(request.Params.Base),
+		int64(request.TestRunId),
+	)
+	if err != nil {
+		//nolint:nilerr // error is passed to response
+		return oapi.GetApiProfilesProfileIdTestRunsTestRunIdDiff500JSONResponse{Error: err.Error()}, nil
+	}
+
+	if !found {
+		return oapi.GetApiProfilesProfileIdTestRunsTestRunIdDiff404Response{}, nil
+	}
+
+	return oapi.GetApiProfilesProfileIdTestRunsTestRunIdDiff200JSONResponse{
+		Base:   testRunFromModel(diff.Base),
+		Target: testRunFromModel(diff.Target),
+		Changes: lo.Map(diff.Changes, func(change models.TestResultChange, _ int) oapi.TestResultChange {
+			return oapi.TestResultChange{
+				Source:   change.Source,
+				SourceId: change.SourceID,
+				Kind:     oapi.TestResultChangeKind(change.Kind),
+				Base:     optionalTestResultFromModel(change.Base),
+				Target:   optionalTestResultFromModel(change.Target),
+			}
+		}),
+	}, nil
+}
+
+// GetApiProfilesProfileIdShadows implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) GetApiProfilesProfileIdShadows(
+	ctx context.Context,
+	request oapi.GetApiProfilesProfileIdShadowsRequestObject,
+) (oapi.GetApiProfilesProfileIdShadowsResponseObject, error) {
+	limit := int64(defaultShadowListLimit)
+	if request.Params.Limit != nil {
+		limit = int64(*request.Params.Limit)
+	}
+
+	shadows, err := s.scout.ListShadows(ctx, int64(request.ProfileId), limit)
+	if err != nil {
+		//nolint:nilerr // error is passed to response
+		return oapi.GetApiProfilesProfileIdShadows500JSONResponse{Error: err.Error()}, nil
+	}
+
+	return oapi.GetApiProfilesProfileIdShadows200JSONResponse(lo.Map(
+		shadows,
+		func(shadow models.Shadow, _ int) oapi.Shadow {
+			return shadowFromModel(shadow)
+		},
+	)), nil
+}
+
+// PostApiProfilesProfileIdShadows implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) PostApiProfilesProfileIdShadows(
+	ctx context.Context,
+	request oapi.PostApiProfilesProfileIdShadowsRequestObject,
+) (oapi.PostApiProfilesProfileIdShadowsResponseObject, error) {
+	shadow, found, err := s.scout.StartShadow(
+		ctx,
+		int64(request.ProfileId),
+		request.Body.Source,
+		draftSettingsFromOapi(int64(request.ProfileId), request.Body.Settings),
+	)
+	if err != nil {
+		if errors.Is(err, models.ErrInvalidShadow) {
+			//nolint:nilerr // error is passed to response
+			return oapi.PostApiProfilesProfileIdShadows400JSONResponse{Error: err.Error()}, nil
+		}
+
+		//nolint:nilerr // error is passed to response
+		return oapi.PostApiProfilesProfileIdShadows500JSONResponse{Error: err.Error()}, nil
+	}
+
+	if !found {
+		return oapi.PostApiProfilesProfileIdShadows404Response{}, nil
+	}
+
+	return oapi.PostApiProfilesProfileIdShadows200JSONResponse(shadowFromModel(shadow)), nil
+}
+
+// GetApiProfilesProfileIdShadowsShadowId implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) GetApiProfilesProfileIdShadowsShadowId(
+	ctx context.Context,
+	request oapi.GetApiProfilesProfileIdShadowsShadowIdRequestObject,
+) (oapi.GetApiProfilesProfileIdShadowsShadowIdResponseObject, error) {
+	shadow, found, err := s.scout.GetShadow(ctx, int64(request.ProfileId), int64(request.ShadowId))
+	if err != nil {
+		//nolint:nilerr // error is passed to response
+		return oapi.GetApiProfilesProfileIdShadowsShadowId500JSONResponse{Error: err.Error()}, nil
+	}
+
+	if !found {
+		return oapi.GetApiProfilesProfileIdShadowsShadowId404Response{}, nil
+	}
+
+	return oapi.GetApiProfilesProfileIdShadowsShadowId200JSONResponse(shadowFromModel(shadow)), nil
+}
+
+// PostApiProfilesProfileIdShadowsShadowIdStop implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) PostApiProfilesProfileIdShadowsShadowIdStop(
+	ctx context.Context,
+	request oapi.PostApiProfilesProfileIdShadowsShadowIdStopRequestObject,
+) (oapi.PostApiProfilesProfileIdShadowsShadowIdStopResponseObject, error) {
+	shadow, found, err := s.scout.StopShadow(ctx, int64(request.ProfileId), int64(request.ShadowId))
+	if err != nil {
+		//nolint:nilerr // error is passed to response
+		return oapi.PostApiProfilesProfileIdShadowsShadowIdStop500JSONResponse{Error: err.Error()}, nil
+	}
+
+	if !found {
+		return oapi.PostApiProfilesProfileIdShadowsShadowIdStop404Response{}, nil
+	}
+
+	return oapi.PostApiProfilesProfileIdShadowsShadowIdStop200JSONResponse(shadowFromModel(shadow)), nil
+}
+
+// GetApiProfilesProfileIdShadowsShadowIdDisagreements implements oapi.StrictServerInterface.
+//
+//nolint:revive,staticcheck // naming is dictated by oapi-codegen
+func (s *Server) GetApiProfilesProfileIdShadowsShadowIdDisagreements(
+	ctx context.Context,
+	request oapi.GetApiProfilesProfileIdShadowsShadowIdDisagreementsRequestObject,
+) (oapi.GetApiProfilesProfileIdShadowsShadowIdDisagreementsResponseObject, error) {
+	limit := int64(defaultShadowDisagreementsLimit)
+	if request.Params.Limit != nil {
+		limit = int64(*request.Params.Limit)
+	}
+
+	results, found, err := s.scout.ListShadowDisagreements(
+		ctx,
+		int64(request.ProfileId),
+		int64(request.ShadowId),
+		limit,
+	)
+	if err != nil {
+		//nolint:nilerr // error is passed to response
+		return oapi.GetApiProfilesProfileIdShadowsShadowIdDisagreements500JSONResponse{Error: err.Error()}, nil
+	}
+
+	if !found {
+		return oapi.GetApiProfilesProfileIdShadowsShadowIdDisagreements404Response{}, nil
+	}
+
+	disagreements := make([]oapi.ShadowDisagreement, 0, len(results))
+
+	for _, result := range results {
+		if disagreement, ok := shadowDisagreementFromModel(result); ok {
+			disagreements = append(disagreements, disagreement)
+		}
+	}
+
+	return oapi.GetApiProfilesProfileIdShadowsShadowIdDisagreements200JSONResponse(disagreements), nil
+}
+
+func profileFromModel(profile models.Profile) oapi.Profile {
+	oapiProfile := oapi.Profile{
+		CreatedAt:       lo.ToPtr(profile.CreatedAt.Format(time.RFC3339)),
+		Id:              int(profile.ID),
+		Name:            profile.Name,
+		Active:          profile.Active,
+		DefaultSettings: nil,
+		SourcesSettings: &map[string]oapi.ProfileSettings{},
+		UpdatedAt:       lo.ToPtr(profile.UpdatedAt.Format(time.RFC3339)),
+	}
+
+	if profile.DefaultSettings != nil {
+		oapiProfile.DefaultSettings = lo.ToPtr(profileSettingsFromModel(*profile.DefaultSettings))
+	}
+
+	for source, settings := range profile.SourcesSettings {
+		(*oapiProfile.SourcesSettings)[source] = profileSettingsFromModel(settings)
+	}
+
+	return oapiProfile
+}
+
+func profileSettingsFromModel(settings models.ProfileSettings) oapi.ProfileSettings {
+	return oapi.ProfileSettings{
+		Version:             int(settings.Version),
+		ExtractedProperties: settings.ExtractedProperties,
+		RelevancyFilter:     settings.RelevancyFilter,
+		PropertyTypes:       propertyTypesFromModel(settings.PropertyTypes),
+		Model:               settings.Model,
+		FewShot:             fewShotSettingsFromModel(settings.FewShot),
+		CreatedAt:           lo.ToPtr(settings.CreatedAt.Format(time.RFC3339)),
+		UpdatedAt:           lo.ToPtr(settings.UpdatedAt.Format(time.RFC3339)),
+	}
+}
+
+func profileSettingsVersionFromModel(version models.ProfileSettingsVersion) oapi.ProfileSettingsVersion {
+	oapiVersion := oapi.ProfileSettingsVersion{
+		ProfileId:           int(version.ProfileID),
+		Source:              oapinullable.NewNullNullable[string](),
+		Version:             int(version.Version),
+		RelevancyFilter:     version.RelevancyFilter,
+		ExtractedProperties: version.ExtractedProperties,
+		PropertyTypes:       propertyTypesFromModel(version.PropertyTypes),
+		Model:               version.Model,
+		FewShot:             fewShotSettingsFromModel(version.FewShot),
+		CreatedAt:           version.CreatedAt.Format(time.RFC3339),
+	}
+
+	if version.Source != nil {
+		oapiVersion.Source = oapinullable.NewNullableWithValue(*version.Source)
+	}
+
+	return oapiVersion
+}
+
+func fewShotSettingsFromModel(settings *models.FewShotSettings) *oapi.FewShotSettings {
+	if settings == nil {
+		return nil
+	}
+
+	return &oapi.FewShotSettings{
+		Examples:  settings.Examples,
+		Strategy:  oapi.FewShotSettingsStrategy(settings.Strategy),
+		MaxTokens: settings.MaxTokens,
+	}
+}
+
+func propertyTypesFromModel(propertyTypes map[string]models.PropertyType) *map[string]oapi.PropertyType {
+	if len(propertyTypes) == 0 {
+		return nil
+	}
+
+	oapiPropertyTypes := lo.MapValues(propertyTypes, func(propertyType models.PropertyType, _ string) oapi.PropertyType {
+		return oapi.PropertyType{
+			Kind:     oapi.PropertyTypeKind(propertyType.Kind),
+			Enum:     lo.EmptyableToPtr(propertyType.Enum),
+			Required: lo.ToPtr(propertyType.Required),
+		}
+	})
+
+	return &oapiPropertyTypes
+}
+
+func hackerNewsFeedSettingsFromModel(settings hackernews.FeedSettings) oapi.HackerNewsFeedSettings {
+	return oapi.HackerNewsFeedSettings{
+		Feed:     settings.Feed,
+		Profiles: lo.Map(settings.Profiles, func(id int64, _ int) int { return int(id) }),
+	}
+}
+
+func rssFeedSettingsFromModel(settings rss.FeedSettings) oapi.RSSFeedSettings {
+	return oapi.RSSFeedSettings{
+		FeedUrl:  settings.FeedURL,
+		Profiles: lo.Map(settings.Profiles, func(id int64, _ int) int { return int(id) }),
+	}
+}
+
+func subredditSettingsFromModel(settings reddit.SubredditSettings) oapi.SubredditSettings {
+	return oapi.SubredditSettings{
+		Subreddit: settings.Subreddit,
+		Profiles:  lo.Map(settings.Profiles, func(id int64, _ int) int { return int(id) }),
+	}
+}
+
+func subredditScrapeStateFromModel(state reddit.SubredditScrapeState) oapi.SubredditScrapeState {
+	return oapi.SubredditScrapeState{
+		Subreddit:      state.Subreddit,
+		NextPage:       state.Next,
+		AvailableAt:    state.AvailableAt.Format(time.RFC3339),
+		LastScrapedAt:  optionalTimeFromModel(state.LastScrapedAt),
+		LastFullScanAt: optionalTimeFromModel(state.LastFullScanAt),
+		LastError:      state.LastError,
+		LastErrorAt:    optionalTimeFromModel(state.LastErrorAt),
+	}
+}
+
+func analyzerLimiterFromModel(stats llm.LimiterStats) oapi.AnalyzerLimiter {
+	return oapi.AnalyzerLimiter{
+		Provider:          stats.Provider,
+		Model:             stats.Model,
+		RequestsPerMinute: float32(stats.RequestsPerMinute),
+		TokensPerMinute:   float32(stats.TokensPerMinute),
+		MaxConcurrency:    stats.MaxConcurrency,
+		InFlight:          stats.InFlight,
+		Waiting:           stats.Waiting,
+		PausedUntil:       optionalTimeFromModel(stats.PausedUntil),
+		RequestsTotal:     int(stats.RequestsTotal),
+		TokensTotal:       int(stats.TokensTotal),
+		RateLimitedTotal:  int(stats.RateLimitedTotal),
+	}
+}
+
+func optionalTimeFromModel(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+
+	return lo.ToPtr(t.Format(time.RFC3339))
+}
+
+func detectionTagsFromModel(tags models.DetectionTags) oapi.DetectionTags {
+	return oapi.DetectionTags{
+		RelevancyDetectedCorrectly: tags.RelevancyDetectedCorrectly,
+	}
+}
+
+func detectionFromModel(detection models.Detection) oapi.Detection {
+	return oapi.Detection{
+		IsRelevant: detection.IsRelevant,
+		Confidence: lo.ToPtr(detection.Confidence),
+		Rationale:  lo.EmptyableToPtr(detection.Rationale),
+		Properties: detection.Properties,
+		Model:      lo.EmptyableToPtr(detection.Model),
+		ExampleIds: exampleIDsFromModel(detection.ExampleIDs),
+	}
+}
+
+func exampleIDsFromModel(exampleIDs []int64) *[]int {
+	if len(exampleIDs) == 0 {
+		return nil
+	}
+
+	return lo.ToPtr(lo.Map(exampleIDs, func(id int64, _ int) int {
+		return int(id)
+	}))
+}
+
+func profileStatisticsFromModel(statistics models.ProfileStatistics) oapi.ProfileStatistics {
+	return oapi.ProfileStatistics{
+		ProfileId:  int(statistics.ProfileID),
+		Detections: detectionStatisticsFromModel(statistics.Detections),
+		Sources: lo.Map(statistics.Sources, func(source models.SourceStatistics, _ int) oapi.SourceStatistics {
+			return oapi.SourceStatistics{
+				Source:     source.Source,
+				Detections: detectionStatisticsFromModel(source.Detections),
+				SettingsVersions: lo.Map(
+					source.SettingsVersions,
+					func(version models.SettingsVersionStatistics, _ int) oapi.SettingsVersionStatistics {
+						return oapi.SettingsVersionStatistics{
+							Version:    int(version.SettingsVersion),
+							Detections: detectionStatisticsFromModel(version.Detections),
+						}
+					},
+				),
+			}
+		}),
+		Tasks: oapi.TaskStatistics{
+			Pending:   int(statistics.Tasks.Pending),
+			Claimed:   int(statistics.Tasks.Claimed),
+			Failed:    int(statistics.Tasks.Failed),
+			Committed: int(statistics.Tasks.Committed),
+		},
+	}
+}
+
+func detectionStatisticsFromModel(statistics models.DetectionStatistics) oapi.DetectionStatistics {
+	return oapi.DetectionStatistics{
+		Total:         int(statistics.Total),
+		Relevant:      int(statistics.Relevant),
+		Irrelevant:    int(statistics.Irrelevant),
+		TaggedCorrect: int(statistics.TaggedCorrect),
+		TaggedWrong:   int(statistics.TaggedWrong),
+	}
+}
+
+func settingsVersionAccuracyFromModel(accuracy models.SettingsVersionAccuracy) oapi.SettingsVersionAccuracy {
+	return oapi.SettingsVersionAccuracy{
+		Version:        int(accuracy.SettingsVersion),
+		Detections:     int(accuracy.Detections),
+		Tagged:         int(accuracy.Tagged),
+		TruePositives:  int(accuracy.TruePositives),
+		FalsePositives: int(accuracy.FalsePositives),
+		TrueNegatives:  int(accuracy.TrueNegatives),
+		FalseNegatives: int(accuracy.FalseNegatives),
+		Precision:      accuracy.Precision(),
+		Recall:         accuracy.Recall(),
+		F1:             accuracy.F1(),
+		Accuracy:       accuracy.Accuracy(),
+	}
+}
+
+func analysisTaskFromModel(task models.AnalysisTaskRecord) oapi.AnalysisTask {
+	oapiTask := oapi.AnalysisTask{
+		Id:            int(task.ID),
+		Type:          task.Type,
+		Priority:      task.Priority,
+		Status:        oapi.TaskStatus(task.Status),
+		Source:        task.Parameters.Source,
+		SourceId:      task.Parameters.SourceID,
+		ProfileId:     int(task.Parameters.ProfileID),
+		ShouldSave:    task.Parameters.ShouldSave,
+		TestRunId:     nil,
+		ShadowId:      nil,
+		Errors:        task.Errors,
+		CreatedAt:     task.CreatedAt.Format(time.RFC3339),
+		ClaimedAt:     optionalTimeFromModel(task.ClaimedAt),
+		CommittedAt:   optionalTimeFromModel(task.CommittedAt),
+		FailedAt:      optionalTimeFromModel(task.FailedAt),
+		FailureReason: task.FailureReason,
+	}
+
+	if task.Parameters.TestRunID != nil {
+		oapiTask.TestRunId = lo.ToPtr(int(*task.Parameters.TestRunID))
+	}
+
+	if task.Parameters.ShadowID != nil {
+		oapiTask.ShadowId = lo.ToPtr(int(*task.Parameters.ShadowID))
+	}
+
+	return oapiTask
+}
+
+func testCaseFromModel(testCase models.TestCase) oapi.TestCase {
+	return oapi.TestCase{
+		Id:                 int(testCase.ID),
+		ProfileId:          int(testCase.ProfileID),
+		Source:             testCase.Source,
+		SourceId:           testCase.SourceID,
+		ExpectedRelevant:   testCase.ExpectedRelevant,
+		ExpectedProperties: testCase.ExpectedProperties,
+		CreatedAt:          testCase.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:          testCase.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func testRunFromModel(run models.TestRun) oapi.TestRun {
+	settings := oapi.TestRunSettings{
+		Draft:           run.Settings.Draft,
+		DefaultSettings: nil,
+		SourcesSettings: nil,
+	}
+
+	if run.Settings.DefaultSettings != nil {
+		settings.DefaultSettings = lo.ToPtr(profileSettingsFromModel(*run.Settings.DefaultSettings))
+	}
+
+	if len(run.Settings.SourcesSettings) > 0 {
+		settings.SourcesSettings = lo.ToPtr(lo.MapValues(
+			run.Settings.SourcesSettings,
+			func(settings models.ProfileSettings, _ string) oapi.ProfileSettings {
+				return profileSettingsFromModel(settings)
+			},
+		))
+	}
+
+	summary := run.Summary
+
+	return oapi.TestRun{
+		Id:        int(run.ID),
+		ProfileId: int(run.ProfileID),
+		Status:    oapi.TestRunStatus(run.Status()),
+		Settings:  settings,
+		Summary: oapi.TestRunSummary{
+			Total:             int(summary.Total),
+			Pending:           int(summary.Pending),
+			Failed:            int(summary.Failed),
+			Passed:            int(summary.Passed),
+			TruePositives:     int(summary.TruePositives),
+			FalsePositives:    int(summary.FalsePositives),
+			TrueNegatives:     int(summary.TrueNegatives),
+			FalseNegatives:    int(summary.FalseNegatives),
+			PropertiesChecked: int(summary.PropertiesChecked),
+			PropertiesMatched: int(summary.PropertiesMatched),
+			Precision:         summary.Precision(),
+			Recall:            summary.Recall(),
+			Accuracy:          summary.Accuracy(),
+			PropertyAccuracy:  summary.PropertyAccuracy(),
+		},
+		CreatedAt:   run.CreatedAt.Format(time.RFC3339),
+		CompletedAt: optionalTimeFromModel(run.CompletedAt),
+	}
+}
+
+func testResultFromModel(result models.TestResult) oapi.TestResult {
+	oapiResult := oapi.TestResult{
+		TestCaseId:           int(result.TestCaseID),
+		Source:               result.Source,
+		SourceId:             result.SourceID,
+		ExpectedRelevant:     result.ExpectedRelevant,
+		ExpectedProperties:   result.ExpectedProperties,
+		IsRelevant:           nil,
+		Properties:           nil,
+		Model:                nil,
+		Error:                result.Error,
+		MismatchedProperties: result.MismatchedProperties,
+		Passed:               result.Passed(),
+		CompletedAt:          optionalTimeFromModel(result.CompletedAt),
+	}
+
+	if result.Detection != nil {
+		oapiResult.IsRelevant = lo.ToPtr(result.Detection.IsRelevant)
+		oapiResult.Properties = lo.ToPtr(result.Detection.Properties)
+		oapiResult.Model = lo.EmptyableToPtr(result.Detection.Model)
+	}
+
+	return oapiResult
+}
+
+func optionalTestResultFromModel(result *models.TestResult) *oapi.TestResult {
+	if result == nil {
+		return nil
+	}
+
+	return lo.ToPtr(testResultFromModel(*result))
+}
+
+// draftSettingsFromOapi converts unsaved settings of a profile, they are used for posts of all sources.
+func draftSettingsFromOapi(profileID int64, settings oapi.DraftProfileSettings) models.ProfileSettings {
+	return models.ProfileSettings{
+		ProfileID:           profileID,
+		RelevancyFilter:     settings.RelevancyFilter,
+		ExtractedProperties: settings.ExtractedProperties,
+		PropertyTypes:       propertyTypesFromOapi(lo.FromPtr(settings.PropertyTypes)),
+		Model:               settings.Model,
+		FewShot:             fewShotSettingsFromOapi(settings.FewShot),
+	}
+}
+
+func shadowFromModel(shadow models.Shadow) oapi.Shadow {
+	return oapi.Shadow{
+		Id:        int(shadow.ID),
+		ProfileId: int(shadow.ProfileID),
+		Source:    shadow.Source,
+		Settings:  profileSettingsFromModel(shadow.Settings),
+		Active:    shadow.Active(),
+		Summary: oapi.ShadowSummary{
+			Analyzed:               int(shadow.Summary.Analyzed),
+			Failed:                 int(shadow.Summary.Failed),
+			Unpaired:               int(shadow.Summary.Unpaired),
+			Agreed:                 int(shadow.Summary.Agreed),
+			RelevanceDisagreements: int(shadow.Summary.RelevanceDisagreements),
+			PropertyDisagreements:  int(shadow.Summary.PropertyDisagreements),
+			ProductionRelevant:     int(shadow.Summary.ProductionRelevant),
+			ShadowRelevant:         int(shadow.Summary.ShadowRelevant),
+		},
+		CreatedAt: shadow.CreatedAt.Format(time.RFC3339),
+		StoppedAt: optionalTimeFromModel(shadow.StoppedAt),
+	}
+}
+
+// shadowDisagreementFromModel converts a shadow result, results that don't disagree are skipped.
+func shadowDisagreementFromModel(result models.ShadowResult) (oapi.ShadowDisagreement, bool) {
+	kind, disagrees := result.Disagreement()
+	if !disagrees {
+		return oapi.ShadowDisagreement{}, false
+	}
+
+	production := result.ProductionDetection
+
+	return oapi.ShadowDisagreement{
+		Source:   result.Source,
+		SourceId: result.SourceID,
+		Kind:     oapi.ShadowDisagreementKind(kind),
+		Production: oapi.Detection{
+			Id:              int(production.ID),
+			Source:          production.Source,
+			SourceId:        production.SourceID,
+			ProfileId:       int(production.ProfileID),
+			SettingsVersion: int(production.SettingsVersion),
+			IsRelevant:      production.IsRelevant,
+			Properties:      production.Properties,
+			Model:           production.Model,
+			CreatedAt:       production.CreatedAt.Format(time.RFC3339),
+		},
+		IsRelevant:           result.Detection.IsRelevant,
+		Properties:           result.Detection.Properties,
+		Model:                lo.EmptyableToPtr(result.Detection.Model),
+		MismatchedProperties: result.MismatchedProperties(),
+		ShadowedAt:           result.CreatedAt.Format(time.RFC3339),
+	}, true
+}
+
+// webhookFromModel converts a webhook to its API representation. The secret is included only if withSecret is true.
+func webhookFromModel(webhook models.Webhook, withSecret bool) oapi.Webhook {
+	oapiWebhook := oapi.Webhook{
+		Id:        int(webhook.ID),
+		ProfileId: int(webhook.ProfileID),
+		Url:       webhook.URL,
+		Secret:    nil,
+		Active:    webhook.Active,
+		CreatedAt: webhook.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: webhook.UpdatedAt.Format(time.RFC3339),
+	}
+
+	if withSecret {
+		oapiWebhook.Secret = lo.ToPtr(webhook.Secret)
+	}
+
+	return oapiWebhook
+}
+
+func webhookDeliveryAttemptFromModel(entry models.WebhookDeliveryLogEntry) oapi.WebhookDeliveryAttempt {
+	return oapi.WebhookDeliveryAttempt{
+		Id:          int(entry.ID),
+		EventId:     int(entry.OutboxID),
+		WebhookId:   int(entry.WebhookID),
+		DetectionId: int(entry.DetectionID),
+		Event:       entry.Event,
+		Status:      oapi.WebhookDeliveryAttemptStatus(entry.Status),
+		Attempt:     entry.Attempt,
+		StatusCode:  entry.StatusCode,
+		Error:       entry.Error,
+		DurationMs:  int(entry.Duration.Milliseconds()),
+		CreatedAt:   entry.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func profileFromOapi(profile oapi.Profile) models.Profile {
+	modelProfile := models.Profile{
+		ID:              int64(profile.Id),
 		Name:            profile.Name,
 		DefaultSettings: nil,
 		SourcesSettings: map[string]models.ProfileSettings{},
@@ -549,7 +2093,32 @@ func profileSettingsFromOapi(settings oapi.ProfileSettings) models.ProfileSettin
 	return models.ProfileSettings{
 		ExtractedProperties: settings.ExtractedProperties,
 		RelevancyFilter:     settings.RelevancyFilter,
+		PropertyTypes:       propertyTypesFromOapi(lo.FromPtr(settings.PropertyTypes)),
+		Model:               settings.Model,
+		FewShot:             fewShotSettingsFromOapi(settings.FewShot),
+	}
+}
+
+func fewShotSettingsFromOapi(settings *oapi.FewShotSettings) *models.FewShotSettings {
+	if settings == nil {
+		return nil
 	}
+
+	return &models.FewShotSettings{
+		Examples:  settings.Examples,
+		Strategy:  models.FewShotStrategy(settings.Strategy),
+		MaxTokens: settings.MaxTokens,
+	}
+}
+
+func propertyTypesFromOapi(propertyTypes map[string]oapi.PropertyType) map[string]models.PropertyType {
+	return lo.MapValues(propertyTypes, func(propertyType oapi.PropertyType, _ string) models.PropertyType {
+		return models.PropertyType{
+			Kind:     models.PropertyKind(propertyType.Kind),
+			Enum:     lo.FromPtr(propertyType.Enum),
+			Required: lo.FromPtr(propertyType.Required),
+		}
+	})
 }
 
 func profileUpdateFromOapi(profileID int64, update oapi.ProfileUpdate) models.ProfileUpdate {
@@ -589,6 +2158,20 @@ func profileSettingsUpdateFromOapi(settings oapi.ProfileSettingsUpdate) models.P
 	modelProfileSettingsUpdate := models.ProfileSettingsUpdate{
 		RelevancyFilter:     settings.RelevancyFilter,
 		ExtractedProperties: nil,
+		PropertyTypes:       nil,
+		Model:               nullableFromOapi(settings.Model),
+		FewShot:             nullable.Unset[models.FewShotSettings](),
+	}
+
+	if fewShot := nullableFromOapi(settings.FewShot); fewShot.IsSet() {
+		modelProfileSettingsUpdate.FewShot = nullable.Nullable[models.FewShotSettings]{
+			Value: fewShotSettingsFromOapi(fewShot.Value),
+			Set:   true,
+		}
+	}
+
+	if settings.PropertyTypes != nil {
+		modelProfileSettingsUpdate.PropertyTypes = lo.ToPtr(propertyTypesFromOapi(*settings.PropertyTypes))
 	}
 
 	if settings.ExtractedProperties != nil {
@@ -608,11 +2191,15 @@ func profileSettingsUpdateFromOapi(settings oapi.ProfileSettingsUpdate) models.P
 	return modelProfileSettingsUpdate
 }
 
-func detectionQueryFromOapi(request oapi.DetectionListRequest) models.DetectionQuery {
+func detectionQueryFromOapi(request oapi.DetectionListRequest) (models.DetectionQuery, error) {
 	query := models.DetectionQuery{
 		LastSeenID: nil,
 		Limit:      defaultDetectionListQueryLimit,
+		Order:      models.DetectionOrderDesc,
 		Filter:     &models.DetectionFilter{},
+		Search:     nil,
+		Sort:       models.DetectionSort{Field: models.DetectionSortByID, Property: ""},
+		After:      nil,
 	}
 
 	if request.LastSeenId != nil {
@@ -627,14 +2214,53 @@ func detectionQueryFromOapi(request oapi.DetectionListRequest) models.DetectionQ
 		query.Filter = lo.ToPtr(detectionFilterFromOapi(*request.Filter))
 	}
 
-	return query
+	if request.Order != nil && *request.Order == oapi.Asc {
+		query.Order = models.DetectionOrderAsc
+	}
+
+	if request.Q != nil && *request.Q != "" {
+		query.Search = request.Q
+		query.Sort.Field = models.DetectionSortByRank
+	}
+
+	if request.SortProperty != nil {
+		query.Sort = models.DetectionSort{Field: models.DetectionSortByProperty, Property: *request.SortProperty}
+	}
+
+	if request.SortBy != nil {
+		switch *request.SortBy {
+		case oapi.Id:
+			query.Sort.Field = models.DetectionSortByID
+		case oapi.CreatedAt:
+			query.Sort.Field = models.DetectionSortByCreatedAt
+		case oapi.Property:
+			query.Sort.Field = models.DetectionSortByProperty
+		case oapi.Rank:
+			query.Sort.Field = models.DetectionSortByRank
+		case oapi.Confidence:
+			query.Sort.Field = models.DetectionSortByConfidence
+		}
+	}
+
+	if request.After != nil {
+		cursor, err := models.ParseDetectionCursor(*request.After)
+		if err != nil {
+			return models.DetectionQuery{}, err
+		}
+
+		query.After = &cursor
+	}
+
+	return query, nil
 }
 
 func detectionFilterFromOapi(filter oapi.DetectionFilter) models.DetectionFilter {
 	modelFilter := models.DetectionFilter{
-		Sources:    filter.Sources,
-		IsRelevant: filter.IsRelevant,
-		Tags:       models.DetectionTagsFilter{},
+		Sources:       filter.Sources,
+		IsRelevant:    filter.IsRelevant,
+		MinConfidence: filter.MinConfidence,
+		MaxConfidence: filter.MaxConfidence,
+		Tags:          models.DetectionTagsFilter{},
 	}
 
 	if filter.Profiles != nil {
@@ -664,6 +2290,23 @@ func detectionFilterFromOapi(filter oapi.DetectionFilter) models.DetectionFilter
 		}
 	}
 
+	if filter.Properties != nil {
+		modelFilter.Properties = lo.Map(
+			*filter.Properties,
+			func(propertyFilter oapi.PropertyFilter, _ int) models.PropertyFilter {
+				return models.PropertyFilter{
+					Key:      propertyFilter.Key,
+					Equals:   lo.FromPtr(propertyFilter.Equals),
+					Contains: propertyFilter.Contains,
+					Exists:   propertyFilter.Exists,
+					Gte:      propertyFilter.Gte,
+					Lte:      propertyFilter.Lte,
+					Regex:    propertyFilter.Regex,
+				}
+			},
+		)
+	}
+
 	return modelFilter
 }
 
@@ -0,0 +1,335 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"github.com/samber/lo"
+
+	"github.com/rishenco/scout/internal/config"
+	"github.com/rishenco/scout/internal/llm"
+	"github.com/rishenco/scout/internal/openai"
+	"github.com/rishenco/scout/internal/pg"
+	"github.com/rishenco/scout/internal/sources/hackernews"
+	hackernewsanalyzers "github.com/rishenco/scout/internal/sources/hackernews/analyzers"
+	"github.com/rishenco/scout/internal/sources/reddit"
+	redditanalyzers "github.com/rishenco/scout/internal/sources/reddit/analyzers"
+	"github.com/rishenco/scout/internal/sources/rss"
+	rssanalyzers "github.com/rishenco/scout/internal/sources/rss/analyzers"
+	"github.com/rishenco/scout/internal/tools"
+	"github.com/rishenco/scout/pkg/models"
+)
+
+type redditAnalyzer interface {
+	Analyze(
+		ctx context.Context,
+		input reddit.AnalysisInput,
+		profileSettings models.ProfileSettings,
+	) (models.Detection, error)
+}
+
+type hackernewsAnalyzer interface {
+	Analyze(
+		ctx context.Context,
+		input hackernews.AnalysisInput,
+		profileSettings models.ProfileSettings,
+	) (models.Detection, error)
+}
+
+type rssAnalyzer interface {
+	Analyze(ctx context.Context, input rss.AnalysisInput, profileSettings models.ProfileSettings) (models.Detection, error)
+}
+
+type sourceAnalyzers struct {
+	reddit     redditAnalyzer
+	hackernews hackernewsAnalyzer
+	rss        rssAnalyzer
+}
+
+type limiterKey struct {
+	provider string
+	model    string
+}
+
+// modelLimiters are request limiters of models of the analyzers chain shared by analyzers of all sources.
+//
+// Each model has its own limiter configured by the rate limit of its provider, since providers enforce
+// quotas per model: rate-limited responses of one model must not throttle its fallback of the same provider.
+type modelLimiters struct {
+	limiters map[limiterKey]*llm.Limiter
+	ordered  llm.Limiters
+}
+
+func newModelLimiters(settingsConfig config.SettingsConfig, logger zerolog.Logger) (modelLimiters, error) {
+	limiters := modelLimiters{
+		limiters: make(map[limiterKey]*llm.Limiter),
+		ordered:  nil,
+	}
+
+	for _, entry := range analyzerChain(settingsConfig) {
+		key := limiterKey{provider: entry.Provider, model: entry.Model}
+		if _, ok := limiters.limiters[key]; ok {
+			continue
+		}
+
+		var rateLimit config.RateLimitConfig
+
+		switch entry.Provider {
+		case config.GoogleProvider:
+			rateLimit = settingsConfig.Google.RateLimit
+		case config.OpenAICompatibleProvider:
+			rateLimit = settingsConfig.OpenAICompatible.RateLimit
+		default:
+			return modelLimiters{}, fmt.Errorf("unknown analyzer provider: %s", entry.Provider)
+		}
+
+		limiter := llm.NewLimiter(
+			entry.Provider,
+			entry.Model,
+			limiterSettings(rateLimit),
+			componentLogger(logger, entry.Provider+"_limiter"),
+		)
+
+		limiters.limiters[key] = limiter
+		limiters.ordered = append(limiters.ordered, limiter)
+	}
+
+	return limiters, nil
+}
+
+// limiter returns the limiter of the model of a chain entry.
+func (l modelLimiters) limiter(entry config.AnalyzerChainEntry) *llm.Limiter {
+	return l.limiters[limiterKey{provider: entry.Provider, model: entry.Model}]
+}
+
+func (l modelLimiters) all() llm.Limiters {
+	return l.ordered
+}
+
+func limiterSettings(rateLimit config.RateLimitConfig) llm.LimiterSettings {
+	return llm.LimiterSettings{
+		RequestsPerMinute: rateLimit.RequestsPerMinute,
+		TokensPerMinute:   rateLimit.TokensPerMinute,
+		MaxConcurrency:    rateLimit.MaxConcurrency,
+	}
+}
+
+// newSourceAnalyzers creates analyzers of all sources backed by the analyzers chain configured in settings.
+//
+// Analyzers of the chain are tried in order, falling back to the next one on retryable errors.
+func newSourceAnalyzers(
+	ctx context.Context,
+	settingsConfig config.SettingsConfig,
+	credentialsConfig config.CredentialsConfig,
+	requestsStorage *pg.RequestsStorage,
+	limiters modelLimiters,
+	logger zerolog.Logger,
+) (sourceAnalyzers, error) {
+	chain := analyzerChain(settingsConfig)
+
+	redditChain := make([]llm.ModelAnalyzer[reddit.AnalysisInput], 0, len(chain))
+	hackernewsChain := make([]llm.ModelAnalyzer[hackernews.AnalysisInput], 0, len(chain))
+	rssChain := make([]llm.ModelAnalyzer[rss.AnalysisInput], 0, len(chain))
+
+	for _, entry := range chain {
+		var (
+			analyzers sourceAnalyzers
+			err       error
+		)
+
+		switch entry.Provider {
+		case config.GoogleProvider:
+			analyzers, err = newGeminiAnalyzers(
+				ctx,
+				entry,
+				settingsConfig,
+				credentialsConfig,
+				requestsStorage,
+				limiters.limiter(entry),
+				logger,
+			)
+			if err != nil {
+				return sourceAnalyzers{}, err
+			}
+		case config.OpenAICompatibleProvider:
+			analyzers = newOpenAICompatibleAnalyzers(
+				entry,
+				settingsConfig,
+				credentialsConfig,
+				requestsStorage,
+				limiters.limiter(entry),
+				logger,
+			)
+		default:
+			return sourceAnalyzers{}, fmt.Errorf("unknown analyzer provider: %s", entry.Provider)
+		}
+
+		redditChain = append(redditChain, llm.ModelAnalyzer[reddit.AnalysisInput]{
+			Model:    entry.Model,
+			Analyzer: analyzers.reddit,
+		})
+		hackernewsChain = append(hackernewsChain, llm.ModelAnalyzer[hackernews.AnalysisInput]{
+			Model:    entry.Model,
+			Analyzer: analyzers.hackernews,
+		})
+		rssChain = append(rssChain, llm.ModelAnalyzer[rss.AnalysisInput]{
+			Model:    entry.Model,
+			Analyzer: analyzers.rss,
+		})
+	}
+
+	return sourceAnalyzers{
+		reddit:     llm.NewFallback(redditChain, componentLogger(logger, "reddit_analyzer")),
+		hackernews: llm.NewFallback(hackernewsChain, componentLogger(logger, "hackernews_analyzer")),
+		rss:        llm.NewFallback(rssChain, componentLogger(logger, "rss_analyzer")),
+	}, nil
+}
+
+// analyzerChain returns the configured analyzers chain with model and temperature defaults
+// taken from the provider's section. If no chain is configured, the chain consists of the single
+// analyzer of the default provider.
+func analyzerChain(settingsConfig config.SettingsConfig) []config.AnalyzerChainEntry {
+	chain := settingsConfig.Analyzer.Chain
+	if len(chain) == 0 {
+		provider := settingsConfig.Analyzer.Provider
+		if provider == "" {
+			provider = config.GoogleProvider
+		}
+
+		chain = []config.AnalyzerChainEntry{{Provider: provider, Model: "", Temperature: nil}}
+	}
+
+	resolved := make([]config.AnalyzerChainEntry, 0, len(chain))
+
+	for _, entry := range chain {
+		switch entry.Provider {
+		case config.GoogleProvider:
+			entry.Model = lo.CoalesceOrEmpty(entry.Model, settingsConfig.Google.Model)
+			entry.Temperature = lo.CoalesceOrEmpty(entry.Temperature, &settingsConfig.Google.Temperature)
+		case config.OpenAICompatibleProvider:
+			entry.Model = lo.CoalesceOrEmpty(entry.Model, settingsConfig.OpenAICompatible.Model)
+			entry.Temperature = lo.CoalesceOrEmpty(entry.Temperature, &settingsConfig.OpenAICompatible.Temperature)
+		}
+
+		resolved = append(resolved, entry)
+	}
+
+	return resolved
+}
+
+func newGeminiAnalyzers(
+	ctx context.Context,
+	entry config.AnalyzerChainEntry,
+	settingsConfig config.SettingsConfig,
+	credentialsConfig config.CredentialsConfig,
+	requestsStorage *pg.RequestsStorage,
+	limiter *llm.Limiter,
+	logger zerolog.Logger,
+) (sourceAnalyzers, error) {
+	redditGeminiAI, err := redditanalyzers.NewGemini(
+		ctx,
+		credentialsConfig.GeminiAPIKey,
+		llm.GeminiSettings{
+			Model:       entry.Model,
+			Temperature: lo.FromPtr(entry.Temperature),
+		},
+		tools.WrapRequestsStorage(requestsStorage, "reddit_gemini_analyzer"),
+		limiter,
+		settingsConfig.Reddit.AI.MaxCommentsPerPost,
+		componentLogger(logger, "reddit_gemini_analyzer"),
+	)
+	if err != nil {
+		return sourceAnalyzers{}, fmt.Errorf("create gemini reddit analyzer (%s): %w", entry.Model, err)
+	}
+
+	hackernewsGeminiAI, err := hackernewsanalyzers.NewGemini(
+		ctx,
+		credentialsConfig.GeminiAPIKey,
+		llm.GeminiSettings{
+			Model:       entry.Model,
+			Temperature: lo.FromPtr(entry.Temperature),
+		},
+		tools.WrapRequestsStorage(requestsStorage, "hackernews_gemini_analyzer"),
+		limiter,
+		settingsConfig.HackerNews.AI.MaxCommentsPerStory,
+		componentLogger(logger, "hackernews_gemini_analyzer"),
+	)
+	if err != nil {
+		return sourceAnalyzers{}, fmt.Errorf("create gemini hacker news analyzer (%s): %w", entry.Model, err)
+	}
+
+	rssGeminiAI, err := rssanalyzers.NewGemini(
+		ctx,
+		credentialsConfig.GeminiAPIKey,
+		llm.GeminiSettings{
+			Model:       entry.Model,
+			Temperature: lo.FromPtr(entry.Temperature),
+		},
+		tools.WrapRequestsStorage(requestsStorage, "rss_gemini_analyzer"),
+		limiter,
+		settingsConfig.RSS.AI.MaxContentLength,
+		componentLogger(logger, "rss_gemini_analyzer"),
+	)
+	if err != nil {
+		return sourceAnalyzers{}, fmt.Errorf("create gemini rss analyzer (%s): %w", entry.Model, err)
+	}
+
+	return sourceAnalyzers{
+		reddit:     redditGeminiAI,
+		hackernews: hackernewsGeminiAI,
+		rss:        rssGeminiAI,
+	}, nil
+}
+
+func newOpenAICompatibleAnalyzers(
+	entry config.AnalyzerChainEntry,
+	settingsConfig config.SettingsConfig,
+	credentialsConfig config.CredentialsConfig,
+	requestsStorage *pg.RequestsStorage,
+	limiter *llm.Limiter,
+	logger zerolog.Logger,
+) sourceAnalyzers {
+	openaiClient := openai.New(
+		settingsConfig.OpenAICompatible.BaseURL,
+		credentialsConfig.OpenAIAPIKey,
+		settingsConfig.OpenAICompatible.Timeout,
+		componentLogger(logger, "openai_client"),
+	)
+
+	return sourceAnalyzers{
+		reddit: redditanalyzers.NewOpenAICompatible(
+			openaiClient,
+			llm.OpenAISettings{
+				Model:       entry.Model,
+				Temperature: lo.FromPtr(entry.Temperature),
+			},
+			tools.WrapRequestsStorage(requestsStorage, "reddit_openai_analyzer"),
+			limiter,
+			settingsConfig.Reddit.AI.MaxCommentsPerPost,
+			componentLogger(logger, "reddit_openai_analyzer"),
+		),
+		hackernews: hackernewsanalyzers.NewOpenAICompatible(
+			openaiClient,
+			llm.OpenAISettings{
+				Model:       entry.Model,
+				Temperature: lo.FromPtr(entry.Temperature),
+			},
+			tools.WrapRequestsStorage(requestsStorage, "hackernews_openai_analyzer"),
+			limiter,
+			settingsConfig.HackerNews.AI.MaxCommentsPerStory,
+			componentLogger(logger, "hackernews_openai_analyzer"),
+		),
+		rss: rssanalyzers.NewOpenAICompatible(
+			openaiClient,
+			llm.OpenAISettings{
+				Model:       entry.Model,
+				Temperature: lo.FromPtr(entry.Temperature),
+			},
+			tools.WrapRequestsStorage(requestsStorage, "rss_openai_analyzer"),
+			limiter,
+			settingsConfig.RSS.AI.MaxContentLength,
+			componentLogger(logger, "rss_openai_analyzer"),
+		),
+	}
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/rs/zerolog"
+
+	"github.com/rishenco/scout/internal/config"
+)
+
+func TestNewModelLimiters(t *testing.T) {
+	var settingsConfig config.SettingsConfig
+
+	settingsConfig.Google.Model = "gemini-2.5-flash"
+	settingsConfig.Analyzer.Chain = []config.AnalyzerChainEntry{
+		{Provider: config.GoogleProvider, Model: "gemini-2.5-pro", Temperature: nil},
+		{Provider: config.GoogleProvider, Model: "", Temperature: nil},
+		{Provider: config.GoogleProvider, Model: "gemini-2.5-flash", Temperature: nil},
+	}
+
+	limiters, err := newModelLimiters(settingsConfig, zerolog.Nop())
+	if err != nil {
+		t.Fatalf("new model limiters: %v", err)
+	}
+
+	chain := analyzerChain(settingsConfig)
+
+	// a fallback model of the same provider has its own limits
+	if limiters.limiter(chain[0]) == limiters.limiter(chain[1]) {
+		t.Error("models of the same provider share a limiter")
+	}
+
+	// entries of the same model share the limits
+	if limiters.limiter(chain[1]) != limiters.limiter(chain[2]) {
+		t.Error("entries of the same model have different limiters")
+	}
+
+	if len(limiters.all()) != 2 {
+		t.Errorf("limiters = %d, want 2", len(limiters.all()))
+	}
+
+	for i, stats := range limiters.all().Stats() {
+		if stats.Provider != config.GoogleProvider || stats.Model != chain[i].Model {
+			t.Errorf("limiter %d = %s/%s, want %s/%s", i, stats.Provider, stats.Model, config.GoogleProvider, chain[i].Model)
+		}
+	}
+}
+
+func TestNewModelLimiters_UnknownProvider(t *testing.T) {
+	var settingsConfig config.SettingsConfig
+
+	settingsConfig.Analyzer.Chain = []config.AnalyzerChainEntry{{Provider: "unknown", Model: "model", Temperature: nil}}
+
+	if _, err := newModelLimiters(settingsConfig, zerolog.Nop()); err == nil {
+		t.Error("error = nil, want unknown provider error")
+	}
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/rishenco/scout/internal/auth"
+	"github.com/rishenco/scout/internal/config"
+	"github.com/rishenco/scout/internal/pg"
+	"github.com/rishenco/scout/pkg/models"
+)
+
+const apiKeysUsage = `usage:
+  scout apikeys create -name NAME -scopes SCOPE[,SCOPE...]
+  scout apikeys list
+  scout apikeys revoke -id ID
+
+scopes: %s`
+
+var (
+	errUnknownAPIKeysCommand = errors.New("unknown apikeys command")
+	errAPIKeyNotFound        = errors.New("key not found")
+)
+
+// runAPIKeys manages API keys: "scout apikeys <create|list|revoke> [flags]".
+func runAPIKeys(ctx context.Context, args []string, logger zerolog.Logger) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%w\n"+apiKeysUsage, errUnknownAPIKeysCommand, strings.Join(models.AllScopes(), ", "))
+	}
+
+	credentialsConfig, err := config.ParseCredentialsConfig()
+	if err != nil {
+		return fmt.Errorf("parse config: %w", err)
+	}
+
+	postgresPool, err := pgxpool.New(ctx, credentialsConfig.PostgresConnString)
+	if err != nil {
+		return fmt.Errorf("connect to database: %w", err)
+	}
+	defer postgresPool.Close()
+
+	keys := auth.NewKeys(pg.NewAPIKeyStorage(postgresPool, componentLogger(logger, "api_key_storage")))
+
+	command, args := args[0], args[1:]
+
+	switch command {
+	case "create":
+		return createAPIKey(ctx, keys, args)
+	case "list":
+		return listAPIKeys(ctx, keys)
+	case "revoke":
+		return revokeAPIKey(ctx, keys, args)
+	default:
+		return fmt.Errorf(
+			"%w %q\n"+apiKeysUsage,
+			errUnknownAPIKeysCommand,
+			command,
+			strings.Join(models.AllScopes(), ", "),
+		)
+	}
+}
+
+func createAPIKey(ctx context.Context, keys *auth.Keys, args []string) error {
+	flags := flag.NewFlagSet("apikeys create", flag.ContinueOnError)
+	name := flags.String("name", "", "name of the key, e.g. its owner")
+	scopes := flags.String("scopes", models.ReadDetectionsScope, "comma separated scopes of the key")
+
+	if err := flags.Parse(args); err != nil {
+		return fmt.Errorf("parse flags: %w", err)
+	}
+
+	secret, key, err := keys.Create(ctx, *name, strings.Split(*scopes, ","))
+	if err != nil {
+		return fmt.Errorf("create key: %w", err)
+	}
+
+	fmt.Printf("created key %d %q with scopes %s\n", key.ID, key.Name, strings.Join(key.Scopes, ","))
+	fmt.Println("store the key now, it can't be shown again:")
+	fmt.Println(secret)
+
+	return nil
+}
+
+func listAPIKeys(ctx context.Context, keys *auth.Keys) error {
+	list, err := keys.List(ctx)
+	if err != nil {
+		return fmt.Errorf("list keys: %w", err)
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0) //nolint:mnd // column padding
+
+	fmt.Fprintln(writer, "ID\tNAME\tPREFIX\tSCOPES\tCREATED\tREVOKED")
+
+	for _, key := range list {
+		revokedAt := "-"
+		if key.RevokedAt != nil {
+			revokedAt = key.RevokedAt.Format(time.RFC3339)
+		}
+
+		fmt.Fprintf(
+			writer,
+			"%d\t%s\t%s\t%s\t%s\t%s\n",
+			key.ID,
+			key.Name,
+			key.Prefix,
+			strings.Join(key.Scopes, ","),
+			key.CreatedAt.Format(time.RFC3339),
+			revokedAt,
+		)
+	}
+
+	if err := writer.Flush(); err != nil {
+		return fmt.Errorf("flush: %w", err)
+	}
+
+	return nil
+}
+
+func revokeAPIKey(ctx context.Context, keys *auth.Keys, args []string) error {
+	flags := flag.NewFlagSet("apikeys revoke", flag.ContinueOnError)
+	id := flags.Int64("id", 0, "id of the key")
+
+	if err := flags.Parse(args); err != nil {
+		return fmt.Errorf("parse flags: %w", err)
+	}
+
+	found, err := keys.Revoke(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("revoke key: %w", err)
+	}
+
+	if !found {
+		return fmt.Errorf("key %d: %w", *id, errAPIKeyNotFound)
+	}
+
+	fmt.Printf("revoked key %d\n", *id)
+
+	return nil
+}
@@ -10,22 +10,37 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/exaring/otelpgx"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/zerolog"
+	"github.com/samber/lo"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/rishenco/scout/api"
+	"github.com/rishenco/scout/internal/auth"
 	"github.com/rishenco/scout/internal/config"
+	"github.com/rishenco/scout/internal/health"
+	"github.com/rishenco/scout/internal/llm"
+	"github.com/rishenco/scout/internal/metrics"
 	"github.com/rishenco/scout/internal/pg"
 	"github.com/rishenco/scout/internal/scout"
 	"github.com/rishenco/scout/internal/sources"
+	"github.com/rishenco/scout/internal/sources/hackernews"
+	hackernewsclient "github.com/rishenco/scout/internal/sources/hackernews/client"
+	hackernewspg "github.com/rishenco/scout/internal/sources/hackernews/pg"
 	"github.com/rishenco/scout/internal/sources/reddit"
-	redditanalyzers "github.com/rishenco/scout/internal/sources/reddit/analyzers"
 	redditclient "github.com/rishenco/scout/internal/sources/reddit/client"
 	redditpg "github.com/rishenco/scout/internal/sources/reddit/pg"
+	"github.com/rishenco/scout/internal/sources/rss"
+	rssclient "github.com/rishenco/scout/internal/sources/rss/client"
+	rsspg "github.com/rishenco/scout/internal/sources/rss/pg"
 	"github.com/rishenco/scout/internal/tools"
+	"github.com/rishenco/scout/internal/tracing"
+	"github.com/rishenco/scout/internal/webhooks"
+	"github.com/rishenco/scout/migrations"
 )
 
 //nolint:gochecknoglobals // globals are fine for an entrypoint
@@ -43,6 +58,14 @@ func main() {
 
 	logger := zerolog.New(os.Stdout).With().Timestamp().Logger()
 
+	if flag.Arg(0) == "apikeys" {
+		if err := runAPIKeys(ctx, flag.Args()[1:], logger); err != nil {
+			logger.Fatal().Err(err).Msg("failed to manage api keys")
+		}
+
+		return
+	}
+
 	// Parse config
 	credentialsConfig, err := config.ParseCredentialsConfig()
 	if err != nil {
@@ -54,43 +77,93 @@ func main() {
 		logger.Fatal().Err(err).Msg("failed to parse settings config")
 	}
 
+	// Setup tracing
+	shutdownTracing, err := tracing.Setup(ctx, tracing.Settings{
+		Exporter:    settingsConfig.Tracing.Exporter,
+		Endpoint:    settingsConfig.Tracing.Endpoint,
+		SampleRatio: lo.FromPtrOr(settingsConfig.Tracing.SampleRatio, tracing.DefaultSampleRatio),
+	})
+	if err != nil {
+		logger.Fatal().Err(err).Msg("failed to setup tracing")
+	}
+
+	defer func() {
+		//nolint:contextcheck // the main context is canceled at shutdown
+		if err := shutdownTracing(context.Background()); err != nil {
+			logger.Error().Err(err).Msg("failed to shutdown tracing")
+		}
+	}()
+
 	// Connect to Postgres
-	postgresPool, err := pgxpool.New(ctx, credentialsConfig.PostgresConnString)
+	postgresConfig, err := pgxpool.ParseConfig(credentialsConfig.PostgresConnString)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("failed to parse database connection string")
+	}
+
+	// Queries are traced only within spans of Scout components
+	postgresConfig.ConnConfig.Tracer = otelpgx.NewTracer(otelpgx.WithTrimSQLInSpanName())
+
+	postgresPool, err := pgxpool.NewWithConfig(ctx, postgresConfig)
 	if err != nil {
 		logger.Fatal().Err(err).Msg("failed to connect to database")
 	}
 	defer postgresPool.Close()
 
-	scoutStorage := pg.NewScoutStorage(postgresPool, componentLogger(logger, "scout_storage"))
-	taskStorage := pg.NewTaskStorage(
-		postgresPool,
-		settingsConfig.TaskProcessor.TaskErrorTimeout,
-		componentLogger(logger, "task_storage"),
+	schemaVersion, err := migrations.LatestVersion()
+	if err != nil {
+		logger.Fatal().Err(err).Msg("failed to get schema version")
+	}
+
+	healthRegistry := health.NewRegistry(
+		settingsConfig.Health.StaleAfter,
+		settingsConfig.Health.CheckTimeout,
+		componentLogger(logger, "health"),
+	)
+	healthRegistry.AddCheck("postgres", pg.CheckConnection(postgresPool))
+	healthRegistry.AddCheck("migrations", pg.CheckMigrations(postgresPool, schemaVersion))
+
+	// Heartbeats of loops, each is registered when its loop is started
+	var (
+		redditScraperHeartbeat       = health.NewHeartbeat("reddit_scraper")
+		redditEnricherHeartbeat      = health.NewHeartbeat("reddit_enricher")
+		redditSchedulerHeartbeat     = health.NewHeartbeat("reddit_scheduler")
+		hackernewsScraperHeartbeat   = health.NewHeartbeat("hackernews_scraper")
+		hackernewsEnricherHeartbeat  = health.NewHeartbeat("hackernews_enricher")
+		hackernewsSchedulerHeartbeat = health.NewHeartbeat("hackernews_scheduler")
+		rssScraperHeartbeat          = health.NewHeartbeat("rss_scraper")
+		rssSchedulerHeartbeat        = health.NewHeartbeat("rss_scheduler")
+		taskProcessorHeartbeat       = health.NewHeartbeat("task_processor")
+		unclaimerHeartbeat           = health.NewHeartbeat("tasks_unclaimer")
+		webhookDispatcherHeartbeat   = health.NewHeartbeat("webhook_dispatcher")
 	)
+
+	scoutStorage := pg.NewScoutStorage(postgresPool, componentLogger(logger, "scout_storage"))
+	taskStorage := pg.NewTaskStorage(postgresPool, componentLogger(logger, "task_storage"))
+	webhookStorage := pg.NewWebhookStorage(postgresPool, componentLogger(logger, "webhook_storage"))
+	testSuiteStorage := pg.NewTestSuiteStorage(postgresPool, componentLogger(logger, "test_suite_storage"))
+	shadowStorage := pg.NewShadowStorage(postgresPool, componentLogger(logger, "shadow_storage"))
 	requestsStorage := pg.NewRequestsStorage(
 		postgresPool,
 		componentLogger(logger, "requests_storage"),
 	)
 	redditStorage := redditpg.NewStorage(postgresPool, componentLogger(logger, "reddit_storage"))
+	hackernewsStorage := hackernewspg.NewStorage(postgresPool, componentLogger(logger, "hackernews_storage"))
+	rssStorage := rsspg.NewStorage(postgresPool, componentLogger(logger, "rss_storage"))
 
-	redditGeminiAI, err := redditanalyzers.NewGemini(
-		ctx,
-		credentialsConfig.GeminiAPIKey,
-		redditanalyzers.GeminiSettings{
-			Model:       settingsConfig.Google.Model,
-			Temperature: settingsConfig.Google.Temperature,
-		},
-		tools.WrapRequestsStorage(requestsStorage, "reddit_gemini_analyzer"),
-		settingsConfig.Reddit.AI.MaxCommentsPerPost,
-		componentLogger(logger, "reddit_gemini_analyzer"),
-	)
+	limiters, err := newModelLimiters(settingsConfig, logger)
 	if err != nil {
-		logger.Fatal().Err(err).Msg("failed to create gemini reddit analyzer")
+		logger.Fatal().Err(err).Msg("failed to create limiters")
+	}
+
+	analyzers, err := newSourceAnalyzers(ctx, settingsConfig, credentialsConfig, requestsStorage, limiters, logger)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("failed to create analyzers")
 	}
 
 	redditToolkit := reddit.NewToolkit(
 		redditStorage,
-		redditGeminiAI,
+		scoutStorage,
+		analyzers.reddit,
 		componentLogger(logger, "reddit_analyzer"),
 	)
 
@@ -116,6 +189,7 @@ func main() {
 		settingsConfig.Reddit.Scraper.ErrorTimeout,
 		settingsConfig.Reddit.Scraper.TimeoutAfterFullScan,
 		settingsConfig.Reddit.Scraper.AtLeastOneExhaustingScan,
+		redditScraperHeartbeat,
 		componentLogger(logger, "reddit_scraper"),
 	)
 
@@ -128,15 +202,81 @@ func main() {
 		settingsConfig.Reddit.Enricher.ErrorTimeout,
 		settingsConfig.Reddit.Enricher.Retries,
 		settingsConfig.Reddit.Enricher.Workers,
+		redditEnricherHeartbeat,
 		componentLogger(logger, "reddit_enricher"),
 	)
 
+	hackernewsToolkit := hackernews.NewToolkit(
+		hackernewsStorage,
+		scoutStorage,
+		analyzers.hackernews,
+		componentLogger(logger, "hackernews_analyzer"),
+	)
+
+	hackernewsClient := hackernewsclient.New(
+		settingsConfig.HackerNews.FirebaseURL,
+		settingsConfig.HackerNews.AlgoliaURL,
+		tools.WrapRequestsStorage(requestsStorage, "hackernews_client"),
+		componentLogger(logger, "hackernews_client"),
+	)
+
+	hackernewsScraper := hackernews.NewScraper(
+		hackernewsClient,
+		hackernewsStorage,
+		settingsConfig.HackerNews.Scraper.BatchSize,
+		settingsConfig.HackerNews.Scraper.Timeout,
+		settingsConfig.HackerNews.Scraper.ErrorTimeout,
+		settingsConfig.HackerNews.Scraper.TimeoutAfterFullScan,
+		hackernewsScraperHeartbeat,
+		componentLogger(logger, "hackernews_scraper"),
+	)
+
+	hackernewsEnricher := hackernews.NewEnricher(
+		hackernewsClient,
+		hackernewsStorage,
+		settingsConfig.HackerNews.Enricher.BatchSize,
+		settingsConfig.HackerNews.Enricher.MinStoryAge,
+		settingsConfig.HackerNews.Enricher.Timeout,
+		settingsConfig.HackerNews.Enricher.ErrorTimeout,
+		settingsConfig.HackerNews.Enricher.Retries,
+		settingsConfig.HackerNews.Enricher.Workers,
+		hackernewsEnricherHeartbeat,
+		componentLogger(logger, "hackernews_enricher"),
+	)
+
+	rssToolkit := rss.NewToolkit(
+		rssStorage,
+		scoutStorage,
+		analyzers.rss,
+		componentLogger(logger, "rss_analyzer"),
+	)
+
+	rssClient := rssclient.New(
+		tools.WrapRequestsStorage(requestsStorage, "rss_client"),
+		componentLogger(logger, "rss_client"),
+	)
+
+	rssScraper := rss.NewScraper(
+		rssClient,
+		rssStorage,
+		settingsConfig.RSS.Scraper.Timeout,
+		settingsConfig.RSS.Scraper.ErrorTimeout,
+		settingsConfig.RSS.Scraper.RefreshInterval,
+		rssScraperHeartbeat,
+		componentLogger(logger, "rss_scraper"),
+	)
+
 	scoutService := scout.New(
 		map[string]scout.SourceToolkit{
-			sources.RedditSource: redditToolkit,
+			sources.RedditSource:     redditToolkit,
+			sources.HackerNewsSource: hackernewsToolkit,
+			sources.RSSSource:        rssToolkit,
 		},
 		scoutStorage,
 		taskStorage,
+		webhookStorage,
+		testSuiteStorage,
+		shadowStorage,
 		componentLogger(logger, "scout"),
 	)
 
@@ -147,29 +287,78 @@ func main() {
 		settingsConfig.Reddit.Scheduler.MinScore,
 		settingsConfig.Reddit.Scheduler.Timeout,
 		settingsConfig.Reddit.Scheduler.ErrorTimeout,
+		redditSchedulerHeartbeat,
 		componentLogger(logger, "reddit_scheduler"),
 	)
 
+	hackernewsScheduler := hackernews.NewScheduler(
+		hackernewsStorage,
+		scoutService,
+		settingsConfig.HackerNews.Scheduler.BatchSize,
+		settingsConfig.HackerNews.Scheduler.MinScore,
+		settingsConfig.HackerNews.Scheduler.Timeout,
+		settingsConfig.HackerNews.Scheduler.ErrorTimeout,
+		hackernewsSchedulerHeartbeat,
+		componentLogger(logger, "hackernews_scheduler"),
+	)
+
+	rssScheduler := rss.NewScheduler(
+		rssStorage,
+		scoutService,
+		settingsConfig.RSS.Scheduler.BatchSize,
+		settingsConfig.RSS.Scheduler.Timeout,
+		settingsConfig.RSS.Scheduler.ErrorTimeout,
+		rssSchedulerHeartbeat,
+		componentLogger(logger, "rss_scheduler"),
+	)
+
 	scoutProcessor := scout.NewTaskProcessor(
 		taskStorage,
 		scoutService,
 		settingsConfig.TaskProcessor.Timeout,
 		settingsConfig.TaskProcessor.ErrorTimeout,
 		settingsConfig.TaskProcessor.NoTasksTimeout,
+		settingsConfig.TaskProcessor.TaskErrorTimeout,
+		settingsConfig.TaskProcessor.TaskErrorMaxTimeout,
 		settingsConfig.TaskProcessor.MaxAttempts,
 		settingsConfig.TaskProcessor.Workers,
+		taskProcessorHeartbeat,
 		componentLogger(logger, "processor"),
 	)
 
+	tasksListener := pg.NewListener(
+		postgresPool,
+		pg.TasksChannel,
+		settingsConfig.TaskProcessor.Notifications.ReconnectTimeout,
+		componentLogger(logger, "tasks_listener"),
+	)
+
+	webhookDispatcher := webhooks.NewDispatcher(
+		webhookStorage,
+		settingsConfig.Webhooks.RequestTimeout,
+		settingsConfig.Webhooks.MinBackoff,
+		settingsConfig.Webhooks.MaxBackoff,
+		settingsConfig.Webhooks.MaxAttempts,
+		settingsConfig.Webhooks.Timeout,
+		settingsConfig.Webhooks.ErrorTimeout,
+		settingsConfig.Webhooks.NoEventsTimeout,
+		settingsConfig.Webhooks.Workers,
+		webhookDispatcherHeartbeat,
+		componentLogger(logger, "webhook_dispatcher"),
+	)
+
 	// Run services using errgroup
 	g, ctx := errgroup.WithContext(ctx)
 
+	healthRegistry.Register(unclaimerHeartbeat)
+
 	g.Go(func() error {
 		pg.UnclaimTasks(
 			ctx,
 			taskStorage,
 			time.Minute,
 			time.Minute,
+			unclaimerHeartbeat,
 			componentLogger(logger, "tasks_unclaimer"),
 		)
 
@@ -177,47 +366,134 @@ func main() {
 	})
 
 	if !settingsConfig.Reddit.Scraper.Disabled {
+		healthRegistry.Register(redditScraperHeartbeat)
+
 		g.Go(func() error {
 			return redditScraper.Start(ctx)
 		})
 	}
 
 	if !settingsConfig.Reddit.Enricher.Disabled {
+		healthRegistry.Register(redditEnricherHeartbeat)
+
 		g.Go(func() error {
 			return redditEnricher.Start(ctx)
 		})
 	}
 
 	if !settingsConfig.Reddit.Scheduler.Disabled {
+		healthRegistry.Register(redditSchedulerHeartbeat)
+
 		g.Go(func() error {
 			return redditScheduler.Start(ctx)
 		})
 	}
 
+	if !settingsConfig.HackerNews.Scraper.Disabled {
+		healthRegistry.Register(hackernewsScraperHeartbeat)
+
+		g.Go(func() error {
+			return hackernewsScraper.Start(ctx)
+		})
+	}
+
+	if !settingsConfig.HackerNews.Enricher.Disabled {
+		healthRegistry.Register(hackernewsEnricherHeartbeat)
+
+		g.Go(func() error {
+			return hackernewsEnricher.Start(ctx)
+		})
+	}
+
+	if !settingsConfig.HackerNews.Scheduler.Disabled {
+		healthRegistry.Register(hackernewsSchedulerHeartbeat)
+
+		g.Go(func() error {
+			return hackernewsScheduler.Start(ctx)
+		})
+	}
+
+	if !settingsConfig.RSS.Scraper.Disabled {
+		healthRegistry.Register(rssScraperHeartbeat)
+
+		g.Go(func() error {
+			return rssScraper.Start(ctx)
+		})
+	}
+
+	if !settingsConfig.RSS.Scheduler.Disabled {
+		healthRegistry.Register(rssSchedulerHeartbeat)
+
+		g.Go(func() error {
+			return rssScheduler.Start(ctx)
+		})
+	}
+
 	if !settingsConfig.TaskProcessor.Disabled {
+		healthRegistry.Register(taskProcessorHeartbeat)
+
 		g.Go(func() error {
 			scoutProcessor.Start(ctx)
 
 			return nil
 		})
+
+		if !settingsConfig.TaskProcessor.Notifications.Disabled {
+			g.Go(func() error {
+				tasksListener.Listen(ctx, scoutProcessor.Wake)
+
+				return nil
+			})
+		}
+	}
+
+	if !settingsConfig.Webhooks.Disabled {
+		healthRegistry.Register(webhookDispatcherHeartbeat)
+
+		g.Go(func() error {
+			webhookDispatcher.Start(ctx)
+
+			return nil
+		})
 	}
 
 	if !settingsConfig.API.Disabled {
 		server := api.NewServer(
 			scoutService,
 			redditToolkit,
+			hackernewsToolkit,
+			rssToolkit,
+			limiters.all(),
 			logger,
 		)
 
+		authenticator := auth.NewAuthenticator(
+			pg.NewAPIKeyStorage(postgresPool, componentLogger(logger, "api_key_storage")),
+			credentialsConfig.APIAccounts,
+			settingsConfig.API.Auth.Disabled,
+			componentLogger(logger, "authenticator"),
+		)
+
+		corsConfig := cors.DefaultConfig()
+		corsConfig.AddAllowHeaders("Authorization", auth.APIKeyHeader)
+
+		if len(settingsConfig.API.AllowedOrigins) > 0 {
+			corsConfig.AllowOrigins = settingsConfig.API.AllowedOrigins
+		} else {
+			corsConfig.AllowAllOrigins = true
+		}
+
 		ginEngine := api.NewGinEngine(
 			server,
+			authenticator,
 			gin.Recovery(),
-			cors.Default(),
+			metrics.GinMiddleware(),
+			cors.New(corsConfig),
 		)
 
-		// ginEngine.Group("/api").Use(gin.BasicAuth(gin.Accounts(credentialsConfig.APIAccounts)))
-
 		ginEngine.StaticFile("swagger.yaml", "./api/swagger.yaml")
+		ginEngine.GET("/healthz", gin.WrapH(healthRegistry.LivenessHandler()))
+		ginEngine.GET("/readyz", gin.WrapH(healthRegistry.ReadinessHandler()))
 
 		httpServer := &http.Server{
 			Addr:              fmt.Sprintf(":%d", settingsConfig.API.Port),
@@ -225,25 +501,31 @@ func main() {
 			ReadHeaderTimeout: time.Minute,
 		}
 
-		go func() {
-			<-ctx.Done()
+		serveHTTP(ctx, g, httpServer, logger)
+	}
 
-			//nolint:contextcheck // there's no other context to use
-			shutdownCtx, shutdownCancel := context.WithTimeout(
-				context.Background(),
-				//nolint:mnd // currently hardcoded
-				5*time.Second,
-			)
-			defer shutdownCancel()
+	if !settingsConfig.Metrics.Disabled {
+		prometheus.MustRegister(
+			metrics.NewQueueCollector(
+				taskStorage,
+				settingsConfig.Metrics.QueueTimeout,
+				componentLogger(logger, "queue_collector"),
+			),
+			llm.NewLimitersCollector(limiters.all()),
+		)
 
-			if err := httpServer.Shutdown(shutdownCtx); err != nil {
-				logger.Error().Err(err).Msg("failed to shutdown http server")
-			}
-		}()
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle("/metrics", metrics.Handler())
+		metricsMux.Handle("/healthz", healthRegistry.LivenessHandler())
+		metricsMux.Handle("/readyz", healthRegistry.ReadinessHandler())
 
-		g.Go(func() error {
-			return httpServer.ListenAndServe()
-		})
+		metricsServer := &http.Server{
+			Addr:              fmt.Sprintf(":%d", settingsConfig.Metrics.Port),
+			Handler:           metricsMux,
+			ReadHeaderTimeout: time.Minute,
+		}
+
+		serveHTTP(ctx, g, metricsServer, logger)
 	}
 
 	// Wait for all services to complete
@@ -254,6 +536,29 @@ func main() {
 	logger.Info().Msg("gracefully shut down")
 }
 
+// serveHTTP runs the server in the errgroup and shuts it down when the context is canceled.
+func serveHTTP(ctx context.Context, g *errgroup.Group, httpServer *http.Server, logger zerolog.Logger) {
+	go func() {
+		<-ctx.Done()
+
+		//nolint:contextcheck // there's no other context to use
+		shutdownCtx, shutdownCancel := context.WithTimeout(
+			context.Background(),
+			//nolint:mnd // currently hardcoded
+			5*time.Second,
+		)
+		defer shutdownCancel()
+
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			logger.Error().Err(err).Str("addr", httpServer.Addr).Msg("failed to shutdown http server")
+		}
+	}()
+
+	g.Go(func() error {
+		return httpServer.ListenAndServe()
+	})
+}
+
 func componentLogger(logger zerolog.Logger, component string) zerolog.Logger {
 	return logger.With().Str("component", component).Logger()
 }
@@ -0,0 +1,163 @@
+// Package auth authenticates and authorizes requests to the HTTP API.
+//
+// Requests are authenticated with API keys passed in the X-API-Key header
+// or with basic auth accounts from the credentials config.
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"github.com/rishenco/scout/pkg/models"
+)
+
+// APIKeyHeader is a header with an API key.
+const APIKeyHeader = "X-API-Key"
+
+// principalKey is a gin context key of an authenticated principal.
+const principalKey = "auth.principal"
+
+// Principal is an authenticated client of the API.
+type Principal struct {
+	// Name is a name of the API key or the basic auth user.
+	Name   string
+	Scopes []string
+}
+
+// HasScope reports whether the principal is allowed to use a scope.
+func (p Principal) HasScope(scope string) bool {
+	return slices.Contains(p.Scopes, scope)
+}
+
+type keyFinder interface {
+	GetAPIKeyByHash(ctx context.Context, keyHash string) (key models.APIKey, found bool, err error)
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// Authenticator is a gin middleware checking API keys and basic auth accounts.
+type Authenticator struct {
+	keys keyFinder
+	// accounts are basic auth users and their passwords, they are allowed to use all scopes.
+	accounts map[string]string
+	// disabled turns off authentication, all requests are allowed to use all scopes.
+	disabled bool
+	logger   zerolog.Logger
+}
+
+func NewAuthenticator(
+	keys keyFinder,
+	accounts map[string]string,
+	disabled bool,
+	logger zerolog.Logger,
+) *Authenticator {
+	return &Authenticator{
+		keys:     keys,
+		accounts: accounts,
+		disabled: disabled,
+		logger:   logger,
+	}
+}
+
+// Authenticate identifies the client of a request and aborts it with 401 if the client is unknown.
+func (a *Authenticator) Authenticate(c *gin.Context) {
+	if a.disabled {
+		c.Set(principalKey, Principal{Name: "anonymous", Scopes: models.AllScopes()})
+
+		return
+	}
+
+	principal, ok, err := a.authenticate(c)
+	if err != nil {
+		a.logger.Error().
+			Err(err).
+			Msg("authenticate request")
+
+		c.AbortWithStatusJSON(http.StatusInternalServerError, errorResponse{Error: "failed to authenticate request"})
+
+		return
+	}
+
+	if !ok {
+		if len(a.accounts) > 0 {
+			c.Header("WWW-Authenticate", `Basic realm="scout"`)
+		}
+
+		c.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse{Error: "authentication required"})
+
+		return
+	}
+
+	c.Set(principalKey, principal)
+}
+
+// Authorize returns a middleware aborting requests with 403 if the principal lacks a required scope.
+//
+// Required scopes are read from the gin context by scopesKey, they are set by the generated API router.
+// Requests without required scopes are allowed.
+func (a *Authenticator) Authorize(scopesKey string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		required := c.GetStringSlice(scopesKey)
+
+		principal, ok := PrincipalFromContext(c)
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse{Error: "authentication required"})
+
+			return
+		}
+
+		for _, scope := range required {
+			if !principal.HasScope(scope) {
+				c.AbortWithStatusJSON(http.StatusForbidden, errorResponse{Error: "missing scope " + scope})
+
+				return
+			}
+		}
+	}
+}
+
+// PrincipalFromContext returns a principal set by Authenticate.
+func PrincipalFromContext(c *gin.Context) (Principal, bool) {
+	value, ok := c.Get(principalKey)
+	if !ok {
+		return Principal{}, false
+	}
+
+	principal, ok := value.(Principal)
+
+	return principal, ok
+}
+
+func (a *Authenticator) authenticate(c *gin.Context) (principal Principal, ok bool, err error) {
+	if secret := c.GetHeader(APIKeyHeader); secret != "" {
+		key, found, err := a.keys.GetAPIKeyByHash(c.Request.Context(), hashKey(secret))
+		if err != nil {
+			return Principal{}, false, err //nolint:wrapcheck // storage errors are descriptive
+		}
+
+		if !found {
+			return Principal{}, false, nil
+		}
+
+		return Principal{Name: key.Name, Scopes: key.Scopes}, true, nil
+	}
+
+	user, password, hasBasicAuth := c.Request.BasicAuth()
+	if !hasBasicAuth {
+		return Principal{}, false, nil
+	}
+
+	expected, found := a.accounts[user]
+	if !found || subtle.ConstantTimeCompare([]byte(password), []byte(expected)) != 1 {
+		return Principal{}, false, nil
+	}
+
+	return Principal{Name: user, Scopes: models.AllScopes()}, true, nil
+}
@@ -0,0 +1,124 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"github.com/rishenco/scout/pkg/models"
+)
+
+const testScopesKey = "test.scopes"
+
+// fakeKeyFinder finds keys by their hashes.
+type fakeKeyFinder map[string]models.APIKey
+
+func (f fakeKeyFinder) GetAPIKeyByHash(_ context.Context, keyHash string) (models.APIKey, bool, error) {
+	key, found := f[keyHash]
+
+	return key, found, nil
+}
+
+func TestPrincipal_HasScope(t *testing.T) {
+	principal := Principal{Name: "ci", Scopes: []string{models.ReadDetectionsScope}}
+
+	if !principal.HasScope(models.ReadDetectionsScope) {
+		t.Errorf("principal has no scope %s", models.ReadDetectionsScope)
+	}
+
+	if principal.HasScope(models.AdminQueueScope) {
+		t.Errorf("principal has scope %s", models.AdminQueueScope)
+	}
+}
+
+func TestAuthenticator(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	keys := fakeKeyFinder{
+		hashKey("scout_reader"): {ID: 1, Name: "reader", Scopes: []string{models.ReadDetectionsScope}},
+		hashKey("scout_admin"):  {ID: 2, Name: "admin", Scopes: []string{models.AdminQueueScope}},
+	}
+	accounts := map[string]string{"alice": "secret"}
+
+	tests := []struct {
+		name       string
+		disabled   bool
+		apiKey     string
+		user       string
+		password   string
+		required   []string
+		wantStatus int
+	}{
+		{name: "no credentials", required: nil, wantStatus: http.StatusUnauthorized},
+		{name: "unknown key", apiKey: "scout_unknown", required: nil, wantStatus: http.StatusUnauthorized},
+		{
+			name:       "key with required scope",
+			apiKey:     "scout_reader",
+			required:   []string{models.ReadDetectionsScope},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "key without required scope",
+			apiKey:     "scout_reader",
+			required:   []string{models.AdminQueueScope},
+			wantStatus: http.StatusForbidden,
+		},
+		{name: "key without required scopes", apiKey: "scout_admin", required: nil, wantStatus: http.StatusOK},
+		{
+			name:       "basic auth account",
+			user:       "alice",
+			password:   "secret",
+			required:   []string{models.AdminQueueScope, models.ManageProfilesScope},
+			wantStatus: http.StatusOK,
+		},
+		{name: "wrong password", user: "alice", password: "guess", required: nil, wantStatus: http.StatusUnauthorized},
+		{name: "unknown user", user: "bob", password: "secret", required: nil, wantStatus: http.StatusUnauthorized},
+		{
+			name:       "disabled",
+			disabled:   true,
+			required:   []string{models.AdminQueueScope},
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authenticator := NewAuthenticator(keys, accounts, tt.disabled, zerolog.Nop())
+
+			engine := gin.New()
+			engine.GET(
+				"/api",
+				func(c *gin.Context) { c.Set(testScopesKey, tt.required) },
+				authenticator.Authenticate,
+				authenticator.Authorize(testScopesKey),
+				func(c *gin.Context) { c.Status(http.StatusOK) },
+			)
+
+			request := httptest.NewRequest(http.MethodGet, "/api", nil)
+			if tt.apiKey != "" {
+				request.Header.Set(APIKeyHeader, tt.apiKey)
+			}
+
+			if tt.user != "" {
+				request.SetBasicAuth(tt.user, tt.password)
+			}
+
+			recorder := httptest.NewRecorder()
+			engine.ServeHTTP(recorder, request)
+
+			if recorder.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", recorder.Code, tt.wantStatus)
+			}
+
+			// basic auth accounts are configured, so browsers are asked for them
+			challenge := recorder.Header().Get("WWW-Authenticate")
+			if (challenge != "") != (tt.wantStatus == http.StatusUnauthorized) {
+				t.Errorf("WWW-Authenticate = %q on status %d", challenge, recorder.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rishenco/scout/pkg/models"
+)
+
+const (
+	// keyPrefix marks Scout API keys, e.g. for secret scanners.
+	keyPrefix = "scout_"
+	// keyBytes is an amount of random bytes in a key.
+	keyBytes = 32
+	// displayedPrefixLength is a length of the key's beginning stored to identify the key.
+	displayedPrefixLength = len(keyPrefix) + 6
+)
+
+type keyStorage interface {
+	CreateAPIKey(ctx context.Context, key models.APIKey, keyHash string) (models.APIKey, error)
+	ListAPIKeys(ctx context.Context) ([]models.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id int64) (found bool, err error)
+}
+
+// Keys manages API keys.
+type Keys struct {
+	storage keyStorage
+}
+
+func NewKeys(storage keyStorage) *Keys {
+	return &Keys{
+		storage: storage,
+	}
+}
+
+// Create generates a new key with given scopes.
+//
+// The returned secret is the key itself, it is not stored and can't be shown again.
+func (k *Keys) Create(ctx context.Context, name string, scopes []string) (secret string, key models.APIKey, err error) {
+	if strings.TrimSpace(name) == "" {
+		return "", models.APIKey{}, fmt.Errorf("%w: name is required", models.ErrInvalidAPIKey)
+	}
+
+	if err := models.ValidateScopes(scopes); err != nil {
+		return "", models.APIKey{}, err //nolint:wrapcheck // error is already descriptive
+	}
+
+	random := make([]byte, keyBytes)
+	if _, err := rand.Read(random); err != nil {
+		return "", models.APIKey{}, fmt.Errorf("generate key: %w", err)
+	}
+
+	secret = keyPrefix + base64.RawURLEncoding.EncodeToString(random)
+
+	key, err = k.storage.CreateAPIKey(ctx, models.APIKey{
+		ID:        0,
+		Name:      name,
+		Prefix:    secret[:displayedPrefixLength],
+		Scopes:    scopes,
+		CreatedAt: time.Time{},
+		RevokedAt: nil,
+	}, hashKey(secret))
+	if err != nil {
+		return "", models.APIKey{}, fmt.Errorf("create api key: %w", err)
+	}
+
+	return secret, key, nil
+}
+
+// List returns all keys including revoked ones.
+func (k *Keys) List(ctx context.Context) ([]models.APIKey, error) {
+	keys, err := k.storage.ListAPIKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list api keys: %w", err)
+	}
+
+	return keys, nil
+}
+
+// Revoke revokes a key, it can't be used anymore.
+func (k *Keys) Revoke(ctx context.Context, id int64) (found bool, err error) {
+	found, err = k.storage.RevokeAPIKey(ctx, id)
+	if err != nil {
+		return false, fmt.Errorf("revoke api key: %w", err)
+	}
+
+	return found, nil
+}
+
+// hashKey returns a hex encoded SHA-256 hash of a key.
+//
+// Keys are random and long, so a fast hash is enough to protect them if the database leaks.
+func hashKey(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/rishenco/scout/pkg/models"
+)
+
+// fakeKeyStorage records created keys and their hashes.
+type fakeKeyStorage struct {
+	keyStorage
+
+	keys   []models.APIKey
+	hashes []string
+}
+
+func (s *fakeKeyStorage) CreateAPIKey(_ context.Context, key models.APIKey, keyHash string) (models.APIKey, error) {
+	key.ID = int64(len(s.keys) + 1)
+
+	s.keys = append(s.keys, key)
+	s.hashes = append(s.hashes, keyHash)
+
+	return key, nil
+}
+
+func TestHashKey(t *testing.T) {
+	// stored hashes are looked up by this value, so the format must not change
+	want := "f45d3c5cc770804333fc9104b5826577a428ae8426d14d542ad1a4c8f126695b"
+
+	if got := hashKey("scout_test"); got != want {
+		t.Errorf("hashKey() = %s, want %s", got, want)
+	}
+}
+
+func TestKeys_Create(t *testing.T) {
+	storage := &fakeKeyStorage{}
+	keys := NewKeys(storage)
+
+	secret, key, err := keys.Create(context.Background(), "ci", []string{models.ReadDetectionsScope})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	if !strings.HasPrefix(secret, keyPrefix) {
+		t.Errorf("secret = %s, want prefix %s", secret, keyPrefix)
+	}
+
+	if len(storage.hashes) != 1 || storage.hashes[0] != hashKey(secret) {
+		t.Fatalf("stored hashes = %v, want the hash of the secret", storage.hashes)
+	}
+
+	// only the displayed prefix of the secret is stored
+	if key.Prefix != secret[:displayedPrefixLength] {
+		t.Errorf("key = %+v, want prefix %s", key, secret[:displayedPrefixLength])
+	}
+
+	if key.ID != 1 || key.Name != "ci" || len(key.Scopes) != 1 || key.Scopes[0] != models.ReadDetectionsScope {
+		t.Errorf("key = %+v, want the created key", key)
+	}
+
+	otherSecret, _, err := keys.Create(context.Background(), "ci", []string{models.ReadDetectionsScope})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	if otherSecret == secret {
+		t.Error("secrets of different keys are equal")
+	}
+}
+
+func TestKeys_CreateInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		key    string
+		scopes []string
+	}{
+		{name: "empty name", key: " ", scopes: []string{models.ReadDetectionsScope}},
+		{name: "no scopes", key: "ci", scopes: nil},
+		{name: "unknown scope", key: "ci", scopes: []string{models.ReadDetectionsScope, "detections:write"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := &fakeKeyStorage{}
+
+			_, _, err := NewKeys(storage).Create(context.Background(), tt.key, tt.scopes)
+			if !errors.Is(err, models.ErrInvalidAPIKey) {
+				t.Errorf("error = %v, want %v", err, models.ErrInvalidAPIKey)
+			}
+
+			if len(storage.keys) != 0 {
+				t.Errorf("stored keys = %+v, want none", storage.keys)
+			}
+		})
+	}
+}
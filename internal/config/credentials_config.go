@@ -9,10 +9,16 @@ import (
 // CredentialsConfig holds application secrets stored in environment variables.
 type CredentialsConfig struct {
 	GeminiAPIKey string `envconfig:"GEMINI_API_KEY"`
+	// OpenAIAPIKey is optional: self-hosted OpenAI-compatible servers usually don't require it.
+	OpenAIAPIKey string `envconfig:"OPENAI_API_KEY"`
 
 	PostgresConnString string `envconfig:"POSTGRES_CONN_STRING" required:"true"`
 
 	Reddit RedditCredentialsConfig `envconfig:"REDDIT_CREDENTIALS"`
+
+	// APIAccounts are basic auth accounts of the API in the "user:password,user2:password2" format.
+	// Accounts are allowed to use all scopes.
+	APIAccounts map[string]string `envconfig:"API_ACCOUNTS"`
 }
 
 type RedditCredentialsConfig struct {
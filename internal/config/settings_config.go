@@ -10,28 +10,113 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
+const (
+	// GoogleProvider uses Gemini API for analysis.
+	GoogleProvider = "google"
+	// OpenAICompatibleProvider uses any server implementing OpenAI Chat Completions API for analysis.
+	OpenAICompatibleProvider = "openai_compatible"
+)
+
+// AnalyzerChainEntry is a single analyzer of the fallback chain.
+//
+// Empty Model and nil Temperature are taken from the provider's section.
+type AnalyzerChainEntry struct {
+	Provider    string   `json:"provider" yaml:"provider"`
+	Model       string   `json:"model" yaml:"model"`
+	Temperature *float32 `json:"temperature" yaml:"temperature"`
+}
+
+// RateLimitConfig limits requests to each model of an LLM provider. Zero values mean no limit.
+type RateLimitConfig struct {
+	RequestsPerMinute int `json:"requests_per_minute" yaml:"requests_per_minute"`
+	TokensPerMinute   int `json:"tokens_per_minute" yaml:"tokens_per_minute"`
+	MaxConcurrency    int `json:"max_concurrency" yaml:"max_concurrency"`
+}
+
 // SettingsConfig represents application's parametrization provided in a JSON/YAML file.
 type SettingsConfig struct {
+	Analyzer struct {
+		Provider string `json:"provider" yaml:"provider"`
+		// Chain lists analyzers in priority order. If empty, a single analyzer of Provider is used.
+		Chain []AnalyzerChainEntry `json:"chain" yaml:"chain"`
+	} `json:"analyzer" yaml:"analyzer"`
+
 	Google struct {
-		Model       string  `json:"model" yaml:"model"`
-		Temperature float32 `json:"temperature" yaml:"temperature"`
+		Model       string          `json:"model" yaml:"model"`
+		Temperature float32         `json:"temperature" yaml:"temperature"`
+		RateLimit   RateLimitConfig `json:"rate_limit" yaml:"rate_limit"`
 	} `json:"google" yaml:"google"`
 
+	OpenAICompatible struct {
+		BaseURL     string          `json:"base_url" yaml:"base_url"`
+		Model       string          `json:"model" yaml:"model"`
+		Temperature float32         `json:"temperature" yaml:"temperature"`
+		Timeout     time.Duration   `json:"timeout" yaml:"timeout"`
+		RateLimit   RateLimitConfig `json:"rate_limit" yaml:"rate_limit"`
+	} `json:"openai_compatible" yaml:"openai_compatible"`
+
 	TaskProcessor struct {
-		Workers          int           `json:"workers" yaml:"workers"`
-		MaxAttempts      int           `json:"max_attempts" yaml:"max_attempts"`
-		TaskErrorTimeout time.Duration `json:"task_error_timeout" yaml:"task_error_timeout"`
-		Timeout          time.Duration `json:"timeout" yaml:"timeout"`
-		ErrorTimeout     time.Duration `json:"error_timeout" yaml:"error_timeout"`
-		NoTasksTimeout   time.Duration `json:"no_tasks_timeout" yaml:"no_tasks_timeout"`
-		Disabled         bool          `json:"disabled" yaml:"disabled"`
+		Workers             int           `json:"workers" yaml:"workers"`
+		MaxAttempts         int           `json:"max_attempts" yaml:"max_attempts"`
+		TaskErrorTimeout    time.Duration `json:"task_error_timeout" yaml:"task_error_timeout"`
+		TaskErrorMaxTimeout time.Duration `json:"task_error_max_timeout" yaml:"task_error_max_timeout"`
+		Timeout             time.Duration `json:"timeout" yaml:"timeout"`
+		ErrorTimeout        time.Duration `json:"error_timeout" yaml:"error_timeout"`
+		NoTasksTimeout      time.Duration `json:"no_tasks_timeout" yaml:"no_tasks_timeout"`
+		Disabled            bool          `json:"disabled" yaml:"disabled"`
+
+		Notifications struct {
+			ReconnectTimeout time.Duration `json:"reconnect_timeout" yaml:"reconnect_timeout"`
+			Disabled         bool          `json:"disabled" yaml:"disabled"`
+		} `json:"notifications" yaml:"notifications"`
 	} `json:"task_processor" yaml:"task_processor"`
 
+	Webhooks struct {
+		Workers         int           `json:"workers" yaml:"workers"`
+		MaxAttempts     int           `json:"max_attempts" yaml:"max_attempts"`
+		RequestTimeout  time.Duration `json:"request_timeout" yaml:"request_timeout"`
+		MinBackoff      time.Duration `json:"min_backoff" yaml:"min_backoff"`
+		MaxBackoff      time.Duration `json:"max_backoff" yaml:"max_backoff"`
+		Timeout         time.Duration `json:"timeout" yaml:"timeout"`
+		ErrorTimeout    time.Duration `json:"error_timeout" yaml:"error_timeout"`
+		NoEventsTimeout time.Duration `json:"no_events_timeout" yaml:"no_events_timeout"`
+		Disabled        bool          `json:"disabled" yaml:"disabled"`
+	} `json:"webhooks" yaml:"webhooks"`
+
 	API struct {
 		Port     int  `json:"port" yaml:"port"`
 		Disabled bool `json:"disabled" yaml:"disabled"`
+		// AllowedOrigins are origins allowed by CORS. Empty means all origins.
+		AllowedOrigins []string `json:"allowed_origins" yaml:"allowed_origins"`
+		Auth           struct {
+			// Disabled allows all requests without credentials.
+			Disabled bool `json:"disabled" yaml:"disabled"`
+		} `json:"auth" yaml:"auth"`
 	} `json:"api" yaml:"api"`
 
+	Metrics struct {
+		Port int `json:"port" yaml:"port"`
+		// QueueTimeout limits queries of the task queue depth on each scrape.
+		QueueTimeout time.Duration `json:"queue_timeout" yaml:"queue_timeout"`
+		Disabled     bool          `json:"disabled" yaml:"disabled"`
+	} `json:"metrics" yaml:"metrics"`
+
+	Health struct {
+		// StaleAfter is a time without successful iterations after which a loop is not ready.
+		StaleAfter time.Duration `json:"stale_after" yaml:"stale_after"`
+		// CheckTimeout limits each dependency check of a readiness request.
+		CheckTimeout time.Duration `json:"check_timeout" yaml:"check_timeout"`
+	} `json:"health" yaml:"health"`
+
+	Tracing struct {
+		// Exporter is "otlp" or "stdout". Empty exporter disables tracing.
+		Exporter string `json:"exporter" yaml:"exporter"`
+		// Endpoint is an OTLP/HTTP endpoint URL. If empty, OTEL_EXPORTER_OTLP_* environment variables are used.
+		Endpoint string `json:"endpoint" yaml:"endpoint"`
+		// SampleRatio is a share of traces to sample, from 0 to 1. Nil samples all traces (tracing.DefaultSampleRatio).
+		SampleRatio *float64 `json:"sample_ratio" yaml:"sample_ratio"`
+	} `json:"tracing" yaml:"tracing"`
+
 	Reddit struct {
 		AI struct {
 			MaxCommentsPerPost int `json:"max_comments_per_post" yaml:"max_comments_per_post"`
@@ -63,6 +148,61 @@ type SettingsConfig struct {
 			Disabled     bool          `json:"disabled" yaml:"disabled"`
 		} `json:"scheduler" yaml:"scheduler"`
 	} `json:"reddit" yaml:"reddit"`
+
+	HackerNews struct {
+		FirebaseURL string `json:"firebase_url" yaml:"firebase_url"`
+		AlgoliaURL  string `json:"algolia_url" yaml:"algolia_url"`
+
+		AI struct {
+			MaxCommentsPerStory int `json:"max_comments_per_story" yaml:"max_comments_per_story"`
+		} `json:"ai" yaml:"ai"`
+
+		Scraper struct {
+			BatchSize            int           `json:"batch_size" yaml:"batch_size"`
+			Timeout              time.Duration `json:"timeout" yaml:"timeout"`
+			ErrorTimeout         time.Duration `json:"error_timeout" yaml:"error_timeout"`
+			TimeoutAfterFullScan time.Duration `json:"timeout_after_full_scan" yaml:"timeout_after_full_scan"`
+			Disabled             bool          `json:"disabled" yaml:"disabled"`
+		} `json:"scraper" yaml:"scraper"`
+
+		Enricher struct {
+			BatchSize    int           `json:"batch_size" yaml:"batch_size"`
+			MinStoryAge  time.Duration `json:"min_story_age" yaml:"min_story_age"`
+			Workers      int           `json:"workers" yaml:"workers"`
+			Retries      int           `json:"retries" yaml:"retries"`
+			Timeout      time.Duration `json:"timeout" yaml:"timeout"`
+			ErrorTimeout time.Duration `json:"error_timeout" yaml:"error_timeout"`
+			Disabled     bool          `json:"disabled" yaml:"disabled"`
+		} `json:"enricher" yaml:"enricher"`
+
+		Scheduler struct {
+			BatchSize    int           `json:"batch_size" yaml:"batch_size"`
+			MinScore     int           `json:"min_score" yaml:"min_score"`
+			Timeout      time.Duration `json:"timeout" yaml:"timeout"`
+			ErrorTimeout time.Duration `json:"error_timeout" yaml:"error_timeout"`
+			Disabled     bool          `json:"disabled" yaml:"disabled"`
+		} `json:"scheduler" yaml:"scheduler"`
+	} `json:"hackernews" yaml:"hackernews"`
+
+	RSS struct {
+		AI struct {
+			MaxContentLength int `json:"max_content_length" yaml:"max_content_length"`
+		} `json:"ai" yaml:"ai"`
+
+		Scraper struct {
+			Timeout         time.Duration `json:"timeout" yaml:"timeout"`
+			ErrorTimeout    time.Duration `json:"error_timeout" yaml:"error_timeout"`
+			RefreshInterval time.Duration `json:"refresh_interval" yaml:"refresh_interval"`
+			Disabled        bool          `json:"disabled" yaml:"disabled"`
+		} `json:"scraper" yaml:"scraper"`
+
+		Scheduler struct {
+			BatchSize    int           `json:"batch_size" yaml:"batch_size"`
+			Timeout      time.Duration `json:"timeout" yaml:"timeout"`
+			ErrorTimeout time.Duration `json:"error_timeout" yaml:"error_timeout"`
+			Disabled     bool          `json:"disabled" yaml:"disabled"`
+		} `json:"scheduler" yaml:"scheduler"`
+	} `json:"rss" yaml:"rss"`
 }
 
 func ParseSettingsConfig(path string) (SettingsConfig, error) {
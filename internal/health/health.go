@@ -0,0 +1,238 @@
+// Package health reports liveness and readiness of Scout.
+//
+// Background loops report heartbeats to a shared Registry, dependencies are checked on each readiness request.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// Registry collects heartbeats of background loops and checks of dependencies.
+type Registry struct {
+	staleAfter   time.Duration
+	checkTimeout time.Duration
+	logger       zerolog.Logger
+
+	lock       sync.Mutex
+	heartbeats []*Heartbeat
+	checks     []check
+}
+
+type check struct {
+	name string
+	fn   func(ctx context.Context) error
+}
+
+// NewRegistry creates a registry. A loop is not ready if it has not completed an iteration successfully
+// for staleAfter.
+func NewRegistry(staleAfter time.Duration, checkTimeout time.Duration, logger zerolog.Logger) *Registry {
+	return &Registry{
+		staleAfter:   staleAfter,
+		checkTimeout: checkTimeout,
+		logger:       logger,
+	}
+}
+
+// Register starts tracking heartbeats of running loops.
+//
+// Only loops that are started should be registered. A newly registered loop has the stale timeout
+// to complete its first iteration.
+func (r *Registry) Register(heartbeats ...*Heartbeat) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, heartbeat := range heartbeats {
+		heartbeat.lock.Lock()
+		heartbeat.registeredAt = time.Now()
+		heartbeat.lock.Unlock()
+
+		r.heartbeats = append(r.heartbeats, heartbeat)
+	}
+}
+
+// AddCheck registers a dependency check. The component is not ready while the check returns an error.
+func (r *Registry) AddCheck(component string, fn func(ctx context.Context) error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.checks = append(r.checks, check{name: component, fn: fn})
+}
+
+// ComponentStatus is a readiness status of a component.
+type ComponentStatus struct {
+	Component string `json:"component"`
+	Ready     bool   `json:"ready"`
+	// Reason explains why the component is not ready.
+	Reason string `json:"reason,omitempty"`
+	// LastSuccessAt is a time of the last successful iteration of a loop.
+	LastSuccessAt *time.Time `json:"last_success_at,omitempty"`
+}
+
+// Report is a readiness report of all components.
+type Report struct {
+	Ready      bool              `json:"ready"`
+	Components []ComponentStatus `json:"components"`
+}
+
+// Check runs dependency checks and checks heartbeats of all loops.
+func (r *Registry) Check(ctx context.Context) Report {
+	r.lock.Lock()
+	heartbeats := append([]*Heartbeat(nil), r.heartbeats...)
+	checks := append([]check(nil), r.checks...)
+	r.lock.Unlock()
+
+	report := Report{
+		Ready:      true,
+		Components: make([]ComponentStatus, 0, len(checks)+len(heartbeats)),
+	}
+
+	for _, check := range checks {
+		status := ComponentStatus{
+			Component:     check.name,
+			Ready:         true,
+			Reason:        "",
+			LastSuccessAt: nil,
+		}
+
+		if err := r.runCheck(ctx, check); err != nil {
+			status.Ready = false
+			status.Reason = err.Error()
+		}
+
+		report.Components = append(report.Components, status)
+	}
+
+	now := time.Now()
+
+	for _, heartbeat := range heartbeats {
+		report.Components = append(report.Components, heartbeat.status(now, r.staleAfter))
+	}
+
+	for _, status := range report.Components {
+		if !status.Ready {
+			report.Ready = false
+
+			r.logger.Warn().
+				Str("component", status.Component).
+				Str("reason", status.Reason).
+				Msg("component is not ready")
+		}
+	}
+
+	return report
+}
+
+func (r *Registry) runCheck(ctx context.Context, check check) error {
+	ctx, cancel := context.WithTimeout(ctx, r.checkTimeout)
+	defer cancel()
+
+	return check.fn(ctx)
+}
+
+// LivenessHandler responds with 200 while the process is up.
+func (r *Registry) LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+	})
+}
+
+// ReadinessHandler responds with a readiness report: 200 if all components are ready and 503 otherwise.
+func (r *Registry) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		report := r.Check(req.Context())
+
+		statusCode := http.StatusOK
+		if !report.Ready {
+			statusCode = http.StatusServiceUnavailable
+		}
+
+		writeJSON(w, statusCode, report)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// Heartbeat tracks iterations of a loop. It affects readiness only after it is registered.
+type Heartbeat struct {
+	component string
+
+	lock          sync.Mutex
+	registeredAt  time.Time
+	lastSuccessAt time.Time
+	lastError     error
+}
+
+func NewHeartbeat(component string) *Heartbeat {
+	return &Heartbeat{
+		component:     component,
+		registeredAt:  time.Time{},
+		lastSuccessAt: time.Time{},
+		lastError:     nil,
+	}
+}
+
+// Beat records a successful iteration.
+func (h *Heartbeat) Beat() {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.lastSuccessAt = time.Now()
+	h.lastError = nil
+}
+
+// Fail records a failed iteration, the error is reported as a reason if the loop becomes stale.
+func (h *Heartbeat) Fail(err error) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.lastError = err
+}
+
+func (h *Heartbeat) status(now time.Time, staleAfter time.Duration) ComponentStatus {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	status := ComponentStatus{
+		Component:     h.component,
+		Ready:         true,
+		Reason:        "",
+		LastSuccessAt: nil,
+	}
+
+	since := h.registeredAt
+	if !h.lastSuccessAt.IsZero() {
+		lastSuccessAt := h.lastSuccessAt
+		since = lastSuccessAt
+		status.LastSuccessAt = &lastSuccessAt
+	}
+
+	if now.Sub(since) <= staleAfter {
+		return status
+	}
+
+	status.Ready = false
+
+	if h.lastSuccessAt.IsZero() {
+		status.Reason = fmt.Sprintf("no successful iteration since the loop started %s ago", now.Sub(since).Round(time.Second))
+	} else {
+		status.Reason = fmt.Sprintf("no successful iteration for %s", now.Sub(since).Round(time.Second))
+	}
+
+	if h.lastError != nil {
+		status.Reason += ", last error: " + h.lastError.Error()
+	}
+
+	return status
+}
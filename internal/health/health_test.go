@@ -0,0 +1,170 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+func TestHeartbeat_Status(t *testing.T) {
+	now := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		registeredAgo time.Duration
+		// lastSuccessAgo is zero if the loop has no successful iterations
+		lastSuccessAgo time.Duration
+		lastError      error
+		wantReady      bool
+		wantReason     string
+	}{
+		{name: "just started", registeredAgo: time.Minute, wantReady: true, wantReason: ""},
+		{
+			name:          "no iterations",
+			registeredAgo: time.Hour,
+			wantReady:     false,
+			wantReason:    "no successful iteration since the loop started 1h0m0s ago",
+		},
+		{name: "recent success", registeredAgo: time.Hour, lastSuccessAgo: time.Minute, wantReady: true, wantReason: ""},
+		{
+			name:           "recent success after errors",
+			registeredAgo:  time.Hour,
+			lastSuccessAgo: time.Minute,
+			lastError:      errors.New("connection refused"),
+			wantReady:      true,
+			wantReason:     "",
+		},
+		{
+			name:           "stale",
+			registeredAgo:  time.Hour,
+			lastSuccessAgo: 30 * time.Minute,
+			wantReady:      false,
+			wantReason:     "no successful iteration for 30m0s",
+		},
+		{
+			name:           "stale with error",
+			registeredAgo:  time.Hour,
+			lastSuccessAgo: 30 * time.Minute,
+			lastError:      errors.New("connection refused"),
+			wantReady:      false,
+			wantReason:     "no successful iteration for 30m0s, last error: connection refused",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			heartbeat := NewHeartbeat("scraper")
+			heartbeat.registeredAt = now.Add(-tt.registeredAgo)
+			heartbeat.lastError = tt.lastError
+
+			if tt.lastSuccessAgo > 0 {
+				heartbeat.lastSuccessAt = now.Add(-tt.lastSuccessAgo)
+			}
+
+			status := heartbeat.status(now, 10*time.Minute)
+
+			if status.Component != "scraper" || status.Ready != tt.wantReady || status.Reason != tt.wantReason {
+				t.Errorf("status = %+v, want ready %t with reason %q", status, tt.wantReady, tt.wantReason)
+			}
+
+			if (status.LastSuccessAt != nil) != (tt.lastSuccessAgo > 0) {
+				t.Errorf("last success at = %v, want set %t", status.LastSuccessAt, tt.lastSuccessAgo > 0)
+			}
+		})
+	}
+}
+
+func TestHeartbeat_BeatClearsError(t *testing.T) {
+	heartbeat := NewHeartbeat("scraper")
+	heartbeat.Fail(errors.New("connection refused"))
+	heartbeat.Beat()
+
+	if heartbeat.lastError != nil || heartbeat.lastSuccessAt.IsZero() {
+		t.Errorf("heartbeat = error %v, last success at %s, want a success", heartbeat.lastError, heartbeat.lastSuccessAt)
+	}
+}
+
+func TestRegistry_ReadinessHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		checkErr   error
+		beat       bool
+		wantStatus int
+		wantReason string
+	}{
+		{name: "ready", checkErr: nil, beat: true, wantStatus: http.StatusOK, wantReason: ""},
+		{
+			name:       "check fails",
+			checkErr:   errors.New("ping: connection refused"),
+			beat:       true,
+			wantStatus: http.StatusServiceUnavailable,
+			wantReason: "ping: connection refused",
+		},
+		{
+			name:       "loop is stale",
+			checkErr:   nil,
+			beat:       false,
+			wantStatus: http.StatusServiceUnavailable,
+			wantReason: "no successful iteration since the loop started",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := NewRegistry(time.Minute, time.Second, zerolog.Nop())
+			registry.AddCheck("postgres", func(context.Context) error { return tt.checkErr })
+
+			heartbeat := NewHeartbeat("scraper")
+			registry.Register(heartbeat)
+
+			if tt.beat {
+				heartbeat.Beat()
+			} else {
+				// the loop started long ago
+				heartbeat.registeredAt = time.Now().Add(-time.Hour)
+			}
+
+			recorder := httptest.NewRecorder()
+			registry.ReadinessHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+			if recorder.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", recorder.Code, tt.wantStatus)
+			}
+
+			var report Report
+			if err := json.NewDecoder(recorder.Body).Decode(&report); err != nil {
+				t.Fatalf("decode report: %v", err)
+			}
+
+			if report.Ready != (tt.wantStatus == http.StatusOK) || len(report.Components) != 2 {
+				t.Fatalf("report = %+v, want ready %t with 2 components", report, tt.wantStatus == http.StatusOK)
+			}
+
+			reasons := report.Components[0].Reason + report.Components[1].Reason
+			if !strings.Contains(reasons, tt.wantReason) || (tt.wantReason == "") != (reasons == "") {
+				t.Errorf("reasons = %q, want %q", reasons, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestRegistry_CheckTimeout(t *testing.T) {
+	registry := NewRegistry(time.Minute, 10*time.Millisecond, zerolog.Nop())
+	registry.AddCheck("postgres", func(ctx context.Context) error {
+		<-ctx.Done()
+
+		return ctx.Err()
+	})
+
+	report := registry.Check(context.Background())
+	if report.Ready || !strings.Contains(report.Components[0].Reason, context.DeadlineExceeded.Error()) {
+		t.Errorf("report = %+v, want a timed out check", report)
+	}
+}
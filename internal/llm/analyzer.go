@@ -0,0 +1,55 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/rishenco/scout/pkg/models"
+)
+
+type requestsLog interface {
+	Save(ctx context.Context, requestType string, request any, response any) error
+}
+
+type limiter interface {
+	Acquire(ctx context.Context, tokens int) (release func(err error), err error)
+}
+
+// Input is a source item prepared for analysis.
+type Input struct {
+	Source   string
+	SourceID string
+	// Object is sent to the model as a JSON user message.
+	Object any
+	// ExampleIDs are ids of tagged detections included in Object as few-shot examples.
+	ExampleIDs []int64
+}
+
+// PrepareInput prepares a source item of type T for analysis with profile settings.
+type PrepareInput[T any] func(item T, profileSettings models.ProfileSettings) Input
+
+// detectionOutput is a model response matching the detection schema.
+type detectionOutput struct {
+	IsRelevant bool           `json:"is_relevant"`
+	Confidence float64        `json:"confidence"`
+	Rationale  string         `json:"rationale"`
+	Properties map[string]any `json:"properties"`
+}
+
+// parseDetection parses a model response into a detection.
+func parseDetection(outputMessage string, model string, input Input) (models.Detection, error) {
+	var output detectionOutput
+	if err := json.Unmarshal([]byte(outputMessage), &output); err != nil {
+		return models.Detection{}, fmt.Errorf("unmarshal output: %w", err)
+	}
+
+	return models.Detection{
+		IsRelevant: output.IsRelevant,
+		Confidence: NormalizeConfidence(output.Confidence),
+		Rationale:  output.Rationale,
+		Properties: output.Properties,
+		Model:      model,
+		ExampleIDs: input.ExampleIDs,
+	}, nil
+}
@@ -0,0 +1,116 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"google.golang.org/genai"
+
+	"github.com/rishenco/scout/internal/openai"
+	"github.com/rishenco/scout/pkg/models"
+)
+
+// geminiRetryInfoType is a type of the Gemini error detail containing a requested retry delay.
+const geminiRetryInfoType = "type.googleapis.com/google.rpc.RetryInfo"
+
+// IsRetryable reports whether an analysis error is transient and the analysis may succeed
+// with another model or later: quota exhaustion, server errors and timeouts.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var geminiErr genai.APIError
+	if errors.As(err, &geminiErr) {
+		return isRetryableStatusCode(geminiErr.Code)
+	}
+
+	var openaiErr *openai.APIError
+	if errors.As(err, &openaiErr) {
+		return isRetryableStatusCode(openaiErr.StatusCode)
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	return false
+}
+
+func isRetryableStatusCode(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests ||
+		statusCode == http.StatusRequestTimeout ||
+		statusCode >= http.StatusInternalServerError
+}
+
+// classify returns a classification of an analysis error without the wrapped error.
+//
+// Rate limits keep the delay requested by the provider. Rejected requests are permanent:
+// the same input will be rejected again. Other errors, including authentication errors
+// that are fixed by configuration, are retryable.
+func classify(err error) models.AnalysisError {
+	var analysisErr *models.AnalysisError
+	if errors.As(err, &analysisErr) {
+		return models.AnalysisError{
+			Kind:       analysisErr.Kind,
+			Reason:     analysisErr.Reason,
+			RetryAfter: analysisErr.RetryAfter,
+			Err:        nil,
+		}
+	}
+
+	var (
+		statusCode int
+		retryAfter time.Duration
+		geminiErr  genai.APIError
+		openaiErr  *openai.APIError
+	)
+
+	switch {
+	case errors.As(err, &geminiErr):
+		statusCode = geminiErr.Code
+		retryAfter = geminiRetryDelay(geminiErr)
+	case errors.As(err, &openaiErr):
+		statusCode = openaiErr.StatusCode
+		retryAfter = openaiErr.RetryAfter
+	}
+
+	switch statusCode {
+	case http.StatusTooManyRequests:
+		return models.AnalysisError{Kind: models.RateLimitedAnalysisError, RetryAfter: retryAfter}
+	case http.StatusBadRequest, http.StatusRequestEntityTooLarge, http.StatusUnprocessableEntity:
+		return models.AnalysisError{Kind: models.PermanentAnalysisError, Reason: models.InvalidLLMRequestReason}
+	default:
+		return models.AnalysisError{Kind: models.RetryableAnalysisError}
+	}
+}
+
+func geminiRetryDelay(err genai.APIError) time.Duration {
+	for _, detail := range err.Details {
+		if detail["@type"] != geminiRetryInfoType {
+			continue
+		}
+
+		rawDelay, ok := detail["retryDelay"].(string)
+		if !ok {
+			continue
+		}
+
+		delay, parseErr := time.ParseDuration(rawDelay)
+		if parseErr != nil {
+			continue
+		}
+
+		return delay
+	}
+
+	return 0
+}
@@ -0,0 +1,66 @@
+package llm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/rishenco/scout/internal/openai"
+	"github.com/rishenco/scout/pkg/models"
+)
+
+func TestClassify_OpenAIErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       *openai.APIError
+		retryable bool
+		want      models.AnalysisError
+	}{
+		{
+			name:      "rate limited",
+			err:       &openai.APIError{StatusCode: http.StatusTooManyRequests, RetryAfter: 30 * time.Second},
+			retryable: true,
+			want:      models.AnalysisError{Kind: models.RateLimitedAnalysisError, RetryAfter: 30 * time.Second},
+		},
+		{
+			name:      "server error",
+			err:       &openai.APIError{StatusCode: http.StatusInternalServerError},
+			retryable: true,
+			want:      models.AnalysisError{Kind: models.RetryableAnalysisError},
+		},
+		{
+			name:      "bad gateway",
+			err:       &openai.APIError{StatusCode: http.StatusBadGateway},
+			retryable: true,
+			want:      models.AnalysisError{Kind: models.RetryableAnalysisError},
+		},
+		{
+			name:      "bad request",
+			err:       &openai.APIError{StatusCode: http.StatusBadRequest},
+			retryable: false,
+			want:      models.AnalysisError{Kind: models.PermanentAnalysisError, Reason: models.InvalidLLMRequestReason},
+		},
+		{
+			name:      "unprocessable entity",
+			err:       &openai.APIError{StatusCode: http.StatusUnprocessableEntity},
+			retryable: false,
+			want:      models.AnalysisError{Kind: models.PermanentAnalysisError, Reason: models.InvalidLLMRequestReason},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// analyzers wrap client errors
+			err := fmt.Errorf("create chat completion: %w", tt.err)
+
+			if got := classify(err); got != tt.want {
+				t.Errorf("classify() = %+v, want %+v", got, tt.want)
+			}
+
+			if got := IsRetryable(err); got != tt.retryable {
+				t.Errorf("IsRetryable() = %t, want %t", got, tt.retryable)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	"github.com/rishenco/scout/pkg/models"
+)
+
+// Analyzer is a source-specific analyzer of items of type T.
+type Analyzer[T any] interface {
+	Analyze(ctx context.Context, item T, profileSettings models.ProfileSettings) (models.Detection, error)
+}
+
+// ModelAnalyzer is an analyzer backed by a specific model.
+type ModelAnalyzer[T any] struct {
+	Model    string
+	Analyzer Analyzer[T]
+}
+
+// Fallback runs analyzers in priority order and moves to the next one when an analyzer fails with
+// a retryable error (see IsRetryable). Non-retryable errors are returned immediately.
+//
+// Returned errors are classified as models.AnalysisError by the error of the last analyzer.
+//
+// If profile settings pin a model, only the analyzer of that model is used.
+type Fallback[T any] struct {
+	analyzers []ModelAnalyzer[T]
+	logger    zerolog.Logger
+}
+
+func NewFallback[T any](analyzers []ModelAnalyzer[T], logger zerolog.Logger) *Fallback[T] {
+	return &Fallback[T]{
+		analyzers: analyzers,
+		logger:    logger,
+	}
+}
+
+func (f *Fallback[T]) Analyze(
+	ctx context.Context,
+	item T,
+	profileSettings models.ProfileSettings,
+) (models.Detection, error) {
+	analyzers, err := f.route(profileSettings)
+	if err != nil {
+		return models.Detection{}, err
+	}
+
+	var (
+		errs    []error
+		lastErr error
+	)
+
+	for _, analyzer := range analyzers {
+		detection, err := analyzer.Analyzer.Analyze(ctx, item, profileSettings)
+		if err == nil {
+			detection.Model = analyzer.Model
+
+			return detection, nil
+		}
+
+		errs = append(errs, fmt.Errorf("model %s: %w", analyzer.Model, err))
+		lastErr = err
+
+		if !IsRetryable(err) {
+			break
+		}
+
+		f.logger.Warn().
+			Err(err).
+			Str("model", analyzer.Model).
+			Msg("analyzer failed with retryable error, falling back to the next one")
+	}
+
+	// The error of the last analyzer defines how the analysis is retried
+	analysisErr := classify(lastErr)
+	analysisErr.Err = fmt.Errorf("all analyzers failed: %w", errors.Join(errs...))
+
+	return models.Detection{}, &analysisErr
+}
+
+func (f *Fallback[T]) route(profileSettings models.ProfileSettings) ([]ModelAnalyzer[T], error) {
+	if profileSettings.Model == nil {
+		return f.analyzers, nil
+	}
+
+	for _, analyzer := range f.analyzers {
+		if analyzer.Model == *profileSettings.Model {
+			return []ModelAnalyzer[T]{analyzer}, nil
+		}
+	}
+
+	return nil, models.NewPermanentAnalysisError(
+		models.ModelNotConfiguredReason,
+		fmt.Errorf("pinned model %s is not configured", *profileSettings.Model),
+	)
+}
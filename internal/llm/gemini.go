@@ -0,0 +1,155 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/samber/lo"
+	"google.golang.org/genai"
+
+	"github.com/rishenco/scout/internal/tracing"
+	"github.com/rishenco/scout/pkg/models"
+)
+
+type GeminiSettings struct {
+	Model       string
+	Temperature float32
+}
+
+// GeminiAnalyzer analyzes source items of type T using Gemini.
+// Sources differ only in the prompt and the input prepared from their items.
+type GeminiAnalyzer[T any] struct {
+	client       *genai.Client
+	settings     GeminiSettings
+	prompt       string
+	prepareInput PrepareInput[T]
+	requestsLog  requestsLog
+	limiter      limiter
+	logger       zerolog.Logger
+}
+
+func NewGeminiAnalyzer[T any](
+	ctx context.Context,
+	apiKey string,
+	settings GeminiSettings,
+	prompt string,
+	prepareInput PrepareInput[T],
+	requestsLog requestsLog,
+	limiter limiter,
+	logger zerolog.Logger,
+) (*GeminiAnalyzer[T], error) {
+	client, err := genai.NewClient(ctx, &genai.ClientConfig{
+		APIKey: apiKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("new client: %w", err)
+	}
+
+	return &GeminiAnalyzer[T]{
+		client:       client,
+		settings:     settings,
+		prompt:       prompt,
+		prepareInput: prepareInput,
+		requestsLog:  requestsLog,
+		limiter:      limiter,
+		logger:       logger,
+	}, nil
+}
+
+func (a *GeminiAnalyzer[T]) Analyze(
+	ctx context.Context,
+	item T,
+	profileSettings models.ProfileSettings,
+) (detection models.Detection, err error) {
+	input := a.prepareInput(item, profileSettings)
+
+	ctx, span := StartGeminiSpan(ctx, a.settings.Model, input.Source, input.SourceID, profileSettings.ProfileID)
+	defer func() { tracing.End(span, err) }()
+
+	logger := a.logger.With().Str("source_id", input.SourceID).Str("source", input.Source).Logger()
+
+	inputObjectJSON, err := json.Marshal(input.Object)
+	if err != nil {
+		return models.Detection{}, fmt.Errorf("marshal input object to json: %w", err)
+	}
+
+	cfg := &genai.GenerateContentConfig{
+		HTTPOptions:       &genai.HTTPOptions{},
+		SystemInstruction: genai.Text(a.prompt)[0],
+		Temperature:       lo.ToPtr(a.settings.Temperature),
+		TopP:              lo.ToPtr(float32(0.95)), //nolint:mnd // Currently hardcoded
+		TopK:              lo.ToPtr(float32(0)),
+		// CandidateCount:       0,
+		MaxOutputTokens: 8192, //nolint:mnd // Currently hardcoded
+		// StopSequences:        []string{},
+		// ResponseLogprobs:     false,
+		// Logprobs:             new(int32),
+		// PresencePenalty:      new(float32),
+		// FrequencyPenalty:     new(float32),
+		// Seed:                 new(int32),
+		ResponseMIMEType: "application/json",
+		ResponseSchema:   GeminiDetectionSchema(profileSettings.PropertyDefinitions()),
+		// RoutingConfig:        &genai.GenerationConfigRoutingConfig{},
+		// ModelSelectionConfig: &genai.ModelSelectionConfig{},
+		// SafetySettings:       []*genai.SafetySetting{},
+		// Tools:                []*genai.Tool{},
+		// ToolConfig:           &genai.ToolConfig{},
+		// Labels:               map[string]string{},
+		// CachedContent:        "",
+		// ResponseModalities:   []string{},
+		// MediaResolution:      "",
+		// SpeechConfig:         &genai.SpeechConfig{},
+		// AudioTimestamp:       false,
+		ThinkingConfig: &genai.ThinkingConfig{
+			ThinkingBudget: lo.ToPtr(int32(0)),
+		},
+	}
+
+	release, err := a.limiter.Acquire(ctx, EstimateTokens(a.prompt, string(inputObjectJSON)))
+	if err != nil {
+		return models.Detection{}, fmt.Errorf("acquire limiter: %w", err)
+	}
+
+	startedAt := time.Now()
+
+	// Generate content
+	resp, err := a.client.Models.GenerateContent(
+		ctx,
+		a.settings.Model,
+		genai.Text(string(inputObjectJSON)),
+		cfg,
+	)
+	release(err)
+	ObserveGeminiRequest(a.settings.Model, startedAt, resp, err)
+
+	if err != nil {
+		return models.Detection{}, fmt.Errorf("generate content: %w", err)
+	}
+
+	// Save request and response to log
+	if a.requestsLog != nil {
+		if err := a.requestsLog.Save(ctx, "analyze", inputObjectJSON, resp); err != nil {
+			logger.Error().Err(err).Msg("failed to save request/response to log")
+			// Don't return here, continue processing
+		}
+	}
+
+	if len(resp.Candidates) == 0 || len(resp.Candidates[0].Content.Parts) == 0 {
+		return models.Detection{}, errors.New("no content generated")
+	}
+
+	outputMessage := resp.Candidates[0].Content.Parts[0].Text
+
+	detection, err = parseDetection(outputMessage, a.settings.Model, input)
+	if err != nil {
+		logger.Error().Str("output_message", outputMessage).Err(err).Msg("failed to parse Google response")
+
+		return models.Detection{}, fmt.Errorf("failed to parse Google response: %w", err)
+	}
+
+	return detection, nil
+}
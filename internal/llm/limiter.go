@@ -0,0 +1,299 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang.org/x/time/rate"
+
+	"github.com/rishenco/scout/pkg/models"
+)
+
+const (
+	// minLimitFactor is a minimum share of the configured limits kept after rate-limited responses.
+	minLimitFactor = 0.1
+	// limitRecoveryStep is a share of the configured limits restored after each successful request.
+	limitRecoveryStep = 0.05
+	// charsPerToken is an approximate number of characters per token used to estimate prompt sizes.
+	charsPerToken = 4
+)
+
+// LimiterSettings configures a Limiter. Zero values mean no limit.
+type LimiterSettings struct {
+	RequestsPerMinute int
+	TokensPerMinute   int
+	MaxConcurrency    int
+}
+
+// LimiterStats is a snapshot of a Limiter state.
+type LimiterStats struct {
+	Provider string
+	Model    string
+	// RequestsPerMinute and TokensPerMinute are current limits, they are lower than configured ones
+	// after rate-limited responses. Zero means no limit.
+	RequestsPerMinute float64
+	TokensPerMinute   float64
+	MaxConcurrency    int
+	// InFlight is a number of requests being executed.
+	InFlight int
+	// Waiting is a number of requests waiting for the limits.
+	Waiting int
+	// PausedUntil is set when the provider requested to retry later.
+	PausedUntil *time.Time
+
+	RequestsTotal    int64
+	TokensTotal      int64
+	RateLimitedTotal int64
+}
+
+// Limiter limits requests to a model of an LLM provider: requests and tokens per minute and concurrent requests.
+// It is shared by all analyzers of the model.
+//
+// Limits adapt to rate-limited responses: they are halved on each rate-limited response
+// and restored step by step with successful requests. If the provider requests a retry delay,
+// new requests wait until it passes.
+type Limiter struct {
+	provider    string
+	model       string
+	settings    LimiterSettings
+	requests    *rate.Limiter
+	tokens      *rate.Limiter
+	concurrency chan struct{}
+
+	lock             sync.Mutex
+	factor           float64
+	pausedUntil      time.Time
+	inFlight         int
+	waiting          int
+	requestsTotal    int64
+	tokensTotal      int64
+	rateLimitedTotal int64
+
+	logger zerolog.Logger
+}
+
+func NewLimiter(provider string, model string, settings LimiterSettings, logger zerolog.Logger) *Limiter {
+	limiter := &Limiter{
+		provider: provider,
+		model:    model,
+		settings: settings,
+		requests: rate.NewLimiter(rate.Inf, 0),
+		tokens:   rate.NewLimiter(rate.Inf, 0),
+		factor:   1,
+		logger:   logger,
+	}
+
+	if settings.RequestsPerMinute > 0 {
+		limiter.requests = rate.NewLimiter(
+			perMinute(settings.RequestsPerMinute, 1),
+			burst(settings.RequestsPerMinute, 1),
+		)
+	}
+
+	if settings.TokensPerMinute > 0 {
+		limiter.tokens = rate.NewLimiter(perMinute(settings.TokensPerMinute, 1), burst(settings.TokensPerMinute, 1))
+	}
+
+	if settings.MaxConcurrency > 0 {
+		limiter.concurrency = make(chan struct{}, settings.MaxConcurrency)
+	}
+
+	return limiter
+}
+
+// Acquire waits until a request of a given estimated size fits the limits.
+//
+// The returned release function must be called with the result of the request.
+func (l *Limiter) Acquire(ctx context.Context, tokens int) (release func(err error), err error) {
+	l.updateWaiting(1)
+	defer l.updateWaiting(-1)
+
+	if err := l.waitPause(ctx); err != nil {
+		return nil, err
+	}
+
+	if l.concurrency != nil {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("wait for concurrency slot: %w", ctx.Err())
+		case l.concurrency <- struct{}{}:
+		}
+	}
+
+	if err := l.waitRate(ctx, tokens); err != nil {
+		l.releaseSlot()
+
+		return nil, err
+	}
+
+	l.lock.Lock()
+	l.inFlight++
+	l.requestsTotal++
+	l.tokensTotal += int64(tokens)
+	l.lock.Unlock()
+
+	return func(err error) {
+		l.releaseSlot()
+		l.observe(err)
+	}, nil
+}
+
+// Stats returns a snapshot of the limiter state.
+func (l *Limiter) Stats() LimiterStats {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	stats := LimiterStats{
+		Provider:          l.provider,
+		Model:             l.model,
+		RequestsPerMinute: float64(l.settings.RequestsPerMinute) * l.factor,
+		TokensPerMinute:   float64(l.settings.TokensPerMinute) * l.factor,
+		MaxConcurrency:    l.settings.MaxConcurrency,
+		InFlight:          l.inFlight,
+		Waiting:           l.waiting,
+		PausedUntil:       nil,
+		RequestsTotal:     l.requestsTotal,
+		TokensTotal:       l.tokensTotal,
+		RateLimitedTotal:  l.rateLimitedTotal,
+	}
+
+	if l.pausedUntil.After(time.Now()) {
+		pausedUntil := l.pausedUntil
+		stats.PausedUntil = &pausedUntil
+	}
+
+	return stats
+}
+
+func (l *Limiter) waitPause(ctx context.Context) error {
+	l.lock.Lock()
+	pause := time.Until(l.pausedUntil)
+	l.lock.Unlock()
+
+	if pause <= 0 {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("wait for pause: %w", ctx.Err())
+	case <-time.After(pause):
+		return nil
+	}
+}
+
+func (l *Limiter) waitRate(ctx context.Context, tokens int) error {
+	if err := l.requests.Wait(ctx); err != nil {
+		return fmt.Errorf("wait for requests limit: %w", err)
+	}
+
+	// Requests larger than the bucket would never fit, they wait for the full bucket instead
+	if l.settings.TokensPerMinute > 0 {
+		tokens = min(tokens, l.tokens.Burst())
+	}
+
+	if err := l.tokens.WaitN(ctx, tokens); err != nil {
+		return fmt.Errorf("wait for tokens limit: %w", err)
+	}
+
+	return nil
+}
+
+func (l *Limiter) releaseSlot() {
+	if l.concurrency != nil {
+		<-l.concurrency
+	}
+}
+
+func (l *Limiter) updateWaiting(delta int) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.waiting += delta
+}
+
+// observe adapts the limits to a result of a request.
+func (l *Limiter) observe(err error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.inFlight--
+
+	if err == nil {
+		if l.factor < 1 {
+			l.setFactorUnsafe(min(l.factor+limitRecoveryStep, 1))
+		}
+
+		return
+	}
+
+	classified := classify(err)
+	if classified.Kind != models.RateLimitedAnalysisError {
+		return
+	}
+
+	l.rateLimitedTotal++
+	l.setFactorUnsafe(max(l.factor/2, minLimitFactor)) //nolint:mnd // limits are halved
+
+	if classified.RetryAfter > 0 {
+		l.pausedUntil = time.Now().Add(classified.RetryAfter)
+	}
+
+	l.logger.Warn().
+		Str("provider", l.provider).
+		Str("model", l.model).
+		Float64("limit_factor", l.factor).
+		Dur("retry_after", classified.RetryAfter).
+		Msg("provider rate limited requests, lowering limits")
+}
+
+// setFactorUnsafe scales the limits and their bursts, so a full bucket can't exceed the lowered limits.
+func (l *Limiter) setFactorUnsafe(factor float64) {
+	l.factor = factor
+
+	if l.settings.RequestsPerMinute > 0 {
+		l.requests.SetLimit(perMinute(l.settings.RequestsPerMinute, factor))
+		l.requests.SetBurst(burst(l.settings.RequestsPerMinute, factor))
+	}
+
+	if l.settings.TokensPerMinute > 0 {
+		l.tokens.SetLimit(perMinute(l.settings.TokensPerMinute, factor))
+		l.tokens.SetBurst(burst(l.settings.TokensPerMinute, factor))
+	}
+}
+
+// EstimateTokens approximates a number of tokens in prompt parts.
+func EstimateTokens(parts ...string) int {
+	chars := 0
+	for _, part := range parts {
+		chars += len(part)
+	}
+
+	return chars/charsPerToken + 1
+}
+
+func perMinute(limit int, factor float64) rate.Limit {
+	return rate.Limit(float64(limit) * factor / time.Minute.Seconds())
+}
+
+// burst is a bucket size of a per minute limit, at least one request or token always fits.
+func burst(limit int, factor float64) int {
+	return max(int(float64(limit)*factor), 1)
+}
+
+// Limiters are limiters of all configured models.
+type Limiters []*Limiter
+
+// Stats returns snapshots of all limiters.
+func (l Limiters) Stats() []LimiterStats {
+	stats := make([]LimiterStats, 0, len(l))
+
+	for _, limiter := range l {
+		stats = append(stats, limiter.Stats())
+	}
+
+	return stats
+}
@@ -0,0 +1,81 @@
+package llm
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const limiterSubsystem = "llm_limiter"
+
+// LimitersCollector reports current limits and utilization of limiters.
+type LimitersCollector struct {
+	limiters          Limiters
+	requestsPerMinute *prometheus.Desc
+	tokensPerMinute   *prometheus.Desc
+	maxConcurrency    *prometheus.Desc
+	inFlight          *prometheus.Desc
+	waiting           *prometheus.Desc
+	paused            *prometheus.Desc
+	rateLimited       *prometheus.Desc
+}
+
+func NewLimitersCollector(limiters Limiters) *LimitersCollector {
+	desc := func(name string, help string) *prometheus.Desc {
+		return prometheus.NewDesc(
+			prometheus.BuildFQName("scout", limiterSubsystem, name),
+			help,
+			[]string{"provider", "model"},
+			nil,
+		)
+	}
+
+	return &LimitersCollector{
+		limiters:          limiters,
+		requestsPerMinute: desc("requests_per_minute", "Current requests per minute limit, 0 means no limit."),
+		tokensPerMinute:   desc("tokens_per_minute", "Current tokens per minute limit, 0 means no limit."),
+		maxConcurrency:    desc("max_concurrency", "Max concurrent requests, 0 means no limit."),
+		inFlight:          desc("in_flight_requests", "Number of requests being executed."),
+		waiting:           desc("waiting_requests", "Number of requests waiting for the limits."),
+		paused:            desc("paused", "1 if the provider requested to retry later and requests are paused."),
+		rateLimited:       desc("rate_limited_total", "Number of rate-limited responses."),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *LimitersCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.requestsPerMinute
+	ch <- c.tokensPerMinute
+	ch <- c.maxConcurrency
+	ch <- c.inFlight
+	ch <- c.waiting
+	ch <- c.paused
+	ch <- c.rateLimited
+}
+
+// Collect implements prometheus.Collector.
+func (c *LimitersCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, stats := range c.limiters.Stats() {
+		paused := 0.0
+		if stats.PausedUntil != nil {
+			paused = 1
+		}
+
+		for desc, value := range map[*prometheus.Desc]float64{
+			c.requestsPerMinute: stats.RequestsPerMinute,
+			c.tokensPerMinute:   stats.TokensPerMinute,
+			c.maxConcurrency:    float64(stats.MaxConcurrency),
+			c.inFlight:          float64(stats.InFlight),
+			c.waiting:           float64(stats.Waiting),
+			c.paused:            paused,
+		} {
+			ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, value, stats.Provider, stats.Model)
+		}
+
+		ch <- prometheus.MustNewConstMetric(
+			c.rateLimited,
+			prometheus.CounterValue,
+			float64(stats.RateLimitedTotal),
+			stats.Provider,
+			stats.Model,
+		)
+	}
+}
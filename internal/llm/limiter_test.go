@@ -0,0 +1,58 @@
+package llm
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/rs/zerolog"
+
+	"github.com/rishenco/scout/internal/openai"
+)
+
+func TestLimiter_ScalesBursts(t *testing.T) {
+	settings := LimiterSettings{RequestsPerMinute: 60, TokensPerMinute: 1000}
+	limiter := NewLimiter("test", "test-model", settings, zerolog.Nop())
+
+	release, err := limiter.Acquire(context.Background(), 10)
+	if err != nil {
+		t.Fatalf("acquire: %v", err)
+	}
+
+	release(&openai.APIError{StatusCode: http.StatusTooManyRequests})
+
+	if got := limiter.requests.Burst(); got != 30 {
+		t.Errorf("requests burst = %d, want 30", got)
+	}
+
+	if got := limiter.tokens.Burst(); got != 500 {
+		t.Errorf("tokens burst = %d, want 500", got)
+	}
+
+	release, err = limiter.Acquire(context.Background(), 10)
+	if err != nil {
+		t.Fatalf("acquire: %v", err)
+	}
+
+	release(nil)
+
+	if got := limiter.requests.Burst(); got != 33 {
+		t.Errorf("requests burst after recovery = %d, want 33", got)
+	}
+
+	if got := limiter.tokens.Burst(); got != 550 {
+		t.Errorf("tokens burst after recovery = %d, want 550", got)
+	}
+}
+
+func TestLimiter_BurstKeepsOneRequest(t *testing.T) {
+	limiter := NewLimiter("test", "test-model", LimiterSettings{RequestsPerMinute: 2, TokensPerMinute: 0}, zerolog.Nop())
+
+	limiter.lock.Lock()
+	limiter.setFactorUnsafe(minLimitFactor)
+	limiter.lock.Unlock()
+
+	if got := limiter.requests.Burst(); got != 1 {
+		t.Errorf("requests burst = %d, want 1", got)
+	}
+}
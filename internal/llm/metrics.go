@@ -0,0 +1,35 @@
+package llm
+
+import (
+	"time"
+
+	"google.golang.org/genai"
+
+	"github.com/rishenco/scout/internal/config"
+	"github.com/rishenco/scout/internal/metrics"
+	"github.com/rishenco/scout/internal/openai"
+)
+
+// ObserveGeminiRequest records metrics of a Gemini request.
+func ObserveGeminiRequest(model string, startedAt time.Time, resp *genai.GenerateContentResponse, err error) {
+	var promptTokens, completionTokens int
+
+	if resp != nil && resp.UsageMetadata != nil {
+		promptTokens = int(resp.UsageMetadata.PromptTokenCount)
+		completionTokens = int(resp.UsageMetadata.CandidatesTokenCount)
+	}
+
+	metrics.ObserveLLMRequest(config.GoogleProvider, model, startedAt, promptTokens, completionTokens, err)
+}
+
+// ObserveOpenAIRequest records metrics of an OpenAI-compatible request.
+func ObserveOpenAIRequest(model string, startedAt time.Time, resp openai.ChatCompletionResponse, err error) {
+	var promptTokens, completionTokens int
+
+	if resp.Usage != nil {
+		promptTokens = resp.Usage.PromptTokens
+		completionTokens = resp.Usage.CompletionTokens
+	}
+
+	metrics.ObserveLLMRequest(config.OpenAICompatibleProvider, model, startedAt, promptTokens, completionTokens, err)
+}
@@ -0,0 +1,129 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/samber/lo"
+
+	"github.com/rishenco/scout/internal/openai"
+	"github.com/rishenco/scout/internal/tracing"
+	"github.com/rishenco/scout/pkg/models"
+)
+
+type chatCompletionsClient interface {
+	CreateChatCompletion(
+		ctx context.Context,
+		request openai.ChatCompletionRequest,
+	) (openai.ChatCompletionResponse, error)
+}
+
+type OpenAISettings struct {
+	Model       string
+	Temperature float32
+}
+
+// OpenAIAnalyzer analyzes source items of type T using any server implementing OpenAI Chat Completions API
+// with JSON schema response format (OpenAI, llama.cpp, Ollama, vLLM, etc.).
+// Sources differ only in the prompt and the input prepared from their items.
+type OpenAIAnalyzer[T any] struct {
+	client       chatCompletionsClient
+	settings     OpenAISettings
+	prompt       string
+	prepareInput PrepareInput[T]
+	requestsLog  requestsLog
+	limiter      limiter
+	logger       zerolog.Logger
+}
+
+func NewOpenAIAnalyzer[T any](
+	client chatCompletionsClient,
+	settings OpenAISettings,
+	prompt string,
+	prepareInput PrepareInput[T],
+	requestsLog requestsLog,
+	limiter limiter,
+	logger zerolog.Logger,
+) *OpenAIAnalyzer[T] {
+	return &OpenAIAnalyzer[T]{
+		client:       client,
+		settings:     settings,
+		prompt:       prompt,
+		prepareInput: prepareInput,
+		requestsLog:  requestsLog,
+		limiter:      limiter,
+		logger:       logger,
+	}
+}
+
+func (a *OpenAIAnalyzer[T]) Analyze(
+	ctx context.Context,
+	item T,
+	profileSettings models.ProfileSettings,
+) (detection models.Detection, err error) {
+	input := a.prepareInput(item, profileSettings)
+
+	ctx, span := StartOpenAISpan(ctx, a.settings.Model, input.Source, input.SourceID, profileSettings.ProfileID)
+	defer func() { tracing.End(span, err) }()
+
+	logger := a.logger.With().Str("source_id", input.SourceID).Str("source", input.Source).Logger()
+
+	inputObjectJSON, err := json.Marshal(input.Object)
+	if err != nil {
+		return models.Detection{}, fmt.Errorf("marshal input object to json: %w", err)
+	}
+
+	request := openai.ChatCompletionRequest{
+		Model: a.settings.Model,
+		Messages: []openai.Message{
+			{Role: openai.RoleSystem, Content: a.prompt},
+			{Role: openai.RoleUser, Content: string(inputObjectJSON)},
+		},
+		Temperature:    lo.ToPtr(a.settings.Temperature),
+		TopP:           lo.ToPtr(float32(0.95)), //nolint:mnd // Currently hardcoded
+		MaxTokens:      lo.ToPtr(8192),          //nolint:mnd // Currently hardcoded
+		ResponseFormat: openai.DetectionResponseFormat(profileSettings.PropertyDefinitions()),
+	}
+
+	release, err := a.limiter.Acquire(ctx, EstimateTokens(a.prompt, string(inputObjectJSON)))
+	if err != nil {
+		return models.Detection{}, fmt.Errorf("acquire limiter: %w", err)
+	}
+
+	startedAt := time.Now()
+
+	resp, err := a.client.CreateChatCompletion(ctx, request)
+	release(err)
+	ObserveOpenAIRequest(a.settings.Model, startedAt, resp, err)
+
+	if err != nil {
+		return models.Detection{}, fmt.Errorf("create chat completion: %w", err)
+	}
+
+	// Save request and response to log
+	if a.requestsLog != nil {
+		if err := a.requestsLog.Save(ctx, "analyze", inputObjectJSON, resp); err != nil {
+			logger.Error().Err(err).Msg("failed to save request/response to log")
+			// Don't return here, continue processing
+		}
+	}
+
+	if len(resp.Choices) == 0 || resp.Choices[0].Message.Content == "" {
+		return models.Detection{}, errors.New("no content generated")
+	}
+
+	outputMessage := resp.Choices[0].Message.Content
+
+	detection, err = parseDetection(outputMessage, a.settings.Model, input)
+	if err != nil {
+		logger.Error().Str("output_message", outputMessage).Err(err).Msg("failed to parse model response")
+
+		return models.Detection{}, fmt.Errorf("failed to parse model response: %w", err)
+	}
+
+	return detection, nil
+}
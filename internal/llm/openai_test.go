@@ -0,0 +1,132 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/rishenco/scout/internal/openai"
+	"github.com/rishenco/scout/pkg/models"
+)
+
+type noopLimiter struct{}
+
+func (noopLimiter) Acquire(context.Context, int) (func(err error), error) {
+	return func(error) {}, nil
+}
+
+// newFakeChatCompletions starts a server responding to chat completions with a given message
+// and records the last request.
+func newFakeChatCompletions(t *testing.T, content string, lastRequest *openai.ChatCompletionRequest) *openai.Client {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(lastRequest); err != nil {
+			t.Errorf("decode request: %v", err)
+		}
+
+		response := openai.ChatCompletionResponse{
+			ID:    "chatcmpl-1",
+			Model: "gpt-test",
+			Choices: []openai.Choice{
+				{Index: 0, Message: openai.Message{Role: "assistant", Content: content}, FinishReason: "stop"},
+			},
+			Usage: nil,
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	t.Cleanup(server.Close)
+
+	return openai.New(server.URL, "", time.Second, zerolog.Nop())
+}
+
+func newTestOpenAIAnalyzer(client *openai.Client) *OpenAIAnalyzer[string] {
+	return NewOpenAIAnalyzer(
+		client,
+		OpenAISettings{Model: "gpt-test", Temperature: 0.2},
+		"prompt",
+		func(item string, profileSettings models.ProfileSettings) Input {
+			return Input{
+				Source:     "test",
+				SourceID:   item,
+				Object:     map[string]string{"item": item, "relevancy_filter": profileSettings.RelevancyFilter},
+				ExampleIDs: []int64{7},
+			}
+		},
+		nil,
+		noopLimiter{},
+		zerolog.Nop(),
+	)
+}
+
+func TestOpenAIAnalyzer_Analyze(t *testing.T) {
+	var request openai.ChatCompletionRequest
+
+	client := newFakeChatCompletions(
+		t,
+		`{"is_relevant": true, "confidence": 1.5, "rationale": "Matches the filter.", "properties": {"summary": "A post"}}`,
+		&request,
+	)
+
+	profileSettings := models.ProfileSettings{
+		ProfileID:           1,
+		RelevancyFilter:     "go posts",
+		ExtractedProperties: map[string]string{"summary": "Summary of the post"},
+	}
+
+	detection, err := newTestOpenAIAnalyzer(client).Analyze(context.Background(), "post-1", profileSettings)
+	if err != nil {
+		t.Fatalf("analyze: %v", err)
+	}
+
+	want := models.Detection{
+		IsRelevant: true,
+		Confidence: 1, // clamped to [0, 1]
+		Rationale:  "Matches the filter.",
+		Properties: map[string]any{"summary": "A post"},
+		Model:      "gpt-test",
+		ExampleIDs: []int64{7},
+	}
+
+	if !reflect.DeepEqual(detection, want) {
+		t.Errorf("detection = %+v, want %+v", detection, want)
+	}
+
+	if request.Model != "gpt-test" || len(request.Messages) != 2 {
+		t.Fatalf("unexpected request: %+v", request)
+	}
+
+	if request.Messages[0].Role != openai.RoleSystem || request.Messages[0].Content != "prompt" {
+		t.Errorf("system message = %+v, want the prompt", request.Messages[0])
+	}
+
+	if want := `{"item":"post-1","relevancy_filter":"go posts"}`; request.Messages[1].Content != want {
+		t.Errorf("user message = %s, want %s", request.Messages[1].Content, want)
+	}
+
+	if request.ResponseFormat == nil || request.ResponseFormat.Type != openai.ResponseFormatJSONSchema {
+		t.Errorf("response format = %+v, want json schema", request.ResponseFormat)
+	}
+}
+
+func TestOpenAIAnalyzer_AnalyzeInvalidResponse(t *testing.T) {
+	var request openai.ChatCompletionRequest
+
+	client := newFakeChatCompletions(t, `not a json`, &request)
+
+	_, err := newTestOpenAIAnalyzer(client).Analyze(context.Background(), "post-1", models.ProfileSettings{})
+
+	var syntaxErr *json.SyntaxError
+	if !errors.As(err, &syntaxErr) {
+		t.Errorf("error = %v, want a json syntax error", err)
+	}
+}
@@ -0,0 +1,108 @@
+package llm
+
+import (
+	"sort"
+
+	"github.com/samber/lo"
+	"google.golang.org/genai"
+
+	"github.com/rishenco/scout/pkg/models"
+)
+
+// Descriptions of the confidence and rationale fields of a detection.
+const (
+	confidenceDescription = "Confidence in the relevance decision from 0 (a guess) to 1 (certain)."
+	rationaleDescription  = "One or two sentences explaining the relevance decision."
+)
+
+// NormalizeConfidence clamps a confidence returned by a model to [0, 1],
+// since not all providers enforce numeric bounds of response schemas.
+func NormalizeConfidence(confidence float64) float64 {
+	return min(max(confidence, 0), 1)
+}
+
+// urlDescriptionSuffix is appended to descriptions of url properties, since Gemini schemas have no URL format.
+const urlDescriptionSuffix = " The value must be an absolute URL."
+
+// GeminiDetectionSchema builds a Gemini response schema of a detection:
+// a relevancy flag, a confidence with a rationale of the decision and a typed value for each extracted property.
+//
+// Example of a response:
+//
+//	{
+//		"is_relevant": true,
+//		"confidence": 0.9,
+//		"rationale": "The post introduces a new open-source project written in Go.",
+//		"properties": {
+//			"idea_success": 7,
+//			"is_ai_related": false,
+//			"project_url": "https://github.com/rasadov/EcommerceAPI",
+//			"summary": "Introduction of an open-source e-commerce backend built in Go..."
+//		}
+//	}
+func GeminiDetectionSchema(definitions map[string]models.PropertyDefinition) *genai.Schema {
+	propertiesSchema := make(map[string]*genai.Schema, len(definitions))
+	requiredProperties := make([]string, 0, len(definitions))
+
+	for property, definition := range definitions {
+		propertiesSchema[property] = GeminiPropertySchema(definition)
+
+		if definition.Required {
+			requiredProperties = append(requiredProperties, property)
+		}
+	}
+
+	sort.Strings(requiredProperties)
+
+	return &genai.Schema{
+		Type: genai.TypeObject,
+		Properties: map[string]*genai.Schema{
+			"is_relevant": {
+				Type: genai.TypeBoolean,
+			},
+			"confidence": {
+				Type:        genai.TypeNumber,
+				Description: confidenceDescription,
+				Minimum:     lo.ToPtr(0.0),
+				Maximum:     lo.ToPtr(1.0),
+			},
+			"rationale": {
+				Type:        genai.TypeString,
+				Description: rationaleDescription,
+			},
+			"properties": {
+				Type:       genai.TypeObject,
+				Properties: propertiesSchema,
+				Required:   requiredProperties,
+			},
+		},
+		Required: []string{"is_relevant", "confidence", "rationale", "properties"},
+	}
+}
+
+// GeminiPropertySchema builds a Gemini schema of an extracted property value.
+func GeminiPropertySchema(definition models.PropertyDefinition) *genai.Schema {
+	schema := &genai.Schema{
+		Type:        genai.TypeString,
+		Nullable:    lo.ToPtr(!definition.Required),
+		Description: definition.Description,
+	}
+
+	switch definition.Kind {
+	case models.PropertyKindEnum:
+		schema.Format = "enum"
+		schema.Enum = definition.Enum
+	case models.PropertyKindNumber:
+		schema.Type = genai.TypeNumber
+	case models.PropertyKindBoolean:
+		schema.Type = genai.TypeBoolean
+	case models.PropertyKindList:
+		schema.Type = genai.TypeArray
+		schema.Items = &genai.Schema{Type: genai.TypeString}
+	case models.PropertyKindURL:
+		schema.Description += urlDescriptionSuffix
+	case models.PropertyKindString:
+	}
+
+	return schema
+}
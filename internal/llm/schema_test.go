@@ -0,0 +1,111 @@
+package llm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/samber/lo"
+	"google.golang.org/genai"
+
+	"github.com/rishenco/scout/pkg/models"
+)
+
+func TestGeminiPropertySchema(t *testing.T) {
+	definition := func(kind models.PropertyKind, enum []string, required bool) models.PropertyDefinition {
+		return models.PropertyDefinition{
+			Description:  "Description.",
+			PropertyType: models.PropertyType{Kind: kind, Enum: enum, Required: required},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		definition models.PropertyDefinition
+		want       *genai.Schema
+	}{
+		{
+			name:       "optional string",
+			definition: definition(models.PropertyKindString, nil, false),
+			want:       &genai.Schema{Type: genai.TypeString, Nullable: lo.ToPtr(true), Description: "Description."},
+		},
+		{
+			name:       "required number",
+			definition: definition(models.PropertyKindNumber, nil, true),
+			want:       &genai.Schema{Type: genai.TypeNumber, Nullable: lo.ToPtr(false), Description: "Description."},
+		},
+		{
+			name:       "boolean",
+			definition: definition(models.PropertyKindBoolean, nil, true),
+			want:       &genai.Schema{Type: genai.TypeBoolean, Nullable: lo.ToPtr(false), Description: "Description."},
+		},
+		{
+			name:       "enum",
+			definition: definition(models.PropertyKindEnum, []string{"low", "high"}, false),
+			want: &genai.Schema{
+				Type:        genai.TypeString,
+				Format:      "enum",
+				Enum:        []string{"low", "high"},
+				Nullable:    lo.ToPtr(true),
+				Description: "Description.",
+			},
+		},
+		{
+			name:       "list",
+			definition: definition(models.PropertyKindList, nil, false),
+			want: &genai.Schema{
+				Type:        genai.TypeArray,
+				Items:       &genai.Schema{Type: genai.TypeString},
+				Nullable:    lo.ToPtr(true),
+				Description: "Description.",
+			},
+		},
+		{
+			name:       "url",
+			definition: definition(models.PropertyKindURL, nil, false),
+			want: &genai.Schema{
+				Type:        genai.TypeString,
+				Nullable:    lo.ToPtr(true),
+				Description: "Description." + urlDescriptionSuffix,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GeminiPropertySchema(tt.definition); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("schema = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGeminiDetectionSchema(t *testing.T) {
+	definition := func(kind models.PropertyKind, required bool) models.PropertyDefinition {
+		return models.PropertyDefinition{
+			Description:  "Description.",
+			PropertyType: models.PropertyType{Kind: kind, Enum: nil, Required: required},
+		}
+	}
+
+	schema := GeminiDetectionSchema(map[string]models.PropertyDefinition{
+		"summary": definition(models.PropertyKindString, true),
+		"stars":   definition(models.PropertyKindNumber, true),
+		"url":     definition(models.PropertyKindURL, false),
+	})
+
+	properties := schema.Properties["properties"]
+
+	if len(properties.Properties) != 3 || properties.Properties["stars"].Type != genai.TypeNumber {
+		t.Errorf("properties = %+v, want typed schemas of all properties", properties.Properties)
+	}
+
+	// required properties are sorted, so the schema is the same for the same settings
+	if want := []string{"stars", "summary"}; !reflect.DeepEqual(properties.Required, want) {
+		t.Errorf("required properties = %v, want %v", properties.Required, want)
+	}
+
+	wantRequired := []string{"is_relevant", "confidence", "rationale", "properties"}
+	if !reflect.DeepEqual(schema.Required, wantRequired) {
+		t.Errorf("required fields = %v, want %v", schema.Required, wantRequired)
+	}
+}
@@ -0,0 +1,59 @@
+package llm
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/rishenco/scout/internal/config"
+	"github.com/rishenco/scout/internal/tracing"
+)
+
+// StartGeminiSpan starts a span of a Gemini analysis of a source item.
+func StartGeminiSpan(
+	ctx context.Context,
+	model string,
+	source string,
+	sourceID string,
+	profileID int64,
+) (context.Context, trace.Span) {
+	return startAnalyzeSpan(ctx, "Gemini.Analyze", config.GoogleProvider, model, source, sourceID, profileID)
+}
+
+// StartOpenAISpan starts a span of an OpenAI-compatible analysis of a source item.
+func StartOpenAISpan(
+	ctx context.Context,
+	model string,
+	source string,
+	sourceID string,
+	profileID int64,
+) (context.Context, trace.Span) {
+	return startAnalyzeSpan(
+		ctx,
+		"OpenAICompatible.Analyze",
+		config.OpenAICompatibleProvider,
+		model,
+		source,
+		sourceID,
+		profileID,
+	)
+}
+
+func startAnalyzeSpan(
+	ctx context.Context,
+	name string,
+	provider string,
+	model string,
+	source string,
+	sourceID string,
+	profileID int64,
+) (context.Context, trace.Span) {
+	return tracing.Start(ctx, name, trace.WithAttributes(
+		attribute.String("scout.llm.provider", provider),
+		attribute.String("scout.llm.model", model),
+		attribute.String("scout.source", source),
+		attribute.String("scout.source_id", sourceID),
+		attribute.Int64("scout.profile_id", profileID),
+	))
+}
@@ -0,0 +1,167 @@
+// Package metrics defines Prometheus metrics of all Scout components.
+//
+// Metrics are registered in the default registry and served by Handler.
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "scout"
+
+// Result label values.
+const (
+	SuccessResult = "success"
+	FailureResult = "failure"
+)
+
+var (
+	// ScraperPagesFetched counts pages (listings, feeds) loaded by scrapers.
+	// Feed is a subreddit, a Hacker News feed or an RSS feed URL.
+	ScraperPagesFetched = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "scraper",
+		Name:      "pages_fetched_total",
+		Help:      "Number of pages fetched by scrapers.",
+	}, []string{"source", "feed"})
+
+	// ScraperItemsInserted counts new posts, stories and entries saved by scrapers.
+	ScraperItemsInserted = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "scraper",
+		Name:      "items_inserted_total",
+		Help:      "Number of new items inserted by scrapers.",
+	}, []string{"source", "feed"})
+
+	// EnrichedItems counts items loaded by enrichers by result.
+	EnrichedItems = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "enricher",
+		Name:      "items_total",
+		Help:      "Number of items processed by enrichers.",
+	}, []string{"source", "result"})
+
+	// ScheduledTasks counts analysis tasks created by schedulers.
+	ScheduledTasks = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "scheduler",
+		Name:      "tasks_created_total",
+		Help:      "Number of analysis tasks created by schedulers.",
+	}, []string{"source"})
+
+	// TaskProcessingDuration observes processing of claimed analysis tasks.
+	TaskProcessingDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "task_processor",
+		Name:      "task_duration_seconds",
+		Help:      "Duration of analysis task processing.",
+		Buckets:   []float64{0.1, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300},
+	}, []string{"source", "type", "result"})
+
+	// LLMRequestDuration observes requests to LLM providers.
+	LLMRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "llm",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of LLM requests.",
+		Buckets:   []float64{0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300},
+	}, []string{"provider", "model", "result"})
+
+	// LLMTokens counts tokens reported by LLM providers. Kind is "prompt" or "completion".
+	LLMTokens = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "llm",
+		Name:      "tokens_total",
+		Help:      "Number of tokens used by LLM requests.",
+	}, []string{"provider", "model", "kind"})
+
+	// Detections counts saved detections.
+	Detections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "detections_total",
+		Help:      "Number of saved detections.",
+	}, []string{"profile_id", "relevant"})
+
+	// WebhookDeliveries counts webhook delivery attempts.
+	WebhookDeliveries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "webhooks",
+		Name:      "deliveries_total",
+		Help:      "Number of webhook delivery attempts.",
+	}, []string{"result"})
+
+	// HTTPRequestDuration observes requests to the API.
+	HTTPRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of API requests.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+)
+
+// Result returns a result label value of an operation.
+func Result(err error) string {
+	if err != nil {
+		return FailureResult
+	}
+
+	return SuccessResult
+}
+
+// ObserveLLMRequest records a request to an LLM provider and its token usage.
+func ObserveLLMRequest(
+	provider string,
+	model string,
+	startedAt time.Time,
+	promptTokens int,
+	completionTokens int,
+	err error,
+) {
+	LLMRequestDuration.WithLabelValues(provider, model, Result(err)).Observe(time.Since(startedAt).Seconds())
+
+	if promptTokens > 0 {
+		LLMTokens.WithLabelValues(provider, model, "prompt").Add(float64(promptTokens))
+	}
+
+	if completionTokens > 0 {
+		LLMTokens.WithLabelValues(provider, model, "completion").Add(float64(completionTokens))
+	}
+}
+
+// ObserveDetection records a saved detection.
+func ObserveDetection(profileID int64, isRelevant bool) {
+	Detections.WithLabelValues(strconv.FormatInt(profileID, 10), strconv.FormatBool(isRelevant)).Inc()
+}
+
+// Handler serves metrics of the default registry.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// GinMiddleware observes durations of handled requests.
+//
+// Requests are labeled with the route template to keep the number of series bounded.
+func GinMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		startedAt := time.Now()
+
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+
+		HTTPRequestDuration.
+			WithLabelValues(c.Request.Method, route, strconv.Itoa(c.Writer.Status())).
+			Observe(time.Since(startedAt).Seconds())
+	}
+}
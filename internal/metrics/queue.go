@@ -0,0 +1,68 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rs/zerolog"
+
+	"github.com/rishenco/scout/pkg/models"
+)
+
+type taskQueue interface {
+	GetQueueStatistics(ctx context.Context) (models.TaskStatistics, error)
+}
+
+// QueueCollector reports the analysis task queue depth by status.
+//
+// The queue is queried on each scrape.
+type QueueCollector struct {
+	queue   taskQueue
+	timeout time.Duration
+	depth   *prometheus.Desc
+	logger  zerolog.Logger
+}
+
+func NewQueueCollector(queue taskQueue, timeout time.Duration, logger zerolog.Logger) *QueueCollector {
+	return &QueueCollector{
+		queue:   queue,
+		timeout: timeout,
+		depth: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "task_queue", "tasks"),
+			"Number of analysis tasks by status.",
+			[]string{"status"},
+			nil,
+		),
+		logger: logger,
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *QueueCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.depth
+}
+
+// Collect implements prometheus.Collector.
+func (c *QueueCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	statistics, err := c.queue.GetQueueStatistics(ctx)
+	if err != nil {
+		c.logger.Error().Err(err).Msg("get queue statistics")
+
+		ch <- prometheus.NewInvalidMetric(c.depth, err)
+
+		return
+	}
+
+	for status, count := range map[models.TaskStatus]int64{
+		models.TaskStatusPending:   statistics.Pending,
+		models.TaskStatusClaimed:   statistics.Claimed,
+		models.TaskStatusFailed:    statistics.Failed,
+		models.TaskStatusCommitted: statistics.Committed,
+	} {
+		ch <- prometheus.MustNewConstMetric(c.depth, prometheus.GaugeValue, float64(count), string(status))
+	}
+}
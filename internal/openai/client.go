@@ -0,0 +1,128 @@
+package openai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// Client is a minimal client of OpenAI-compatible Chat Completions API.
+//
+// Besides OpenAI itself, the API is implemented by self-hosted servers like llama.cpp, Ollama and vLLM.
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+	apiKey     string
+
+	logger zerolog.Logger
+}
+
+// New creates a new Client.
+//
+// baseURL - URL of the API including version prefix, e.g. https://api.openai.com/v1 or http://localhost:8080/v1
+//
+// apiKey - bearer token, may be empty for servers without authentication.
+func New(baseURL string, apiKey string, timeout time.Duration, logger zerolog.Logger) *Client {
+	return &Client{
+		httpClient: &http.Client{Timeout: timeout},
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		apiKey:     apiKey,
+		logger:     logger,
+	}
+}
+
+// APIError is returned when the API responds with a non-2xx status code.
+type APIError struct {
+	StatusCode int
+	Body       string
+	// RetryAfter is a delay from the Retry-After header. Zero if the header is absent.
+	RetryAfter time.Duration
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("openai api error: status code %d: %s", e.StatusCode, e.Body)
+}
+
+// CreateChatCompletion calls POST /chat/completions.
+func (c *Client) CreateChatCompletion(
+	ctx context.Context,
+	request ChatCompletionRequest,
+) (ChatCompletionResponse, error) {
+	requestBody, err := json.Marshal(request)
+	if err != nil {
+		return ChatCompletionResponse{}, fmt.Errorf("marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		c.baseURL+"/chat/completions",
+		bytes.NewReader(requestBody),
+	)
+	if err != nil {
+		return ChatCompletionResponse{}, fmt.Errorf("new request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return ChatCompletionResponse{}, fmt.Errorf("do request: %w", err)
+	}
+
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			c.logger.Error().Err(closeErr).Msg("failed to close response body")
+		}
+	}()
+
+	responseBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return ChatCompletionResponse{}, fmt.Errorf("read body: %w", err)
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return ChatCompletionResponse{}, &APIError{
+			StatusCode: resp.StatusCode,
+			Body:       string(responseBody),
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+		}
+	}
+
+	var response ChatCompletionResponse
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return ChatCompletionResponse{}, fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	return response, nil
+}
+
+// parseRetryAfter parses a Retry-After header value: either a number of seconds or an HTTP date.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(max(seconds, 0)) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0)
+	}
+
+	return 0
+}
@@ -0,0 +1,145 @@
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/samber/lo"
+
+	"github.com/rishenco/scout/pkg/models"
+)
+
+func TestClient_CreateChatCompletion(t *testing.T) {
+	var requestBody map[string]any
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/chat/completions" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+
+		if auth := r.Header.Get("Authorization"); auth != "Bearer secret" {
+			t.Errorf("authorization = %q, want bearer token", auth)
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("read body: %v", err)
+		}
+
+		if err := json.Unmarshal(body, &requestBody); err != nil {
+			t.Errorf("unmarshal body: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"id": "chatcmpl-1",
+			"model": "gpt-test",
+			"choices": [{"index": 0, "message": {"role": "assistant", "content": "{}"}, "finish_reason": "stop"}],
+			"usage": {"prompt_tokens": 10, "completion_tokens": 2, "total_tokens": 12}
+		}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := New(server.URL+"/v1/", "secret", time.Second, zerolog.Nop())
+
+	request := ChatCompletionRequest{
+		Model: "gpt-test",
+		Messages: []Message{
+			{Role: RoleSystem, Content: "prompt"},
+			{Role: RoleUser, Content: "input"},
+		},
+		Temperature: lo.ToPtr(float32(0.5)),
+		TopP:        nil,
+		MaxTokens:   nil,
+		ResponseFormat: DetectionResponseFormat(map[string]models.PropertyDefinition{
+			"summary": {Description: "Summary", PropertyType: models.PropertyType{Kind: models.PropertyKindString}},
+		}),
+	}
+
+	response, err := client.CreateChatCompletion(context.Background(), request)
+	if err != nil {
+		t.Fatalf("create chat completion: %v", err)
+	}
+
+	if len(response.Choices) != 1 || response.Choices[0].Message.Content != "{}" {
+		t.Errorf("unexpected choices: %+v", response.Choices)
+	}
+
+	if response.Usage == nil || response.Usage.PromptTokens != 10 || response.Usage.CompletionTokens != 2 {
+		t.Errorf("unexpected usage: %+v", response.Usage)
+	}
+
+	if requestBody["model"] != "gpt-test" || len(requestBody["messages"].([]any)) != 2 {
+		t.Errorf("unexpected request body: %v", requestBody)
+	}
+
+	if _, ok := requestBody["top_p"]; ok {
+		t.Error("unset parameters must be omitted")
+	}
+
+	responseFormat, _ := requestBody["response_format"].(map[string]any)
+	if responseFormat["type"] != ResponseFormatJSONSchema {
+		t.Fatalf("response format = %v, want %s", responseFormat, ResponseFormatJSONSchema)
+	}
+
+	jsonSchema, _ := responseFormat["json_schema"].(map[string]any)
+	if jsonSchema["name"] != "detection" || jsonSchema["strict"] != true {
+		t.Errorf("unexpected json schema: %v", jsonSchema)
+	}
+
+	schema, _ := jsonSchema["schema"].(map[string]any)
+	if schema["additionalProperties"] != false {
+		t.Errorf("strict schema must forbid additional properties: %v", schema)
+	}
+
+	required, _ := schema["required"].([]any)
+	if len(required) != 4 {
+		t.Errorf("required = %v, want is_relevant, confidence, rationale and properties", required)
+	}
+}
+
+func TestClient_CreateChatCompletion_APIError(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		retryAfter string
+		want       time.Duration
+	}{
+		{name: "rate limited", statusCode: http.StatusTooManyRequests, retryAfter: "7", want: 7 * time.Second},
+		{name: "without retry after", statusCode: http.StatusServiceUnavailable, retryAfter: "", want: 0},
+		{name: "invalid retry after", statusCode: http.StatusTooManyRequests, retryAfter: "soon", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				if tt.retryAfter != "" {
+					w.Header().Set("Retry-After", tt.retryAfter)
+				}
+
+				w.WriteHeader(tt.statusCode)
+				_, _ = w.Write([]byte(`{"error": {"message": "failed"}}`))
+			}))
+			t.Cleanup(server.Close)
+
+			_, err := New(server.URL, "", time.Second, zerolog.Nop()).
+				CreateChatCompletion(context.Background(), ChatCompletionRequest{Model: "gpt-test"})
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("error = %v, want APIError", err)
+			}
+
+			if apiErr.StatusCode != tt.statusCode || apiErr.RetryAfter != tt.want {
+				t.Errorf("api error = %+v, want status %d and retry after %v", apiErr, tt.statusCode, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package openai
+
+import (
+	"sort"
+
+	"github.com/rishenco/scout/pkg/models"
+)
+
+// urlDescriptionSuffix is appended to descriptions of url properties, since strict schemas do not support formats.
+const urlDescriptionSuffix = " The value must be an absolute URL."
+
+// Descriptions of the confidence and rationale fields of a detection.
+const (
+	confidenceDescription = "Confidence in the relevance decision from 0 (a guess) to 1 (certain)."
+	rationaleDescription  = "One or two sentences explaining the relevance decision."
+)
+
+// DetectionResponseFormat builds a strict JSON schema response format of a detection:
+// a relevancy flag, a confidence with a rationale of the decision and a typed value for each extracted property.
+// Optional properties are nullable.
+func DetectionResponseFormat(definitions map[string]models.PropertyDefinition) *ResponseFormat {
+	propertiesSchema := make(map[string]any, len(definitions))
+	requiredProperties := make([]string, 0, len(definitions))
+
+	for property, definition := range definitions {
+		propertiesSchema[property] = propertySchema(definition)
+
+		// Strict mode requires all properties to be listed, optional ones are nullable instead.
+		requiredProperties = append(requiredProperties, property)
+	}
+
+	sort.Strings(requiredProperties)
+
+	return &ResponseFormat{
+		Type: ResponseFormatJSONSchema,
+		JSONSchema: &JSONSchema{
+			Name:   "detection",
+			Strict: true,
+			Schema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"is_relevant": map[string]any{
+						"type": "boolean",
+					},
+					"confidence": map[string]any{
+						"type":        "number",
+						"description": confidenceDescription,
+					},
+					"rationale": map[string]any{
+						"type":        "string",
+						"description": rationaleDescription,
+					},
+					"properties": map[string]any{
+						"type":                 "object",
+						"properties":           propertiesSchema,
+						"required":             requiredProperties,
+						"additionalProperties": false,
+					},
+				},
+				"required":             []string{"is_relevant", "confidence", "rationale", "properties"},
+				"additionalProperties": false,
+			},
+		},
+	}
+}
+
+func propertySchema(definition models.PropertyDefinition) map[string]any {
+	schema := map[string]any{
+		"description": definition.Description,
+	}
+
+	valueType := "string"
+
+	switch definition.Kind {
+	case models.PropertyKindEnum:
+		enum := make([]any, 0, len(definition.Enum)+1)
+		for _, value := range definition.Enum {
+			enum = append(enum, value)
+		}
+
+		if !definition.Required {
+			enum = append(enum, nil)
+		}
+
+		schema["enum"] = enum
+	case models.PropertyKindNumber:
+		valueType = "number"
+	case models.PropertyKindBoolean:
+		valueType = "boolean"
+	case models.PropertyKindList:
+		valueType = "array"
+		schema["items"] = map[string]any{"type": "string"}
+	case models.PropertyKindURL:
+		schema["description"] = definition.Description + urlDescriptionSuffix
+	case models.PropertyKindString:
+	}
+
+	if definition.Required {
+		schema["type"] = valueType
+	} else {
+		schema["type"] = []string{valueType, "null"}
+	}
+
+	return schema
+}
@@ -0,0 +1,127 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/rishenco/scout/pkg/models"
+)
+
+type APIKeyStorage struct {
+	pool   *pgxpool.Pool
+	logger zerolog.Logger
+}
+
+func NewAPIKeyStorage(pool *pgxpool.Pool, logger zerolog.Logger) *APIKeyStorage {
+	return &APIKeyStorage{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// CreateAPIKey saves a key with a given hash.
+func (s *APIKeyStorage) CreateAPIKey(ctx context.Context, key models.APIKey, keyHash string) (models.APIKey, error) {
+	query := `
+		INSERT INTO scout.api_keys (name, prefix, key_hash, scopes, created_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		RETURNING id, name, prefix, scopes, created_at, revoked_at
+	`
+
+	created, err := scanAPIKey(s.pool.QueryRow(ctx, query, key.Name, key.Prefix, keyHash, key.Scopes))
+	if err != nil {
+		return models.APIKey{}, fmt.Errorf("scan: %w", err)
+	}
+
+	return created, nil
+}
+
+// GetAPIKeyByHash returns a key that is not revoked by its hash.
+func (s *APIKeyStorage) GetAPIKeyByHash(ctx context.Context, keyHash string) (key models.APIKey, found bool, err error) {
+	query := `
+		SELECT id, name, prefix, scopes, created_at, revoked_at
+		FROM scout.api_keys
+		WHERE key_hash = $1 AND revoked_at IS NULL
+	`
+
+	key, err = scanAPIKey(s.pool.QueryRow(ctx, query, keyHash))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.APIKey{}, false, nil
+		}
+
+		return models.APIKey{}, false, fmt.Errorf("scan: %w", err)
+	}
+
+	return key, true, nil
+}
+
+// ListAPIKeys returns all keys including revoked ones.
+func (s *APIKeyStorage) ListAPIKeys(ctx context.Context) ([]models.APIKey, error) {
+	query := `
+		SELECT id, name, prefix, scopes, created_at, revoked_at
+		FROM scout.api_keys
+		ORDER BY id
+	`
+
+	rows, err := s.pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	keys := make([]models.APIKey, 0)
+
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		keys = append(keys, key)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows: %w", err)
+	}
+
+	return keys, nil
+}
+
+// RevokeAPIKey revokes a key. Revoked keys are kept to be listed.
+func (s *APIKeyStorage) RevokeAPIKey(ctx context.Context, id int64) (found bool, err error) {
+	query := `
+		UPDATE scout.api_keys
+		SET revoked_at = COALESCE(revoked_at, NOW())
+		WHERE id = $1
+	`
+
+	tag, err := s.pool.Exec(ctx, query, id)
+	if err != nil {
+		return false, fmt.Errorf("exec: %w", err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+func scanAPIKey(row pgx.Row) (models.APIKey, error) {
+	var key models.APIKey
+
+	err := row.Scan(
+		&key.ID,
+		&key.Name,
+		&key.Prefix,
+		&key.Scopes,
+		&key.CreatedAt,
+		&key.RevokedAt,
+	)
+	if err != nil {
+		return models.APIKey{}, err //nolint:wrapcheck // callers wrap the error
+	}
+
+	return key, nil
+}
@@ -0,0 +1,47 @@
+package pg
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// CheckConnection checks that the database accepts connections.
+func CheckConnection(pool *pgxpool.Pool) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		if err := pool.Ping(ctx); err != nil {
+			return fmt.Errorf("ping: %w", err)
+		}
+
+		return nil
+	}
+}
+
+// CheckMigrations checks that goose migrations are applied up to the expected version.
+func CheckMigrations(pool *pgxpool.Pool, expectedVersion int64) func(ctx context.Context) error {
+	// The latest record of a version tells whether it is applied
+	query := `
+		SELECT COALESCE(MAX(version_id), 0)
+		FROM (
+			SELECT DISTINCT ON (version_id) version_id, is_applied
+			FROM goose_db_version
+			ORDER BY version_id, id DESC
+		) AS versions
+		WHERE is_applied
+	`
+
+	return func(ctx context.Context) error {
+		var version int64
+
+		if err := pool.QueryRow(ctx, query).Scan(&version); err != nil {
+			return fmt.Errorf("get schema version: %w", err)
+		}
+
+		if version < expectedVersion {
+			return fmt.Errorf("migrations are not applied: schema version %d, expected %d", version, expectedVersion)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,92 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+)
+
+// Listener listens to a Postgres notification channel on a dedicated connection.
+type Listener struct {
+	pool             *pgxpool.Pool
+	channel          string
+	reconnectTimeout time.Duration
+	logger           zerolog.Logger
+}
+
+func NewListener(
+	pool *pgxpool.Pool,
+	channel string,
+	reconnectTimeout time.Duration,
+	logger zerolog.Logger,
+) *Listener {
+	return &Listener{
+		pool:             pool,
+		channel:          channel,
+		reconnectTimeout: reconnectTimeout,
+		logger:           logger,
+	}
+}
+
+// Listen calls onNotification for each notification of the channel until the context is canceled.
+//
+// If the connection is lost, Listen reconnects after the reconnect timeout.
+// Notifications sent while there is no connection are lost, so listeners should not rely on them.
+func (l *Listener) Listen(ctx context.Context, onNotification func()) {
+	l.logger.Info().
+		Str("channel", l.channel).
+		Msg("starting listener")
+
+	for {
+		err := l.listen(ctx, onNotification)
+		if ctx.Err() != nil {
+			return
+		}
+
+		l.logger.Error().
+			Err(err).
+			Str("channel", l.channel).
+			Msg("listen")
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(l.reconnectTimeout):
+		}
+	}
+}
+
+func (l *Listener) listen(ctx context.Context, onNotification func()) error {
+	pooledConn, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("acquire connection: %w", err)
+	}
+
+	// The connection is in the LISTEN state, so it is closed instead of being returned to the pool
+	conn := pooledConn.Hijack()
+	defer conn.Close(context.WithoutCancel(ctx))
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{l.channel}.Sanitize()); err != nil {
+		return fmt.Errorf("listen: %w", err)
+	}
+
+	// Wake up once after (re)connecting in case notifications were missed
+	onNotification()
+
+	for {
+		if _, err := conn.WaitForNotification(ctx); err != nil {
+			if errors.Is(err, context.Canceled) {
+				return nil
+			}
+
+			return fmt.Errorf("wait for notification: %w", err)
+		}
+
+		onNotification()
+	}
+}
@@ -5,9 +5,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog"
 	"github.com/samber/lo"
@@ -16,6 +18,29 @@ import (
 	"github.com/rishenco/scout/pkg/models"
 )
 
+// snapshotProfileSettingsQuery appends the current state of profile settings to their version history.
+const snapshotProfileSettingsQuery = `
+	INSERT INTO scout.profile_settings_versions (
+		profile_id, source, version, relevancy_filter, extracted_properties, property_types, model, few_shot, created_at
+	)
+	SELECT ps.profile_id, ps.source, ps.version, ps.relevancy_filter, ps.extracted_properties, ps.property_types,
+		ps.model, ps.few_shot, ps.updated_at
+	FROM scout.profile_settings ps
+	WHERE ps.profile_id = $1 AND ps.source IS NOT DISTINCT FROM $2
+`
+
+// returningProfileSettingsVersion returns a version appended by snapshotProfileSettingsQuery.
+const returningProfileSettingsVersion = `
+	RETURNING profile_id, source, version, relevancy_filter, extracted_properties, property_types,
+		model, few_shot, created_at
+`
+
+// invalidRegularExpressionCode is the SQLSTATE of errors raised by Postgres on malformed regular expressions.
+const invalidRegularExpressionCode = "2201B"
+
+// webhookExcerptLength is a maximum length of a post excerpt in webhook events.
+const webhookExcerptLength = 500
+
 type ScoutStorage struct {
 	pool   *pgxpool.Pool
 	logger zerolog.Logger
@@ -28,24 +53,103 @@ func NewScoutStorage(pool *pgxpool.Pool, logger zerolog.Logger) *ScoutStorage {
 	}
 }
 
-func (s *ScoutStorage) SaveDetection(ctx context.Context, record models.DetectionRecord) error {
-	query := `
-		INSERT INTO scout.detections (source, source_id, profile_id, settings_version, is_relevant, properties)
-		VALUES ($1, $2, $3, $4, $5, $6)
+// SaveDetection saves a detection. For a relevant detection it also adds events for active webhooks
+// of the profile to the webhook outbox in the same transaction.
+func (s *ScoutStorage) SaveDetection(
+	ctx context.Context,
+	record models.DetectionRecord,
+) (detectionID int64, err error) {
+	saveDetectionQuery := `
+		INSERT INTO scout.detections (
+			source, source_id, profile_id, settings_version, is_relevant, properties, model, example_ids,
+			confidence, rationale
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id, created_at
 	`
 
-	_, err := s.pool.Exec(
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("begin tx: %w", err)
+	}
+
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+
+		if rollbackErr == nil || errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			return
+		}
+
+		s.logger.Error().Err(rollbackErr).Msg("failed to rollback tx")
+	}()
+
+	row := tx.QueryRow(
 		ctx,
-		query,
+		saveDetectionQuery,
 		record.Source,
 		record.SourceID,
 		record.ProfileID,
 		record.SettingsVersion,
 		record.IsRelevant,
 		record.Properties,
+		record.Model,
+		lo.CoalesceSliceOrEmpty(record.ExampleIDs),
+		record.Confidence,
+		record.Rationale,
 	)
+
+	if err := row.Scan(&record.ID, &record.CreatedAt); err != nil {
+		return 0, fmt.Errorf("scan: %w", err)
+	}
+
+	if record.IsRelevant {
+		if err := s.enqueueWebhookEvents(ctx, tx, record); err != nil {
+			return 0, fmt.Errorf("enqueue webhook events: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("commit tx: %w", err)
+	}
+
+	return record.ID, nil
+}
+
+// enqueueWebhookEvents adds relevant detection events for active webhooks of the detection's profile to the outbox.
+func (s *ScoutStorage) enqueueWebhookEvents(ctx context.Context, tx pgx.Tx, record models.DetectionRecord) error {
+	summaryQuery := `
+		SELECT COALESCE(sd.title, ''), COALESCE(sd.url, ''), COALESCE(left(sd.body, $3), '')
+		FROM scout.source_documents sd
+		WHERE sd.source = $1 AND sd.source_id = $2
+	`
+
+	enqueueQuery := `
+		INSERT INTO scout.webhook_outbox (webhook_id, detection_id, event, payload)
+		SELECT w.id, $1, $2, $3
+		FROM scout.webhooks w
+		WHERE w.profile_id = $4 AND w.active
+	`
+
+	event := models.WebhookEvent{
+		Event:     models.RelevantDetectionEvent,
+		Detection: record,
+		Post:      models.PostSummary{},
+	}
+
+	row := tx.QueryRow(ctx, summaryQuery, record.Source, record.SourceID, webhookExcerptLength)
+
+	err := row.Scan(&event.Post.Title, &event.Post.URL, &event.Post.Excerpt)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("get post summary: %w", err)
+	}
+
+	payload, err := json.Marshal(event)
 	if err != nil {
-		return fmt.Errorf("exec: %w", err)
+		return fmt.Errorf("marshal event: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, enqueueQuery, record.ID, event.Event, payload, record.ProfileID); err != nil {
+		return fmt.Errorf("insert outbox events: %w", err)
 	}
 
 	return nil
@@ -62,7 +166,8 @@ func (s *ScoutStorage) GetProfile(
 	`
 
 	getProfileSettingsQuery := `
-		SELECT ps.source, ps.profile_id, ps.version, ps.relevancy_filter, ps.extracted_properties, ps.created_at, ps.updated_at
+		SELECT ps.source, ps.profile_id, ps.version, ps.relevancy_filter, ps.extracted_properties, ps.property_types,
+			ps.model, ps.few_shot, ps.created_at, ps.updated_at
 		FROM scout.profile_settings ps
 		WHERE ps.profile_id = $1
 	`
@@ -94,6 +199,9 @@ func (s *ScoutStorage) GetProfile(
 			&settings.Version,
 			&settings.RelevancyFilter,
 			&settings.ExtractedProperties,
+			&settings.PropertyTypes,
+			&settings.Model,
+			&settings.FewShot,
 			&settings.CreatedAt,
 			&settings.UpdatedAt,
 		)
@@ -124,7 +232,8 @@ func (s *ScoutStorage) GetAllProfiles(ctx context.Context) ([]models.Profile, er
 	`
 
 	getProfileSettingsQuery := `
-		SELECT ps.profile_id, ps.source, ps.version, ps.relevancy_filter, ps.extracted_properties, ps.created_at, ps.updated_at
+		SELECT ps.profile_id, ps.source, ps.version, ps.relevancy_filter, ps.extracted_properties, ps.property_types,
+			ps.model, ps.few_shot, ps.created_at, ps.updated_at
 		FROM scout.profile_settings ps
 	`
 
@@ -168,6 +277,9 @@ func (s *ScoutStorage) GetAllProfiles(ctx context.Context) ([]models.Profile, er
 			&settings.Version,
 			&settings.RelevancyFilter,
 			&settings.ExtractedProperties,
+			&settings.PropertyTypes,
+			&settings.Model,
+			&settings.FewShot,
 			&settings.CreatedAt,
 			&settings.UpdatedAt,
 		)
@@ -224,8 +336,11 @@ func (s *ScoutStorage) CreateProfile(ctx context.Context, profile models.Profile
 	`
 
 	createSettingsQuery := `
-		INSERT INTO scout.profile_settings (profile_id, source, relevancy_filter, extracted_properties, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, NOW(), NOW())
+		INSERT INTO scout.profile_settings (
+			profile_id, source, relevancy_filter, extracted_properties, property_types, model, few_shot,
+			created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
 	`
 
 	tx, err := s.pool.Begin(ctx)
@@ -254,6 +369,16 @@ func (s *ScoutStorage) CreateProfile(ctx context.Context, profile models.Profile
 			return 0, fmt.Errorf("marshal default settings extracted properties: %w", err)
 		}
 
+		propertyTypesJSON, err := marshalPropertyTypes(profile.DefaultSettings.PropertyTypes)
+		if err != nil {
+			return 0, fmt.Errorf("marshal default settings property types: %w", err)
+		}
+
+		fewShotJSON, err := marshalFewShotSettings(profile.DefaultSettings.FewShot)
+		if err != nil {
+			return 0, fmt.Errorf("marshal default settings few-shot settings: %w", err)
+		}
+
 		_, err = tx.Exec(
 			ctx,
 			createSettingsQuery,
@@ -261,10 +386,17 @@ func (s *ScoutStorage) CreateProfile(ctx context.Context, profile models.Profile
 			nil,
 			profile.DefaultSettings.RelevancyFilter,
 			extractedPropertiesJSON,
+			propertyTypesJSON,
+			profile.DefaultSettings.Model,
+			fewShotJSON,
 		)
 		if err != nil {
 			return 0, fmt.Errorf("insert default settings: %w", err)
 		}
+
+		if _, err := tx.Exec(ctx, snapshotProfileSettingsQuery, profileID, nil); err != nil {
+			return 0, fmt.Errorf("snapshot default settings: %w", err)
+		}
 	}
 
 	for source, settings := range profile.SourcesSettings {
@@ -273,6 +405,16 @@ func (s *ScoutStorage) CreateProfile(ctx context.Context, profile models.Profile
 			return 0, fmt.Errorf("marshal source settings extracted properties: %w", err)
 		}
 
+		propertyTypesJSON, err := marshalPropertyTypes(settings.PropertyTypes)
+		if err != nil {
+			return 0, fmt.Errorf("marshal source settings property types: %w", err)
+		}
+
+		fewShotJSON, err := marshalFewShotSettings(settings.FewShot)
+		if err != nil {
+			return 0, fmt.Errorf("marshal source settings few-shot settings: %w", err)
+		}
+
 		_, err = tx.Exec(
 			ctx,
 			createSettingsQuery,
@@ -280,10 +422,17 @@ func (s *ScoutStorage) CreateProfile(ctx context.Context, profile models.Profile
 			source,
 			settings.RelevancyFilter,
 			extractedPropertiesJSON,
+			propertyTypesJSON,
+			settings.Model,
+			fewShotJSON,
 		)
 		if err != nil {
 			return 0, fmt.Errorf("insert source settings: %w", err)
 		}
+
+		if _, err := tx.Exec(ctx, snapshotProfileSettingsQuery, profileID, source); err != nil {
+			return 0, fmt.Errorf("snapshot source settings: %w", err)
+		}
 	}
 
 	if err := tx.Commit(ctx); err != nil {
@@ -369,65 +518,289 @@ func (s *ScoutStorage) UpdateProfile(ctx context.Context, update models.ProfileU
 
 		// Update settings
 
-		if settingsUpdate.RelevancyFilter == nil && settingsUpdate.ExtractedProperties == nil {
+		updated, err := updateProfileSettings(ctx, tx, update.ProfileID, source, *settingsUpdate)
+		if err != nil {
+			return err
+		}
+
+		if !updated {
 			// No changes
 			continue
 		}
 
-		// Inserting if not exists
+		_, err = tx.Exec(ctx, snapshotProfileSettingsQuery, update.ProfileID, source)
+		if err != nil {
+			return fmt.Errorf("insert scout.profile_settings_versions: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+
+	return nil
+}
+
+// updateProfileSettings inserts missing settings of a profile and applies a settings update to them
+// with a new version. It returns false without changes if the update is empty.
+//
+// source - settings source, nil means default settings
+func updateProfileSettings(
+	ctx context.Context,
+	tx pgx.Tx,
+	profileID int64,
+	source *string,
+	settingsUpdate models.ProfileSettingsUpdate,
+) (updated bool, err error) {
+	if settingsUpdate.RelevancyFilter == nil &&
+		settingsUpdate.ExtractedProperties == nil &&
+		settingsUpdate.PropertyTypes == nil &&
+		!settingsUpdate.Model.IsSet() &&
+		!settingsUpdate.FewShot.IsSet() {
+		return false, nil
+	}
+
+	// Inserting if not exists
+	//
+	// Version continues the history of previously deleted settings, so versions are never reused.
+
+	lastVersionExpr := sq.Expr(
+		`(
+			SELECT COALESCE(MAX(psv.version), 0)
+			FROM scout.profile_settings_versions psv
+			WHERE psv.profile_id = ? AND psv.source IS NOT DISTINCT FROM ?
+		)`,
+		profileID,
+		source,
+	)
+
+	insertSettingsSb := tools.Psq().
+		Insert("scout.profile_settings").
+		Columns("profile_id", "source", "version", "relevancy_filter", "extracted_properties").
+		Values(profileID, source, lastVersionExpr, "", "{}").
+		Suffix("ON CONFLICT DO NOTHING")
+
+	insertSettingsSQL, insertSettingsArgs, err := insertSettingsSb.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("insertSettingsSb to sql: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, insertSettingsSQL, insertSettingsArgs...)
+	if err != nil {
+		return false, fmt.Errorf("insert scout.profile_settings: %w", err)
+	}
 
-		insertSettingsSb := tools.Psq().
-			Insert("scout.profile_settings").
-			Columns("profile_id", "source", "relevancy_filter", "extracted_properties").
-			Values(update.ProfileID, source, "", "{}").
-			Suffix("ON CONFLICT DO NOTHING")
+	sb := tools.Psq().
+		Update("scout.profile_settings").
+		Where(sq.Eq{"profile_id": profileID}).
+		Where(sq.Eq{"source": source}).
+		Set("updated_at", sq.Expr("NOW()")).
+		Set("version", sq.Expr("version + 1"))
+
+	if settingsUpdate.RelevancyFilter != nil {
+		sb = sb.Set("relevancy_filter", *settingsUpdate.RelevancyFilter)
+	}
 
-		insertSettingsSQL, insertSettingsArgs, err := insertSettingsSb.ToSql()
+	if settingsUpdate.ExtractedProperties != nil {
+		extractedPropertiesJSON, err := json.Marshal(settingsUpdate.ExtractedProperties)
 		if err != nil {
-			return fmt.Errorf("insertSettingsSb to sql: %w", err)
+			return false, fmt.Errorf("marshal extracted properties: %w", err)
 		}
 
-		_, err = tx.Exec(ctx, insertSettingsSQL, insertSettingsArgs...)
+		sb = sb.Set("extracted_properties", extractedPropertiesJSON)
+	}
+
+	if settingsUpdate.PropertyTypes != nil {
+		propertyTypesJSON, err := marshalPropertyTypes(*settingsUpdate.PropertyTypes)
 		if err != nil {
-			return fmt.Errorf("insert scout.profile_settings: %w", err)
+			return false, fmt.Errorf("marshal property types: %w", err)
 		}
 
-		sb := tools.Psq().
-			Update("scout.profile_settings").
-			Where(sq.Eq{"profile_id": update.ProfileID}).
-			Where(sq.Eq{"source": source}).
-			Set("updated_at", sq.Expr("NOW()")).
-			Set("version", sq.Expr("version + 1"))
+		sb = sb.Set("property_types", propertyTypesJSON)
+	}
+
+	if settingsUpdate.Model.IsSet() {
+		sb = sb.Set("model", settingsUpdate.Model.Value)
+	}
 
-		if settingsUpdate.RelevancyFilter != nil {
-			sb = sb.Set("relevancy_filter", *settingsUpdate.RelevancyFilter)
+	if settingsUpdate.FewShot.IsSet() {
+		fewShotJSON, err := marshalFewShotSettings(settingsUpdate.FewShot.Value)
+		if err != nil {
+			return false, fmt.Errorf("marshal few-shot settings: %w", err)
 		}
 
-		if settingsUpdate.ExtractedProperties != nil {
-			extractedPropertiesJSON, err := json.Marshal(settingsUpdate.ExtractedProperties)
-			if err != nil {
-				return fmt.Errorf("marshal extracted properties: %w", err)
-			}
+		sb = sb.Set("few_shot", fewShotJSON)
+	}
+
+	updateSettingsSQL, updateSettingsArgs, err := sb.ToSql()
+	if err != nil {
+		return false, fmt.Errorf("updateSettingsSb to sql: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, updateSettingsSQL, updateSettingsArgs...)
+	if err != nil {
+		return false, fmt.Errorf("update scout.profile_settings: %w", err)
+	}
 
-			sb = sb.Set("extracted_properties", extractedPropertiesJSON)
+	return true, nil
+}
+
+// UpdateProfileSettings applies a settings update to settings of a profile and returns the version it created.
+//
+// source - settings source, nil means default settings
+func (s *ScoutStorage) UpdateProfileSettings(
+	ctx context.Context,
+	profileID int64,
+	source *string,
+	settingsUpdate models.ProfileSettingsUpdate,
+) (settingsVersion models.ProfileSettingsVersion, found bool, err error) {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return models.ProfileSettingsVersion{}, false, fmt.Errorf("begin tx: %w", err)
+	}
+
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+
+		if rollbackErr == nil || errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			return
 		}
 
-		updateSettingsSQL, updateSettingsArgs, err := sb.ToSql()
+		s.logger.Error().Err(rollbackErr).Msg("failed to rollback tx")
+	}()
+
+	tag, err := tx.Exec(ctx, "UPDATE scout.profiles SET updated_at = NOW() WHERE id = $1", profileID)
+	if err != nil {
+		return models.ProfileSettingsVersion{}, false, fmt.Errorf("update scout.profiles: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return models.ProfileSettingsVersion{}, false, nil
+	}
+
+	updated, err := updateProfileSettings(ctx, tx, profileID, source, settingsUpdate)
+	if err != nil {
+		return models.ProfileSettingsVersion{}, false, err
+	}
+
+	if !updated {
+		return models.ProfileSettingsVersion{}, false, errors.New("empty settings update")
+	}
+
+	row := tx.QueryRow(ctx, snapshotProfileSettingsQuery+returningProfileSettingsVersion, profileID, source)
+
+	settingsVersion, err = scanProfileSettingsVersion(row)
+	if err != nil {
+		return models.ProfileSettingsVersion{}, false, fmt.Errorf("snapshot settings: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return models.ProfileSettingsVersion{}, false, fmt.Errorf("commit tx: %w", err)
+	}
+
+	return settingsVersion, true, nil
+}
+
+// GetProfileSettingsVersions returns the version history of profile settings ordered from the newest version.
+//
+// source - settings source, nil means default settings
+func (s *ScoutStorage) GetProfileSettingsVersions(
+	ctx context.Context,
+	profileID int64,
+	source *string,
+) ([]models.ProfileSettingsVersion, error) {
+	query := `
+		SELECT psv.profile_id, psv.source, psv.version, psv.relevancy_filter, psv.extracted_properties, psv.property_types,
+			psv.model, psv.few_shot, psv.created_at
+		FROM scout.profile_settings_versions psv
+		WHERE psv.profile_id = $1 AND psv.source IS NOT DISTINCT FROM $2
+		ORDER BY psv.version DESC
+	`
+
+	rows, err := s.pool.Query(ctx, query, profileID, source)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+
+	defer rows.Close()
+
+	result := make([]models.ProfileSettingsVersion, 0)
+
+	for rows.Next() {
+		var version models.ProfileSettingsVersion
+
+		err := rows.Scan(
+			&version.ProfileID,
+			&version.Source,
+			&version.Version,
+			&version.RelevancyFilter,
+			&version.ExtractedProperties,
+			&version.PropertyTypes,
+			&version.Model,
+			&version.FewShot,
+			&version.CreatedAt,
+		)
 		if err != nil {
-			return fmt.Errorf("updateSettingsSb to sql: %w", err)
+			return nil, fmt.Errorf("scan: %w", err)
 		}
 
-		_, err = tx.Exec(ctx, updateSettingsSQL, updateSettingsArgs...)
-		if err != nil {
-			return fmt.Errorf("update scout.profile_settings: %w", err)
+		result = append(result, version)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows err: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetProfileSettingsVersion returns a specific version of profile settings.
+//
+// source - settings source, nil means default settings
+func (s *ScoutStorage) GetProfileSettingsVersion(
+	ctx context.Context,
+	profileID int64,
+	source *string,
+	version int64,
+) (settingsVersion models.ProfileSettingsVersion, found bool, err error) {
+	query := `
+		SELECT psv.profile_id, psv.source, psv.version, psv.relevancy_filter, psv.extracted_properties, psv.property_types,
+			psv.model, psv.few_shot, psv.created_at
+		FROM scout.profile_settings_versions psv
+		WHERE psv.profile_id = $1 AND psv.source IS NOT DISTINCT FROM $2 AND psv.version = $3
+	`
+
+	settingsVersion, err = scanProfileSettingsVersion(s.pool.QueryRow(ctx, query, profileID, source, version))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.ProfileSettingsVersion{}, false, nil
 		}
+
+		return models.ProfileSettingsVersion{}, false, err
 	}
 
-	if err := tx.Commit(ctx); err != nil {
-		return fmt.Errorf("commit tx: %w", err)
+	return settingsVersion, true, nil
+}
+
+func scanProfileSettingsVersion(row pgx.Row) (models.ProfileSettingsVersion, error) {
+	var settingsVersion models.ProfileSettingsVersion
+
+	err := row.Scan(
+		&settingsVersion.ProfileID,
+		&settingsVersion.Source,
+		&settingsVersion.Version,
+		&settingsVersion.RelevancyFilter,
+		&settingsVersion.ExtractedProperties,
+		&settingsVersion.PropertyTypes,
+		&settingsVersion.Model,
+		&settingsVersion.FewShot,
+		&settingsVersion.CreatedAt,
+	)
+	if err != nil {
+		return models.ProfileSettingsVersion{}, fmt.Errorf("scan: %w", err)
 	}
 
-	return nil
+	return settingsVersion, nil
 }
 
 func (s *ScoutStorage) UpdateTags(
@@ -493,6 +866,84 @@ func (s *ScoutStorage) GetDetectionTags(ctx context.Context, detectionIDs []int6
 	return result, nil
 }
 
+// taggedExamplesCTE selects the latest tagged detection of each post of a profile and a source
+// with its relevance confirmed by the tag. The post being analyzed ($3) is never an example of itself.
+const taggedExamplesCTE = `
+	WITH tagged AS (
+		SELECT DISTINCT ON (d.source_id)
+			d.id,
+			d.source_id,
+			d.is_relevant = dt.relevancy_detected_correctly AS is_relevant,
+			NOT dt.relevancy_detected_correctly AS corrected,
+			dt.created_at AS tagged_at
+		FROM scout.detections d
+		JOIN scout.detection_tags dt ON dt.detection_id = d.id
+		WHERE d.profile_id = $1 AND d.source = $2 AND d.source_id <> $3
+			AND dt.relevancy_detected_correctly IS NOT NULL
+		ORDER BY d.source_id, dt.created_at DESC, d.id DESC
+	)
+`
+
+// GetFewShotExamples chooses tagged detections of a profile as examples for analyzing a post.
+// Examples are ordered by their priority, so the most important ones come first.
+func (s *ScoutStorage) GetFewShotExamples(
+	ctx context.Context,
+	profileID int64,
+	source string,
+	sourceID string,
+	settings models.FewShotSettings,
+) ([]models.FewShotExample, error) {
+	// Only the latest detections of each class and the latest corrections may be chosen by any strategy,
+	// so the rest of tagged detections are not loaded
+	query := taggedExamplesCTE + `
+		SELECT id, source_id, is_relevant, corrected, tagged_at
+		FROM (
+			SELECT
+				t.*,
+				ROW_NUMBER() OVER (PARTITION BY t.is_relevant ORDER BY t.tagged_at DESC, t.id DESC) AS class_rank,
+				ROW_NUMBER() OVER (PARTITION BY t.corrected ORDER BY t.tagged_at DESC, t.id DESC) AS corrected_rank
+			FROM tagged t
+		) ranked
+		WHERE class_rank <= $4 OR (corrected AND corrected_rank <= $4)
+	`
+
+	rows, err := s.pool.Query(ctx, query, profileID, source, sourceID, settings.Examples)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+
+	defer rows.Close()
+
+	candidates := make([]models.FewShotCandidate, 0)
+
+	for rows.Next() {
+		var candidate models.FewShotCandidate
+
+		if err := rows.Scan(
+			&candidate.DetectionID,
+			&candidate.SourceID,
+			&candidate.IsRelevant,
+			&candidate.Corrected,
+			&candidate.TaggedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		candidates = append(candidates, candidate)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows err: %w", err)
+	}
+
+	examples, err := models.ChooseFewShotExamples(candidates, settings)
+	if err != nil {
+		return nil, fmt.Errorf("choose examples: %w", err)
+	}
+
+	return examples, nil
+}
+
 func (s *ScoutStorage) ListDetections(
 	ctx context.Context,
 	query models.DetectionQuery,
@@ -503,34 +954,43 @@ func (s *ScoutStorage) ListDetections(
 			"d.source",
 			"d.source_id",
 			"d.profile_id",
+			"d.settings_version",
 			"d.is_relevant",
 			"d.properties",
+			"d.model",
+			"d.example_ids",
+			"d.confidence",
+			"d.rationale",
 			"d.created_at",
 		).
 		From("scout.detections d").
 		Limit(uint64(max(0, query.Limit))) //nolint:gosec // limit value can't overflow uint64
 
-	switch query.Order {
-	case models.DetectionOrderAsc:
-		sb = sb.OrderBy("d.id ASC")
-
-		if query.LastSeenID != nil {
-			sb = sb.Where(sq.Gt{"d.id": *query.LastSeenID})
-		}
-	case models.DetectionOrderDesc:
-		sb = sb.OrderBy("d.id DESC")
+	if query.Search != nil {
+		sb = sb.
+			JoinClause("CROSS JOIN websearch_to_tsquery('english', ?) tsq", *query.Search).
+			LeftJoin("scout.source_documents sd ON sd.source = d.source AND sd.source_id = d.source_id").
+			Column(searchRankExpr).
+			Where("(d.search_vector @@ tsq OR sd.search_vector @@ tsq)")
+	}
 
-		if query.LastSeenID != nil {
-			sb = sb.Where(sq.Lt{"d.id": *query.LastSeenID})
-		}
-	default:
-		return nil, fmt.Errorf("unknown order: %s", query.Order)
+	sb, err := orderDetections(sb, query)
+	if err != nil {
+		return nil, err
 	}
 
 	if query.Filter.IsRelevant != nil {
 		sb = sb.Where(sq.Eq{"d.is_relevant": *query.Filter.IsRelevant})
 	}
 
+	if query.Filter.MinConfidence != nil {
+		sb = sb.Where(sq.GtOrEq{"d.confidence": *query.Filter.MinConfidence})
+	}
+
+	if query.Filter.MaxConfidence != nil {
+		sb = sb.Where(sq.LtOrEq{"d.confidence": *query.Filter.MaxConfidence})
+	}
+
 	if query.Filter.Profiles != nil && len(*query.Filter.Profiles) > 0 {
 		var profilesFilterClause sq.Or
 
@@ -573,6 +1033,21 @@ func (s *ScoutStorage) ListDetections(
 		sb = sb.Where(sq.Eq{"dt.relevancy_detected_correctly": *query.Filter.Tags.RelevancyDetectedCorrectly})
 	}
 
+	for _, propertyFilter := range query.Filter.Properties {
+		clause, err := propertyFilterClause(propertyFilter)
+		if err != nil {
+			return nil, err
+		}
+
+		if propertyFilter.Regex != nil {
+			if err := s.validateRegex(ctx, propertyFilter.Key, *propertyFilter.Regex); err != nil {
+				return nil, err
+			}
+		}
+
+		sb = sb.Where(clause)
+	}
+
 	sql, args, err := sb.ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("sb to sql: %w", err)
@@ -592,17 +1067,27 @@ func (s *ScoutStorage) ListDetections(
 	for rows.Next() {
 		var detection models.DetectionRecord
 
-		err := rows.Scan(
+		dest := []any{
 			&detection.ID,
 			&detection.Source,
 			&detection.SourceID,
 			&detection.ProfileID,
+			&detection.SettingsVersion,
 			&detection.IsRelevant,
 			&detection.Properties,
+			&detection.Model,
+			&detection.ExampleIDs,
+			&detection.Confidence,
+			&detection.Rationale,
 			&detection.CreatedAt,
-		)
+		}
 
-		if err != nil {
+		if query.Search != nil {
+			detection.Search = &models.DetectionSearchMatch{Rank: 0, Snippet: ""}
+			dest = append(dest, &detection.Search.Rank)
+		}
+
+		if err := rows.Scan(dest...); err != nil {
 			return nil, fmt.Errorf("scan: %w", err)
 		}
 
@@ -613,9 +1098,77 @@ func (s *ScoutStorage) ListDetections(
 		return nil, fmt.Errorf("rows err: %w", err)
 	}
 
+	if query.Search != nil && len(result) > 0 {
+		if err := s.highlightDetections(ctx, result, *query.Search); err != nil {
+			return nil, fmt.Errorf("highlight detections: %w", err)
+		}
+	}
+
 	return result, nil
 }
 
+// highlightDetections fills search snippets of detections found by a full-text search query.
+//
+// Snippets are built in a separate query, since ts_headline is expensive and must run only for returned detections.
+func (s *ScoutStorage) highlightDetections(
+	ctx context.Context,
+	detections []models.DetectionRecord,
+	search string,
+) error {
+	query := `
+		SELECT d.id, ts_headline(
+			'english',
+			concat_ws(
+				' ... ',
+				sd.title,
+				sd.body,
+				(SELECT string_agg(p.value, ' ') FROM jsonb_each_text(COALESCE(d.properties, '{}'::jsonb)) p)
+			),
+			websearch_to_tsquery('english', $2),
+			'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=30, MinWords=10'
+		)
+		FROM scout.detections d
+		LEFT JOIN scout.source_documents sd ON sd.source = d.source AND sd.source_id = d.source_id
+		WHERE d.id = ANY($1)
+	`
+
+	ids := lo.Map(detections, func(detection models.DetectionRecord, _ int) int64 { return detection.ID })
+
+	rows, err := s.pool.Query(ctx, query, ids, search)
+	if err != nil {
+		return fmt.Errorf("query: %w", err)
+	}
+
+	defer rows.Close()
+
+	snippets := make(map[int64]string, len(detections))
+
+	for rows.Next() {
+		var (
+			id      int64
+			snippet *string
+		)
+
+		if err := rows.Scan(&id, &snippet); err != nil {
+			return fmt.Errorf("scan: %w", err)
+		}
+
+		snippets[id] = lo.FromPtr(snippet)
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("rows err: %w", err)
+	}
+
+	for i := range detections {
+		if detections[i].Search != nil {
+			detections[i].Search.Snippet = snippets[detections[i].ID]
+		}
+	}
+
+	return nil
+}
+
 func (s *ScoutStorage) GetPresentDetectionsForProfile(
 	ctx context.Context,
 	profileID int64,
@@ -653,3 +1206,352 @@ func (s *ScoutStorage) GetPresentDetectionsForProfile(
 
 	return result, nil
 }
+
+// GetDetectionStatistics returns detection counts of a profile grouped by source and settings version.
+func (s *ScoutStorage) GetDetectionStatistics(
+	ctx context.Context,
+	profileID int64,
+) ([]models.SettingsVersionStatistics, error) {
+	query := `
+		SELECT
+			d.source,
+			d.settings_version,
+			COUNT(*),
+			COUNT(*) FILTER (WHERE d.is_relevant),
+			COUNT(*) FILTER (WHERE NOT d.is_relevant),
+			COUNT(*) FILTER (WHERE dt.relevancy_detected_correctly),
+			COUNT(*) FILTER (WHERE NOT dt.relevancy_detected_correctly)
+		FROM scout.detections d
+		LEFT JOIN scout.detection_tags dt ON d.id = dt.detection_id
+		WHERE d.profile_id = $1
+		GROUP BY d.source, d.settings_version
+		ORDER BY d.source, d.settings_version
+	`
+
+	rows, err := s.pool.Query(ctx, query, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+
+	defer rows.Close()
+
+	result := make([]models.SettingsVersionStatistics, 0)
+
+	for rows.Next() {
+		var statistics models.SettingsVersionStatistics
+
+		err := rows.Scan(
+			&statistics.Source,
+			&statistics.SettingsVersion,
+			&statistics.Detections.Total,
+			&statistics.Detections.Relevant,
+			&statistics.Detections.Irrelevant,
+			&statistics.Detections.TaggedCorrect,
+			&statistics.Detections.TaggedWrong,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		result = append(result, statistics)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows err: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetSettingsVersionsAccuracy returns confusion matrices of a profile source grouped by settings version.
+func (s *ScoutStorage) GetSettingsVersionsAccuracy(
+	ctx context.Context,
+	filter models.AccuracyFilter,
+) ([]models.SettingsVersionAccuracy, error) {
+	sql, args, err := settingsVersionsAccuracyQuery(filter).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("sb to sql: %w", err)
+	}
+
+	rows, err := s.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+
+	defer rows.Close()
+
+	result := make([]models.SettingsVersionAccuracy, 0)
+
+	for rows.Next() {
+		var accuracy models.SettingsVersionAccuracy
+
+		err := rows.Scan(
+			&accuracy.SettingsVersion,
+			&accuracy.Detections,
+			&accuracy.Tagged,
+			&accuracy.TruePositives,
+			&accuracy.FalsePositives,
+			&accuracy.TrueNegatives,
+			&accuracy.FalseNegatives,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		result = append(result, accuracy)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows err: %w", err)
+	}
+
+	return result, nil
+}
+
+// settingsVersionsAccuracyQuery builds a query of confusion matrices of settings versions.
+// A detection tagged as correct is true, as wrong is false. Untagged detections are only counted.
+func settingsVersionsAccuracyQuery(filter models.AccuracyFilter) sq.SelectBuilder {
+	sb := tools.Psq().
+		Select(
+			"d.settings_version",
+			"COUNT(*)",
+			"COUNT(dt.relevancy_detected_correctly)",
+			"COUNT(*) FILTER (WHERE d.is_relevant AND dt.relevancy_detected_correctly)",
+			"COUNT(*) FILTER (WHERE d.is_relevant AND NOT dt.relevancy_detected_correctly)",
+			"COUNT(*) FILTER (WHERE NOT d.is_relevant AND dt.relevancy_detected_correctly)",
+			"COUNT(*) FILTER (WHERE NOT d.is_relevant AND NOT dt.relevancy_detected_correctly)",
+		).
+		From("scout.detections d").
+		LeftJoin("scout.detection_tags dt ON d.id = dt.detection_id").
+		Where(sq.Eq{"d.profile_id": filter.ProfileID, "d.source": filter.Source}).
+		GroupBy("d.settings_version").
+		OrderBy("d.settings_version")
+
+	if filter.Since != nil {
+		sb = sb.Where(sq.GtOrEq{"d.created_at": *filter.Since})
+	}
+
+	if filter.Until != nil {
+		sb = sb.Where(sq.Lt{"d.created_at": *filter.Until})
+	}
+
+	return sb
+}
+
+// marshalPropertyTypes marshals property types, nil types are stored as an empty object.
+func marshalPropertyTypes(propertyTypes map[string]models.PropertyType) ([]byte, error) {
+	if propertyTypes == nil {
+		propertyTypes = map[string]models.PropertyType{}
+	}
+
+	return json.Marshal(propertyTypes)
+}
+
+// marshalFewShotSettings marshals few-shot settings, nil settings are stored as NULL.
+func marshalFewShotSettings(settings *models.FewShotSettings) ([]byte, error) {
+	if settings == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(settings)
+}
+
+// searchRankExpr is a full-text search rank of a detection and its source post, it requires the tsq query joined.
+const searchRankExpr = "(ts_rank(d.search_vector, tsq) + COALESCE(ts_rank(sd.search_vector, tsq), 0))::float8"
+
+// propertyValueExpr is a value of an extracted property, JSON null is treated as a missing value.
+const propertyValueExpr = "NULLIF(d.properties -> ?::text, 'null'::jsonb)"
+
+// orderDetections adds sorting and keyset pagination to a query of detections.
+//
+//nolint:cyclop,funlen // one branch per sort field
+func orderDetections(sb sq.SelectBuilder, query models.DetectionQuery) (sq.SelectBuilder, error) {
+	var direction, compare string
+
+	switch query.Order {
+	case models.DetectionOrderAsc:
+		direction, compare = "ASC", ">"
+	case models.DetectionOrderDesc:
+		direction, compare = "DESC", "<"
+	default:
+		return sb, fmt.Errorf("unknown order: %s", query.Order)
+	}
+
+	if query.LastSeenID != nil && query.Sort.Field != models.DetectionSortByID {
+		return sb, fmt.Errorf("%w: last seen id is supported only with sorting by id", models.ErrInvalidDetectionQuery)
+	}
+
+	idCompareExpr := "d.id " + compare + " ?"
+
+	switch query.Sort.Field {
+	case models.DetectionSortByID:
+		sb = sb.OrderBy("d.id " + direction)
+
+		lastSeenID := query.LastSeenID
+		if query.After != nil {
+			lastSeenID = &query.After.ID
+		}
+
+		if lastSeenID != nil {
+			sb = sb.Where(idCompareExpr, *lastSeenID)
+		}
+	case models.DetectionSortByCreatedAt:
+		sb = sb.OrderBy("d.created_at "+direction, "d.id "+direction)
+
+		if query.After != nil {
+			var createdAt time.Time
+
+			if err := json.Unmarshal(query.After.Value, &createdAt); err != nil {
+				return sb, fmt.Errorf("%w: cursor is not a creation time cursor", models.ErrInvalidDetectionQuery)
+			}
+
+			sb = sb.Where("(d.created_at, d.id) "+compare+" (?, ?)", createdAt, query.After.ID)
+		}
+	case models.DetectionSortByRank:
+		if query.Search == nil {
+			return sb, fmt.Errorf("%w: rank sort requires a search query", models.ErrInvalidDetectionQuery)
+		}
+
+		sb = sb.OrderBy(searchRankExpr+" "+direction, "d.id "+direction)
+
+		if query.After != nil {
+			var rank float64
+
+			if err := json.Unmarshal(query.After.Value, &rank); err != nil {
+				return sb, fmt.Errorf("%w: cursor is not a rank cursor", models.ErrInvalidDetectionQuery)
+			}
+
+			sb = sb.Where("("+searchRankExpr+", d.id) "+compare+" (?::float8, ?)", rank, query.After.ID)
+		}
+	case models.DetectionSortByConfidence:
+		sb = sb.OrderBy("d.confidence "+direction+" NULLS LAST", "d.id "+direction)
+
+		if query.After == nil {
+			break
+		}
+
+		// Detections without a confidence come last, so they follow any detection with a confidence
+		if query.After.Value == nil {
+			sb = sb.Where(sq.And{
+				sq.Expr("d.confidence IS NULL"),
+				sq.Expr(idCompareExpr, query.After.ID),
+			})
+
+			break
+		}
+
+		var confidence float64
+
+		if err := json.Unmarshal(query.After.Value, &confidence); err != nil {
+			return sb, fmt.Errorf("%w: cursor is not a confidence cursor", models.ErrInvalidDetectionQuery)
+		}
+
+		sb = sb.Where(sq.Or{
+			sq.Expr("(d.confidence, d.id) "+compare+" (?::float8, ?)", confidence, query.After.ID),
+			sq.Expr("d.confidence IS NULL"),
+		})
+	case models.DetectionSortByProperty:
+		property := query.Sort.Property
+		if property == "" {
+			return sb, fmt.Errorf("%w: sort property is not specified", models.ErrInvalidDetectionQuery)
+		}
+
+		sb = sb.OrderByClause(propertyValueExpr+" "+direction+" NULLS LAST", property).OrderBy("d.id " + direction)
+
+		if query.After == nil {
+			break
+		}
+
+		// Detections without the property come last, so they follow any detection with the property
+		if query.After.Value == nil {
+			sb = sb.Where(sq.And{
+				sq.Expr(propertyValueExpr+" IS NULL", property),
+				sq.Expr(idCompareExpr, query.After.ID),
+			})
+
+			break
+		}
+
+		value := string(query.After.Value)
+
+		sb = sb.Where(sq.Or{
+			sq.Expr(propertyValueExpr+" "+compare+" ?::jsonb", property, value),
+			sq.And{
+				sq.Expr(propertyValueExpr+" = ?::jsonb", property, value),
+				sq.Expr(idCompareExpr, query.After.ID),
+			},
+			sq.Expr(propertyValueExpr+" IS NULL", property),
+		})
+	default:
+		return sb, fmt.Errorf("%w: unknown sort field %q", models.ErrInvalidDetectionQuery, query.Sort.Field)
+	}
+
+	return sb, nil
+}
+
+// validateRegex checks that a regex of a property filter is accepted by Postgres.
+// Property filters are matched with POSIX regular expressions of Postgres, which differ from the Go syntax.
+func (s *ScoutStorage) validateRegex(ctx context.Context, key string, regex string) error {
+	var matched bool
+
+	if err := s.pool.QueryRow(ctx, "SELECT '' ~ $1", regex).Scan(&matched); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == invalidRegularExpressionCode {
+			return fmt.Errorf("%w: invalid regex of property %s: %s", models.ErrInvalidDetectionQuery, key, pgErr.Message)
+		}
+
+		return fmt.Errorf("validate regex: %w", err)
+	}
+
+	return nil
+}
+
+// propertyFilterClause builds a clause matching all predicates of a property filter.
+func propertyFilterClause(filter models.PropertyFilter) (sq.And, error) {
+	if filter.Key == "" {
+		return nil, fmt.Errorf("%w: property filter key is not specified", models.ErrInvalidDetectionQuery)
+	}
+
+	key := filter.Key
+	clause := sq.And{}
+
+	if filter.Equals != nil {
+		// Containment compares typed JSON values, so 1 matches a number and "1" matches a string
+		containedJSON, err := json.Marshal(map[string]any{key: filter.Equals})
+		if err != nil {
+			return nil, fmt.Errorf("marshal property filter: %w", err)
+		}
+
+		clause = append(clause, sq.Expr("d.properties @> ?::jsonb", string(containedJSON)))
+	}
+
+	if filter.Contains != nil {
+		clause = append(clause, sq.Expr("strpos(lower(d.properties ->> ?::text), lower(?)) > 0", key, *filter.Contains))
+	}
+
+	if filter.Exists != nil {
+		if *filter.Exists {
+			clause = append(clause, sq.Expr(propertyValueExpr+" IS NOT NULL", key))
+		} else {
+			clause = append(clause, sq.Expr(propertyValueExpr+" IS NULL", key))
+		}
+	}
+
+	// Non-numeric values never match numeric ranges
+	numericValueExpr := "CASE WHEN jsonb_typeof(d.properties -> ?::text) = 'number' " +
+		"THEN (d.properties ->> ?::text)::numeric END"
+
+	if filter.Gte != nil {
+		clause = append(clause, sq.Expr(numericValueExpr+" >= ?", key, key, *filter.Gte))
+	}
+
+	if filter.Lte != nil {
+		clause = append(clause, sq.Expr(numericValueExpr+" <= ?", key, key, *filter.Lte))
+	}
+
+	if filter.Regex != nil {
+		clause = append(clause, sq.Expr("d.properties ->> ?::text ~ ?", key, *filter.Regex))
+	}
+
+	return clause, nil
+}
@@ -0,0 +1,357 @@
+package pg
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/rishenco/scout/internal/tools"
+	"github.com/rishenco/scout/pkg/models"
+)
+
+func TestOrderDetections(t *testing.T) {
+	cursor := func(value string) *models.DetectionCursor {
+		return &models.DetectionCursor{ID: 7, Value: json.RawMessage(value)}
+	}
+
+	missingValueCursor := &models.DetectionCursor{ID: 7, Value: nil}
+
+	tests := []struct {
+		name     string
+		query    models.DetectionQuery
+		wantSQL  string
+		wantArgs []any
+	}{
+		{
+			name:     "id",
+			query:    models.DetectionQuery{Order: models.DetectionOrderDesc},
+			wantSQL:  "ORDER BY d.id DESC",
+			wantArgs: nil,
+		},
+		{
+			name:     "id after last seen id",
+			query:    models.DetectionQuery{Order: models.DetectionOrderAsc, LastSeenID: lo.ToPtr(int64(5))},
+			wantSQL:  "WHERE d.id > $1 ORDER BY d.id ASC",
+			wantArgs: []any{int64(5)},
+		},
+		{
+			name:     "id after cursor",
+			query:    models.DetectionQuery{Order: models.DetectionOrderDesc, After: missingValueCursor},
+			wantSQL:  "WHERE d.id < $1 ORDER BY d.id DESC",
+			wantArgs: []any{int64(7)},
+		},
+		{
+			name: "created at after cursor",
+			query: models.DetectionQuery{
+				Order: models.DetectionOrderAsc,
+				Sort:  models.DetectionSort{Field: models.DetectionSortByCreatedAt},
+				After: cursor(`"2025-01-02T03:04:05Z"`),
+			},
+			wantSQL:  "WHERE (d.created_at, d.id) > ($1, $2) ORDER BY d.created_at ASC, d.id ASC",
+			wantArgs: []any{time.Date(2025, time.January, 2, 3, 4, 5, 0, time.UTC), int64(7)},
+		},
+		{
+			name: "rank after cursor",
+			query: models.DetectionQuery{
+				Order:  models.DetectionOrderDesc,
+				Sort:   models.DetectionSort{Field: models.DetectionSortByRank},
+				Search: lo.ToPtr("golang"),
+				After:  cursor(`0.5`),
+			},
+			wantSQL: "WHERE (" + searchRankExpr + ", d.id) < ($1::float8, $2) " +
+				"ORDER BY " + searchRankExpr + " DESC, d.id DESC",
+			wantArgs: []any{0.5, int64(7)},
+		},
+		{
+			name: "confidence after cursor",
+			query: models.DetectionQuery{
+				Order: models.DetectionOrderDesc,
+				Sort:  models.DetectionSort{Field: models.DetectionSortByConfidence},
+				After: cursor(`0.8`),
+			},
+			// detections without a confidence follow any detection with a confidence
+			wantSQL: "WHERE ((d.confidence, d.id) < ($1::float8, $2) OR d.confidence IS NULL) " +
+				"ORDER BY d.confidence DESC NULLS LAST, d.id DESC",
+			wantArgs: []any{0.8, int64(7)},
+		},
+		{
+			name: "confidence after cursor without confidence",
+			query: models.DetectionQuery{
+				Order: models.DetectionOrderDesc,
+				Sort:  models.DetectionSort{Field: models.DetectionSortByConfidence},
+				After: missingValueCursor,
+			},
+			wantSQL:  "WHERE (d.confidence IS NULL AND d.id < $1) ORDER BY d.confidence DESC NULLS LAST, d.id DESC",
+			wantArgs: []any{int64(7)},
+		},
+		{
+			name: "property",
+			query: models.DetectionQuery{
+				Order: models.DetectionOrderDesc,
+				Sort:  models.DetectionSort{Field: models.DetectionSortByProperty, Property: "stars"},
+			},
+			wantSQL:  "ORDER BY NULLIF(d.properties -> $1::text, 'null'::jsonb) DESC NULLS LAST, d.id DESC",
+			wantArgs: []any{"stars"},
+		},
+		{
+			name: "property after cursor",
+			query: models.DetectionQuery{
+				Order: models.DetectionOrderAsc,
+				Sort:  models.DetectionSort{Field: models.DetectionSortByProperty, Property: "stars"},
+				After: cursor(`10`),
+			},
+			wantSQL: "WHERE (NULLIF(d.properties -> $1::text, 'null'::jsonb) > $2::jsonb " +
+				"OR (NULLIF(d.properties -> $3::text, 'null'::jsonb) = $4::jsonb AND d.id > $5) " +
+				"OR NULLIF(d.properties -> $6::text, 'null'::jsonb) IS NULL) " +
+				"ORDER BY NULLIF(d.properties -> $7::text, 'null'::jsonb) ASC NULLS LAST, d.id ASC",
+			wantArgs: []any{"stars", "10", "stars", "10", int64(7), "stars", "stars"},
+		},
+		{
+			name: "property after cursor without property",
+			query: models.DetectionQuery{
+				Order: models.DetectionOrderAsc,
+				Sort:  models.DetectionSort{Field: models.DetectionSortByProperty, Property: "stars"},
+				After: missingValueCursor,
+			},
+			wantSQL: "WHERE (NULLIF(d.properties -> $1::text, 'null'::jsonb) IS NULL AND d.id > $2) " +
+				"ORDER BY NULLIF(d.properties -> $3::text, 'null'::jsonb) ASC NULLS LAST, d.id ASC",
+			wantArgs: []any{"stars", int64(7), "stars"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sb, err := orderDetections(tools.Psq().Select("d.id").From("scout.detections d"), tt.query)
+			if err != nil {
+				t.Fatalf("order detections: %v", err)
+			}
+
+			sql, args, err := sb.ToSql()
+			if err != nil {
+				t.Fatalf("to sql: %v", err)
+			}
+
+			if want := "SELECT d.id FROM scout.detections d " + tt.wantSQL; sql != want {
+				t.Errorf("sql = %q, want %q", sql, want)
+			}
+
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("args = %#v, want %#v", args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestOrderDetections_InvalidQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query models.DetectionQuery
+	}{
+		{
+			name: "last seen id with created at sort",
+			query: models.DetectionQuery{
+				Order:      models.DetectionOrderDesc,
+				Sort:       models.DetectionSort{Field: models.DetectionSortByCreatedAt},
+				LastSeenID: lo.ToPtr(int64(5)),
+			},
+		},
+		{
+			name: "last seen id with property sort",
+			query: models.DetectionQuery{
+				Order:      models.DetectionOrderDesc,
+				Sort:       models.DetectionSort{Field: models.DetectionSortByProperty, Property: "stars"},
+				LastSeenID: lo.ToPtr(int64(5)),
+			},
+		},
+		{
+			name: "rank sort without search",
+			query: models.DetectionQuery{
+				Order: models.DetectionOrderDesc,
+				Sort:  models.DetectionSort{Field: models.DetectionSortByRank},
+			},
+		},
+		{
+			name: "property sort without property",
+			query: models.DetectionQuery{
+				Order: models.DetectionOrderDesc,
+				Sort:  models.DetectionSort{Field: models.DetectionSortByProperty},
+			},
+		},
+		{
+			name: "confidence sort with created at cursor",
+			query: models.DetectionQuery{
+				Order: models.DetectionOrderDesc,
+				Sort:  models.DetectionSort{Field: models.DetectionSortByConfidence},
+				After: &models.DetectionCursor{ID: 7, Value: json.RawMessage(`"2025-01-02T03:04:05Z"`)},
+			},
+		},
+		{
+			name: "unknown sort field",
+			query: models.DetectionQuery{
+				Order: models.DetectionOrderDesc,
+				Sort:  models.DetectionSort{Field: "score"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := orderDetections(tools.Psq().Select("d.id").From("scout.detections d"), tt.query)
+			if !errors.Is(err, models.ErrInvalidDetectionQuery) {
+				t.Errorf("error = %v, want %v", err, models.ErrInvalidDetectionQuery)
+			}
+		})
+	}
+}
+
+func TestPropertyFilterClause(t *testing.T) {
+	numericValueExpr := "CASE WHEN jsonb_typeof(d.properties -> ?::text) = 'number' " +
+		"THEN (d.properties ->> ?::text)::numeric END"
+
+	tests := []struct {
+		name     string
+		filter   models.PropertyFilter
+		wantSQL  string
+		wantArgs []any
+	}{
+		{
+			name:     "equals string",
+			filter:   models.PropertyFilter{Key: "level", Equals: "high"},
+			wantSQL:  "(d.properties @> ?::jsonb)",
+			wantArgs: []any{`{"level":"high"}`},
+		},
+		{
+			name:     "equals number",
+			filter:   models.PropertyFilter{Key: "stars", Equals: 7},
+			wantSQL:  "(d.properties @> ?::jsonb)",
+			wantArgs: []any{`{"stars":7}`},
+		},
+		{
+			name:     "contains",
+			filter:   models.PropertyFilter{Key: "name", Contains: lo.ToPtr("Go")},
+			wantSQL:  "(strpos(lower(d.properties ->> ?::text), lower(?)) > 0)",
+			wantArgs: []any{"name", "Go"},
+		},
+		{
+			name:     "exists",
+			filter:   models.PropertyFilter{Key: "name", Exists: lo.ToPtr(true)},
+			wantSQL:  "(NULLIF(d.properties -> ?::text, 'null'::jsonb) IS NOT NULL)",
+			wantArgs: []any{"name"},
+		},
+		{
+			name:     "not exists",
+			filter:   models.PropertyFilter{Key: "name", Exists: lo.ToPtr(false)},
+			wantSQL:  "(NULLIF(d.properties -> ?::text, 'null'::jsonb) IS NULL)",
+			wantArgs: []any{"name"},
+		},
+		{
+			name:     "numeric range",
+			filter:   models.PropertyFilter{Key: "stars", Gte: lo.ToPtr(1.5), Lte: lo.ToPtr(10.0)},
+			wantSQL:  "(" + numericValueExpr + " >= ? AND " + numericValueExpr + " <= ?)",
+			wantArgs: []any{"stars", "stars", 1.5, "stars", "stars", 10.0},
+		},
+		{
+			name:     "regex",
+			filter:   models.PropertyFilter{Key: "name", Regex: lo.ToPtr("^go")},
+			wantSQL:  "(d.properties ->> ?::text ~ ?)",
+			wantArgs: []any{"name", "^go"},
+		},
+		{
+			name:     "all predicates",
+			filter:   models.PropertyFilter{Key: "name", Exists: lo.ToPtr(true), Regex: lo.ToPtr("^go")},
+			wantSQL:  "(NULLIF(d.properties -> ?::text, 'null'::jsonb) IS NOT NULL AND d.properties ->> ?::text ~ ?)",
+			wantArgs: []any{"name", "name", "^go"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clause, err := propertyFilterClause(tt.filter)
+			if err != nil {
+				t.Fatalf("property filter clause: %v", err)
+			}
+
+			sql, args, err := clause.ToSql()
+			if err != nil {
+				t.Fatalf("to sql: %v", err)
+			}
+
+			if sql != tt.wantSQL {
+				t.Errorf("sql = %q, want %q", sql, tt.wantSQL)
+			}
+
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("args = %#v, want %#v", args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestPropertyFilterClause_MissingKey(t *testing.T) {
+	_, err := propertyFilterClause(models.PropertyFilter{Key: "", Exists: lo.ToPtr(true)})
+	if !errors.Is(err, models.ErrInvalidDetectionQuery) {
+		t.Errorf("error = %v, want %v", err, models.ErrInvalidDetectionQuery)
+	}
+}
+
+func TestSettingsVersionsAccuracyQuery(t *testing.T) {
+	since := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+	selectSQL := "SELECT d.settings_version, COUNT(*), COUNT(dt.relevancy_detected_correctly), " +
+		"COUNT(*) FILTER (WHERE d.is_relevant AND dt.relevancy_detected_correctly), " +
+		"COUNT(*) FILTER (WHERE d.is_relevant AND NOT dt.relevancy_detected_correctly), " +
+		"COUNT(*) FILTER (WHERE NOT d.is_relevant AND dt.relevancy_detected_correctly), " +
+		"COUNT(*) FILTER (WHERE NOT d.is_relevant AND NOT dt.relevancy_detected_correctly) " +
+		"FROM scout.detections d LEFT JOIN scout.detection_tags dt ON d.id = dt.detection_id " +
+		"WHERE d.profile_id = $1 AND d.source = $2 "
+
+	tests := []struct {
+		name     string
+		filter   models.AccuracyFilter
+		wantSQL  string
+		wantArgs []any
+	}{
+		{
+			name:     "all time",
+			filter:   models.AccuracyFilter{ProfileID: 1, Source: "reddit", Since: nil, Until: nil},
+			wantSQL:  selectSQL + "GROUP BY d.settings_version ORDER BY d.settings_version",
+			wantArgs: []any{int64(1), "reddit"},
+		},
+		{
+			name:     "since",
+			filter:   models.AccuracyFilter{ProfileID: 1, Source: "reddit", Since: &since, Until: nil},
+			wantSQL:  selectSQL + "AND d.created_at >= $3 GROUP BY d.settings_version ORDER BY d.settings_version",
+			wantArgs: []any{int64(1), "reddit", since},
+		},
+		{
+			name:   "window",
+			filter: models.AccuracyFilter{ProfileID: 1, Source: "reddit", Since: &since, Until: &until},
+			// the window includes its start and excludes its end
+			wantSQL: selectSQL + "AND d.created_at >= $3 AND d.created_at < $4 " +
+				"GROUP BY d.settings_version ORDER BY d.settings_version",
+			wantArgs: []any{int64(1), "reddit", since, until},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, args, err := settingsVersionsAccuracyQuery(tt.filter).ToSql()
+			if err != nil {
+				t.Fatalf("to sql: %v", err)
+			}
+
+			if sql != tt.wantSQL {
+				t.Errorf("sql = %q, want %q", sql, tt.wantSQL)
+			}
+
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("args = %#v, want %#v", args, tt.wantArgs)
+			}
+		})
+	}
+}
@@ -0,0 +1,443 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+	"github.com/samber/lo"
+
+	"github.com/rishenco/scout/pkg/models"
+)
+
+// shadowColumns selects a shadow with a summary of its results, the query must join shadowSummaryJoin.
+const shadowColumns = `
+	sh.id, sh.profile_id, sh.source, sh.settings, sh.created_at, sh.stopped_at,
+	s.analyzed, s.failed, s.unpaired, s.agreed, s.relevance_disagreements, s.property_disagreements,
+	s.production_relevant, s.shadow_relevant
+`
+
+// productionDetectionJoin pairs shadow detections (sd) of a shadow (sh) with the latest production detection (d).
+const productionDetectionJoin = `
+	LEFT JOIN LATERAL (
+		SELECT id, profile_id, settings_version, is_relevant, properties, model, created_at
+		FROM scout.detections
+		WHERE profile_id = sh.profile_id AND source = sd.source AND source_id = sd.source_id
+		ORDER BY id DESC
+		LIMIT 1
+	) d ON true
+`
+
+// Disagreement conditions of paired detections, they match models.ShadowResult.Disagreement.
+const (
+	relevanceDisagreementCondition = `sd.is_relevant <> d.is_relevant`
+	propertyDisagreementCondition  = `sd.is_relevant AND d.is_relevant
+		AND COALESCE(sd.properties, '{}') <> COALESCE(d.properties, '{}')`
+)
+
+const shadowSummaryJoin = `
+	CROSS JOIN LATERAL (
+		SELECT
+			COUNT(*) FILTER (WHERE sd.is_relevant IS NOT NULL) AS analyzed,
+			COUNT(*) FILTER (WHERE sd.error IS NOT NULL) AS failed,
+			COUNT(*) FILTER (WHERE sd.is_relevant IS NOT NULL AND d.id IS NULL) AS unpaired,
+			COUNT(*) FILTER (
+				WHERE d.id IS NOT NULL AND sd.is_relevant IS NOT NULL
+					AND NOT (` + relevanceDisagreementCondition + `)
+					AND NOT (` + propertyDisagreementCondition + `)
+			) AS agreed,
+			COUNT(*) FILTER (WHERE ` + relevanceDisagreementCondition + `) AS relevance_disagreements,
+			COUNT(*) FILTER (WHERE ` + propertyDisagreementCondition + `) AS property_disagreements,
+			COUNT(*) FILTER (WHERE sd.is_relevant IS NOT NULL AND d.is_relevant) AS production_relevant,
+			COUNT(*) FILTER (WHERE sd.is_relevant AND d.id IS NOT NULL) AS shadow_relevant
+		FROM scout.shadow_detections sd
+		` + productionDetectionJoin + `
+		WHERE sd.shadow_id = sh.id
+	) s
+`
+
+const shadowResultColumns = `
+	sd.shadow_id, sd.source, sd.source_id, sd.is_relevant, sd.properties, sd.model, sd.error, sd.created_at,
+	d.id, d.profile_id, d.settings_version, d.is_relevant, d.properties, d.model, d.created_at
+`
+
+type ShadowStorage struct {
+	pool   *pgxpool.Pool
+	logger zerolog.Logger
+}
+
+func NewShadowStorage(pool *pgxpool.Pool, logger zerolog.Logger) *ShadowStorage {
+	return &ShadowStorage{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// CreateShadow creates an active shadow of a profile, the previous active shadow of the profile is stopped.
+func (s *ShadowStorage) CreateShadow(ctx context.Context, shadow models.Shadow) (shadowID int64, err error) {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("begin tx: %w", err)
+	}
+
+	defer s.rollback(ctx, tx)
+
+	stopQuery := `
+		UPDATE scout.profile_shadows
+		SET stopped_at = NOW()
+		WHERE profile_id = $1 AND stopped_at IS NULL
+	`
+
+	if _, err := tx.Exec(ctx, stopQuery, shadow.ProfileID); err != nil {
+		return 0, fmt.Errorf("stop active shadow: %w", err)
+	}
+
+	insertQuery := `
+		INSERT INTO scout.profile_shadows (profile_id, source, settings, created_at)
+		VALUES ($1, $2, $3, NOW())
+		RETURNING id
+	`
+
+	err = tx.QueryRow(ctx, insertQuery, shadow.ProfileID, shadow.Source, shadow.Settings).Scan(&shadowID)
+	if err != nil {
+		return 0, fmt.Errorf("insert shadow: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("commit tx: %w", err)
+	}
+
+	return shadowID, nil
+}
+
+func (s *ShadowStorage) GetShadow(ctx context.Context, shadowID int64) (shadow models.Shadow, found bool, err error) {
+	query := `SELECT ` + shadowColumns + ` FROM scout.profile_shadows sh ` + shadowSummaryJoin + ` WHERE sh.id = $1`
+
+	shadow, err = scanShadow(s.pool.QueryRow(ctx, query, shadowID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Shadow{}, false, nil
+		}
+
+		return models.Shadow{}, false, fmt.Errorf("scan: %w", err)
+	}
+
+	return shadow, true, nil
+}
+
+// ListShadows returns the latest shadows of a profile starting from the newest one.
+func (s *ShadowStorage) ListShadows(ctx context.Context, profileID int64, limit int64) ([]models.Shadow, error) {
+	query := `SELECT ` + shadowColumns + ` FROM scout.profile_shadows sh ` + shadowSummaryJoin + `
+		WHERE sh.profile_id = $1
+		ORDER BY sh.id DESC
+		LIMIT $2
+	`
+
+	rows, err := s.pool.Query(ctx, query, profileID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	shadows := make([]models.Shadow, 0)
+
+	for rows.Next() {
+		shadow, err := scanShadow(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		shadows = append(shadows, shadow)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows: %w", err)
+	}
+
+	return shadows, nil
+}
+
+// GetActiveShadows returns active shadows of given profiles without summaries.
+func (s *ShadowStorage) GetActiveShadows(ctx context.Context, profileIDs []int64) ([]models.Shadow, error) {
+	query := `
+		SELECT id, profile_id, source, settings, created_at
+		FROM scout.profile_shadows
+		WHERE profile_id = ANY($1) AND stopped_at IS NULL
+	`
+
+	rows, err := s.pool.Query(ctx, query, profileIDs)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	shadows := make([]models.Shadow, 0)
+
+	for rows.Next() {
+		var shadow models.Shadow
+
+		err := rows.Scan(&shadow.ID, &shadow.ProfileID, &shadow.Source, &shadow.Settings, &shadow.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		shadows = append(shadows, shadow)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows: %w", err)
+	}
+
+	return shadows, nil
+}
+
+// GetShadowSettings returns candidate settings of a shadow without computing its summary.
+func (s *ShadowStorage) GetShadowSettings(
+	ctx context.Context,
+	shadowID int64,
+) (settings models.ProfileSettings, found bool, err error) {
+	query := `SELECT settings FROM scout.profile_shadows WHERE id = $1`
+
+	if err := s.pool.QueryRow(ctx, query, shadowID).Scan(&settings); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.ProfileSettings{}, false, nil
+		}
+
+		return models.ProfileSettings{}, false, fmt.Errorf("scan: %w", err)
+	}
+
+	return settings, true, nil
+}
+
+// StopShadow stops an active shadow of a profile, stopping a stopped shadow does nothing.
+func (s *ShadowStorage) StopShadow(ctx context.Context, profileID int64, shadowID int64) (found bool, err error) {
+	query := `
+		UPDATE scout.profile_shadows
+		SET stopped_at = COALESCE(stopped_at, NOW())
+		WHERE profile_id = $1 AND id = $2
+	`
+
+	tag, err := s.pool.Exec(ctx, query, profileID, shadowID)
+	if err != nil {
+		return false, fmt.Errorf("exec: %w", err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// SaveShadowResult saves a detection or an error of a post analyzed with shadow settings.
+// A result of the same post replaces the previous one.
+func (s *ShadowStorage) SaveShadowResult(ctx context.Context, result models.ShadowResult) error {
+	query := `
+		INSERT INTO scout.shadow_detections
+			(shadow_id, source, source_id, is_relevant, properties, model, error, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW())
+		ON CONFLICT (shadow_id, source, source_id) DO UPDATE
+		SET is_relevant = EXCLUDED.is_relevant,
+			properties = EXCLUDED.properties,
+			model = EXCLUDED.model,
+			error = EXCLUDED.error,
+			created_at = EXCLUDED.created_at
+	`
+
+	var (
+		isRelevant *bool
+		properties map[string]any
+		model      *string
+	)
+
+	if result.Detection != nil {
+		isRelevant = &result.Detection.IsRelevant
+		properties = result.Detection.Properties
+		model = &result.Detection.Model
+	}
+
+	_, err := s.pool.Exec(
+		ctx,
+		query,
+		result.ShadowID,
+		result.Source,
+		result.SourceID,
+		isRelevant,
+		properties,
+		model,
+		result.Error,
+	)
+	if err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
+
+// ListShadowDisagreements returns the latest results of a shadow that disagree with production detections.
+func (s *ShadowStorage) ListShadowDisagreements(
+	ctx context.Context,
+	shadowID int64,
+	limit int64,
+) ([]models.ShadowResult, error) {
+	query := `
+		SELECT ` + shadowResultColumns + `
+		FROM scout.shadow_detections sd
+		JOIN scout.profile_shadows sh ON sh.id = sd.shadow_id
+		` + productionDetectionJoin + `
+		WHERE sd.shadow_id = $1
+			AND ((` + relevanceDisagreementCondition + `) OR (` + propertyDisagreementCondition + `))
+		ORDER BY sd.created_at DESC, sd.source, sd.source_id
+		LIMIT $2
+	`
+
+	rows, err := s.pool.Query(ctx, query, shadowID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	results := make([]models.ShadowResult, 0)
+
+	for rows.Next() {
+		result, err := scanShadowResult(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		results = append(results, result)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows: %w", err)
+	}
+
+	return results, nil
+}
+
+// DeleteProfileShadows deletes shadows of a profile and their results.
+func (s *ShadowStorage) DeleteProfileShadows(ctx context.Context, profileID int64) error {
+	queries := []string{
+		`DELETE FROM scout.shadow_detections
+		WHERE shadow_id IN (SELECT id FROM scout.profile_shadows WHERE profile_id = $1)`,
+		`DELETE FROM scout.profile_shadows WHERE profile_id = $1`,
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+
+	defer s.rollback(ctx, tx)
+
+	for _, query := range queries {
+		if _, err := tx.Exec(ctx, query, profileID); err != nil {
+			return fmt.Errorf("exec: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+
+	return nil
+}
+
+func (s *ShadowStorage) rollback(ctx context.Context, tx pgx.Tx) {
+	rollbackErr := tx.Rollback(ctx)
+
+	if rollbackErr == nil || errors.Is(rollbackErr, pgx.ErrTxClosed) {
+		return
+	}
+
+	s.logger.Error().Err(rollbackErr).Msg("failed to rollback tx")
+}
+
+func scanShadow(row pgx.Row) (models.Shadow, error) {
+	var shadow models.Shadow
+
+	err := row.Scan(
+		&shadow.ID,
+		&shadow.ProfileID,
+		&shadow.Source,
+		&shadow.Settings,
+		&shadow.CreatedAt,
+		&shadow.StoppedAt,
+		&shadow.Summary.Analyzed,
+		&shadow.Summary.Failed,
+		&shadow.Summary.Unpaired,
+		&shadow.Summary.Agreed,
+		&shadow.Summary.RelevanceDisagreements,
+		&shadow.Summary.PropertyDisagreements,
+		&shadow.Summary.ProductionRelevant,
+		&shadow.Summary.ShadowRelevant,
+	)
+	if err != nil {
+		return models.Shadow{}, err //nolint:wrapcheck // callers wrap the error
+	}
+
+	return shadow, nil
+}
+
+func scanShadowResult(row pgx.Row) (models.ShadowResult, error) {
+	var (
+		result     models.ShadowResult
+		isRelevant *bool
+		properties map[string]any
+		model      *string
+
+		productionID              *int64
+		productionProfileID       *int64
+		productionSettingsVersion *int64
+		productionIsRelevant      *bool
+		productionProperties      map[string]any
+		productionModel           *string
+		productionCreatedAt       *time.Time
+	)
+
+	err := row.Scan(
+		&result.ShadowID,
+		&result.Source,
+		&result.SourceID,
+		&isRelevant,
+		&properties,
+		&model,
+		&result.Error,
+		&result.CreatedAt,
+		&productionID,
+		&productionProfileID,
+		&productionSettingsVersion,
+		&productionIsRelevant,
+		&productionProperties,
+		&productionModel,
+		&productionCreatedAt,
+	)
+	if err != nil {
+		return models.ShadowResult{}, err //nolint:wrapcheck // callers wrap the error
+	}
+
+	if isRelevant != nil {
+		result.Detection = &models.Detection{
+			IsRelevant: *isRelevant,
+			Properties: properties,
+			Model:      lo.FromPtr(model),
+		}
+	}
+
+	if productionID != nil {
+		result.ProductionDetection = &models.DetectionRecord{
+			ID:              *productionID,
+			Source:          result.Source,
+			SourceID:        result.SourceID,
+			ProfileID:       lo.FromPtr(productionProfileID),
+			SettingsVersion: lo.FromPtr(productionSettingsVersion),
+			IsRelevant:      lo.FromPtr(productionIsRelevant),
+			Properties:      productionProperties,
+			Model:           productionModel,
+			CreatedAt:       lo.FromPtr(productionCreatedAt),
+			Search:          nil,
+		}
+	}
+
+	return result, nil
+}
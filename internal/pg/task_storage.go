@@ -6,39 +6,52 @@ import (
 	"fmt"
 	"time"
 
+	sq "github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog"
 	"github.com/samber/lo"
 
+	"github.com/rishenco/scout/internal/tools"
 	"github.com/rishenco/scout/pkg/models"
 )
 
+// taskStatusExpr computes a task status, it matches the definitions of GetTaskStatistics.
+const taskStatusExpr = `CASE
+	WHEN is_committed THEN 'committed'
+	WHEN is_failed THEN 'failed'
+	WHEN is_claimed THEN 'claimed'
+	ELSE 'pending'
+END`
+
+// TasksChannel is notified when new tasks become available for claiming.
+const TasksChannel = "scout_analysis_tasks"
+
+const notifyTasksQuery = `SELECT pg_notify($1, '')`
+
 type TaskStorage struct {
-	pool                       *pgxpool.Pool
-	errorTimeoutBeforeClaiming time.Duration
-	logger                     zerolog.Logger
+	pool   *pgxpool.Pool
+	logger zerolog.Logger
 }
 
-func NewTaskStorage(
-	pool *pgxpool.Pool,
-	errorTimeoutBeforeClaiming time.Duration,
-	logger zerolog.Logger,
-) *TaskStorage {
+func NewTaskStorage(pool *pgxpool.Pool, logger zerolog.Logger) *TaskStorage {
 	return &TaskStorage{
-		pool:                       pool,
-		errorTimeoutBeforeClaiming: errorTimeoutBeforeClaiming,
-		logger:                     logger,
+		pool:   pool,
+		logger: logger,
 	}
 }
 
+// Add adds tasks to the queue and notifies listeners of TasksChannel.
 func (s *TaskStorage) Add(ctx context.Context, tasks []models.AnalysisTask) error {
 	columns := []string{
 		"type",
+		"priority",
 		"source",
 		"source_id",
 		"profile_id",
 		"should_save",
+		"test_run_id",
+		"shadow_id",
 		"is_claimed",
 		"claimed_at",
 		"is_committed",
@@ -46,15 +59,19 @@ func (s *TaskStorage) Add(ctx context.Context, tasks []models.AnalysisTask) erro
 		"is_failed",
 		"failed_at",
 		"errors",
+		"trace_context",
 	}
 
 	rows := lo.Map(tasks, func(task models.AnalysisTask, _ int) []any {
 		return []any{
 			task.Type,                  // type
+			task.Priority,              // priority
 			task.Parameters.Source,     // source
 			task.Parameters.SourceID,   // source_id
 			task.Parameters.ProfileID,  // profile_id
 			task.Parameters.ShouldSave, // should_save
+			task.Parameters.TestRunID,  // test_run_id
+			task.Parameters.ShadowID,   // shadow_id
 			false,                      // is_claimed
 			nil,                        // claimed_at
 			false,                      // is_committed
@@ -62,10 +79,26 @@ func (s *TaskStorage) Add(ctx context.Context, tasks []models.AnalysisTask) erro
 			false,                      // is_failed
 			nil,                        // failed_at
 			[]string{},                 // errors
+			task.TraceContext,          // trace_context
 		}
 	})
 
-	_, err := s.pool.CopyFrom(
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+
+		if rollbackErr == nil || errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			return
+		}
+
+		s.logger.Error().Err(rollbackErr).Msg("failed to rollback tx")
+	}()
+
+	_, err = tx.CopyFrom(
 		ctx,
 		pgx.Identifier{"scout", "analysis_tasks"},
 		columns,
@@ -75,6 +108,15 @@ func (s *TaskStorage) Add(ctx context.Context, tasks []models.AnalysisTask) erro
 		return fmt.Errorf("copy from: %w", err)
 	}
 
+	// Notification is delivered to listeners only when the transaction is committed
+	if _, err := tx.Exec(ctx, notifyTasksQuery, TasksChannel); err != nil {
+		return fmt.Errorf("notify: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+
 	return nil
 }
 
@@ -92,10 +134,13 @@ func (s *TaskStorage) Claim(ctx context.Context, taskTypes []string, profileIDs
 				AND claim_available_at < NOW()
 				AND "type" = ANY($1)
 				AND profile_id = ANY($2)
+			ORDER BY priority DESC, id
 			LIMIT 1
 			FOR UPDATE SKIP LOCKED
 		)
-		RETURNING id, "type", source, source_id, profile_id, should_save
+		RETURNING
+			id, "type", priority, source, source_id, profile_id, should_save, test_run_id, shadow_id,
+			errors, trace_context
 	`
 
 	row := s.pool.QueryRow(ctx, query, taskTypes, profileIDs)
@@ -103,10 +148,15 @@ func (s *TaskStorage) Claim(ctx context.Context, taskTypes []string, profileIDs
 	err = row.Scan(
 		&task.ID,
 		&task.Type,
+		&task.Priority,
 		&task.Parameters.Source,
 		&task.Parameters.SourceID,
 		&task.Parameters.ProfileID,
 		&task.Parameters.ShouldSave,
+		&task.Parameters.TestRunID,
+		&task.Parameters.ShadowID,
+		&task.Errors,
+		&task.TraceContext,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -134,7 +184,8 @@ func (s *TaskStorage) Unclaim(ctx context.Context, taskID int64) error {
 	return nil
 }
 
-func (s *TaskStorage) AddError(ctx context.Context, taskID int64, err string) error {
+// AddError appends an error to the task and postpones its next claim by a given delay.
+func (s *TaskStorage) AddError(ctx context.Context, taskID int64, err string, retryAfter time.Duration) error {
 	query := `
 		UPDATE scout.analysis_tasks
 		SET errors = array_append(errors, $1), 
@@ -142,7 +193,7 @@ func (s *TaskStorage) AddError(ctx context.Context, taskID int64, err string) er
 		WHERE id = $3
 	`
 
-	_, execErr := s.pool.Exec(ctx, query, err, s.errorTimeoutBeforeClaiming.Seconds(), taskID)
+	_, execErr := s.pool.Exec(ctx, query, err, retryAfter.Seconds(), taskID)
 	if execErr != nil {
 		return fmt.Errorf("exec: %w", execErr)
 	}
@@ -150,14 +201,15 @@ func (s *TaskStorage) AddError(ctx context.Context, taskID int64, err string) er
 	return nil
 }
 
-func (s *TaskStorage) Fail(ctx context.Context, taskID int64) error {
+// Fail marks the task as failed with a given reason code.
+func (s *TaskStorage) Fail(ctx context.Context, taskID int64, reason string) error {
 	query := `
 		UPDATE scout.analysis_tasks
-		SET is_failed = true, failed_at = NOW()
+		SET is_failed = true, failed_at = NOW(), failure_reason = $2
 		WHERE id = $1
 	`
 
-	_, err := s.pool.Exec(ctx, query, taskID)
+	_, err := s.pool.Exec(ctx, query, taskID, reason)
 	if err != nil {
 		return fmt.Errorf("exec: %w", err)
 	}
@@ -195,11 +247,219 @@ func (s *TaskStorage) UnclaimOldTasks(ctx context.Context, timeout time.Duration
 	return nil
 }
 
+// UpdatePriority sets priority of pending (not claimed, committed or failed) tasks of a profile.
+func (s *TaskStorage) UpdatePriority(ctx context.Context, update models.TaskPriorityUpdate) (updated int64, err error) {
+	query := `
+		UPDATE scout.analysis_tasks
+		SET priority = $2
+		WHERE 1=1
+			AND profile_id = $1
+			AND NOT is_claimed
+			AND NOT is_committed
+			AND NOT is_failed
+			AND ($3::text IS NULL OR "type" = $3)
+			AND ($4::text IS NULL OR source = $4)
+	`
+
+	tag, err := s.pool.Exec(ctx, query, update.ProfileID, update.Priority, update.Type, update.Source)
+	if err != nil {
+		return 0, fmt.Errorf("exec: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// List returns tasks matching a query in descending order of ids.
+func (s *TaskStorage) List(ctx context.Context, query models.TaskQuery) ([]models.AnalysisTaskRecord, error) {
+	sb := tools.Psq().
+		Select(
+			"id", `"type"`, "priority", "source", "source_id", "profile_id", "should_save", "test_run_id", "shadow_id",
+			"COALESCE(errors, '{}')",
+			"created_at", "claimed_at", "committed_at", "failed_at", "failure_reason", taskStatusExpr,
+		).
+		From("scout.analysis_tasks").
+		OrderBy("id DESC").
+		Limit(uint64(max(query.Limit, 0)))
+
+	if query.LastSeenID != nil {
+		sb = sb.Where(sq.Lt{"id": *query.LastSeenID})
+	}
+
+	if query.Status != nil {
+		sb = sb.Where(taskStatusExpr+" = ?", string(*query.Status))
+	}
+
+	sb = sb.Where(taskSelectorClause(models.TaskSelector{
+		TaskIDs:   nil,
+		ProfileID: query.ProfileID,
+		Source:    query.Source,
+		Type:      query.Type,
+	}))
+
+	sql, args, err := sb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("to sql: %w", err)
+	}
+
+	rows, err := s.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	tasks := make([]models.AnalysisTaskRecord, 0)
+
+	for rows.Next() {
+		var task models.AnalysisTaskRecord
+
+		err := rows.Scan(
+			&task.ID,
+			&task.Type,
+			&task.Priority,
+			&task.Parameters.Source,
+			&task.Parameters.SourceID,
+			&task.Parameters.ProfileID,
+			&task.Parameters.ShouldSave,
+			&task.Parameters.TestRunID,
+			&task.Parameters.ShadowID,
+			&task.Errors,
+			&task.CreatedAt,
+			&task.ClaimedAt,
+			&task.CommittedAt,
+			&task.FailedAt,
+			&task.FailureReason,
+			&task.Status,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		tasks = append(tasks, task)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows: %w", err)
+	}
+
+	return tasks, nil
+}
+
+// RetryFailed returns selected failed tasks to the queue with no errors.
+func (s *TaskStorage) RetryFailed(ctx context.Context, selector models.TaskSelector) (retried int64, err error) {
+	sb := tools.Psq().
+		Update("scout.analysis_tasks").
+		Set("is_failed", false).
+		Set("failed_at", nil).
+		Set("failure_reason", nil).
+		Set("is_claimed", false).
+		Set("claimed_at", nil).
+		Set("errors", []string{}).
+		Set("claim_available_at", sq.Expr("NOW()")).
+		Where("is_failed AND NOT is_committed").
+		Where(taskSelectorClause(selector))
+
+	retried, err = s.execBulk(ctx, sb)
+	if err != nil {
+		return 0, err
+	}
+
+	if retried > 0 {
+		if _, err := s.pool.Exec(ctx, notifyTasksQuery, TasksChannel); err != nil {
+			return 0, fmt.Errorf("notify: %w", err)
+		}
+	}
+
+	return retried, nil
+}
+
+// CancelPending deletes selected tasks that were not claimed, committed or failed.
+func (s *TaskStorage) CancelPending(ctx context.Context, selector models.TaskSelector) (canceled int64, err error) {
+	sb := tools.Psq().
+		Delete("scout.analysis_tasks").
+		Where("NOT is_claimed AND NOT is_committed AND NOT is_failed").
+		Where(taskSelectorClause(selector))
+
+	return s.execBulk(ctx, sb)
+}
+
+// PurgeCommitted deletes tasks committed earlier than a given time.
+func (s *TaskStorage) PurgeCommitted(ctx context.Context, committedBefore time.Time) (purged int64, err error) {
+	sb := tools.Psq().
+		Delete("scout.analysis_tasks").
+		Where("is_committed").
+		Where(sq.Lt{"committed_at": committedBefore})
+
+	return s.execBulk(ctx, sb)
+}
+
+func (s *TaskStorage) execBulk(ctx context.Context, sb sq.Sqlizer) (affected int64, err error) {
+	sql, args, err := sb.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("to sql: %w", err)
+	}
+
+	tag, err := s.pool.Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, fmt.Errorf("exec: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// GetTaskStatistics returns amounts of profile's tasks in each state.
+func (s *TaskStorage) GetTaskStatistics(ctx context.Context, profileID int64) (models.TaskStatistics, error) {
+	return s.taskStatistics(ctx, sq.Eq{"profile_id": profileID})
+}
+
+// GetQueueStatistics returns amounts of all tasks in each state.
+func (s *TaskStorage) GetQueueStatistics(ctx context.Context) (models.TaskStatistics, error) {
+	return s.taskStatistics(ctx, sq.And{})
+}
+
+func (s *TaskStorage) taskStatistics(ctx context.Context, where sq.Sqlizer) (models.TaskStatistics, error) {
+	sql, args, err := tools.Psq().
+		Select(
+			"COUNT(*) FILTER (WHERE NOT is_claimed AND NOT is_committed AND NOT is_failed)",
+			"COUNT(*) FILTER (WHERE is_claimed AND NOT is_committed AND NOT is_failed)",
+			"COUNT(*) FILTER (WHERE is_failed AND NOT is_committed)",
+			"COUNT(*) FILTER (WHERE is_committed)",
+		).
+		From("scout.analysis_tasks").
+		Where(where).
+		ToSql()
+	if err != nil {
+		return models.TaskStatistics{}, fmt.Errorf("to sql: %w", err)
+	}
+
+	var statistics models.TaskStatistics
+
+	row := s.pool.QueryRow(ctx, sql, args...)
+
+	err = row.Scan(
+		&statistics.Pending,
+		&statistics.Claimed,
+		&statistics.Failed,
+		&statistics.Committed,
+	)
+	if err != nil {
+		return models.TaskStatistics{}, fmt.Errorf("scan: %w", err)
+	}
+
+	return statistics, nil
+}
+
+// heartbeat reports iterations of a loop to the health registry.
+type heartbeat interface {
+	Beat()
+	Fail(err error)
+}
+
 func UnclaimTasks(
 	ctx context.Context,
 	taskStorage *TaskStorage,
 	interval time.Duration,
 	claimTimeout time.Duration,
+	heartbeat heartbeat,
 	logger zerolog.Logger,
 ) {
 	for {
@@ -209,7 +469,35 @@ func UnclaimTasks(
 		case <-time.After(interval):
 			if err := taskStorage.UnclaimOldTasks(ctx, claimTimeout); err != nil {
 				logger.Error().Err(err).Msg("unclaim old tasks")
+
+				heartbeat.Fail(err)
+
+				continue
 			}
+
+			heartbeat.Beat()
 		}
 	}
 }
+
+func taskSelectorClause(selector models.TaskSelector) sq.And {
+	clause := sq.And{}
+
+	if selector.TaskIDs != nil {
+		clause = append(clause, sq.Eq{"id": selector.TaskIDs})
+	}
+
+	if selector.ProfileID != nil {
+		clause = append(clause, sq.Eq{"profile_id": *selector.ProfileID})
+	}
+
+	if selector.Source != nil {
+		clause = append(clause, sq.Eq{"source": *selector.Source})
+	}
+
+	if selector.Type != nil {
+		clause = append(clause, sq.Eq{`"type"`: *selector.Type})
+	}
+
+	return clause
+}
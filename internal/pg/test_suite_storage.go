@@ -0,0 +1,475 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+	"github.com/samber/lo"
+
+	"github.com/rishenco/scout/pkg/models"
+)
+
+// testRunColumns selects a test run with a summary of its results, the query must join testRunSummaryJoin.
+const testRunColumns = `
+	r.id, r.profile_id, r.settings, r.created_at, s.completed_at,
+	s.total, s.pending, s.failed, s.true_positives, s.false_positives, s.true_negatives, s.false_negatives,
+	s.properties_checked, s.properties_matched, s.passed
+`
+
+const testRunSummaryJoin = `
+	CROSS JOIN LATERAL (
+		SELECT
+			COUNT(*) AS total,
+			COUNT(*) FILTER (WHERE completed_at IS NULL) AS pending,
+			COUNT(*) FILTER (WHERE error IS NOT NULL) AS failed,
+			COUNT(*) FILTER (WHERE expected_relevant AND is_relevant) AS true_positives,
+			COUNT(*) FILTER (WHERE NOT expected_relevant AND is_relevant) AS false_positives,
+			COUNT(*) FILTER (WHERE NOT expected_relevant AND NOT is_relevant) AS true_negatives,
+			COUNT(*) FILTER (WHERE expected_relevant AND NOT is_relevant) AS false_negatives,
+			COALESCE(SUM(properties_checked), 0) AS properties_checked,
+			COALESCE(SUM(properties_checked - CARDINALITY(mismatched_properties)), 0) AS properties_matched,
+			COUNT(*) FILTER (
+				WHERE is_relevant = expected_relevant AND CARDINALITY(mismatched_properties) = 0
+			) AS passed,
+			CASE WHEN COUNT(*) FILTER (WHERE completed_at IS NULL) = 0 THEN MAX(completed_at) END AS completed_at
+		FROM scout.profile_test_results
+		WHERE run_id = r.id
+	) s
+`
+
+const testResultColumns = `
+	run_id, test_case_id, source, source_id, expected_relevant, expected_properties,
+	is_relevant, properties, model, error, mismatched_properties, completed_at
+`
+
+type TestSuiteStorage struct {
+	pool   *pgxpool.Pool
+	logger zerolog.Logger
+}
+
+func NewTestSuiteStorage(pool *pgxpool.Pool, logger zerolog.Logger) *TestSuiteStorage {
+	return &TestSuiteStorage{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+func (s *TestSuiteStorage) ListTestCases(ctx context.Context, profileID int64) ([]models.TestCase, error) {
+	query := `
+		SELECT id, profile_id, source, source_id, expected_relevant, expected_properties, created_at, updated_at
+		FROM scout.profile_test_cases
+		WHERE profile_id = $1
+		ORDER BY id
+	`
+
+	rows, err := s.pool.Query(ctx, query, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	testCases := make([]models.TestCase, 0)
+
+	for rows.Next() {
+		testCase, err := scanTestCase(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		testCases = append(testCases, testCase)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows: %w", err)
+	}
+
+	return testCases, nil
+}
+
+// UpsertTestCase creates a test case or replaces expected values of the test case of the same post.
+func (s *TestSuiteStorage) UpsertTestCase(ctx context.Context, testCase models.TestCase) (models.TestCase, error) {
+	query := `
+		INSERT INTO scout.profile_test_cases
+			(profile_id, source, source_id, expected_relevant, expected_properties, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+		ON CONFLICT (profile_id, source, source_id) DO UPDATE
+		SET expected_relevant = EXCLUDED.expected_relevant,
+			expected_properties = EXCLUDED.expected_properties,
+			updated_at = NOW()
+		RETURNING id, profile_id, source, source_id, expected_relevant, expected_properties, created_at, updated_at
+	`
+
+	expectedProperties := testCase.ExpectedProperties
+	if expectedProperties == nil {
+		expectedProperties = map[string]any{}
+	}
+
+	upserted, err := scanTestCase(s.pool.QueryRow(
+		ctx,
+		query,
+		testCase.ProfileID,
+		testCase.Source,
+		testCase.SourceID,
+		testCase.ExpectedRelevant,
+		expectedProperties,
+	))
+	if err != nil {
+		return models.TestCase{}, fmt.Errorf("scan: %w", err)
+	}
+
+	return upserted, nil
+}
+
+func (s *TestSuiteStorage) DeleteTestCase(
+	ctx context.Context,
+	profileID int64,
+	testCaseID int64,
+) (found bool, err error) {
+	query := `
+		DELETE FROM scout.profile_test_cases
+		WHERE profile_id = $1 AND id = $2
+	`
+
+	tag, err := s.pool.Exec(ctx, query, profileID, testCaseID)
+	if err != nil {
+		return false, fmt.Errorf("exec: %w", err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// DeleteProfileTests deletes test cases, runs and results of a profile.
+func (s *TestSuiteStorage) DeleteProfileTests(ctx context.Context, profileID int64) error {
+	queries := []string{
+		`DELETE FROM scout.profile_test_results
+		WHERE run_id IN (SELECT id FROM scout.profile_test_runs WHERE profile_id = $1)`,
+		`DELETE FROM scout.profile_test_runs WHERE profile_id = $1`,
+		`DELETE FROM scout.profile_test_cases WHERE profile_id = $1`,
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+
+	defer s.rollback(ctx, tx)
+
+	for _, query := range queries {
+		if _, err := tx.Exec(ctx, query, profileID); err != nil {
+			return fmt.Errorf("exec: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+
+	return nil
+}
+
+// CreateTestRun creates a run with pending results of all test cases of the profile.
+func (s *TestSuiteStorage) CreateTestRun(
+	ctx context.Context,
+	profileID int64,
+	settings models.TestRunSettings,
+) (runID int64, results []models.TestResult, err error) {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return 0, nil, fmt.Errorf("begin tx: %w", err)
+	}
+
+	defer s.rollback(ctx, tx)
+
+	runQuery := `
+		INSERT INTO scout.profile_test_runs (profile_id, settings, created_at)
+		VALUES ($1, $2, NOW())
+		RETURNING id
+	`
+
+	if err := tx.QueryRow(ctx, runQuery, profileID, settings).Scan(&runID); err != nil {
+		return 0, nil, fmt.Errorf("insert run: %w", err)
+	}
+
+	resultsQuery := `
+		INSERT INTO scout.profile_test_results
+			(run_id, test_case_id, source, source_id, expected_relevant, expected_properties)
+		SELECT $1, id, source, source_id, expected_relevant, expected_properties
+		FROM scout.profile_test_cases
+		WHERE profile_id = $2
+		RETURNING ` + testResultColumns
+
+	rows, err := tx.Query(ctx, resultsQuery, runID, profileID)
+	if err != nil {
+		return 0, nil, fmt.Errorf("insert results: %w", err)
+	}
+
+	results, err = collectTestResults(rows)
+	if err != nil {
+		return 0, nil, fmt.Errorf("collect results: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, nil, fmt.Errorf("commit tx: %w", err)
+	}
+
+	return runID, results, nil
+}
+
+func (s *TestSuiteStorage) GetTestRun(ctx context.Context, runID int64) (run models.TestRun, found bool, err error) {
+	query := `SELECT ` + testRunColumns + ` FROM scout.profile_test_runs r ` + testRunSummaryJoin + ` WHERE r.id = $1`
+
+	run, err = scanTestRun(s.pool.QueryRow(ctx, query, runID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.TestRun{}, false, nil
+		}
+
+		return models.TestRun{}, false, fmt.Errorf("scan: %w", err)
+	}
+
+	return run, true, nil
+}
+
+// ListTestRuns returns the latest runs of a profile starting from the newest one.
+func (s *TestSuiteStorage) ListTestRuns(ctx context.Context, profileID int64, limit int64) ([]models.TestRun, error) {
+	query := `SELECT ` + testRunColumns + ` FROM scout.profile_test_runs r ` + testRunSummaryJoin + `
+		WHERE r.profile_id = $1
+		ORDER BY r.id DESC
+		LIMIT $2
+	`
+
+	rows, err := s.pool.Query(ctx, query, profileID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	runs := make([]models.TestRun, 0)
+
+	for rows.Next() {
+		run, err := scanTestRun(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		runs = append(runs, run)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows: %w", err)
+	}
+
+	return runs, nil
+}
+
+func (s *TestSuiteStorage) ListTestResults(ctx context.Context, runID int64) ([]models.TestResult, error) {
+	query := `
+		SELECT ` + testResultColumns + `
+		FROM scout.profile_test_results
+		WHERE run_id = $1
+		ORDER BY test_case_id
+	`
+
+	rows, err := s.pool.Query(ctx, query, runID)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+
+	return collectTestResults(rows)
+}
+
+func (s *TestSuiteStorage) GetTestResult(
+	ctx context.Context,
+	runID int64,
+	source string,
+	sourceID string,
+) (result models.TestResult, found bool, err error) {
+	query := `
+		SELECT ` + testResultColumns + `
+		FROM scout.profile_test_results
+		WHERE run_id = $1 AND source = $2 AND source_id = $3
+	`
+
+	result, err = scanTestResult(s.pool.QueryRow(ctx, query, runID, source, sourceID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.TestResult{}, false, nil
+		}
+
+		return models.TestResult{}, false, fmt.Errorf("scan: %w", err)
+	}
+
+	return result, true, nil
+}
+
+// CompleteTestResult saves a detection or an error of a test case.
+func (s *TestSuiteStorage) CompleteTestResult(ctx context.Context, result models.TestResult) error {
+	query := `
+		UPDATE scout.profile_test_results
+		SET is_relevant = $4,
+			properties = $5,
+			model = $6,
+			error = $7,
+			mismatched_properties = $8,
+			properties_checked = $9,
+			completed_at = NOW()
+		WHERE run_id = $1 AND source = $2 AND source_id = $3
+	`
+
+	var (
+		isRelevant        *bool
+		properties        map[string]any
+		model             *string
+		propertiesChecked int
+	)
+
+	if result.Detection != nil {
+		isRelevant = &result.Detection.IsRelevant
+		properties = result.Detection.Properties
+		model = &result.Detection.Model
+		propertiesChecked = len(result.ExpectedProperties)
+	}
+
+	mismatchedProperties := result.MismatchedProperties
+	if mismatchedProperties == nil {
+		mismatchedProperties = []string{}
+	}
+
+	_, err := s.pool.Exec(
+		ctx,
+		query,
+		result.RunID,
+		result.Source,
+		result.SourceID,
+		isRelevant,
+		properties,
+		model,
+		result.Error,
+		mismatchedProperties,
+		propertiesChecked,
+	)
+	if err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
+
+func (s *TestSuiteStorage) rollback(ctx context.Context, tx pgx.Tx) {
+	rollbackErr := tx.Rollback(ctx)
+
+	if rollbackErr == nil || errors.Is(rollbackErr, pgx.ErrTxClosed) {
+		return
+	}
+
+	s.logger.Error().Err(rollbackErr).Msg("failed to rollback tx")
+}
+
+func scanTestCase(row pgx.Row) (models.TestCase, error) {
+	var testCase models.TestCase
+
+	err := row.Scan(
+		&testCase.ID,
+		&testCase.ProfileID,
+		&testCase.Source,
+		&testCase.SourceID,
+		&testCase.ExpectedRelevant,
+		&testCase.ExpectedProperties,
+		&testCase.CreatedAt,
+		&testCase.UpdatedAt,
+	)
+	if err != nil {
+		return models.TestCase{}, err //nolint:wrapcheck // callers wrap the error
+	}
+
+	return testCase, nil
+}
+
+func scanTestRun(row pgx.Row) (models.TestRun, error) {
+	var run models.TestRun
+
+	err := row.Scan(
+		&run.ID,
+		&run.ProfileID,
+		&run.Settings,
+		&run.CreatedAt,
+		&run.CompletedAt,
+		&run.Summary.Total,
+		&run.Summary.Pending,
+		&run.Summary.Failed,
+		&run.Summary.TruePositives,
+		&run.Summary.FalsePositives,
+		&run.Summary.TrueNegatives,
+		&run.Summary.FalseNegatives,
+		&run.Summary.PropertiesChecked,
+		&run.Summary.PropertiesMatched,
+		&run.Summary.Passed,
+	)
+	if err != nil {
+		return models.TestRun{}, err //nolint:wrapcheck // callers wrap the error
+	}
+
+	return run, nil
+}
+
+func scanTestResult(row pgx.Row) (models.TestResult, error) {
+	var (
+		result     models.TestResult
+		isRelevant *bool
+		properties map[string]any
+		model      *string
+	)
+
+	err := row.Scan(
+		&result.RunID,
+		&result.TestCaseID,
+		&result.Source,
+		&result.SourceID,
+		&result.ExpectedRelevant,
+		&result.ExpectedProperties,
+		&isRelevant,
+		&properties,
+		&model,
+		&result.Error,
+		&result.MismatchedProperties,
+		&result.CompletedAt,
+	)
+	if err != nil {
+		return models.TestResult{}, err //nolint:wrapcheck // callers wrap the error
+	}
+
+	if isRelevant != nil {
+		result.Detection = &models.Detection{
+			IsRelevant: *isRelevant,
+			Properties: properties,
+			Model:      lo.FromPtr(model),
+		}
+	}
+
+	return result, nil
+}
+
+func collectTestResults(rows pgx.Rows) ([]models.TestResult, error) {
+	defer rows.Close()
+
+	results := make([]models.TestResult, 0)
+
+	for rows.Next() {
+		result, err := scanTestResult(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		results = append(results, result)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows: %w", err)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,399 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/rishenco/scout/pkg/models"
+)
+
+type WebhookStorage struct {
+	pool   *pgxpool.Pool
+	logger zerolog.Logger
+}
+
+func NewWebhookStorage(pool *pgxpool.Pool, logger zerolog.Logger) *WebhookStorage {
+	return &WebhookStorage{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+func (s *WebhookStorage) ListWebhooks(ctx context.Context, profileID int64) ([]models.Webhook, error) {
+	query := `
+		SELECT id, profile_id, url, secret, active, created_at, updated_at
+		FROM scout.webhooks
+		WHERE profile_id = $1
+		ORDER BY id
+	`
+
+	rows, err := s.pool.Query(ctx, query, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	webhooks := make([]models.Webhook, 0)
+
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		webhooks = append(webhooks, webhook)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+func (s *WebhookStorage) GetWebhook(
+	ctx context.Context,
+	webhookID int64,
+) (webhook models.Webhook, found bool, err error) {
+	query := `
+		SELECT id, profile_id, url, secret, active, created_at, updated_at
+		FROM scout.webhooks
+		WHERE id = $1
+	`
+
+	webhook, err = scanWebhook(s.pool.QueryRow(ctx, query, webhookID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Webhook{}, false, nil
+		}
+
+		return models.Webhook{}, false, fmt.Errorf("scan: %w", err)
+	}
+
+	return webhook, true, nil
+}
+
+func (s *WebhookStorage) CreateWebhook(ctx context.Context, webhook models.Webhook) (models.Webhook, error) {
+	query := `
+		INSERT INTO scout.webhooks (profile_id, url, secret, active, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, NOW(), NOW())
+		RETURNING id, profile_id, url, secret, active, created_at, updated_at
+	`
+
+	created, err := scanWebhook(s.pool.QueryRow(
+		ctx,
+		query,
+		webhook.ProfileID,
+		webhook.URL,
+		webhook.Secret,
+		webhook.Active,
+	))
+	if err != nil {
+		return models.Webhook{}, fmt.Errorf("scan: %w", err)
+	}
+
+	return created, nil
+}
+
+func (s *WebhookStorage) UpdateWebhook(
+	ctx context.Context,
+	update models.WebhookUpdate,
+) (webhook models.Webhook, found bool, err error) {
+	query := `
+		UPDATE scout.webhooks
+		SET url = COALESCE($2, url),
+			secret = COALESCE($3, secret),
+			active = COALESCE($4, active),
+			updated_at = NOW()
+		WHERE id = $1
+		RETURNING id, profile_id, url, secret, active, created_at, updated_at
+	`
+
+	webhook, err = scanWebhook(s.pool.QueryRow(ctx, query, update.WebhookID, update.URL, update.Secret, update.Active))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Webhook{}, false, nil
+		}
+
+		return models.Webhook{}, false, fmt.Errorf("scan: %w", err)
+	}
+
+	return webhook, true, nil
+}
+
+// DeleteWebhook deletes a webhook with its outbox events and delivery log.
+func (s *WebhookStorage) DeleteWebhook(ctx context.Context, webhookID int64) (found bool, err error) {
+	return s.deleteWebhooks(ctx, "id = $1", webhookID)
+}
+
+// DeleteProfileWebhooks deletes all webhooks of a profile with their outbox events and delivery logs.
+func (s *WebhookStorage) DeleteProfileWebhooks(ctx context.Context, profileID int64) error {
+	if _, err := s.deleteWebhooks(ctx, "profile_id = $1", profileID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *WebhookStorage) deleteWebhooks(ctx context.Context, condition string, arg any) (found bool, err error) {
+	deleteWebhooksQuery := `
+		DELETE FROM scout.webhooks
+		WHERE ` + condition + `
+		RETURNING id
+	`
+
+	deleteOutboxQuery := `
+		DELETE FROM scout.webhook_outbox
+		WHERE webhook_id = ANY($1)
+	`
+
+	deleteDeliveriesQuery := `
+		DELETE FROM scout.webhook_deliveries
+		WHERE webhook_id = ANY($1)
+	`
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("begin tx: %w", err)
+	}
+
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+
+		if rollbackErr == nil || errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			return
+		}
+
+		s.logger.Error().Err(rollbackErr).Msg("failed to rollback tx")
+	}()
+
+	rows, err := tx.Query(ctx, deleteWebhooksQuery, arg)
+	if err != nil {
+		return false, fmt.Errorf("delete webhooks: %w", err)
+	}
+
+	webhookIDs, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+	if err != nil {
+		return false, fmt.Errorf("collect deleted webhook ids: %w", err)
+	}
+
+	if len(webhookIDs) == 0 {
+		return false, nil
+	}
+
+	if _, err := tx.Exec(ctx, deleteOutboxQuery, webhookIDs); err != nil {
+		return false, fmt.Errorf("delete outbox events: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, deleteDeliveriesQuery, webhookIDs); err != nil {
+		return false, fmt.Errorf("delete delivery log: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("commit tx: %w", err)
+	}
+
+	return true, nil
+}
+
+// ListDeliveries returns the latest delivery attempts of a webhook, newest first.
+func (s *WebhookStorage) ListDeliveries(
+	ctx context.Context,
+	webhookID int64,
+	limit int64,
+) ([]models.WebhookDeliveryLogEntry, error) {
+	query := `
+		SELECT wd.id, wd.outbox_id, wd.webhook_id, wo.detection_id, wo.event, wo.status,
+			wd.attempt, wd.status_code, wd.error, wd.duration_ms, wd.created_at
+		FROM scout.webhook_deliveries wd
+		JOIN scout.webhook_outbox wo ON wo.id = wd.outbox_id
+		WHERE wd.webhook_id = $1
+		ORDER BY wd.id DESC
+		LIMIT $2
+	`
+
+	rows, err := s.pool.Query(ctx, query, webhookID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	entries := make([]models.WebhookDeliveryLogEntry, 0)
+
+	for rows.Next() {
+		var (
+			entry      models.WebhookDeliveryLogEntry
+			durationMs int64
+		)
+
+		err := rows.Scan(
+			&entry.ID,
+			&entry.OutboxID,
+			&entry.WebhookID,
+			&entry.DetectionID,
+			&entry.Event,
+			&entry.Status,
+			&entry.Attempt,
+			&entry.StatusCode,
+			&entry.Error,
+			&durationMs,
+			&entry.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		entry.Duration = time.Duration(durationMs) * time.Millisecond
+
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows: %w", err)
+	}
+
+	return entries, nil
+}
+
+// Claim claims a due outbox event of an active webhook for a delivery attempt.
+//
+// The event is leased for a given duration: if the attempt result is not recorded in time
+// (e.g. the process crashed), the event becomes available for claiming again.
+func (s *WebhookStorage) Claim(
+	ctx context.Context,
+	lease time.Duration,
+) (delivery models.WebhookDelivery, anyDelivery bool, err error) {
+	query := `
+		UPDATE scout.webhook_outbox wo
+		SET attempts = wo.attempts + 1, next_attempt_at = NOW() + $1 * interval '1 second'
+		FROM scout.webhooks w
+		WHERE w.id = wo.webhook_id AND wo.id IN (
+			SELECT o.id
+			FROM scout.webhook_outbox o
+			JOIN scout.webhooks ow ON ow.id = o.webhook_id
+			WHERE 1=1
+				AND o.status = 'pending'
+				AND o.next_attempt_at <= NOW()
+				AND ow.active
+			ORDER BY o.next_attempt_at
+			LIMIT 1
+			FOR UPDATE OF o SKIP LOCKED
+		)
+		RETURNING wo.id, wo.webhook_id, w.url, w.secret, wo.event, wo.payload, wo.attempts
+	`
+
+	row := s.pool.QueryRow(ctx, query, lease.Seconds())
+
+	err = row.Scan(
+		&delivery.ID,
+		&delivery.WebhookID,
+		&delivery.URL,
+		&delivery.Secret,
+		&delivery.Event,
+		&delivery.Payload,
+		&delivery.Attempt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.WebhookDelivery{}, false, nil
+		}
+
+		return models.WebhookDelivery{}, false, fmt.Errorf("scan: %w", err)
+	}
+
+	return delivery, true, nil
+}
+
+// RecordResult appends a delivery attempt to the delivery log and updates the outbox event:
+// it is delivered if there is no error, rescheduled if there is a next attempt and failed otherwise.
+func (s *WebhookStorage) RecordResult(ctx context.Context, result models.WebhookDeliveryResult) error {
+	logQuery := `
+		INSERT INTO scout.webhook_deliveries (outbox_id, webhook_id, attempt, status_code, error, duration_ms)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	updateOutboxQuery := `
+		UPDATE scout.webhook_outbox
+		SET status = $2,
+			last_error = $3,
+			next_attempt_at = COALESCE($4, next_attempt_at),
+			delivered_at = CASE WHEN $2 = 'delivered' THEN NOW() ELSE delivered_at END
+		WHERE id = $1
+	`
+
+	var status models.WebhookDeliveryStatus
+
+	switch {
+	case result.Error == nil:
+		status = models.WebhookDeliveryDelivered
+	case result.NextAttemptAt != nil:
+		status = models.WebhookDeliveryPending
+	default:
+		status = models.WebhookDeliveryFailed
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+
+		if rollbackErr == nil || errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			return
+		}
+
+		s.logger.Error().Err(rollbackErr).Msg("failed to rollback tx")
+	}()
+
+	_, err = tx.Exec(
+		ctx,
+		logQuery,
+		result.DeliveryID,
+		result.WebhookID,
+		result.Attempt,
+		result.StatusCode,
+		result.Error,
+		result.Duration.Milliseconds(),
+	)
+	if err != nil {
+		return fmt.Errorf("insert delivery log: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, updateOutboxQuery, result.DeliveryID, string(status), result.Error, result.NextAttemptAt)
+	if err != nil {
+		return fmt.Errorf("update outbox event: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+
+	return nil
+}
+
+func scanWebhook(row pgx.Row) (models.Webhook, error) {
+	var webhook models.Webhook
+
+	err := row.Scan(
+		&webhook.ID,
+		&webhook.ProfileID,
+		&webhook.URL,
+		&webhook.Secret,
+		&webhook.Active,
+		&webhook.CreatedAt,
+		&webhook.UpdatedAt,
+	)
+	if err != nil {
+		return models.Webhook{}, err //nolint:wrapcheck // callers wrap the error
+	}
+
+	return webhook, nil
+}
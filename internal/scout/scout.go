@@ -3,11 +3,16 @@ package scout
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"time"
 
 	"github.com/rs/zerolog"
 	"github.com/samber/lo"
 
+	"github.com/rishenco/scout/internal/metrics"
+	"github.com/rishenco/scout/internal/tracing"
 	"github.com/rishenco/scout/pkg/models"
+	"github.com/rishenco/scout/pkg/nullable"
 )
 
 type storage interface {
@@ -16,7 +21,14 @@ type storage interface {
 	DeleteProfileByID(ctx context.Context, id int64) error
 	CreateProfile(ctx context.Context, profile models.Profile) (id int64, err error)
 	UpdateProfile(ctx context.Context, update models.ProfileUpdate) error
-	SaveDetection(ctx context.Context, record models.DetectionRecord) error
+	// UpdateProfileSettings applies a settings update and returns the version it created.
+	UpdateProfileSettings(
+		ctx context.Context,
+		profileID int64,
+		source *string,
+		settingsUpdate models.ProfileSettingsUpdate,
+	) (settingsVersion models.ProfileSettingsVersion, found bool, err error)
+	SaveDetection(ctx context.Context, record models.DetectionRecord) (detectionID int64, err error)
 	ListDetections(ctx context.Context, query models.DetectionQuery) ([]models.DetectionRecord, error)
 	GetDetectionTags(ctx context.Context, detectionIDs []int64) ([]models.DetectionTags, error)
 	GetPresentDetectionsForProfile(
@@ -26,10 +38,32 @@ type storage interface {
 		sourceIDs []string,
 	) ([]string, error)
 	UpdateTags(ctx context.Context, detectionID int64, update models.DetectionTagsUpdate) (models.DetectionTags, error)
+	GetDetectionStatistics(ctx context.Context, profileID int64) ([]models.SettingsVersionStatistics, error)
+	GetSettingsVersionsAccuracy(
+		ctx context.Context,
+		filter models.AccuracyFilter,
+	) ([]models.SettingsVersionAccuracy, error)
+	GetProfileSettingsVersions(
+		ctx context.Context,
+		profileID int64,
+		source *string,
+	) ([]models.ProfileSettingsVersion, error)
+	GetProfileSettingsVersion(
+		ctx context.Context,
+		profileID int64,
+		source *string,
+		version int64,
+	) (settingsVersion models.ProfileSettingsVersion, found bool, err error)
 }
 
-type taskAdder interface {
+type taskStorage interface {
 	Add(ctx context.Context, tasks []models.AnalysisTask) error
+	GetTaskStatistics(ctx context.Context, profileID int64) (models.TaskStatistics, error)
+	UpdatePriority(ctx context.Context, update models.TaskPriorityUpdate) (updated int64, err error)
+	List(ctx context.Context, query models.TaskQuery) ([]models.AnalysisTaskRecord, error)
+	RetryFailed(ctx context.Context, selector models.TaskSelector) (retried int64, err error)
+	CancelPending(ctx context.Context, selector models.TaskSelector) (canceled int64, err error)
+	PurgeCommitted(ctx context.Context, committedBefore time.Time) (purged int64, err error)
 }
 
 type SourceToolkit interface {
@@ -47,23 +81,32 @@ type SourceToolkit interface {
 }
 
 type Scout struct {
-	toolkits  map[string]SourceToolkit
-	storage   storage
-	taskAdder taskAdder
-	logger    zerolog.Logger
+	toolkits         map[string]SourceToolkit
+	storage          storage
+	taskStorage      taskStorage
+	webhookStorage   webhookStorage
+	testSuiteStorage testSuiteStorage
+	shadowStorage    shadowStorage
+	logger           zerolog.Logger
 }
 
 func New(
 	toolkits map[string]SourceToolkit,
 	storage storage,
-	taskAdder taskAdder,
+	taskStorage taskStorage,
+	webhookStorage webhookStorage,
+	testSuiteStorage testSuiteStorage,
+	shadowStorage shadowStorage,
 	logger zerolog.Logger,
 ) *Scout {
 	return &Scout{
-		toolkits:  toolkits,
-		storage:   storage,
-		taskAdder: taskAdder,
-		logger:    logger,
+		toolkits:         toolkits,
+		storage:          storage,
+		taskStorage:      taskStorage,
+		webhookStorage:   webhookStorage,
+		testSuiteStorage: testSuiteStorage,
+		shadowStorage:    shadowStorage,
+		logger:           logger,
 	}
 }
 
@@ -78,7 +121,10 @@ func (s *Scout) Analyze(
 
 	toolkit, ok := s.toolkits[source]
 	if !ok {
-		return models.Detection{}, fmt.Errorf("toolkit not found: %s", sourceID)
+		return models.Detection{}, models.NewPermanentAnalysisError(
+			models.ToolkitNotFoundReason,
+			fmt.Errorf("toolkit not found: %s", source),
+		)
 	}
 
 	// Analyze post
@@ -96,13 +142,19 @@ func (s *Scout) Analyze(
 			SettingsVersion: profileSettings.Version,
 			IsRelevant:      detection.IsRelevant,
 			Properties:      detection.Properties,
+			Model:           lo.EmptyableToPtr(detection.Model),
+			Confidence:      lo.ToPtr(detection.Confidence),
+			Rationale:       lo.EmptyableToPtr(detection.Rationale),
+			ExampleIDs:      detection.ExampleIDs,
 		}
 
-		if err := s.storage.SaveDetection(ctx, record); err != nil {
+		if _, err := s.storage.SaveDetection(ctx, record); err != nil {
 			logger.Error().Err(err).Msg("failed to save post")
 
 			return models.Detection{}, fmt.Errorf("save report: %w", err)
 		}
+
+		metrics.ObserveDetection(profileSettings.ProfileID, detection.IsRelevant)
 	}
 
 	return detection, nil
@@ -110,15 +162,33 @@ func (s *Scout) Analyze(
 
 // ScheduleAnalysis adds tasks to the task queue.
 func (s *Scout) ScheduleAnalysis(ctx context.Context, tasks []models.AnalysisTask) error {
+	traceContext := tracing.Inject(ctx)
+
 	for i := range tasks {
 		task := &tasks[i]
 
 		if task.Type == "" {
 			task.Type = models.ScheduledTaskType
 		}
+
+		if task.Priority == 0 {
+			task.Priority = models.DefaultTaskPriority(task.Type)
+		}
+
+		if task.TraceContext == nil {
+			task.TraceContext = traceContext
+		}
 	}
 
-	if err := s.taskAdder.Add(ctx, tasks); err != nil {
+	// Shadow tasks are added together with scheduled ones, so each scheduled post is analyzed by both settings
+	shadowTasks, err := s.shadowTasks(ctx, tasks)
+	if err != nil {
+		return fmt.Errorf("shadow tasks: %w", err)
+	}
+
+	tasks = append(tasks, shadowTasks...)
+
+	if err := s.taskStorage.Add(ctx, tasks); err != nil {
 		return fmt.Errorf("add tasks: %w", err)
 	}
 
@@ -137,6 +207,18 @@ func (s *Scout) DeleteProfile(ctx context.Context, id int64) error {
 		return fmt.Errorf("delete profile from storage: %w", err)
 	}
 
+	if err := s.webhookStorage.DeleteProfileWebhooks(ctx, id); err != nil {
+		return fmt.Errorf("delete profile webhooks: %w", err)
+	}
+
+	if err := s.testSuiteStorage.DeleteProfileTests(ctx, id); err != nil {
+		return fmt.Errorf("delete profile tests: %w", err)
+	}
+
+	if err := s.shadowStorage.DeleteProfileShadows(ctx, id); err != nil {
+		return fmt.Errorf("delete profile shadows: %w", err)
+	}
+
 	for source, toolkit := range s.toolkits {
 		if err := toolkit.DeleteProfile(ctx, id); err != nil {
 			return fmt.Errorf("delete profile from source toolkit (source=%s): %w", source, err)
@@ -158,14 +240,215 @@ func (s *Scout) GetProfile(ctx context.Context, id int64) (profile models.Profil
 
 // CreateProfile creates a new profile in the scout's storage.
 func (s *Scout) CreateProfile(ctx context.Context, profile models.Profile) (id int64, err error) {
+	if profile.DefaultSettings != nil {
+		err := models.ValidateTypedProperties(
+			profile.DefaultSettings.ExtractedProperties,
+			profile.DefaultSettings.PropertyTypes,
+		)
+		if err != nil {
+			return 0, fmt.Errorf("default settings: %w", err)
+		}
+
+		if err := models.ValidateFewShotSettings(profile.DefaultSettings.FewShot); err != nil {
+			return 0, fmt.Errorf("default settings: %w", err)
+		}
+	}
+
+	for source, settings := range profile.SourcesSettings {
+		if err := models.ValidateTypedProperties(settings.ExtractedProperties, settings.PropertyTypes); err != nil {
+			return 0, fmt.Errorf("%s settings: %w", source, err)
+		}
+
+		if err := models.ValidateFewShotSettings(settings.FewShot); err != nil {
+			return 0, fmt.Errorf("%s settings: %w", source, err)
+		}
+	}
+
 	return s.storage.CreateProfile(ctx, profile)
 }
 
 // UpdateProfile partially updates a profile in the scout's storage.
 func (s *Scout) UpdateProfile(ctx context.Context, update models.ProfileUpdate) error {
+	if update.DefaultSettings.IsSet() && update.DefaultSettings.Value != nil {
+		if err := validateSettingsUpdate(*update.DefaultSettings.Value); err != nil {
+			return fmt.Errorf("default settings: %w", err)
+		}
+	}
+
+	for source, settingsUpdate := range update.SourcesSettings {
+		if settingsUpdate == nil {
+			continue
+		}
+
+		if err := validateSettingsUpdate(*settingsUpdate); err != nil {
+			return fmt.Errorf("%s settings: %w", source, err)
+		}
+	}
+
 	return s.storage.UpdateProfile(ctx, update)
 }
 
+// validateSettingsUpdate validates property types and few-shot settings of a settings update.
+// Property names are checked only when extracted properties are updated as well.
+func validateSettingsUpdate(settingsUpdate models.ProfileSettingsUpdate) error {
+	if err := models.ValidateFewShotSettings(settingsUpdate.FewShot.Value); err != nil {
+		return err
+	}
+
+	if settingsUpdate.PropertyTypes == nil {
+		return nil
+	}
+
+	if settingsUpdate.ExtractedProperties == nil {
+		return models.ValidatePropertyTypes(*settingsUpdate.PropertyTypes)
+	}
+
+	return models.ValidateTypedProperties(*settingsUpdate.ExtractedProperties, *settingsUpdate.PropertyTypes)
+}
+
+// GetProfileSettingsVersions returns the version history of profile settings for a given source.
+//
+// source - settings source, nil means default settings
+func (s *Scout) GetProfileSettingsVersions(
+	ctx context.Context,
+	profileID int64,
+	source *string,
+) ([]models.ProfileSettingsVersion, error) {
+	return s.storage.GetProfileSettingsVersions(ctx, profileID, source)
+}
+
+// DiffProfileSettingsVersions compares two versions of profile settings for a given source.
+//
+// source - settings source, nil means default settings
+func (s *Scout) DiffProfileSettingsVersions(
+	ctx context.Context,
+	profileID int64,
+	source *string,
+	fromVersion int64,
+	toVersion int64,
+) (diff models.ProfileSettingsDiff, found bool, err error) {
+	from, found, err := s.storage.GetProfileSettingsVersion(ctx, profileID, source, fromVersion)
+	if err != nil {
+		return models.ProfileSettingsDiff{}, false, fmt.Errorf("get version %d: %w", fromVersion, err)
+	}
+
+	if !found {
+		return models.ProfileSettingsDiff{}, false, nil
+	}
+
+	to, found, err := s.storage.GetProfileSettingsVersion(ctx, profileID, source, toVersion)
+	if err != nil {
+		return models.ProfileSettingsDiff{}, false, fmt.Errorf("get version %d: %w", toVersion, err)
+	}
+
+	if !found {
+		return models.ProfileSettingsDiff{}, false, nil
+	}
+
+	diff = models.ProfileSettingsDiff{
+		From:                   from,
+		To:                     to,
+		RelevancyFilterChanged: from.RelevancyFilter != to.RelevancyFilter,
+		ModelChanged:           lo.FromPtr(from.Model) != lo.FromPtr(to.Model),
+		FewShotChanged:         lo.FromPtr(from.FewShot) != lo.FromPtr(to.FewShot),
+		PropertyTypesChanged:   !reflect.DeepEqual(normalizedPropertyTypes(from), normalizedPropertyTypes(to)),
+		AddedProperties:        make(map[string]string),
+		RemovedProperties:      make(map[string]string),
+		ChangedProperties:      make(map[string]models.PropertyDefinitionChange),
+	}
+
+	for property, toDefinition := range to.ExtractedProperties {
+		fromDefinition, ok := from.ExtractedProperties[property]
+
+		switch {
+		case !ok:
+			diff.AddedProperties[property] = toDefinition
+		case fromDefinition != toDefinition:
+			diff.ChangedProperties[property] = models.PropertyDefinitionChange{
+				From: fromDefinition,
+				To:   toDefinition,
+			}
+		}
+	}
+
+	for property, fromDefinition := range from.ExtractedProperties {
+		if _, ok := to.ExtractedProperties[property]; !ok {
+			diff.RemovedProperties[property] = fromDefinition
+		}
+	}
+
+	return diff, true, nil
+}
+
+// normalizedPropertyTypes returns types of all extracted properties of a settings version,
+// so that an implicit string type equals an explicit optional string type.
+func normalizedPropertyTypes(version models.ProfileSettingsVersion) map[string]models.PropertyType {
+	settings := models.ProfileSettings{
+		ExtractedProperties: version.ExtractedProperties,
+		PropertyTypes:       version.PropertyTypes,
+	}
+
+	return lo.MapValues(
+		settings.PropertyDefinitions(),
+		func(definition models.PropertyDefinition, _ string) models.PropertyType {
+			return definition.PropertyType
+		},
+	)
+}
+
+// RollbackProfileSettings creates a new version of profile settings with the content of a given older version.
+//
+// source - settings source, nil means default settings
+func (s *Scout) RollbackProfileSettings(
+	ctx context.Context,
+	profileID int64,
+	source *string,
+	version int64,
+) (newVersion models.ProfileSettingsVersion, found bool, err error) {
+	oldVersion, found, err := s.storage.GetProfileSettingsVersion(ctx, profileID, source, version)
+	if err != nil {
+		return models.ProfileSettingsVersion{}, false, fmt.Errorf("get version %d: %w", version, err)
+	}
+
+	if !found {
+		return models.ProfileSettingsVersion{}, false, nil
+	}
+
+	settingsUpdate := models.ProfileSettingsUpdate{
+		RelevancyFilter:     lo.ToPtr(oldVersion.RelevancyFilter),
+		ExtractedProperties: lo.ToPtr(oldVersion.ExtractedProperties),
+		PropertyTypes:       lo.ToPtr(oldVersion.PropertyTypes),
+		Model:               nullable.Null[string](),
+		FewShot:             nullable.Null[models.FewShotSettings](),
+	}
+
+	if oldVersion.Model != nil {
+		settingsUpdate.Model = nullable.Value(*oldVersion.Model)
+	}
+
+	if oldVersion.FewShot != nil {
+		settingsUpdate.FewShot = nullable.Value(*oldVersion.FewShot)
+	}
+
+	// The new version is returned by the same transaction, so concurrent edits can't be mistaken for it
+	newVersion, found, err = s.storage.UpdateProfileSettings(ctx, profileID, source, settingsUpdate)
+	if err != nil {
+		return models.ProfileSettingsVersion{}, false, fmt.Errorf("update profile settings: %w", err)
+	}
+
+	if !found {
+		return models.ProfileSettingsVersion{}, false, nil
+	}
+
+	s.logger.Info().
+		Int64("profile_id", profileID).
+		Int64("rolled_back_to", version).
+		Int64("new_version", newVersion.Version).
+		Msg("rolled back profile settings")
+
+	return newVersion, true, nil
+}
+
 // UpdateTags updates the tags for a given detection.
 func (s *Scout) UpdateTags(
 	ctx context.Context,
@@ -200,6 +483,216 @@ func (s *Scout) ListDetections(ctx context.Context, query models.DetectionQuery)
 	return s.storage.ListDetections(ctx, query)
 }
 
+// GetProfileStatistics returns detection and task queue statistics for a given profile.
+func (s *Scout) GetProfileStatistics(
+	ctx context.Context,
+	profileID int64,
+) (statistics models.ProfileStatistics, found bool, err error) {
+	_, found, err = s.storage.GetProfile(ctx, profileID)
+	if err != nil {
+		return models.ProfileStatistics{}, false, fmt.Errorf("get profile: %w", err)
+	}
+
+	if !found {
+		return models.ProfileStatistics{}, false, nil
+	}
+
+	versionsStatistics, err := s.storage.GetDetectionStatistics(ctx, profileID)
+	if err != nil {
+		return models.ProfileStatistics{}, false, fmt.Errorf("get detection statistics: %w", err)
+	}
+
+	taskStatistics, err := s.taskStorage.GetTaskStatistics(ctx, profileID)
+	if err != nil {
+		return models.ProfileStatistics{}, false, fmt.Errorf("get task statistics: %w", err)
+	}
+
+	statistics = models.ProfileStatistics{
+		ProfileID:  profileID,
+		Detections: models.DetectionStatistics{},
+		Sources:    make([]models.SourceStatistics, 0),
+		Tasks:      taskStatistics,
+	}
+
+	// versions statistics are ordered by source, so each source forms a contiguous block
+	for _, versionStatistics := range versionsStatistics {
+		statistics.Detections = statistics.Detections.Add(versionStatistics.Detections)
+
+		if len(statistics.Sources) == 0 || statistics.Sources[len(statistics.Sources)-1].Source != versionStatistics.Source {
+			statistics.Sources = append(statistics.Sources, models.SourceStatistics{
+				Source:           versionStatistics.Source,
+				Detections:       models.DetectionStatistics{},
+				SettingsVersions: make([]models.SettingsVersionStatistics, 0),
+			})
+		}
+
+		sourceStatistics := &statistics.Sources[len(statistics.Sources)-1]
+
+		sourceStatistics.Detections = sourceStatistics.Detections.Add(versionStatistics.Detections)
+		sourceStatistics.SettingsVersions = append(sourceStatistics.SettingsVersions, versionStatistics)
+	}
+
+	return statistics, true, nil
+}
+
+// GetSettingsVersionsAccuracy measures accuracy of each settings version of a profile source
+// by relevancy tags of its detections.
+func (s *Scout) GetSettingsVersionsAccuracy(
+	ctx context.Context,
+	filter models.AccuracyFilter,
+) (accuracy []models.SettingsVersionAccuracy, found bool, err error) {
+	_, found, err = s.storage.GetProfile(ctx, filter.ProfileID)
+	if err != nil {
+		return nil, false, fmt.Errorf("get profile: %w", err)
+	}
+
+	if !found {
+		return nil, false, nil
+	}
+
+	accuracy, err = s.storage.GetSettingsVersionsAccuracy(ctx, filter)
+	if err != nil {
+		return nil, false, fmt.Errorf("get settings versions accuracy: %w", err)
+	}
+
+	return accuracy, true, nil
+}
+
+// UpdateTaskPriority bumps or lowers priority of pending tasks of a profile.
+func (s *Scout) UpdateTaskPriority(
+	ctx context.Context,
+	update models.TaskPriorityUpdate,
+) (updated int64, found bool, err error) {
+	_, found, err = s.storage.GetProfile(ctx, update.ProfileID)
+	if err != nil {
+		return 0, false, fmt.Errorf("get profile: %w", err)
+	}
+
+	if !found {
+		return 0, false, nil
+	}
+
+	updated, err = s.taskStorage.UpdatePriority(ctx, update)
+	if err != nil {
+		return 0, false, fmt.Errorf("update task priority: %w", err)
+	}
+
+	s.logger.Info().
+		Int64("profile_id", update.ProfileID).
+		Int("priority", update.Priority).
+		Int64("tasks_count", updated).
+		Msg("updated task priority")
+
+	return updated, true, nil
+}
+
+// ListTasks returns tasks of the queue matching a query.
+func (s *Scout) ListTasks(ctx context.Context, query models.TaskQuery) ([]models.AnalysisTaskRecord, error) {
+	return s.taskStorage.List(ctx, query)
+}
+
+// RetryFailedTasks resets errors of selected failed tasks and returns them to the queue.
+func (s *Scout) RetryFailedTasks(ctx context.Context, selector models.TaskSelector) (retried int64, err error) {
+	retried, err = s.taskStorage.RetryFailed(ctx, selector)
+	if err != nil {
+		return 0, fmt.Errorf("retry failed tasks: %w", err)
+	}
+
+	s.logger.Info().Int64("tasks_count", retried).Msg("retried failed tasks")
+
+	return retried, nil
+}
+
+// CancelPendingTasks removes selected tasks which are waiting to be claimed from the queue.
+func (s *Scout) CancelPendingTasks(ctx context.Context, selector models.TaskSelector) (canceled int64, err error) {
+	canceled, err = s.taskStorage.CancelPending(ctx, selector)
+	if err != nil {
+		return 0, fmt.Errorf("cancel pending tasks: %w", err)
+	}
+
+	s.logger.Info().Int64("tasks_count", canceled).Msg("canceled pending tasks")
+
+	return canceled, nil
+}
+
+// PurgeCommittedTasks deletes tasks committed earlier than a given time.
+func (s *Scout) PurgeCommittedTasks(ctx context.Context, committedBefore time.Time) (purged int64, err error) {
+	purged, err = s.taskStorage.PurgeCommitted(ctx, committedBefore)
+	if err != nil {
+		return 0, fmt.Errorf("purge committed tasks: %w", err)
+	}
+
+	s.logger.Info().Int64("tasks_count", purged).Msg("purged committed tasks")
+
+	return purged, nil
+}
+
+// RequestAnalysis adds posts explicitly requested for analysis of a profile to the task queue.
+// Tasks are claimed before all other tasks since someone is waiting for them, detections are saved.
+func (s *Scout) RequestAnalysis(
+	ctx context.Context,
+	profileID int64,
+	source string,
+	sourceIDs []string,
+) (found bool, err error) {
+	if _, ok := s.toolkits[source]; !ok {
+		return false, fmt.Errorf("%w: unknown source %q", models.ErrInvalidAnalysisRequest, source)
+	}
+
+	sourceIDs = lo.Uniq(sourceIDs)
+
+	if len(sourceIDs) == 0 || len(sourceIDs) > models.MaxAnalysisRequestPosts {
+		return false, fmt.Errorf(
+			"%w: number of posts must be between 1 and %d",
+			models.ErrInvalidAnalysisRequest,
+			models.MaxAnalysisRequestPosts,
+		)
+	}
+
+	if lo.Contains(sourceIDs, "") {
+		return false, fmt.Errorf("%w: empty post id", models.ErrInvalidAnalysisRequest)
+	}
+
+	_, found, err = s.storage.GetProfile(ctx, profileID)
+	if err != nil {
+		return false, fmt.Errorf("get profile: %w", err)
+	}
+
+	if !found {
+		return false, nil
+	}
+
+	traceContext := tracing.Inject(ctx)
+
+	tasks := lo.Map(sourceIDs, func(sourceID string, _ int) models.AnalysisTask {
+		return models.AnalysisTask{
+			Type:     models.ManualTaskType,
+			Priority: models.ManualTaskPriority,
+			Parameters: models.AnalysisParameters{
+				SourceID:   sourceID,
+				ProfileID:  profileID,
+				Source:     source,
+				ShouldSave: true,
+				TestRunID:  nil,
+				ShadowID:   nil,
+			},
+			TraceContext: traceContext,
+		}
+	})
+
+	if err := s.taskStorage.Add(ctx, tasks); err != nil {
+		return false, fmt.Errorf("add analysis tasks: %w", err)
+	}
+
+	s.logger.Info().
+		Int64("profile_id", profileID).
+		Str("source", source).
+		Int("tasks_count", len(tasks)).
+		Msg("scheduled tasks for requested analysis")
+
+	return true, nil
+}
+
 // JumpstartProfile schedules a jumpstart analysis for a given profile.
 //
 // Jumpstart Algorithm:
@@ -223,14 +716,18 @@ func (s *Scout) JumpstartProfile(
 		return fmt.Errorf("dry jumpstart profile: %w", err)
 	}
 
+	traceContext := tracing.Inject(ctx)
+
 	analysisTasks := lo.Map(analysisTaskParameters, func(taskParameters models.AnalysisParameters, _ int) models.AnalysisTask {
 		return models.AnalysisTask{
-			Type:       models.ManualTaskType,
-			Parameters: taskParameters,
+			Type:         models.JumpstartTaskType,
+			Priority:     models.JumpstartTaskPriority,
+			Parameters:   taskParameters,
+			TraceContext: traceContext,
 		}
 	})
 
-	if err := s.taskAdder.Add(ctx, analysisTasks); err != nil {
+	if err := s.taskStorage.Add(ctx, analysisTasks); err != nil {
 		return fmt.Errorf("add analysis tasks: %w", err)
 	}
 
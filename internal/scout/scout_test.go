@@ -0,0 +1,270 @@
+package scout
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/samber/lo"
+
+	"github.com/rishenco/scout/pkg/models"
+)
+
+// fakeVersionsStorage keeps version history of default settings of profiles in memory.
+// Methods not used by the tests are left to the embedded nil interface.
+type fakeVersionsStorage struct {
+	storage
+
+	versions       []models.ProfileSettingsVersion
+	deletedProfile int64
+	// concurrentEdit is called after each settings update, as if another edit landed right after it
+	concurrentEdit func()
+}
+
+func (s *fakeVersionsStorage) GetProfileSettingsVersion(
+	_ context.Context,
+	profileID int64,
+	source *string,
+	version int64,
+) (models.ProfileSettingsVersion, bool, error) {
+	for _, settingsVersion := range s.versions {
+		if settingsVersion.ProfileID == profileID &&
+			lo.FromPtr(settingsVersion.Source) == lo.FromPtr(source) &&
+			settingsVersion.Version == version {
+			return settingsVersion, true, nil
+		}
+	}
+
+	return models.ProfileSettingsVersion{}, false, nil
+}
+
+func (s *fakeVersionsStorage) UpdateProfileSettings(
+	_ context.Context,
+	profileID int64,
+	source *string,
+	settingsUpdate models.ProfileSettingsUpdate,
+) (models.ProfileSettingsVersion, bool, error) {
+	if profileID == s.deletedProfile {
+		return models.ProfileSettingsVersion{}, false, nil
+	}
+
+	settingsVersion := s.appendVersion(profileID, source, settingsUpdate)
+
+	if s.concurrentEdit != nil {
+		s.concurrentEdit()
+	}
+
+	return settingsVersion, true, nil
+}
+
+func (s *fakeVersionsStorage) appendVersion(
+	profileID int64,
+	source *string,
+	settingsUpdate models.ProfileSettingsUpdate,
+) models.ProfileSettingsVersion {
+	settingsVersion := models.ProfileSettingsVersion{
+		ProfileID:           profileID,
+		Source:              source,
+		Version:             int64(len(s.versions) + 1),
+		RelevancyFilter:     lo.FromPtr(settingsUpdate.RelevancyFilter),
+		ExtractedProperties: lo.FromPtr(settingsUpdate.ExtractedProperties),
+		PropertyTypes:       lo.FromPtr(settingsUpdate.PropertyTypes),
+		Model:               settingsUpdate.Model.Value,
+		FewShot:             settingsUpdate.FewShot.Value,
+		CreatedAt:           time.Time{},
+	}
+
+	s.versions = append(s.versions, settingsVersion)
+
+	return settingsVersion
+}
+
+func newTestVersions() []models.ProfileSettingsVersion {
+	return []models.ProfileSettingsVersion{
+		{
+			ProfileID:           1,
+			Source:              nil,
+			Version:             1,
+			RelevancyFilter:     "go posts",
+			ExtractedProperties: map[string]string{"summary": "Summary", "language": "Language"},
+			PropertyTypes:       map[string]models.PropertyType{},
+			Model:               nil,
+			FewShot:             nil,
+			CreatedAt:           time.Time{},
+		},
+		{
+			ProfileID:           1,
+			Source:              nil,
+			Version:             2,
+			RelevancyFilter:     "rust posts",
+			ExtractedProperties: map[string]string{"summary": "Short summary", "stars": "Stars"},
+			PropertyTypes: map[string]models.PropertyType{
+				"summary": {Kind: models.PropertyKindString, Enum: nil, Required: false},
+				"stars":   {Kind: models.PropertyKindNumber, Enum: nil, Required: true},
+			},
+			Model:     lo.ToPtr("gemini-2.5-pro"),
+			FewShot:   nil,
+			CreatedAt: time.Time{},
+		},
+	}
+}
+
+func TestScout_RollbackProfileSettings(t *testing.T) {
+	storage := &fakeVersionsStorage{versions: newTestVersions()}
+	scout := New(nil, storage, nil, nil, nil, nil, zerolog.Nop())
+
+	newVersion, found, err := scout.RollbackProfileSettings(context.Background(), 1, nil, 1)
+	if err != nil || !found {
+		t.Fatalf("rollback: found = %t, err = %v", found, err)
+	}
+
+	want := newTestVersions()[0]
+	want.Version = 3
+
+	if !reflect.DeepEqual(newVersion, want) {
+		t.Errorf("new version = %+v, want %+v", newVersion, want)
+	}
+
+	// each rollback adds the next version
+	newVersion, found, err = scout.RollbackProfileSettings(context.Background(), 1, nil, 2)
+	if err != nil || !found {
+		t.Fatalf("rollback: found = %t, err = %v", found, err)
+	}
+
+	want = newTestVersions()[1]
+	want.Version = 4
+
+	if !reflect.DeepEqual(newVersion, want) {
+		t.Errorf("new version = %+v, want %+v", newVersion, want)
+	}
+}
+
+func TestScout_RollbackProfileSettingsConcurrentEdit(t *testing.T) {
+	storage := &fakeVersionsStorage{versions: newTestVersions()}
+
+	storage.concurrentEdit = func() {
+		storage.concurrentEdit = nil
+		storage.appendVersion(1, nil, models.ProfileSettingsUpdate{RelevancyFilter: lo.ToPtr("concurrent edit")})
+	}
+
+	scout := New(nil, storage, nil, nil, nil, nil, zerolog.Nop())
+
+	newVersion, found, err := scout.RollbackProfileSettings(context.Background(), 1, nil, 1)
+	if err != nil || !found {
+		t.Fatalf("rollback: found = %t, err = %v", found, err)
+	}
+
+	// the version written by the rollback is returned, not the latest one
+	if newVersion.Version != 3 || newVersion.RelevancyFilter != "go posts" {
+		t.Errorf("new version = %+v, want version 3 with the rolled back filter", newVersion)
+	}
+}
+
+func TestScout_RollbackProfileSettingsNotFound(t *testing.T) {
+	tests := []struct {
+		name      string
+		profileID int64
+		version   int64
+		deleted   bool
+	}{
+		{name: "unknown version", profileID: 1, version: 10, deleted: false},
+		{name: "unknown profile", profileID: 2, version: 1, deleted: false},
+		{name: "deleted profile", profileID: 1, version: 1, deleted: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := &fakeVersionsStorage{versions: newTestVersions()}
+			if tt.deleted {
+				storage.deletedProfile = tt.profileID
+			}
+
+			scout := New(nil, storage, nil, nil, nil, nil, zerolog.Nop())
+
+			_, found, err := scout.RollbackProfileSettings(context.Background(), tt.profileID, nil, tt.version)
+			if err != nil {
+				t.Fatalf("rollback: %v", err)
+			}
+
+			if found {
+				t.Error("found = true, want false")
+			}
+
+			if len(storage.versions) != len(newTestVersions()) {
+				t.Errorf("versions = %d, want no new versions", len(storage.versions))
+			}
+		})
+	}
+}
+
+func TestScout_DiffProfileSettingsVersions(t *testing.T) {
+	storage := &fakeVersionsStorage{versions: newTestVersions()}
+	scout := New(nil, storage, nil, nil, nil, nil, zerolog.Nop())
+
+	diff, found, err := scout.DiffProfileSettingsVersions(context.Background(), 1, nil, 1, 2)
+	if err != nil || !found {
+		t.Fatalf("diff: found = %t, err = %v", found, err)
+	}
+
+	want := models.ProfileSettingsDiff{
+		From:                   newTestVersions()[0],
+		To:                     newTestVersions()[1],
+		RelevancyFilterChanged: true,
+		ModelChanged:           true,
+		FewShotChanged:         false,
+		PropertyTypesChanged:   true,
+		AddedProperties:        map[string]string{"stars": "Stars"},
+		RemovedProperties:      map[string]string{"language": "Language"},
+		ChangedProperties: map[string]models.PropertyDefinitionChange{
+			"summary": {From: "Summary", To: "Short summary"},
+		},
+	}
+
+	if !reflect.DeepEqual(diff, want) {
+		t.Errorf("diff = %+v, want %+v", diff, want)
+	}
+}
+
+func TestScout_DiffProfileSettingsVersionsImplicitTypes(t *testing.T) {
+	versions := newTestVersions()
+	versions[1] = versions[0]
+	versions[1].Version = 2
+	// an explicit optional string is the implicit type of a property without a type
+	versions[1].PropertyTypes = map[string]models.PropertyType{
+		"summary": {Kind: models.PropertyKindString, Enum: nil, Required: false},
+	}
+
+	storage := &fakeVersionsStorage{versions: versions}
+	scout := New(nil, storage, nil, nil, nil, nil, zerolog.Nop())
+
+	diff, found, err := scout.DiffProfileSettingsVersions(context.Background(), 1, nil, 1, 2)
+	if err != nil || !found {
+		t.Fatalf("diff: found = %t, err = %v", found, err)
+	}
+
+	if diff.PropertyTypesChanged || diff.RelevancyFilterChanged || diff.ModelChanged || diff.FewShotChanged {
+		t.Errorf("diff = %+v, want no changes", diff)
+	}
+
+	if len(diff.AddedProperties) != 0 || len(diff.RemovedProperties) != 0 || len(diff.ChangedProperties) != 0 {
+		t.Errorf("diff = %+v, want no property changes", diff)
+	}
+}
+
+func TestScout_DiffProfileSettingsVersionsNotFound(t *testing.T) {
+	storage := &fakeVersionsStorage{versions: newTestVersions()}
+	scout := New(nil, storage, nil, nil, nil, nil, zerolog.Nop())
+
+	for _, versions := range [][2]int64{{1, 10}, {10, 2}} {
+		_, found, err := scout.DiffProfileSettingsVersions(context.Background(), 1, nil, versions[0], versions[1])
+		if err != nil {
+			t.Fatalf("diff %v: %v", versions, err)
+		}
+
+		if found {
+			t.Errorf("diff %v: found = true, want false", versions)
+		}
+	}
+}
@@ -0,0 +1,236 @@
+package scout
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/rishenco/scout/pkg/models"
+)
+
+type shadowStorage interface {
+	CreateShadow(ctx context.Context, shadow models.Shadow) (shadowID int64, err error)
+	GetShadow(ctx context.Context, shadowID int64) (shadow models.Shadow, found bool, err error)
+	ListShadows(ctx context.Context, profileID int64, limit int64) ([]models.Shadow, error)
+	GetActiveShadows(ctx context.Context, profileIDs []int64) ([]models.Shadow, error)
+	GetShadowSettings(
+		ctx context.Context,
+		shadowID int64,
+	) (settings models.ProfileSettings, found bool, err error)
+	StopShadow(ctx context.Context, profileID int64, shadowID int64) (found bool, err error)
+	SaveShadowResult(ctx context.Context, result models.ShadowResult) error
+	ListShadowDisagreements(ctx context.Context, shadowID int64, limit int64) ([]models.ShadowResult, error)
+	DeleteProfileShadows(ctx context.Context, profileID int64) error
+}
+
+// StartShadow starts evaluating candidate settings of a profile on posts scheduled for the profile.
+// The previous active shadow of the profile is stopped.
+//
+// source limits the shadow to posts of a source, nil means posts of all sources.
+func (s *Scout) StartShadow(
+	ctx context.Context,
+	profileID int64,
+	source *string,
+	settings models.ProfileSettings,
+) (shadow models.Shadow, found bool, err error) {
+	if err := models.ValidateTypedProperties(settings.ExtractedProperties, settings.PropertyTypes); err != nil {
+		return models.Shadow{}, false, fmt.Errorf("%w: %w", models.ErrInvalidShadow, err)
+	}
+
+	if err := models.ValidateFewShotSettings(settings.FewShot); err != nil {
+		return models.Shadow{}, false, fmt.Errorf("%w: %w", models.ErrInvalidShadow, err)
+	}
+
+	if source != nil {
+		if _, ok := s.toolkits[*source]; !ok {
+			return models.Shadow{}, false, fmt.Errorf("%w: unknown source %q", models.ErrInvalidShadow, *source)
+		}
+	}
+
+	_, found, err = s.storage.GetProfile(ctx, profileID)
+	if err != nil {
+		return models.Shadow{}, false, fmt.Errorf("get profile: %w", err)
+	}
+
+	if !found {
+		return models.Shadow{}, false, nil
+	}
+
+	now := time.Now()
+
+	settings.ProfileID = profileID
+	settings.CreatedAt = now
+	settings.UpdatedAt = now
+
+	shadowID, err := s.shadowStorage.CreateShadow(ctx, models.Shadow{
+		ProfileID: profileID,
+		Source:    source,
+		Settings:  settings,
+	})
+	if err != nil {
+		return models.Shadow{}, false, fmt.Errorf("create shadow: %w", err)
+	}
+
+	shadow, found, err = s.shadowStorage.GetShadow(ctx, shadowID)
+	if err != nil {
+		return models.Shadow{}, false, fmt.Errorf("get shadow: %w", err)
+	}
+
+	if !found {
+		return models.Shadow{}, false, fmt.Errorf("shadow %d not found after creation", shadowID)
+	}
+
+	s.logger.Info().
+		Int64("profile_id", profileID).
+		Int64("shadow_id", shadowID).
+		Msg("started shadow")
+
+	return shadow, true, nil
+}
+
+// ListShadows returns the latest shadows of a profile with their summaries.
+func (s *Scout) ListShadows(ctx context.Context, profileID int64, limit int64) ([]models.Shadow, error) {
+	return s.shadowStorage.ListShadows(ctx, profileID, limit)
+}
+
+// GetShadow returns a shadow of a profile with its summary.
+func (s *Scout) GetShadow(
+	ctx context.Context,
+	profileID int64,
+	shadowID int64,
+) (shadow models.Shadow, found bool, err error) {
+	shadow, found, err = s.shadowStorage.GetShadow(ctx, shadowID)
+	if err != nil {
+		return models.Shadow{}, false, fmt.Errorf("get shadow: %w", err)
+	}
+
+	if !found || shadow.ProfileID != profileID {
+		return models.Shadow{}, false, nil
+	}
+
+	return shadow, true, nil
+}
+
+// StopShadow stops analyzing new posts with settings of a shadow. Results of the shadow are kept.
+func (s *Scout) StopShadow(
+	ctx context.Context,
+	profileID int64,
+	shadowID int64,
+) (shadow models.Shadow, found bool, err error) {
+	found, err = s.shadowStorage.StopShadow(ctx, profileID, shadowID)
+	if err != nil {
+		return models.Shadow{}, false, fmt.Errorf("stop shadow: %w", err)
+	}
+
+	if !found {
+		return models.Shadow{}, false, nil
+	}
+
+	return s.GetShadow(ctx, profileID, shadowID)
+}
+
+// ListShadowDisagreements returns the latest posts for which a shadow disagrees with production detections.
+func (s *Scout) ListShadowDisagreements(
+	ctx context.Context,
+	profileID int64,
+	shadowID int64,
+	limit int64,
+) (results []models.ShadowResult, found bool, err error) {
+	_, found, err = s.GetShadow(ctx, profileID, shadowID)
+	if err != nil || !found {
+		return nil, found, err
+	}
+
+	results, err = s.shadowStorage.ListShadowDisagreements(ctx, shadowID, limit)
+	if err != nil {
+		return nil, false, fmt.Errorf("list shadow disagreements: %w", err)
+	}
+
+	return results, true, nil
+}
+
+// GetShadowSettings returns candidate settings the posts of a shadow are analyzed with.
+func (s *Scout) GetShadowSettings(
+	ctx context.Context,
+	shadowID int64,
+) (settings models.ProfileSettings, found bool, err error) {
+	return s.shadowStorage.GetShadowSettings(ctx, shadowID)
+}
+
+// RecordShadowResult saves a detection of a post analyzed with shadow settings
+// or an error if the post could not be analyzed.
+func (s *Scout) RecordShadowResult(
+	ctx context.Context,
+	shadowID int64,
+	source string,
+	sourceID string,
+	detection *models.Detection,
+	analysisErr error,
+) error {
+	result := models.ShadowResult{
+		ShadowID:  shadowID,
+		Source:    source,
+		SourceID:  sourceID,
+		Detection: detection,
+	}
+
+	if analysisErr != nil {
+		result.Error = lo.ToPtr(analysisErr.Error())
+	}
+
+	if err := s.shadowStorage.SaveShadowResult(ctx, result); err != nil {
+		return fmt.Errorf("save shadow result: %w", err)
+	}
+
+	return nil
+}
+
+// shadowTasks returns shadow tasks paired with scheduled tasks of profiles with active shadows.
+func (s *Scout) shadowTasks(ctx context.Context, tasks []models.AnalysisTask) ([]models.AnalysisTask, error) {
+	scheduledTasks := lo.Filter(tasks, func(task models.AnalysisTask, _ int) bool {
+		return task.Type == models.ScheduledTaskType
+	})
+
+	if len(scheduledTasks) == 0 {
+		return nil, nil
+	}
+
+	profileIDs := lo.Uniq(lo.Map(scheduledTasks, func(task models.AnalysisTask, _ int) int64 {
+		return task.Parameters.ProfileID
+	}))
+
+	shadows, err := s.shadowStorage.GetActiveShadows(ctx, profileIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get active shadows: %w", err)
+	}
+
+	profileShadows := lo.KeyBy(shadows, func(shadow models.Shadow) int64 {
+		return shadow.ProfileID
+	})
+
+	shadowTasks := make([]models.AnalysisTask, 0)
+
+	for _, task := range scheduledTasks {
+		shadow, ok := profileShadows[task.Parameters.ProfileID]
+		if !ok || !shadow.Covers(task.Parameters.Source) {
+			continue
+		}
+
+		shadowTasks = append(shadowTasks, models.AnalysisTask{
+			Type:     models.ShadowTaskType,
+			Priority: models.ShadowTaskPriority,
+			Parameters: models.AnalysisParameters{
+				SourceID:   task.Parameters.SourceID,
+				ProfileID:  task.Parameters.ProfileID,
+				Source:     task.Parameters.Source,
+				ShouldSave: false,
+				ShadowID:   &shadow.ID,
+			},
+			TraceContext: task.TraceContext,
+		})
+	}
+
+	return shadowTasks, nil
+}
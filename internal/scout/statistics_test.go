@@ -0,0 +1,203 @@
+package scout
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/rs/zerolog"
+
+	"github.com/rishenco/scout/pkg/models"
+)
+
+// fakeStatisticsStorage serves statistics of a single profile.
+// Methods not used by the tests are left to the embedded nil interfaces.
+type fakeStatisticsStorage struct {
+	storage
+	taskStorage
+
+	profileID          int64
+	versionsStatistics []models.SettingsVersionStatistics
+	taskStatistics     models.TaskStatistics
+	accuracy           []models.SettingsVersionAccuracy
+	accuracyFilter     *models.AccuracyFilter
+}
+
+func (s *fakeStatisticsStorage) GetProfile(_ context.Context, id int64) (models.Profile, bool, error) {
+	if id != s.profileID {
+		return models.Profile{}, false, nil
+	}
+
+	return models.Profile{ID: id}, true, nil
+}
+
+func (s *fakeStatisticsStorage) GetDetectionStatistics(
+	context.Context,
+	int64,
+) ([]models.SettingsVersionStatistics, error) {
+	return s.versionsStatistics, nil
+}
+
+func (s *fakeStatisticsStorage) GetTaskStatistics(context.Context, int64) (models.TaskStatistics, error) {
+	return s.taskStatistics, nil
+}
+
+func (s *fakeStatisticsStorage) GetSettingsVersionsAccuracy(
+	_ context.Context,
+	filter models.AccuracyFilter,
+) ([]models.SettingsVersionAccuracy, error) {
+	s.accuracyFilter = &filter
+
+	return s.accuracy, nil
+}
+
+func newStatisticsScout(storage *fakeStatisticsStorage) *Scout {
+	return New(nil, storage, storage, nil, nil, nil, zerolog.Nop())
+}
+
+func TestScout_GetProfileStatistics(t *testing.T) {
+	versionStatistics := func(
+		source string,
+		version int64,
+		relevant int64,
+		irrelevant int64,
+		tagged int64,
+	) models.SettingsVersionStatistics {
+		return models.SettingsVersionStatistics{
+			Source:          source,
+			SettingsVersion: version,
+			Detections: models.DetectionStatistics{
+				Total:         relevant + irrelevant,
+				Relevant:      relevant,
+				Irrelevant:    irrelevant,
+				TaggedCorrect: tagged,
+				TaggedWrong:   1,
+			},
+		}
+	}
+
+	storage := &fakeStatisticsStorage{
+		profileID: 1,
+		versionsStatistics: []models.SettingsVersionStatistics{
+			versionStatistics("hackernews", 1, 2, 3, 1),
+			versionStatistics("reddit", 1, 1, 1, 0),
+			versionStatistics("reddit", 2, 4, 6, 2),
+		},
+		taskStatistics: models.TaskStatistics{Pending: 3, Claimed: 1, Failed: 2, Committed: 10},
+	}
+
+	statistics, found, err := newStatisticsScout(storage).GetProfileStatistics(context.Background(), 1)
+	if err != nil || !found {
+		t.Fatalf("get profile statistics: found = %t, err = %v", found, err)
+	}
+
+	want := models.ProfileStatistics{
+		ProfileID: 1,
+		Detections: models.DetectionStatistics{
+			Total:         17,
+			Relevant:      7,
+			Irrelevant:    10,
+			TaggedCorrect: 3,
+			TaggedWrong:   3,
+		},
+		Sources: []models.SourceStatistics{
+			{
+				Source: "hackernews",
+				Detections: models.DetectionStatistics{
+					Total:         5,
+					Relevant:      2,
+					Irrelevant:    3,
+					TaggedCorrect: 1,
+					TaggedWrong:   1,
+				},
+				SettingsVersions: storage.versionsStatistics[:1],
+			},
+			{
+				Source: "reddit",
+				Detections: models.DetectionStatistics{
+					Total:         12,
+					Relevant:      5,
+					Irrelevant:    7,
+					TaggedCorrect: 2,
+					TaggedWrong:   2,
+				},
+				SettingsVersions: storage.versionsStatistics[1:],
+			},
+		},
+		Tasks: storage.taskStatistics,
+	}
+
+	if !reflect.DeepEqual(statistics, want) {
+		t.Errorf("statistics = %+v, want %+v", statistics, want)
+	}
+}
+
+func TestScout_GetProfileStatisticsEmpty(t *testing.T) {
+	storage := &fakeStatisticsStorage{profileID: 1}
+
+	statistics, found, err := newStatisticsScout(storage).GetProfileStatistics(context.Background(), 1)
+	if err != nil || !found {
+		t.Fatalf("get profile statistics: found = %t, err = %v", found, err)
+	}
+
+	// a profile without detections has empty, not null, sources
+	if statistics.Sources == nil || len(statistics.Sources) != 0 || statistics.Detections.Total != 0 {
+		t.Errorf("statistics = %+v, want empty statistics", statistics)
+	}
+}
+
+func TestScout_GetProfileStatisticsNotFound(t *testing.T) {
+	storage := &fakeStatisticsStorage{profileID: 1}
+
+	_, found, err := newStatisticsScout(storage).GetProfileStatistics(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("get profile statistics: %v", err)
+	}
+
+	if found {
+		t.Error("found = true, want false")
+	}
+}
+
+func TestScout_GetSettingsVersionsAccuracy(t *testing.T) {
+	accuracy := []models.SettingsVersionAccuracy{
+		{
+			SettingsVersion: 2,
+			Detections:      10,
+			Tagged:          4,
+			ConfusionMatrix: models.ConfusionMatrix{TruePositives: 1, FalsePositives: 1, TrueNegatives: 1, FalseNegatives: 1},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		profileID  int64
+		wantFound  bool
+		wantResult []models.SettingsVersionAccuracy
+	}{
+		{name: "found", profileID: 1, wantFound: true, wantResult: accuracy},
+		{name: "unknown profile", profileID: 2, wantFound: false, wantResult: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := &fakeStatisticsStorage{profileID: 1, accuracy: accuracy}
+			filter := models.AccuracyFilter{ProfileID: tt.profileID, Source: "reddit", Since: nil, Until: nil}
+
+			result, found, err := newStatisticsScout(storage).GetSettingsVersionsAccuracy(context.Background(), filter)
+			if err != nil {
+				t.Fatalf("get settings versions accuracy: %v", err)
+			}
+
+			if found != tt.wantFound || !reflect.DeepEqual(result, tt.wantResult) {
+				t.Errorf("accuracy = %+v, found = %t, want %+v, found = %t", result, found, tt.wantResult, tt.wantFound)
+			}
+
+			// accuracy of unknown profiles is not queried
+			if tt.wantFound != (storage.accuracyFilter != nil) ||
+				(storage.accuracyFilter != nil && *storage.accuracyFilter != filter) {
+				t.Errorf("accuracy filter = %+v, want %+v", storage.accuracyFilter, filter)
+			}
+		})
+	}
+}
@@ -4,19 +4,30 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/rand/v2"
 	"sync"
 	"time"
 
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
+	"github.com/rishenco/scout/internal/metrics"
+	"github.com/rishenco/scout/internal/tracing"
 	"github.com/rishenco/scout/pkg/models"
 )
 
+// heartbeat reports iterations of a loop to the health registry.
+type heartbeat interface {
+	Beat()
+	Fail(err error)
+}
+
 type taskQueue interface {
 	Claim(ctx context.Context, taskTypes []string, profileIDs []int64) (task models.AnalysisTask, anyTask bool, err error)
 	Unclaim(ctx context.Context, taskID int64) error
-	AddError(ctx context.Context, taskID int64, err string) error
-	Fail(ctx context.Context, taskID int64) error
+	AddError(ctx context.Context, taskID int64, err string, retryAfter time.Duration) error
+	Fail(ctx context.Context, taskID int64, reason string) error
 	Commit(ctx context.Context, taskID int64) error
 }
 
@@ -30,6 +41,24 @@ type scout interface {
 		profileSettings models.ProfileSettings,
 		shouldSave bool,
 	) (detection models.Detection, err error)
+	GetTestRunSettings(ctx context.Context, runID int64) (settings models.TestRunSettings, found bool, err error)
+	RecordTestResult(
+		ctx context.Context,
+		runID int64,
+		source string,
+		sourceID string,
+		detection *models.Detection,
+		analysisErr error,
+	) error
+	GetShadowSettings(ctx context.Context, shadowID int64) (settings models.ProfileSettings, found bool, err error)
+	RecordShadowResult(
+		ctx context.Context,
+		shadowID int64,
+		source string,
+		sourceID string,
+		detection *models.Detection,
+		analysisErr error,
+	) error
 }
 
 type TaskProcessor struct {
@@ -37,11 +66,16 @@ type TaskProcessor struct {
 	scout             scout
 	profilesCache     *profilesCache
 	profilesCacheLock sync.Mutex
+	wakeup            chan struct{}
+	wakeupLock        sync.Mutex
 	timeout           time.Duration
 	errorTimeout      time.Duration
 	noTasksTimeout    time.Duration
+	minRetryBackoff   time.Duration
+	maxRetryBackoff   time.Duration
 	maxAttempts       int
 	workers           int
+	heartbeat         heartbeat
 	logger            zerolog.Logger
 }
 
@@ -51,19 +85,29 @@ func NewTaskProcessor(
 	timeout time.Duration,
 	errorTimeout time.Duration,
 	noTasksTimeout time.Duration,
+	minRetryBackoff time.Duration,
+	maxRetryBackoff time.Duration,
 	maxAttempts int,
 	workers int,
+	heartbeat heartbeat,
 	logger zerolog.Logger,
 ) *TaskProcessor {
+	// An unset max backoff would cap retries to no delay at all, so the backoff stays at the min instead
+	maxRetryBackoff = max(maxRetryBackoff, minRetryBackoff)
+
 	return &TaskProcessor{
-		taskQueue:      taskQueue,
-		scout:          scout,
-		timeout:        timeout,
-		errorTimeout:   errorTimeout,
-		noTasksTimeout: noTasksTimeout,
-		maxAttempts:    maxAttempts,
-		workers:        workers,
-		logger:         logger,
+		taskQueue:       taskQueue,
+		scout:           scout,
+		wakeup:          make(chan struct{}),
+		timeout:         timeout,
+		errorTimeout:    errorTimeout,
+		noTasksTimeout:  noTasksTimeout,
+		minRetryBackoff: minRetryBackoff,
+		maxRetryBackoff: maxRetryBackoff,
+		maxAttempts:     maxAttempts,
+		workers:         workers,
+		heartbeat:       heartbeat,
+		logger:          logger,
 	}
 }
 
@@ -93,6 +137,24 @@ func (p *TaskProcessor) Start(ctx context.Context) {
 	wg.Wait()
 }
 
+// Wake wakes up workers waiting for new tasks.
+// Without wakeups workers poll the queue after the no tasks timeout.
+func (p *TaskProcessor) Wake() {
+	p.wakeupLock.Lock()
+	defer p.wakeupLock.Unlock()
+
+	close(p.wakeup)
+	p.wakeup = make(chan struct{})
+}
+
+// wakeupSignal returns a channel that is closed on the next wakeup.
+func (p *TaskProcessor) wakeupSignal() <-chan struct{} {
+	p.wakeupLock.Lock()
+	defer p.wakeupLock.Unlock()
+
+	return p.wakeup
+}
+
 func (p *TaskProcessor) taskLoop(
 	ctx context.Context,
 	label string,
@@ -100,31 +162,44 @@ func (p *TaskProcessor) taskLoop(
 ) {
 	timeout := p.timeout
 
+	var wakeup <-chan struct{} // nil channel never fires, workers are woken up only when idle
+
 	for {
 		select {
 		case <-ctx.Done():
 			return
 		case <-time.After(timeout):
-			timeout = p.timeout
+		case <-wakeup:
+		}
+
+		timeout = p.timeout
+		wakeup = nil
+
+		// Subscribe before claiming, so tasks added during an unsuccessful claim are not missed
+		signal := p.wakeupSignal()
+
+		anyTask, err := processor(ctx)
+
+		if err != nil {
+			p.logger.Error().
+				Err(err).
+				Str("label", label).
+				Msg("process task")
 
-			anyTask, err := processor(ctx)
+			p.heartbeat.Fail(err)
 
-			if err != nil {
-				p.logger.Error().
-					Err(err).
-					Str("label", label).
-					Msg("process task")
+			timeout = p.errorTimeout
 
-				timeout = p.errorTimeout
+			continue
+		}
 
-				continue
-			}
+		p.heartbeat.Beat()
 
-			if !anyTask {
-				timeout = p.noTasksTimeout
+		if !anyTask {
+			timeout = p.noTasksTimeout
+			wakeup = signal
 
-				continue
-			}
+			continue
 		}
 	}
 }
@@ -135,7 +210,17 @@ func (p *TaskProcessor) processActiveProfilesTask(ctx context.Context) (anyTask
 		return false, fmt.Errorf("get active profiles: %w", err)
 	}
 
-	return p.processTask(ctx, []string{models.ScheduledTaskType, models.ManualTaskType}, activeProfiles)
+	return p.processTask(
+		ctx,
+		[]string{
+			models.ScheduledTaskType,
+			models.ManualTaskType,
+			models.JumpstartTaskType,
+			models.TestRunTaskType,
+			models.ShadowTaskType,
+		},
+		activeProfiles,
+	)
 }
 
 func (p *TaskProcessor) processInactiveProfilesTask(ctx context.Context) (anyTask bool, err error) {
@@ -144,7 +229,11 @@ func (p *TaskProcessor) processInactiveProfilesTask(ctx context.Context) (anyTas
 		return false, fmt.Errorf("get inactive profiles: %w", err)
 	}
 
-	return p.processTask(ctx, []string{models.ManualTaskType}, inactiveProfiles)
+	return p.processTask(
+		ctx,
+		[]string{models.ManualTaskType, models.JumpstartTaskType, models.TestRunTaskType},
+		inactiveProfiles,
+	)
 }
 
 func (p *TaskProcessor) processTask(ctx context.Context, taskTypes []string, profileIDs []int64) (anyTask bool, err error) {
@@ -157,6 +246,25 @@ func (p *TaskProcessor) processTask(ctx context.Context, taskTypes []string, pro
 		return false, nil
 	}
 
+	spanOptions := []trace.SpanStartOption{
+		trace.WithAttributes(
+			attribute.Int64("scout.task_id", task.ID),
+			attribute.String("scout.task_type", task.Type),
+			attribute.String("scout.source", task.Parameters.Source),
+			attribute.String("scout.source_id", task.Parameters.SourceID),
+			attribute.Int64("scout.profile_id", task.Parameters.ProfileID),
+			attribute.Int("scout.task_attempt", len(task.Errors)+1),
+		),
+	}
+
+	// The task is processed in a separate trace linked to the span that scheduled it
+	if link, ok := tracing.Link(task.TraceContext); ok {
+		spanOptions = append(spanOptions, trace.WithLinks(link))
+	}
+
+	ctx, span := tracing.Start(ctx, "TaskProcessor.processTask", spanOptions...)
+	defer func() { tracing.End(span, err) }()
+
 	p.logger.Info().
 		Int64("task_id", task.ID).
 		Msg("claimed task")
@@ -166,22 +274,24 @@ func (p *TaskProcessor) processTask(ctx context.Context, taskTypes []string, pro
 			Int64("task_id", task.ID).
 			Msg("task failed max attempts")
 
-		if err := p.taskQueue.Fail(ctx, task.ID); err != nil {
+		if err := p.taskQueue.Fail(ctx, task.ID, models.MaxAttemptsReason); err != nil {
 			return false, fmt.Errorf("fail task: %w", err)
 		}
 
+		p.recordTaskFailure(ctx, task, fmt.Errorf("task failed max attempts: attempts = %d", len(task.Errors)))
+
 		return false, nil
 	}
 
+	startedAt := time.Now()
+
 	defer func() {
-		if err != nil {
-			if addErrorErr := p.taskQueue.AddError(ctx, task.ID, err.Error()); addErrorErr != nil {
-				p.logger.Error().
-					Err(addErrorErr).
-					Msg("failed to add error to task")
+		metrics.TaskProcessingDuration.
+			WithLabelValues(task.Parameters.Source, task.Type, metrics.Result(err)).
+			Observe(time.Since(startedAt).Seconds())
 
-				err = errors.Join(err, fmt.Errorf("add error to task: %w", addErrorErr))
-			}
+		if err != nil {
+			err = p.handleTaskError(ctx, task, err)
 		}
 
 		if unclaimErr := p.taskQueue.Unclaim(ctx, task.ID); unclaimErr != nil {
@@ -203,35 +313,29 @@ func (p *TaskProcessor) processTask(ctx context.Context, taskTypes []string, pro
 			Int64("profile_id", task.Parameters.ProfileID).
 			Msg("profile not found")
 
-		return false, fmt.Errorf("profile not found: profile id = %d", task.Parameters.ProfileID)
+		return false, models.NewPermanentAnalysisError(
+			models.ProfileNotFoundReason,
+			fmt.Errorf("profile not found: profile id = %d", task.Parameters.ProfileID),
+		)
 	}
 
-	if !profile.Active && task.Type != models.ManualTaskType {
+	if !profile.Active && (task.Type == models.ScheduledTaskType || task.Type == models.ShadowTaskType) {
 		p.logger.Warn().
 			Int64("profile_id", task.Parameters.ProfileID).
-			Msg("profile is not active but scheduled task was claimed")
+			Str("task_type", task.Type).
+			Msg("profile is not active but task of active profiles was claimed")
 
 		p.invalidateProfilesCache() // invalidate caches to avoid claiming more tasks for inactive profiles
 
 		return false, nil
 	}
 
-	profileSettings, found := profile.SourcesSettings[task.Parameters.Source]
-	if !found {
-		if profile.DefaultSettings == nil {
-			err := fmt.Errorf(
-				"profile settings not found: source = %s, profile id = %d",
-				task.Parameters.Source,
-				task.Parameters.ProfileID,
-			)
-
-			return false, err
-		}
-
-		profileSettings = *profile.DefaultSettings
+	profileSettings, err := p.taskSettings(ctx, task, profile)
+	if err != nil {
+		return false, err
 	}
 
-	_, err = p.scout.Analyze(
+	detection, err := p.scout.Analyze(
 		ctx,
 		task.Parameters.Source,
 		task.Parameters.SourceID,
@@ -242,6 +346,34 @@ func (p *TaskProcessor) processTask(ctx context.Context, taskTypes []string, pro
 		return false, fmt.Errorf("analyze post: %w", err)
 	}
 
+	if task.Parameters.TestRunID != nil {
+		err := p.scout.RecordTestResult(
+			ctx,
+			*task.Parameters.TestRunID,
+			task.Parameters.Source,
+			task.Parameters.SourceID,
+			&detection,
+			nil,
+		)
+		if err != nil {
+			return false, fmt.Errorf("record test result: %w", err)
+		}
+	}
+
+	if task.Parameters.ShadowID != nil {
+		err := p.scout.RecordShadowResult(
+			ctx,
+			*task.Parameters.ShadowID,
+			task.Parameters.Source,
+			task.Parameters.SourceID,
+			&detection,
+			nil,
+		)
+		if err != nil {
+			return false, fmt.Errorf("record shadow result: %w", err)
+		}
+	}
+
 	if err := p.taskQueue.Commit(ctx, task.ID); err != nil {
 		return false, fmt.Errorf("commit analysis task: %w", err)
 	}
@@ -253,6 +385,167 @@ func (p *TaskProcessor) processTask(ctx context.Context, taskTypes []string, pro
 	return anyTask, nil
 }
 
+// handleTaskError records an error of the task. Tasks with permanent errors fail immediately,
+// other tasks are retried with exponential backoff.
+func (p *TaskProcessor) handleTaskError(ctx context.Context, task models.AnalysisTask, taskErr error) error {
+	var analysisErr *models.AnalysisError
+	if !errors.As(taskErr, &analysisErr) {
+		analysisErr = &models.AnalysisError{Kind: models.RetryableAnalysisError, Err: taskErr}
+	}
+
+	retryAfter := p.retryBackoff(len(task.Errors) + 1)
+	if analysisErr.Kind == models.RateLimitedAnalysisError {
+		retryAfter = max(retryAfter, analysisErr.RetryAfter)
+	}
+
+	logger := p.logger.With().
+		Int64("task_id", task.ID).
+		Str("error_kind", string(analysisErr.Kind)).
+		Logger()
+
+	if err := p.taskQueue.AddError(ctx, task.ID, taskErr.Error(), retryAfter); err != nil {
+		logger.Error().
+			Err(err).
+			Msg("failed to add error to task")
+
+		return errors.Join(taskErr, fmt.Errorf("add error to task: %w", err))
+	}
+
+	if analysisErr.Kind != models.PermanentAnalysisError {
+		logger.Warn().
+			Dur("retry_after", retryAfter).
+			Msg("task will be retried")
+
+		return taskErr
+	}
+
+	logger.Error().
+		Str("reason", analysisErr.Reason).
+		Msg("task failed with permanent error")
+
+	if err := p.taskQueue.Fail(ctx, task.ID, analysisErr.Reason); err != nil {
+		logger.Error().
+			Err(err).
+			Msg("failed to fail task")
+
+		return errors.Join(taskErr, fmt.Errorf("fail task: %w", err))
+	}
+
+	p.recordTaskFailure(ctx, task, taskErr)
+
+	return taskErr
+}
+
+// taskSettings returns settings a post of the task is analyzed with: settings of the test run
+// for test_run tasks, candidate settings of the shadow for shadow tasks and profile settings for other tasks.
+func (p *TaskProcessor) taskSettings(
+	ctx context.Context,
+	task models.AnalysisTask,
+	profile models.Profile,
+) (models.ProfileSettings, error) {
+	if task.Parameters.ShadowID != nil {
+		shadowSettings, found, err := p.scout.GetShadowSettings(ctx, *task.Parameters.ShadowID)
+		if err != nil {
+			return models.ProfileSettings{}, fmt.Errorf("get shadow settings: %w", err)
+		}
+
+		if !found {
+			return models.ProfileSettings{}, models.NewPermanentAnalysisError(
+				models.ShadowNotFoundReason,
+				fmt.Errorf("shadow not found: shadow id = %d", *task.Parameters.ShadowID),
+			)
+		}
+
+		return shadowSettings, nil
+	}
+
+	settings := models.TestRunSettings{
+		Draft:           false,
+		DefaultSettings: profile.DefaultSettings,
+		SourcesSettings: profile.SourcesSettings,
+	}
+
+	if task.Parameters.TestRunID != nil {
+		testRunSettings, found, err := p.scout.GetTestRunSettings(ctx, *task.Parameters.TestRunID)
+		if err != nil {
+			return models.ProfileSettings{}, fmt.Errorf("get test run settings: %w", err)
+		}
+
+		if !found {
+			return models.ProfileSettings{}, models.NewPermanentAnalysisError(
+				models.TestRunNotFoundReason,
+				fmt.Errorf("test run not found: test run id = %d", *task.Parameters.TestRunID),
+			)
+		}
+
+		settings = testRunSettings
+	}
+
+	profileSettings, found := settings.ForSource(task.Parameters.Source)
+	if !found {
+		err := fmt.Errorf(
+			"profile settings not found: source = %s, profile id = %d",
+			task.Parameters.Source,
+			task.Parameters.ProfileID,
+		)
+
+		return models.ProfileSettings{}, models.NewPermanentAnalysisError(models.ProfileSettingsNotFoundReason, err)
+	}
+
+	return profileSettings, nil
+}
+
+// recordTaskFailure records an error of a failed test_run or shadow task to its test run or shadow.
+// Errors are only logged: the task is already failed.
+func (p *TaskProcessor) recordTaskFailure(ctx context.Context, task models.AnalysisTask, taskErr error) {
+	var err error
+
+	switch {
+	case task.Parameters.TestRunID != nil:
+		err = p.scout.RecordTestResult(
+			ctx,
+			*task.Parameters.TestRunID,
+			task.Parameters.Source,
+			task.Parameters.SourceID,
+			nil,
+			taskErr,
+		)
+	case task.Parameters.ShadowID != nil:
+		err = p.scout.RecordShadowResult(
+			ctx,
+			*task.Parameters.ShadowID,
+			task.Parameters.Source,
+			task.Parameters.SourceID,
+			nil,
+			taskErr,
+		)
+	default:
+		return
+	}
+
+	if err != nil {
+		p.logger.Error().
+			Err(err).
+			Int64("task_id", task.ID).
+			Msg("failed to record result of failed task")
+	}
+}
+
+// retryBackoff returns a delay before the next attempt of a task with a given number of errors.
+// The delay doubles with each error starting from the min backoff and is capped by the max backoff.
+// It is randomized within [delay/2, delay], so tasks that failed together are not retried together.
+func (p *TaskProcessor) retryBackoff(errorsCount int) time.Duration {
+	backoff := p.minRetryBackoff
+
+	for i := 1; i < errorsCount && backoff < p.maxRetryBackoff; i++ {
+		backoff *= 2
+	}
+
+	backoff = min(backoff, p.maxRetryBackoff)
+
+	return backoff/2 + rand.N(backoff/2+1) //nolint:gosec // jitter does not need a secure random
+}
+
 func (p *TaskProcessor) invalidateProfilesCache() error {
 	p.profilesCacheLock.Lock()
 	defer p.profilesCacheLock.Unlock()
@@ -317,7 +610,7 @@ func (p *TaskProcessor) ensureProfilesCacheUnsafe(ctx context.Context) error {
 }
 
 type profilesCache struct {
-	activeProfiles   []int64 // profiles for scheduled tasks
-	inactiveProfiles []int64 // profiles for manual tasks
+	activeProfiles   []int64 // profiles for all tasks
+	inactiveProfiles []int64 // profiles for manual and jumpstart tasks
 	validUntil       time.Time
 }
@@ -0,0 +1,55 @@
+package scout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+func TestTaskProcessor_RetryBackoff(t *testing.T) {
+	tests := []struct {
+		name        string
+		minBackoff  time.Duration
+		maxBackoff  time.Duration
+		errorsCount int
+		want        time.Duration
+	}{
+		{name: "first error", minBackoff: time.Minute, maxBackoff: time.Hour, errorsCount: 1, want: time.Minute},
+		{name: "doubles", minBackoff: time.Minute, maxBackoff: time.Hour, errorsCount: 2, want: 2 * time.Minute},
+		{name: "doubles again", minBackoff: time.Minute, maxBackoff: time.Hour, errorsCount: 4, want: 8 * time.Minute},
+		{name: "capped", minBackoff: time.Minute, maxBackoff: time.Hour, errorsCount: 7, want: time.Hour},
+		{name: "capped far", minBackoff: time.Minute, maxBackoff: time.Hour, errorsCount: 100, want: time.Hour},
+		{name: "unset max", minBackoff: time.Minute, maxBackoff: 0, errorsCount: 3, want: time.Minute},
+		{name: "max below min", minBackoff: time.Minute, maxBackoff: time.Second, errorsCount: 3, want: time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			processor := NewTaskProcessor(nil, nil, 0, 0, 0, tt.minBackoff, tt.maxBackoff, 0, 0, nil, zerolog.Nop())
+
+			// the delay is randomized within [backoff/2, backoff]
+			for range 100 {
+				got := processor.retryBackoff(tt.errorsCount)
+
+				if got < tt.want/2 || got > tt.want {
+					t.Fatalf("retryBackoff(%d) = %s, want within [%s, %s]", tt.errorsCount, got, tt.want/2, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestTaskProcessor_RetryBackoffJitter(t *testing.T) {
+	processor := NewTaskProcessor(nil, nil, 0, 0, 0, time.Minute, time.Hour, 0, 0, nil, zerolog.Nop())
+
+	delays := make(map[time.Duration]struct{})
+
+	for range 100 {
+		delays[processor.retryBackoff(1)] = struct{}{}
+	}
+
+	if len(delays) < 2 {
+		t.Error("retryBackoff returned the same delay every time, want randomized delays")
+	}
+}
@@ -0,0 +1,295 @@
+package scout
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+
+	"github.com/rishenco/scout/internal/tracing"
+	"github.com/rishenco/scout/pkg/models"
+)
+
+type testSuiteStorage interface {
+	ListTestCases(ctx context.Context, profileID int64) ([]models.TestCase, error)
+	UpsertTestCase(ctx context.Context, testCase models.TestCase) (models.TestCase, error)
+	DeleteTestCase(ctx context.Context, profileID int64, testCaseID int64) (found bool, err error)
+	DeleteProfileTests(ctx context.Context, profileID int64) error
+	CreateTestRun(
+		ctx context.Context,
+		profileID int64,
+		settings models.TestRunSettings,
+	) (runID int64, results []models.TestResult, err error)
+	GetTestRun(ctx context.Context, runID int64) (run models.TestRun, found bool, err error)
+	ListTestRuns(ctx context.Context, profileID int64, limit int64) ([]models.TestRun, error)
+	ListTestResults(ctx context.Context, runID int64) ([]models.TestResult, error)
+	GetTestResult(
+		ctx context.Context,
+		runID int64,
+		source string,
+		sourceID string,
+	) (result models.TestResult, found bool, err error)
+	CompleteTestResult(ctx context.Context, result models.TestResult) error
+}
+
+// ListTestCases returns the test suite of a profile.
+func (s *Scout) ListTestCases(ctx context.Context, profileID int64) ([]models.TestCase, error) {
+	return s.testSuiteStorage.ListTestCases(ctx, profileID)
+}
+
+// UpsertTestCase adds a post to the test suite of its profile or replaces expected values of the post.
+func (s *Scout) UpsertTestCase(
+	ctx context.Context,
+	testCase models.TestCase,
+) (upserted models.TestCase, found bool, err error) {
+	if err := testCase.Validate(); err != nil {
+		return models.TestCase{}, false, err //nolint:wrapcheck // error is already descriptive
+	}
+
+	if _, ok := s.toolkits[testCase.Source]; !ok {
+		return models.TestCase{}, false, fmt.Errorf("%w: unknown source %q", models.ErrInvalidTestCase, testCase.Source)
+	}
+
+	_, found, err = s.storage.GetProfile(ctx, testCase.ProfileID)
+	if err != nil {
+		return models.TestCase{}, false, fmt.Errorf("get profile: %w", err)
+	}
+
+	if !found {
+		return models.TestCase{}, false, nil
+	}
+
+	upserted, err = s.testSuiteStorage.UpsertTestCase(ctx, testCase)
+	if err != nil {
+		return models.TestCase{}, false, fmt.Errorf("upsert test case: %w", err)
+	}
+
+	return upserted, true, nil
+}
+
+// DeleteTestCase removes a post from the test suite of a profile. Results of past runs are kept.
+func (s *Scout) DeleteTestCase(ctx context.Context, profileID int64, testCaseID int64) (found bool, err error) {
+	return s.testSuiteStorage.DeleteTestCase(ctx, profileID, testCaseID)
+}
+
+// StartTestRun runs the test suite of a profile through the task queue.
+//
+// draftSettings are used for posts of all sources if set, otherwise the saved profile settings are used.
+// Detections are not saved, results are recorded to the run as tasks are processed.
+func (s *Scout) StartTestRun(
+	ctx context.Context,
+	profileID int64,
+	draftSettings *models.ProfileSettings,
+) (run models.TestRun, found bool, err error) {
+	profile, found, err := s.storage.GetProfile(ctx, profileID)
+	if err != nil {
+		return models.TestRun{}, false, fmt.Errorf("get profile: %w", err)
+	}
+
+	if !found {
+		return models.TestRun{}, false, nil
+	}
+
+	settings := models.TestRunSettings{
+		Draft:           false,
+		DefaultSettings: profile.DefaultSettings,
+		SourcesSettings: profile.SourcesSettings,
+	}
+
+	if draftSettings != nil {
+		err := models.ValidateTypedProperties(draftSettings.ExtractedProperties, draftSettings.PropertyTypes)
+		if err != nil {
+			return models.TestRun{}, false, fmt.Errorf("draft settings: %w", err)
+		}
+
+		if err := models.ValidateFewShotSettings(draftSettings.FewShot); err != nil {
+			return models.TestRun{}, false, fmt.Errorf("draft settings: %w", err)
+		}
+
+		draft := *draftSettings
+		draft.ProfileID = profileID
+
+		settings = models.TestRunSettings{
+			Draft:           true,
+			DefaultSettings: &draft,
+			SourcesSettings: nil,
+		}
+	}
+
+	testCases, err := s.testSuiteStorage.ListTestCases(ctx, profileID)
+	if err != nil {
+		return models.TestRun{}, false, fmt.Errorf("list test cases: %w", err)
+	}
+
+	if len(testCases) == 0 {
+		return models.TestRun{}, false, fmt.Errorf("%w: profile has no test cases", models.ErrInvalidTestRun)
+	}
+
+	runID, results, err := s.testSuiteStorage.CreateTestRun(ctx, profileID, settings)
+	if err != nil {
+		return models.TestRun{}, false, fmt.Errorf("create test run: %w", err)
+	}
+
+	traceContext := tracing.Inject(ctx)
+
+	tasks := lo.Map(results, func(result models.TestResult, _ int) models.AnalysisTask {
+		return models.AnalysisTask{
+			Type:     models.TestRunTaskType,
+			Priority: models.TestRunTaskPriority,
+			Parameters: models.AnalysisParameters{
+				SourceID:   result.SourceID,
+				ProfileID:  profileID,
+				Source:     result.Source,
+				ShouldSave: false,
+				TestRunID:  &runID,
+			},
+			TraceContext: traceContext,
+		}
+	})
+
+	if err := s.taskStorage.Add(ctx, tasks); err != nil {
+		return models.TestRun{}, false, fmt.Errorf("add analysis tasks: %w", err)
+	}
+
+	run, found, err = s.testSuiteStorage.GetTestRun(ctx, runID)
+	if err != nil {
+		return models.TestRun{}, false, fmt.Errorf("get test run: %w", err)
+	}
+
+	if !found {
+		return models.TestRun{}, false, fmt.Errorf("test run %d not found after creation", runID)
+	}
+
+	s.logger.Info().
+		Int64("profile_id", profileID).
+		Int64("test_run_id", runID).
+		Bool("draft", settings.Draft).
+		Int("tasks_count", len(tasks)).
+		Msg("started test run")
+
+	return run, true, nil
+}
+
+// ListTestRuns returns the latest test runs of a profile with their summaries.
+func (s *Scout) ListTestRuns(ctx context.Context, profileID int64, limit int64) ([]models.TestRun, error) {
+	return s.testSuiteStorage.ListTestRuns(ctx, profileID, limit)
+}
+
+// GetTestRun returns a test run of a profile with results of its test cases.
+func (s *Scout) GetTestRun(
+	ctx context.Context,
+	profileID int64,
+	runID int64,
+) (run models.TestRun, results []models.TestResult, found bool, err error) {
+	run, found, err = s.getProfileTestRun(ctx, profileID, runID)
+	if err != nil || !found {
+		return models.TestRun{}, nil, found, err
+	}
+
+	results, err = s.testSuiteStorage.ListTestResults(ctx, runID)
+	if err != nil {
+		return models.TestRun{}, nil, false, fmt.Errorf("list test results: %w", err)
+	}
+
+	return run, results, true, nil
+}
+
+// DiffTestRuns compares results of two test runs of a profile.
+func (s *Scout) DiffTestRuns(
+	ctx context.Context,
+	profileID int64,
+	baseRunID int64,
+	targetRunID int64,
+) (diff models.TestRunDiff, found bool, err error) {
+	base, baseResults, found, err := s.GetTestRun(ctx, profileID, baseRunID)
+	if err != nil {
+		return models.TestRunDiff{}, false, fmt.Errorf("get base run: %w", err)
+	}
+
+	if !found {
+		return models.TestRunDiff{}, false, nil
+	}
+
+	target, targetResults, found, err := s.GetTestRun(ctx, profileID, targetRunID)
+	if err != nil {
+		return models.TestRunDiff{}, false, fmt.Errorf("get target run: %w", err)
+	}
+
+	if !found {
+		return models.TestRunDiff{}, false, nil
+	}
+
+	return models.TestRunDiff{
+		Base:    base,
+		Target:  target,
+		Changes: models.DiffTestResults(baseResults, targetResults),
+	}, true, nil
+}
+
+// GetTestRunSettings returns settings the posts of a test run are analyzed with.
+func (s *Scout) GetTestRunSettings(
+	ctx context.Context,
+	runID int64,
+) (settings models.TestRunSettings, found bool, err error) {
+	run, found, err := s.testSuiteStorage.GetTestRun(ctx, runID)
+	if err != nil {
+		return models.TestRunSettings{}, false, fmt.Errorf("get test run: %w", err)
+	}
+
+	return run.Settings, found, nil
+}
+
+// RecordTestResult saves a detection of a test case or an error if the post could not be analyzed.
+func (s *Scout) RecordTestResult(
+	ctx context.Context,
+	runID int64,
+	source string,
+	sourceID string,
+	detection *models.Detection,
+	analysisErr error,
+) error {
+	result, found, err := s.testSuiteStorage.GetTestResult(ctx, runID, source, sourceID)
+	if err != nil {
+		return fmt.Errorf("get test result: %w", err)
+	}
+
+	if !found {
+		return fmt.Errorf("test result not found: run id = %d, source = %s, source id = %s", runID, source, sourceID)
+	}
+
+	result.Detection = detection
+	result.Error = nil
+	result.MismatchedProperties = nil
+
+	if detection != nil {
+		testCase := models.TestCase{ExpectedProperties: result.ExpectedProperties}
+		result.MismatchedProperties = testCase.MismatchedProperties(detection.Properties)
+	}
+
+	if analysisErr != nil {
+		result.Error = lo.ToPtr(analysisErr.Error())
+	}
+
+	if err := s.testSuiteStorage.CompleteTestResult(ctx, result); err != nil {
+		return fmt.Errorf("complete test result: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Scout) getProfileTestRun(
+	ctx context.Context,
+	profileID int64,
+	runID int64,
+) (run models.TestRun, found bool, err error) {
+	run, found, err = s.testSuiteStorage.GetTestRun(ctx, runID)
+	if err != nil {
+		return models.TestRun{}, false, fmt.Errorf("get test run: %w", err)
+	}
+
+	if !found || run.ProfileID != profileID {
+		return models.TestRun{}, false, nil
+	}
+
+	return run, true, nil
+}
@@ -0,0 +1,118 @@
+package scout
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/rishenco/scout/pkg/models"
+)
+
+// webhookSecretLength is a length of generated webhook secrets in bytes.
+const webhookSecretLength = 32
+
+type webhookStorage interface {
+	ListWebhooks(ctx context.Context, profileID int64) ([]models.Webhook, error)
+	GetWebhook(ctx context.Context, webhookID int64) (webhook models.Webhook, found bool, err error)
+	CreateWebhook(ctx context.Context, webhook models.Webhook) (models.Webhook, error)
+	UpdateWebhook(ctx context.Context, update models.WebhookUpdate) (webhook models.Webhook, found bool, err error)
+	DeleteWebhook(ctx context.Context, webhookID int64) (found bool, err error)
+	DeleteProfileWebhooks(ctx context.Context, profileID int64) error
+	ListDeliveries(ctx context.Context, webhookID int64, limit int64) ([]models.WebhookDeliveryLogEntry, error)
+}
+
+// ListWebhooks returns webhooks of a profile.
+func (s *Scout) ListWebhooks(ctx context.Context, profileID int64) ([]models.Webhook, error) {
+	return s.webhookStorage.ListWebhooks(ctx, profileID)
+}
+
+// CreateWebhook subscribes a webhook to relevant detections of its profile.
+// If the secret is empty, a random one is generated.
+func (s *Scout) CreateWebhook(
+	ctx context.Context,
+	webhook models.Webhook,
+) (created models.Webhook, found bool, err error) {
+	if err := models.ValidateWebhookURL(webhook.URL); err != nil {
+		return models.Webhook{}, false, err
+	}
+
+	_, found, err = s.storage.GetProfile(ctx, webhook.ProfileID)
+	if err != nil {
+		return models.Webhook{}, false, fmt.Errorf("get profile: %w", err)
+	}
+
+	if !found {
+		return models.Webhook{}, false, nil
+	}
+
+	if webhook.Secret == "" {
+		webhook.Secret, err = generateWebhookSecret()
+		if err != nil {
+			return models.Webhook{}, false, fmt.Errorf("generate secret: %w", err)
+		}
+	}
+
+	created, err = s.webhookStorage.CreateWebhook(ctx, webhook)
+	if err != nil {
+		return models.Webhook{}, false, fmt.Errorf("create webhook: %w", err)
+	}
+
+	return created, true, nil
+}
+
+// UpdateWebhook partially updates a webhook.
+func (s *Scout) UpdateWebhook(
+	ctx context.Context,
+	update models.WebhookUpdate,
+) (webhook models.Webhook, found bool, err error) {
+	if update.URL != nil {
+		if err := models.ValidateWebhookURL(*update.URL); err != nil {
+			return models.Webhook{}, false, err
+		}
+	}
+
+	if update.Secret != nil && *update.Secret == "" {
+		return models.Webhook{}, false, fmt.Errorf("%w: secret must not be empty", models.ErrInvalidWebhook)
+	}
+
+	return s.webhookStorage.UpdateWebhook(ctx, update)
+}
+
+// DeleteWebhook deletes a webhook with its pending events and delivery log.
+func (s *Scout) DeleteWebhook(ctx context.Context, webhookID int64) (found bool, err error) {
+	return s.webhookStorage.DeleteWebhook(ctx, webhookID)
+}
+
+// ListWebhookDeliveries returns the latest delivery attempts of a webhook.
+func (s *Scout) ListWebhookDeliveries(
+	ctx context.Context,
+	webhookID int64,
+	limit int64,
+) (deliveries []models.WebhookDeliveryLogEntry, found bool, err error) {
+	_, found, err = s.webhookStorage.GetWebhook(ctx, webhookID)
+	if err != nil {
+		return nil, false, fmt.Errorf("get webhook: %w", err)
+	}
+
+	if !found {
+		return nil, false, nil
+	}
+
+	deliveries, err = s.webhookStorage.ListDeliveries(ctx, webhookID, limit)
+	if err != nil {
+		return nil, false, fmt.Errorf("list deliveries: %w", err)
+	}
+
+	return deliveries, true, nil
+}
+
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, webhookSecretLength)
+
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("read random bytes: %w", err)
+	}
+
+	return hex.EncodeToString(secret), nil
+}
@@ -1,5 +1,7 @@
 package sources
 
 const (
-	RedditSource = "reddit"
+	RedditSource     = "reddit"
+	HackerNewsSource = "hackernews"
+	RSSSource        = "rss"
 )
@@ -0,0 +1,45 @@
+package analyzers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	"github.com/rishenco/scout/internal/llm"
+	"github.com/rishenco/scout/internal/sources/hackernews"
+)
+
+type requestsLog interface {
+	Save(ctx context.Context, requestType string, request any, response any) error
+}
+
+type limiter interface {
+	Acquire(ctx context.Context, tokens int) (release func(err error), err error)
+}
+
+func NewGemini(
+	ctx context.Context,
+	apiKey string,
+	settings llm.GeminiSettings,
+	requestsLog requestsLog,
+	limiter limiter,
+	maxCommentsPerStory int,
+	logger zerolog.Logger,
+) (*llm.GeminiAnalyzer[hackernews.AnalysisInput], error) {
+	analyzer, err := llm.NewGeminiAnalyzer(
+		ctx,
+		apiKey,
+		settings,
+		Prompt,
+		prepareInput(maxCommentsPerStory),
+		requestsLog,
+		limiter,
+		logger,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("new gemini analyzer: %w", err)
+	}
+
+	return analyzer, nil
+}
@@ -0,0 +1,131 @@
+package analyzers
+
+import (
+	"github.com/rishenco/scout/internal/llm"
+	"github.com/rishenco/scout/internal/sources/hackernews"
+	"github.com/rishenco/scout/pkg/models"
+)
+
+// prepareInput returns a function preparing analyzer inputs of stories.
+func prepareInput(maxCommentsPerStory int) llm.PrepareInput[hackernews.AnalysisInput] {
+	return func(input hackernews.AnalysisInput, profileSettings models.ProfileSettings) llm.Input {
+		inputObject, exampleIDs := prepareInputObject(profileSettings, input, maxCommentsPerStory)
+
+		return llm.Input{
+			Source:     input.Story.Source(),
+			SourceID:   input.Story.ID(),
+			Object:     inputObject,
+			ExampleIDs: exampleIDs,
+		}
+	}
+}
+
+// prepareInputObject returns the analyzer input of a story and ids of the examples included in it.
+func prepareInputObject(
+	profileSettings models.ProfileSettings,
+	input hackernews.AnalysisInput,
+	maxCommentsPerStory int,
+) (inputObject hackerNewsInputObject, exampleIDs []int64) {
+	inputObject = hackerNewsInputObject{
+		Story:               prepareInputStoryObject(input.Story),
+		Comments:            prepareInputComments(input.Story, maxCommentsPerStory),
+		RelevancyFilter:     profileSettings.RelevancyFilter,
+		ExtractedProperties: profileSettings.ExtractedProperties,
+		Examples:            nil,
+	}
+
+	if profileSettings.FewShot != nil {
+		inputObject.Examples, exampleIDs = prepareInputExamples(
+			input.Examples,
+			profileSettings.FewShot.MaxTokens,
+			maxCommentsPerStory,
+		)
+	}
+
+	return inputObject, exampleIDs
+}
+
+// prepareInputExamples converts examples in their order while they fit into the token budget.
+// Examples that don't fit into the rest of the budget are skipped.
+func prepareInputExamples(
+	examples []hackernews.Example,
+	maxTokens int,
+	maxCommentsPerStory int,
+) (inputExamples []hackerNewsInputExampleObject, exampleIDs []int64) {
+	tokens := 0
+
+	for _, example := range examples {
+		inputExample := hackerNewsInputExampleObject{
+			Story:      prepareInputStoryObject(example.Story),
+			Comments:   prepareInputComments(example.Story, maxCommentsPerStory),
+			IsRelevant: example.IsRelevant,
+		}
+
+		exampleTokens := estimateExampleTokens(inputExample)
+		if tokens+exampleTokens > maxTokens {
+			continue
+		}
+
+		tokens += exampleTokens
+
+		inputExamples = append(inputExamples, inputExample)
+		exampleIDs = append(exampleIDs, example.DetectionID)
+	}
+
+	return inputExamples, exampleIDs
+}
+
+func estimateExampleTokens(example hackerNewsInputExampleObject) int {
+	parts := []string{example.Story.Title, example.Story.Text, example.Story.Link}
+
+	for _, comment := range example.Comments {
+		parts = append(parts, comment.Comment)
+	}
+
+	return llm.EstimateTokens(parts...)
+}
+
+func prepareInputStoryObject(story hackernews.StoryAndComments) hackerNewsInputStoryObject {
+	return hackerNewsInputStoryObject{
+		Title: story.Story.Title,
+		Text:  story.Story.Text,
+		Score: story.Story.Score,
+		Link:  story.Story.URL,
+	}
+}
+
+// prepareInputComments returns comments of a story in the order they are ranked in the thread.
+func prepareInputComments(story hackernews.StoryAndComments, maxCommentsPerStory int) []hackerNewsInputCommentObject {
+	// Hacker News does not expose comment scores, so comments are taken in the order
+	// they are ranked in the thread, walking the tree breadth-first to prefer top-level discussion.
+	comments := make([]hackerNewsInputCommentObject, 0)
+
+	type queuedComment struct {
+		comment hackernews.Comment
+		depth   int
+	}
+
+	queue := make([]queuedComment, 0, len(story.Comments))
+
+	for _, comment := range story.Comments {
+		queue = append(queue, queuedComment{comment: comment, depth: 0})
+	}
+
+	for len(queue) > 0 && len(comments) < maxCommentsPerStory {
+		current := queue[0]
+		queue = queue[1:]
+
+		if current.comment.Text != "" {
+			comments = append(comments, hackerNewsInputCommentObject{
+				Comment: current.comment.Text,
+				Depth:   current.depth,
+			})
+		}
+
+		for _, reply := range current.comment.Replies {
+			queue = append(queue, queuedComment{comment: reply, depth: current.depth + 1})
+		}
+	}
+
+	return comments
+}
@@ -0,0 +1,28 @@
+package analyzers
+
+type hackerNewsInputStoryObject struct {
+	Title string `json:"title"`
+	Text  string `json:"text"`
+	Score int    `json:"score"`
+	Link  string `json:"link"`
+}
+
+type hackerNewsInputCommentObject struct {
+	Comment string `json:"comment"`
+	Depth   int    `json:"depth"`
+}
+
+type hackerNewsInputObject struct {
+	Story               hackerNewsInputStoryObject     `json:"story"`
+	Comments            []hackerNewsInputCommentObject `json:"comments"`
+	RelevancyFilter     string                         `json:"relevancy_filter"`
+	ExtractedProperties map[string]string              `json:"extracted_props"`
+	Examples            []hackerNewsInputExampleObject `json:"examples,omitempty"`
+}
+
+// hackerNewsInputExampleObject is a human-tagged story with its correct relevance.
+type hackerNewsInputExampleObject struct {
+	Story      hackerNewsInputStoryObject     `json:"story"`
+	Comments   []hackerNewsInputCommentObject `json:"comments"`
+	IsRelevant bool                           `json:"is_relevant"`
+}
@@ -0,0 +1,39 @@
+package analyzers
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+
+	"github.com/rishenco/scout/internal/llm"
+	"github.com/rishenco/scout/internal/openai"
+	"github.com/rishenco/scout/internal/sources/hackernews"
+)
+
+type chatCompletionsClient interface {
+	CreateChatCompletion(
+		ctx context.Context,
+		request openai.ChatCompletionRequest,
+	) (openai.ChatCompletionResponse, error)
+}
+
+// NewOpenAICompatible creates an analyzer of stories using any server implementing OpenAI Chat Completions API
+// with JSON schema response format (OpenAI, llama.cpp, Ollama, vLLM, etc.).
+func NewOpenAICompatible(
+	client chatCompletionsClient,
+	settings llm.OpenAISettings,
+	requestsLog requestsLog,
+	limiter limiter,
+	maxCommentsPerStory int,
+	logger zerolog.Logger,
+) *llm.OpenAIAnalyzer[hackernews.AnalysisInput] {
+	return llm.NewOpenAIAnalyzer(
+		client,
+		settings,
+		Prompt,
+		prepareInput(maxCommentsPerStory),
+		requestsLog,
+		limiter,
+		logger,
+	)
+}
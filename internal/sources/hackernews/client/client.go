@@ -0,0 +1,285 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/samber/lo"
+
+	"github.com/rishenco/scout/internal/sources/hackernews"
+)
+
+type requestsLog interface {
+	Save(ctx context.Context, requestType string, request any, response any) error
+}
+
+const (
+	DefaultFirebaseURL = "https://hacker-news.firebaseio.com"
+	DefaultAlgoliaURL  = "https://hn.algolia.com"
+
+	requestTimeout = 30 * time.Second
+)
+
+var errItemNotFound = errors.New("item not found")
+
+// Client handles interactions with the Hacker News APIs.
+//
+// Firebase API is used to list stories and load their current state,
+// Algolia API is used to load a story with the whole comment tree in a single request.
+type Client struct {
+	httpClient  *http.Client
+	firebaseURL string
+	algoliaURL  string
+	requestsLog requestsLog
+
+	logger zerolog.Logger
+}
+
+func New(firebaseURL string, algoliaURL string, requestsLog requestsLog, logger zerolog.Logger) *Client {
+	if firebaseURL == "" {
+		firebaseURL = DefaultFirebaseURL
+	}
+
+	if algoliaURL == "" {
+		algoliaURL = DefaultAlgoliaURL
+	}
+
+	return &Client{
+		httpClient:  &http.Client{Timeout: requestTimeout},
+		firebaseURL: strings.TrimSuffix(firebaseURL, "/"),
+		algoliaURL:  strings.TrimSuffix(algoliaURL, "/"),
+		requestsLog: requestsLog,
+		logger:      logger,
+	}
+}
+
+// GetStoryIDs returns ids of stories from a feed ordered as they are ordered in the feed.
+func (c *Client) GetStoryIDs(ctx context.Context, feed string) (storyIDs []string, err error) {
+	if !hackernews.IsValidFeed(feed) {
+		return nil, fmt.Errorf("unknown feed: %s", feed)
+	}
+
+	var ids []int64
+
+	if err := c.get(ctx, fmt.Sprintf("%s/v0/%sstories.json", c.firebaseURL, feed), &ids); err != nil {
+		return nil, fmt.Errorf("get %s stories: %w", feed, err)
+	}
+
+	storyIDs = lo.Map(ids, func(id int64, _ int) string {
+		return strconv.FormatInt(id, 10)
+	})
+
+	err = c.requestsLog.Save(
+		ctx,
+		"get_story_ids",
+		map[string]any{
+			"feed": feed,
+		},
+		storyIDs,
+	)
+	if err != nil {
+		c.logger.Error().Err(err).Msg("failed to save request log")
+	}
+
+	return storyIDs, nil
+}
+
+// GetStory returns the current state of a story without comments.
+func (c *Client) GetStory(ctx context.Context, id string) (hackernews.Story, error) {
+	var item *firebaseItem
+
+	if err := c.get(ctx, fmt.Sprintf("%s/v0/item/%s.json", c.firebaseURL, id), &item); err != nil {
+		return hackernews.Story{}, fmt.Errorf("get item: %w", err)
+	}
+
+	if item == nil {
+		return hackernews.Story{}, fmt.Errorf("get item %s: %w", id, errItemNotFound)
+	}
+
+	story := storyFromFirebase(*item)
+
+	err := c.requestsLog.Save(
+		ctx,
+		"get_story",
+		map[string]any{
+			"story_id": id,
+		},
+		story,
+	)
+	if err != nil {
+		c.logger.Error().Err(err).Msg("failed to save request log")
+	}
+
+	return story, nil
+}
+
+// GetStoryAndComments returns a story with its whole comment tree.
+func (c *Client) GetStoryAndComments(ctx context.Context, id string) (hackernews.StoryAndComments, error) {
+	var item *algoliaItem
+
+	if err := c.get(ctx, fmt.Sprintf("%s/api/v1/items/%s", c.algoliaURL, id), &item); err != nil {
+		return hackernews.StoryAndComments{}, fmt.Errorf("get item: %w", err)
+	}
+
+	if item == nil {
+		return hackernews.StoryAndComments{}, fmt.Errorf("get item %s: %w", id, errItemNotFound)
+	}
+
+	err := c.requestsLog.Save(
+		ctx,
+		"get_story_and_comments",
+		map[string]any{
+			"story_id": id,
+		},
+		item,
+	)
+	if err != nil {
+		c.logger.Error().Err(err).Msg("failed to save request log")
+	}
+
+	return storyAndCommentsFromAlgolia(*item), nil
+}
+
+func (c *Client) get(ctx context.Context, url string, result any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("new request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("do request: %w", err)
+	}
+
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			c.logger.Error().Err(closeErr).Msg("failed to close response body")
+		}
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("read body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return errItemNotFound
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, string(body))
+	}
+
+	if err := json.Unmarshal(body, result); err != nil {
+		return fmt.Errorf("unmarshal: %w", err)
+	}
+
+	return nil
+}
+
+// firebaseItem is an item returned by https://hacker-news.firebaseio.com/v0/item/{id}.json.
+type firebaseItem struct {
+	ID          int64  `json:"id"`
+	Type        string `json:"type"`
+	By          string `json:"by"`
+	Time        int64  `json:"time"`
+	Title       string `json:"title"`
+	URL         string `json:"url"`
+	Text        string `json:"text"`
+	Score       int    `json:"score"`
+	Descendants int    `json:"descendants"`
+	Dead        bool   `json:"dead"`
+	Deleted     bool   `json:"deleted"`
+}
+
+// algoliaItem is an item returned by https://hn.algolia.com/api/v1/items/{id}.
+type algoliaItem struct {
+	ID        int64         `json:"id"`
+	CreatedAt *time.Time    `json:"created_at"`
+	Type      string        `json:"type"`
+	Author    *string       `json:"author"`
+	Title     *string       `json:"title"`
+	URL       *string       `json:"url"`
+	Text      *string       `json:"text"`
+	Points    *int          `json:"points"`
+	ParentID  *int64        `json:"parent_id"`
+	Children  []algoliaItem `json:"children"`
+}
+
+func storyFromFirebase(item firebaseItem) hackernews.Story {
+	return hackernews.Story{
+		ID:               strconv.FormatInt(item.ID, 10),
+		Created:          lo.ToPtr(time.Unix(item.Time, 0).UTC()),
+		URL:              item.URL,
+		Title:            item.Title,
+		Text:             item.Text,
+		Score:            item.Score,
+		NumberOfComments: item.Descendants,
+		Author:           item.By,
+		Dead:             item.Dead,
+		Deleted:          item.Deleted,
+	}
+}
+
+func storyAndCommentsFromAlgolia(item algoliaItem) hackernews.StoryAndComments {
+	comments := commentsFromAlgolia(item.Children)
+
+	return hackernews.StoryAndComments{
+		Story: hackernews.Story{
+			ID:               strconv.FormatInt(item.ID, 10),
+			Created:          item.CreatedAt,
+			URL:              lo.FromPtr(item.URL),
+			Title:            lo.FromPtr(item.Title),
+			Text:             lo.FromPtr(item.Text),
+			Score:            lo.FromPtr(item.Points),
+			NumberOfComments: countComments(comments),
+			Author:           lo.FromPtr(item.Author),
+			Dead:             false,
+			Deleted:          false,
+		},
+		Comments: comments,
+	}
+}
+
+func commentsFromAlgolia(items []algoliaItem) []hackernews.Comment {
+	comments := make([]hackernews.Comment, 0, len(items))
+
+	for _, item := range items {
+		// deleted comments have no author and no text, but their replies are still present
+		comment := hackernews.Comment{
+			ID:      strconv.FormatInt(item.ID, 10),
+			Created: item.CreatedAt,
+			Text:    lo.FromPtr(item.Text),
+			Author:  lo.FromPtr(item.Author),
+			Replies: commentsFromAlgolia(item.Children),
+		}
+
+		if item.ParentID != nil {
+			comment.ParentID = strconv.FormatInt(*item.ParentID, 10)
+		}
+
+		comments = append(comments, comment)
+	}
+
+	return comments
+}
+
+func countComments(comments []hackernews.Comment) int {
+	count := len(comments)
+
+	for _, comment := range comments {
+		count += countComments(comment.Replies)
+	}
+
+	return count
+}
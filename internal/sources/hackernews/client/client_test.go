@@ -0,0 +1,199 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/rishenco/scout/internal/sources/hackernews"
+)
+
+type noopRequestsLog struct{}
+
+func (noopRequestsLog) Save(context.Context, string, any, any) error {
+	return nil
+}
+
+// newFakeHackerNews starts a server mimicking Firebase and Algolia APIs of Hacker News.
+func newFakeHackerNews(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	responses := map[string]string{
+		"/v0/topstories.json": `[3, 1, 2]`,
+		"/v0/item/1.json": `{
+			"id": 1, "type": "story", "by": "alice", "time": 1700000000, "title": "Show HN: Scout",
+			"url": "https://example.com", "score": 42, "descendants": 3
+		}`,
+		"/v0/item/404.json": `null`,
+		"/api/v1/items/1": `{
+			"id": 1, "created_at": "2023-11-14T22:13:20Z", "type": "story", "author": "alice",
+			"title": "Show HN: Scout", "url": "https://example.com", "text": null, "points": 42, "parent_id": null,
+			"children": [
+				{
+					"id": 10, "created_at": "2023-11-14T23:00:00Z", "type": "comment", "author": "bob",
+					"text": "<p>Nice</p>", "parent_id": 1,
+					"children": [
+						{
+							"id": 11, "created_at": "2023-11-14T23:30:00Z", "type": "comment", "author": "alice",
+							"text": "Thanks", "parent_id": 10, "children": []
+						}
+					]
+				},
+				{
+					"id": 12, "created_at": "2023-11-15T00:00:00Z", "type": "comment", "author": null,
+					"text": null, "parent_id": 1, "children": []
+				}
+			]
+		}`,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func newTestClient(t *testing.T) *Client {
+	t.Helper()
+
+	server := newFakeHackerNews(t)
+
+	return New(server.URL, server.URL+"/", noopRequestsLog{}, zerolog.Nop())
+}
+
+func TestClient_GetStoryIDs(t *testing.T) {
+	client := newTestClient(t)
+
+	storyIDs, err := client.GetStoryIDs(context.Background(), hackernews.TopStoriesFeed)
+	if err != nil {
+		t.Fatalf("get story ids: %v", err)
+	}
+
+	if want := []string{"3", "1", "2"}; !reflect.DeepEqual(storyIDs, want) {
+		t.Errorf("story ids = %v, want %v", storyIDs, want)
+	}
+
+	if _, err := client.GetStoryIDs(context.Background(), "best"); err == nil {
+		t.Error("expected an error for an unknown feed")
+	}
+}
+
+func TestClient_GetStory(t *testing.T) {
+	client := newTestClient(t)
+
+	story, err := client.GetStory(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("get story: %v", err)
+	}
+
+	created := time.Unix(1700000000, 0).UTC()
+	want := hackernews.Story{
+		ID:               "1",
+		Created:          &created,
+		URL:              "https://example.com",
+		Title:            "Show HN: Scout",
+		Text:             "",
+		Score:            42,
+		NumberOfComments: 3,
+		Author:           "alice",
+		Dead:             false,
+		Deleted:          false,
+	}
+
+	if !reflect.DeepEqual(story, want) {
+		t.Errorf("story = %+v, want %+v", story, want)
+	}
+}
+
+func TestClient_GetStoryAndComments(t *testing.T) {
+	client := newTestClient(t)
+
+	story, err := client.GetStoryAndComments(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("get story and comments: %v", err)
+	}
+
+	if story.ID() != "1" || story.Story.Title != "Show HN: Scout" || story.Story.Score != 42 {
+		t.Errorf("unexpected story: %+v", story.Story)
+	}
+
+	if story.Story.NumberOfComments != 3 {
+		t.Errorf("number of comments = %d, want 3", story.Story.NumberOfComments)
+	}
+
+	if len(story.Comments) != 2 {
+		t.Fatalf("top-level comments = %d, want 2", len(story.Comments))
+	}
+
+	comment := story.Comments[0]
+	if comment.ID != "10" || comment.ParentID != "1" || comment.Author != "bob" || comment.Text != "<p>Nice</p>" {
+		t.Errorf("unexpected comment: %+v", comment)
+	}
+
+	if len(comment.Replies) != 1 || comment.Replies[0].ID != "11" || comment.Replies[0].ParentID != "10" {
+		t.Errorf("unexpected replies: %+v", comment.Replies)
+	}
+
+	// deleted comments are kept to preserve their replies
+	if deleted := story.Comments[1]; deleted.ID != "12" || deleted.Author != "" || deleted.Text != "" {
+		t.Errorf("unexpected deleted comment: %+v", deleted)
+	}
+}
+
+func TestClient_ItemNotFound(t *testing.T) {
+	client := newTestClient(t)
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{
+			name: "firebase null item",
+			call: func() error {
+				_, err := client.GetStory(context.Background(), "404")
+
+				return err
+			},
+		},
+		{
+			name: "firebase 404",
+			call: func() error {
+				_, err := client.GetStory(context.Background(), "2")
+
+				return err
+			},
+		},
+		{
+			name: "algolia 404",
+			call: func() error {
+				_, err := client.GetStoryAndComments(context.Background(), "2")
+
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); !errors.Is(err, errItemNotFound) {
+				t.Errorf("error = %v, want %v", err, errItemNotFound)
+			}
+		})
+	}
+}
@@ -0,0 +1,240 @@
+package hackernews
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/rishenco/scout/internal/metrics"
+	"github.com/rishenco/scout/internal/sources"
+	"github.com/rishenco/scout/internal/tracing"
+)
+
+type enricherStorage interface {
+	GetStoriesForEnrichment(ctx context.Context, storyCreatedBefore time.Time, limit int) (storyIDs []string, err error)
+	EnrichStories(ctx context.Context, stories []StoryAndComments) error
+}
+
+type enricherHackerNews interface {
+	GetStoryAndComments(ctx context.Context, id string) (story StoryAndComments, err error)
+}
+
+// Enricher loads comment trees of scraped stories once they are old enough to be discussed.
+type Enricher struct {
+	hackerNews    enricherHackerNews
+	storage       enricherStorage
+	minStoryAge   time.Duration
+	batchSize     int
+	timeout       time.Duration
+	errorTimeout  time.Duration
+	retries       int
+	workersAmount int
+	heartbeat     heartbeat
+	logger        zerolog.Logger
+}
+
+func NewEnricher(
+	hackerNews enricherHackerNews,
+	storage enricherStorage,
+	batchSize int,
+	minStoryAge time.Duration,
+	timeout time.Duration,
+	errorTimeout time.Duration,
+	retries int,
+	workersAmount int,
+	heartbeat heartbeat,
+	logger zerolog.Logger,
+) *Enricher {
+	return &Enricher{
+		hackerNews:    hackerNews,
+		storage:       storage,
+		minStoryAge:   minStoryAge,
+		batchSize:     batchSize,
+		timeout:       timeout,
+		errorTimeout:  errorTimeout,
+		retries:       retries,
+		workersAmount: workersAmount,
+		heartbeat:     heartbeat,
+		logger:        logger,
+	}
+}
+
+func (e *Enricher) Start(ctx context.Context) error {
+	for {
+		if ctx.Err() != nil {
+			return fmt.Errorf("context error: %w", ctx.Err())
+		}
+
+		timeout := e.timeout
+
+		if err := e.enrichStories(ctx); err != nil {
+			e.logger.Error().Err(err).Msg("error enriching stories")
+
+			e.heartbeat.Fail(err)
+
+			timeout = e.errorTimeout
+		} else {
+			e.heartbeat.Beat()
+		}
+
+		select {
+		case <-time.After(timeout):
+			continue
+		case <-ctx.Done():
+			return fmt.Errorf("context error: %w", ctx.Err())
+		}
+	}
+}
+
+func (e *Enricher) enrichStories(ctx context.Context) error {
+	storiesCutoffTime := time.Now().Add(-e.minStoryAge)
+
+	storyIDs, err := e.storage.GetStoriesForEnrichment(ctx, storiesCutoffTime, e.batchSize)
+	if err != nil {
+		return fmt.Errorf("get stories for enrichment: %w", err)
+	}
+
+	if len(storyIDs) == 0 {
+		return nil
+	}
+
+	storyIDsChan := lo.SliceToChannel(len(storyIDs), storyIDs)
+	storiesChan := make(chan StoryAndComments, len(storyIDs))
+	wg := new(sync.WaitGroup)
+
+	for range max(1, e.workersAmount) {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			e.storyLoaderWorker(ctx, storyIDsChan, storiesChan)
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+
+		close(storiesChan)
+	}()
+
+	stories := lo.ChannelToSlice(storiesChan)
+
+	err = e.saveStories(ctx, stories)
+
+	metrics.EnrichedItems.WithLabelValues(sources.HackerNewsSource, metrics.Result(err)).Add(float64(len(stories)))
+
+	if err != nil {
+		return fmt.Errorf("save stories: %w", err)
+	}
+
+	for _, story := range stories {
+		e.logger.Info().Str("story_id", story.ID()).Msg("enriched story")
+	}
+
+	return nil
+}
+
+func (e *Enricher) storyLoaderWorker(
+	ctx context.Context,
+	storyIDsChan <-chan string,
+	storiesChan chan<- StoryAndComments,
+) {
+	for {
+		select {
+		case storyID, ok := <-storyIDsChan:
+			if !ok {
+				return
+			}
+
+			story, err := e.loadStory(ctx, storyID)
+			if err != nil {
+				e.logger.Error().Err(err).Msg("error loading story")
+
+				metrics.EnrichedItems.WithLabelValues(sources.HackerNewsSource, metrics.FailureResult).Inc()
+
+				continue
+			}
+
+			storiesChan <- story
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (e *Enricher) loadStory(ctx context.Context, storyID string) (StoryAndComments, error) {
+	ctx, span := tracing.Start(
+		ctx,
+		"hackernews.Enricher.loadStory",
+		trace.WithAttributes(attribute.String("scout.source_id", storyID)),
+	)
+
+	var result StoryAndComments
+
+	err := e.retry(
+		ctx,
+		func() error {
+			story, err := e.hackerNews.GetStoryAndComments(ctx, storyID)
+			if err != nil {
+				return fmt.Errorf("get story and comments: %w", err)
+			}
+
+			result = story
+
+			return nil
+		},
+		e.retries,
+		e.errorTimeout,
+	)
+
+	tracing.End(span, err)
+
+	if err != nil {
+		return StoryAndComments{}, fmt.Errorf("load story: %w", err)
+	}
+
+	return result, nil
+}
+
+func (e *Enricher) saveStories(ctx context.Context, stories []StoryAndComments) error {
+	return e.retry(
+		ctx,
+		func() error {
+			if err := e.storage.EnrichStories(ctx, stories); err != nil {
+				return fmt.Errorf("enrich stories: %w", err)
+			}
+
+			return nil
+		},
+		e.retries,
+		e.errorTimeout,
+	)
+}
+
+func (e *Enricher) retry(ctx context.Context, fn func() error, retries int, errorTimeout time.Duration) error {
+	var err error
+
+	for range retries {
+		if err = fn(); err != nil {
+			e.logger.Error().Err(err).Msg("attempt failed")
+
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("context error: %w", ctx.Err())
+			case <-time.After(errorTimeout):
+				continue
+			}
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("all retries failed: %w", err)
+}
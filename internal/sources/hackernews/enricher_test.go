@@ -0,0 +1,63 @@
+package hackernews
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+func TestEnricher_EnrichStories(t *testing.T) {
+	storage := &fakeStorage{pending: []string{"1", "2", "missing"}}
+
+	enricher := NewEnricher(newFakeHackerNews(), storage, 10, time.Hour, 0, 0, 1, 2, noopHeartbeat{}, zerolog.Nop())
+
+	if err := enricher.enrichStories(context.Background()); err != nil {
+		t.Fatalf("enrich stories: %v", err)
+	}
+
+	// stories that failed to load are skipped, the rest are saved with their comments
+	ids := make([]string, 0, len(storage.enriched))
+	for _, story := range storage.enriched {
+		ids = append(ids, story.ID())
+	}
+
+	sort.Strings(ids)
+
+	if want := []string{"1", "2"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("enriched stories = %v, want %v", ids, want)
+	}
+
+	for _, story := range storage.enriched {
+		if story.ID() == "2" && len(story.Comments) != 1 {
+			t.Errorf("comments of story 2 = %+v, want 1 comment", story.Comments)
+		}
+	}
+}
+
+func TestEnricher_Retry(t *testing.T) {
+	enricher := NewEnricher(nil, nil, 10, time.Hour, 0, 0, 3, 1, noopHeartbeat{}, zerolog.Nop())
+
+	attempts := 0
+
+	err := enricher.retry(context.Background(), func() error {
+		attempts++
+
+		if attempts < 3 {
+			return errors.New("temporary error")
+		}
+
+		return nil
+	}, 3, 0)
+	if err != nil {
+		t.Fatalf("retry: %v", err)
+	}
+
+	if attempts != 3 {
+		t.Errorf("attempts = %d, want 3", attempts)
+	}
+}
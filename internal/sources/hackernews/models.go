@@ -0,0 +1,98 @@
+package hackernews
+
+import (
+	"time"
+
+	"github.com/rishenco/scout/internal/sources"
+)
+
+const (
+	// NewStoriesFeed is a feed of the newest Hacker News stories.
+	NewStoriesFeed = "new"
+	// TopStoriesFeed is a feed of the top Hacker News stories.
+	TopStoriesFeed = "top"
+)
+
+// IsValidFeed checks if a feed is supported by the scraper.
+func IsValidFeed(feed string) bool {
+	return feed == NewStoriesFeed || feed == TopStoriesFeed
+}
+
+type RawStoryAndComments struct {
+	Data    []byte `json:"data"`
+	StoryID string `json:"story_id"`
+}
+
+type FeedSettings struct {
+	Profiles []int64 `json:"profiles"`
+	Feed     string  `json:"feed"`
+}
+
+// FeedStory is a story scraped from a feed.
+//
+// A story may belong to several feeds, each membership is scheduled separately.
+type FeedStory struct {
+	Feed    string `json:"feed"`
+	StoryID string `json:"story_id"`
+	// ScheduledFeeds are other feeds of the story which profiles already received tasks for it.
+	ScheduledFeeds []string `json:"scheduled_feeds"`
+}
+
+type StoryAndComments struct {
+	Story    Story     `json:"story"`
+	Comments []Comment `json:"comments"`
+}
+
+func (s StoryAndComments) ID() string {
+	return s.Story.ID
+}
+
+func (s StoryAndComments) Source() string {
+	return sources.HackerNewsSource
+}
+
+// AnalysisInput is a story to analyze with examples for few-shot prompting.
+type AnalysisInput struct {
+	Story StoryAndComments
+	// Examples are human-tagged stories of the profile. Empty if few-shot prompting is disabled.
+	Examples []Example
+}
+
+// Example is a human-tagged story with its confirmed relevance.
+type Example struct {
+	// DetectionID is an id of the tagged detection of the story.
+	DetectionID int64
+	Story       StoryAndComments
+	IsRelevant  bool
+}
+
+type Story struct {
+	ID      string     `json:"id"`
+	Created *time.Time `json:"created_at,omitempty"`
+
+	// URL is a link attached to the story. Empty for text posts (Ask HN, etc.).
+	URL   string `json:"url,omitempty"`
+	Title string `json:"title,omitempty"`
+	// Text is a body of the story in HTML.
+	Text string `json:"text,omitempty"`
+
+	Score            int `json:"score"`
+	NumberOfComments int `json:"descendants"`
+
+	Author string `json:"by,omitempty"`
+
+	Dead    bool `json:"dead"`
+	Deleted bool `json:"deleted"`
+}
+
+type Comment struct {
+	ID       string     `json:"id"`
+	ParentID string     `json:"parent_id,omitempty"`
+	Created  *time.Time `json:"created_at,omitempty"`
+
+	// Text is a body of the comment in HTML.
+	Text   string `json:"text,omitempty"`
+	Author string `json:"by,omitempty"`
+
+	Replies []Comment `json:"replies,omitempty"`
+}
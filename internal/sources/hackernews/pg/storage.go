@@ -0,0 +1,644 @@
+package pg
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+	"github.com/samber/lo"
+
+	"github.com/rishenco/scout/internal/sources/hackernews"
+	"github.com/rishenco/scout/internal/tools"
+)
+
+type Storage struct {
+	pool   *pgxpool.Pool
+	logger zerolog.Logger
+}
+
+func NewStorage(pool *pgxpool.Pool, logger zerolog.Logger) *Storage {
+	return &Storage{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+// InsertStories inserts new stories and links them to the feed they were scraped from.
+func (s *Storage) InsertStories(ctx context.Context, feed string, stories []hackernews.Story) error {
+	columns := []string{
+		"story_id",
+		"feed",
+		"story_json",
+		"enriched_story_json",
+		"story_created_at",
+		"enriched_at",
+		"scheduled_at",
+		"is_enriched",
+		"is_scheduled",
+	}
+
+	rows := make([][]interface{}, 0, len(stories))
+	feedRows := make([][]interface{}, 0, len(stories))
+
+	for _, story := range stories {
+		marshalledStory, err := json.Marshal(story)
+		if err != nil {
+			return fmt.Errorf("marshal story: %w", err)
+		}
+
+		rows = append(rows, []interface{}{
+			story.ID,        // story_id
+			feed,            // feed
+			marshalledStory, // story_json
+			nil,             // enriched_story_json
+			story.Created,   // story_created_at
+			nil,             // enriched_at
+			nil,             // scheduled_at
+			false,           // is_enriched
+			false,           // is_scheduled
+		})
+
+		feedRows = append(feedRows, []interface{}{story.ID, feed})
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+
+	defer func() {
+		rollbackErr := tx.Rollback(ctx)
+
+		if rollbackErr == nil || errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			return
+		}
+
+		s.logger.Error().Err(rollbackErr).Msg("failed to rollback tx")
+	}()
+
+	if _, err := tx.CopyFrom(ctx, pgx.Identifier{"hackernews", "stories"}, columns, pgx.CopyFromRows(rows)); err != nil {
+		return fmt.Errorf("copy stories: %w", err)
+	}
+
+	_, err = tx.CopyFrom(
+		ctx,
+		pgx.Identifier{"hackernews", "story_feeds"},
+		[]string{"story_id", "feed"},
+		pgx.CopyFromRows(feedRows),
+	)
+	if err != nil {
+		return fmt.Errorf("copy story feeds: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+
+	return nil
+}
+
+// AddStoriesToFeed links already stored stories to a feed and returns ids of all stored stories among given ones.
+func (s *Storage) AddStoriesToFeed(
+	ctx context.Context,
+	feed string,
+	storyIDs []string,
+) (storedStories map[string]struct{}, err error) {
+	query := `
+		WITH linked AS (
+			INSERT INTO hackernews.story_feeds (story_id, feed)
+			SELECT story_id, $1
+			FROM hackernews.stories
+			WHERE story_id = ANY($2)
+			ON CONFLICT (story_id, feed) DO NOTHING
+		)
+		SELECT story_id
+		FROM hackernews.stories
+		WHERE story_id = ANY($2)
+	`
+
+	rows, err := s.pool.Query(ctx, query, feed, storyIDs)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+
+	defer rows.Close()
+
+	storedStories = make(map[string]struct{})
+
+	for rows.Next() {
+		var storyID string
+
+		if err := rows.Scan(&storyID); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		storedStories[storyID] = struct{}{}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return storedStories, nil
+}
+
+func (s *Storage) EnrichStories(ctx context.Context, stories []hackernews.StoryAndComments) error {
+	updateQuery := `
+		UPDATE hackernews.stories
+		SET enriched_story_json = $1,
+			is_enriched = true,
+			enriched_at = now()
+		WHERE story_id = $2
+	`
+
+	batch := new(pgx.Batch)
+
+	for _, story := range stories {
+		marshalledStory, err := json.Marshal(story)
+		if err != nil {
+			return fmt.Errorf("marshal story: %w", err)
+		}
+
+		batch.Queue(updateQuery, marshalledStory, story.Story.ID)
+	}
+
+	if err := s.pool.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("send batch: %w", err)
+	}
+
+	return nil
+}
+
+// MarkStoriesAsScheduled marks feed memberships of stories as scheduled.
+func (s *Storage) MarkStoriesAsScheduled(ctx context.Context, stories []hackernews.FeedStory) error {
+	updateFeedsQuery := `
+		UPDATE hackernews.story_feeds sf
+		SET is_scheduled = true,
+			scheduled_at = now()
+		FROM unnest($1::text[], $2::text[]) AS scheduled(story_id, feed)
+		WHERE sf.story_id = scheduled.story_id AND sf.feed = scheduled.feed
+	`
+
+	// a story is scheduled once it is scheduled in any of its feeds
+	updateStoriesQuery := `
+		UPDATE hackernews.stories
+		SET is_scheduled = true,
+			scheduled_at = COALESCE(scheduled_at, now())
+		WHERE story_id = ANY($1)
+	`
+
+	storyIDs := lo.Map(stories, func(story hackernews.FeedStory, _ int) string {
+		return story.StoryID
+	})
+
+	feeds := lo.Map(stories, func(story hackernews.FeedStory, _ int) string {
+		return story.Feed
+	})
+
+	batch := new(pgx.Batch)
+	batch.Queue(updateFeedsQuery, storyIDs, feeds)
+	batch.Queue(updateStoriesQuery, lo.Uniq(storyIDs))
+
+	if err := s.pool.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("send batch: %w", err)
+	}
+
+	return nil
+}
+
+// CheckPresence returns ids of stories already linked to a feed.
+func (s *Storage) CheckPresence(ctx context.Context, feed string, storyIDs []string) (map[string]struct{}, error) {
+	query := `
+		SELECT story_id
+		FROM hackernews.story_feeds
+		WHERE feed = $1 AND story_id = ANY($2)
+	`
+
+	rows, err := s.pool.Query(ctx, query, feed, storyIDs)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+
+	defer rows.Close()
+
+	presence := make(map[string]struct{})
+
+	for rows.Next() {
+		var storyID string
+
+		if err := rows.Scan(&storyID); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		presence[storyID] = struct{}{}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return presence, nil
+}
+
+func (s *Storage) GetStoriesForEnrichment(
+	ctx context.Context,
+	storyCreatedBefore time.Time,
+	limit int,
+) (storyIDs []string, err error) {
+	query := `
+		SELECT story_id
+		FROM hackernews.stories
+		WHERE NOT is_enriched AND story_created_at < $1
+		ORDER BY story_created_at
+		LIMIT $2
+	`
+
+	rows, err := s.pool.Query(ctx, query, storyCreatedBefore, limit)
+	if err != nil {
+		return nil, fmt.Errorf("select: %w", err)
+	}
+
+	defer rows.Close()
+
+	storyIDs = make([]string, 0)
+
+	for rows.Next() {
+		var storyID string
+
+		if err := rows.Scan(&storyID); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		storyIDs = append(storyIDs, storyID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return storyIDs, nil
+}
+
+// GetStoriesForScheduling returns not scheduled feed memberships of enriched stories.
+func (s *Storage) GetStoriesForScheduling(
+	ctx context.Context,
+	batchSize int,
+	minScore int,
+) (stories []hackernews.FeedStory, err error) {
+	query := `
+		SELECT sf.feed, sf.story_id, ARRAY(
+			SELECT scheduled.feed
+			FROM hackernews.story_feeds scheduled
+			WHERE scheduled.story_id = sf.story_id AND scheduled.is_scheduled
+		)
+		FROM hackernews.story_feeds sf
+		JOIN hackernews.stories s ON s.story_id = sf.story_id
+		WHERE NOT sf.is_scheduled AND s.is_enriched AND (s.enriched_story_json->'story'->>'score')::integer >= $1
+		ORDER BY s.story_created_at, sf.story_id, sf.feed
+		LIMIT $2
+	`
+
+	rows, err := s.pool.Query(ctx, query, minScore, batchSize)
+	if err != nil {
+		return nil, fmt.Errorf("select: %w", err)
+	}
+
+	defer rows.Close()
+
+	stories = make([]hackernews.FeedStory, 0)
+
+	for rows.Next() {
+		var story hackernews.FeedStory
+
+		if err := rows.Scan(&story.Feed, &story.StoryID, &story.ScheduledFeeds); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		stories = append(stories, story)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return stories, nil
+}
+
+func (s *Storage) GetRawStories(
+	ctx context.Context,
+	storyIDs []string,
+) (stories []hackernews.RawStoryAndComments, err error) {
+	query := `
+		SELECT story_id, enriched_story_json
+		FROM hackernews.stories
+		WHERE story_id = ANY($1)
+	`
+
+	rows, err := s.pool.Query(ctx, query, storyIDs)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+
+	defer rows.Close()
+
+	stories = make([]hackernews.RawStoryAndComments, 0)
+
+	for rows.Next() {
+		var rawStory hackernews.RawStoryAndComments
+
+		if err := rows.Scan(&rawStory.StoryID, &rawStory.Data); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		stories = append(stories, rawStory)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return stories, nil
+}
+
+func (s *Storage) GetStories(
+	ctx context.Context,
+	storyIDs []string,
+) (stories []hackernews.StoryAndComments, err error) {
+	rawStories, err := s.GetRawStories(ctx, storyIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get raw stories: %w", err)
+	}
+
+	stories = make([]hackernews.StoryAndComments, 0, len(rawStories))
+
+	for _, rawStory := range rawStories {
+		if len(rawStory.Data) == 0 {
+			continue
+		}
+
+		var story hackernews.StoryAndComments
+		if err := json.Unmarshal(rawStory.Data, &story); err != nil {
+			return nil, fmt.Errorf("unmarshal: %w", err)
+		}
+
+		stories = append(stories, story)
+	}
+
+	return stories, nil
+}
+
+func (s *Storage) GetFeedsSettings(
+	ctx context.Context,
+	feeds []string,
+) (feedsSettings []hackernews.FeedSettings, err error) {
+	query := `
+		SELECT feed, profiles
+		FROM hackernews.feed_settings
+		WHERE feed = ANY($1)
+	`
+
+	rows, err := s.pool.Query(ctx, query, feeds)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var settings hackernews.FeedSettings
+
+		if err := rows.Scan(&settings.Feed, &settings.Profiles); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		feedsSettings = append(feedsSettings, settings)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return feedsSettings, nil
+}
+
+func (s *Storage) GetFeedsForScraping(ctx context.Context) (feeds []string, err error) {
+	query := `
+		SELECT DISTINCT feed
+		FROM hackernews.feed_settings
+		WHERE cardinality(profiles) > 0
+	`
+
+	rows, err := s.pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var feed string
+
+		if err := rows.Scan(&feed); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		feeds = append(feeds, feed)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return feeds, nil
+}
+
+func (s *Storage) GetAllFeedSettings(ctx context.Context) ([]hackernews.FeedSettings, error) {
+	query := `
+		SELECT feed, profiles
+		FROM hackernews.feed_settings
+		ORDER BY feed
+	`
+
+	rows, err := s.pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var settings []hackernews.FeedSettings
+
+	for rows.Next() {
+		var setting hackernews.FeedSettings
+
+		if err := rows.Scan(&setting.Feed, &setting.Profiles); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		settings = append(settings, setting)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return settings, nil
+}
+
+func (s *Storage) GetAllFeedSettingsWithProfileID(
+	ctx context.Context,
+	profileID int64,
+) ([]hackernews.FeedSettings, error) {
+	query := `
+		SELECT feed, profiles
+		FROM hackernews.feed_settings
+		WHERE $1 = ANY(profiles)
+		ORDER BY feed
+	`
+
+	rows, err := s.pool.Query(ctx, query, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var settings []hackernews.FeedSettings
+
+	for rows.Next() {
+		var setting hackernews.FeedSettings
+
+		if err := rows.Scan(&setting.Feed, &setting.Profiles); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		settings = append(settings, setting)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return settings, nil
+}
+
+func (s *Storage) AddProfilesToFeed(ctx context.Context, feed string, profileIDs []int64) error {
+	query := `
+		INSERT INTO hackernews.feed_settings (feed, profiles)
+		VALUES ($1, $2)
+		ON CONFLICT (feed)
+		DO UPDATE SET profiles = (
+			SELECT ARRAY(
+				SELECT DISTINCT unnest(hackernews.feed_settings.profiles || EXCLUDED.profiles)
+			)
+		)
+	`
+
+	if _, err := s.pool.Exec(ctx, query, feed, profileIDs); err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Storage) RemoveProfilesFromFeed(ctx context.Context, feed string, profileIDs []int64) error {
+	query := `
+		UPDATE hackernews.feed_settings
+		SET profiles = COALESCE((
+			SELECT array_agg(p)
+			FROM unnest(profiles) AS p
+			WHERE p != ALL($2)
+		), '{}')
+		WHERE feed = $1
+	`
+
+	if _, err := s.pool.Exec(ctx, query, feed, profileIDs); err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Storage) RemoveProfileFromAllFeedSettings(ctx context.Context, profileID int64) error {
+	query := `
+		UPDATE hackernews.feed_settings
+		SET profiles = COALESCE((
+			SELECT array_agg(p)
+			FROM unnest(profiles) AS p
+			WHERE p != $1
+		), '{}')
+	`
+
+	if _, err := s.pool.Exec(ctx, query, profileID); err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Storage) GetScheduledStoryIDsFromFeeds(
+	ctx context.Context,
+	feeds []string,
+	days *int,
+	limit *int,
+) ([]string, error) {
+	scheduledInFeeds := `EXISTS (
+		SELECT 1
+		FROM hackernews.story_feeds sf
+		WHERE sf.story_id = s.story_id AND sf.is_scheduled AND sf.feed = ANY(?)
+	)`
+
+	psq := tools.Psq().
+		Select("s.story_id").
+		From("hackernews.stories s").
+		Where(scheduledInFeeds, feeds).
+		OrderBy("s.story_created_at")
+
+	if days != nil {
+		cutoffDate := time.Now().AddDate(0, 0, -*days)
+
+		psq = psq.Where(sq.Gt{"s.story_created_at": cutoffDate})
+	}
+
+	if limit != nil {
+		limitValue := *limit
+		limitValue = max(0, limitValue)
+
+		//nolint:gosec // limit value can't overflow uint64
+		psq = psq.Limit(uint64(limitValue))
+	}
+
+	query, args, err := psq.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("to sql: %w", err)
+	}
+
+	rows, err := s.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+
+	defer rows.Close()
+
+	storyIDs := make([]string, 0)
+
+	for rows.Next() {
+		var storyID string
+
+		if err := rows.Scan(&storyID); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		storyIDs = append(storyIDs, storyID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return storyIDs, nil
+}
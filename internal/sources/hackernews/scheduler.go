@@ -0,0 +1,165 @@
+package hackernews
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/samber/lo"
+
+	"github.com/rishenco/scout/internal/metrics"
+	"github.com/rishenco/scout/internal/sources"
+	"github.com/rishenco/scout/internal/tracing"
+	"github.com/rishenco/scout/pkg/models"
+)
+
+type schedulerStorage interface {
+	GetFeedsSettings(ctx context.Context, feeds []string) (feedsSettings []FeedSettings, err error)
+	GetStoriesForScheduling(ctx context.Context, batchSize int, minScore int) (stories []FeedStory, err error)
+	MarkStoriesAsScheduled(ctx context.Context, stories []FeedStory) error
+}
+
+type scout interface {
+	ScheduleAnalysis(ctx context.Context, tasks []models.AnalysisTask) error
+}
+
+type Scheduler struct {
+	storage      schedulerStorage
+	scout        scout
+	batchSize    int
+	minScore     int
+	timeout      time.Duration
+	errorTimeout time.Duration
+	heartbeat    heartbeat
+	logger       zerolog.Logger
+}
+
+func NewScheduler(
+	storage schedulerStorage,
+	scout scout,
+	batchSize int,
+	minScore int,
+	timeout time.Duration,
+	errorTimeout time.Duration,
+	heartbeat heartbeat,
+	logger zerolog.Logger,
+) *Scheduler {
+	return &Scheduler{
+		storage:      storage,
+		scout:        scout,
+		batchSize:    batchSize,
+		minScore:     minScore,
+		timeout:      timeout,
+		errorTimeout: errorTimeout,
+		heartbeat:    heartbeat,
+		logger:       logger,
+	}
+}
+
+func (s *Scheduler) Start(ctx context.Context) error {
+	timeout := s.timeout
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(timeout):
+			timeout = s.timeout
+			if err := s.scheduleStories(ctx); err != nil {
+				s.logger.Error().
+					Err(err).
+					Msg("schedule stories")
+
+				s.heartbeat.Fail(err)
+
+				timeout = s.errorTimeout
+			} else {
+				s.heartbeat.Beat()
+			}
+		}
+	}
+}
+
+func (s *Scheduler) scheduleStories(ctx context.Context) (err error) {
+	ctx, span := tracing.Start(ctx, "hackernews.Scheduler.scheduleStories")
+	defer func() { tracing.End(span, err) }()
+
+	stories, err := s.storage.GetStoriesForScheduling(ctx, s.batchSize, s.minScore)
+	if err != nil {
+		return fmt.Errorf("get stories for scheduling: %w", err)
+	}
+
+	feeds := lo.Uniq(lo.FlatMap(stories, func(story FeedStory, _ int) []string {
+		return append([]string{story.Feed}, story.ScheduledFeeds...)
+	}))
+
+	feedsSettings, err := s.storage.GetFeedsSettings(ctx, feeds)
+	if err != nil {
+		return fmt.Errorf("get feeds settings: %w", err)
+	}
+
+	feedSettingsIndex := lo.SliceToMap(feedsSettings, func(setting FeedSettings) (string, FeedSettings) {
+		return setting.Feed, setting
+	})
+
+	tasks := make([]models.AnalysisTask, 0)
+
+	// a story may be in several feeds, each profile gets a single task for it
+	scheduledProfiles := make(map[string]map[int64]struct{})
+
+	for _, story := range stories {
+		feedSettings, ok := feedSettingsIndex[story.Feed]
+		if !ok {
+			s.logger.Warn().
+				Str("feed", story.Feed).
+				Msg("feed settings not found")
+
+			continue
+		}
+
+		storyProfiles, ok := scheduledProfiles[story.StoryID]
+		if !ok {
+			storyProfiles = make(map[int64]struct{})
+
+			for _, scheduledFeed := range story.ScheduledFeeds {
+				for _, profileID := range feedSettingsIndex[scheduledFeed].Profiles {
+					storyProfiles[profileID] = struct{}{}
+				}
+			}
+
+			scheduledProfiles[story.StoryID] = storyProfiles
+		}
+
+		for _, profileID := range feedSettings.Profiles {
+			if _, ok := storyProfiles[profileID]; ok {
+				continue
+			}
+
+			storyProfiles[profileID] = struct{}{}
+
+			tasks = append(tasks, models.AnalysisTask{
+				Type: models.ScheduledTaskType,
+				Parameters: models.AnalysisParameters{
+					SourceID:  story.StoryID,
+					ProfileID: profileID,
+
+					Source:     sources.HackerNewsSource,
+					ShouldSave: true,
+				},
+			})
+		}
+	}
+
+	if err := s.scout.ScheduleAnalysis(ctx, tasks); err != nil {
+		return fmt.Errorf("schedule analysis: %w", err)
+	}
+
+	metrics.ScheduledTasks.WithLabelValues(sources.HackerNewsSource).Add(float64(len(tasks)))
+
+	if err := s.storage.MarkStoriesAsScheduled(ctx, stories); err != nil {
+		return fmt.Errorf("mark stories as scheduled: %w", err)
+	}
+
+	return nil
+}
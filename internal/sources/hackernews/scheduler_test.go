@@ -0,0 +1,102 @@
+package hackernews
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/rs/zerolog"
+
+	"github.com/rishenco/scout/pkg/models"
+)
+
+type fakeSchedulerStorage struct {
+	feedsSettings []FeedSettings
+	stories       []FeedStory
+	scheduled     []FeedStory
+}
+
+func (s *fakeSchedulerStorage) GetFeedsSettings(_ context.Context, feeds []string) ([]FeedSettings, error) {
+	result := make([]FeedSettings, 0)
+
+	for _, settings := range s.feedsSettings {
+		for _, feed := range feeds {
+			if settings.Feed == feed {
+				result = append(result, settings)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func (s *fakeSchedulerStorage) GetStoriesForScheduling(context.Context, int, int) ([]FeedStory, error) {
+	return s.stories, nil
+}
+
+func (s *fakeSchedulerStorage) MarkStoriesAsScheduled(_ context.Context, stories []FeedStory) error {
+	s.scheduled = append(s.scheduled, stories...)
+
+	return nil
+}
+
+type fakeScout struct {
+	tasks []models.AnalysisTask
+}
+
+func (s *fakeScout) ScheduleAnalysis(_ context.Context, tasks []models.AnalysisTask) error {
+	s.tasks = append(s.tasks, tasks...)
+
+	return nil
+}
+
+func TestScheduler_ScheduleStories(t *testing.T) {
+	storage := &fakeSchedulerStorage{
+		feedsSettings: []FeedSettings{
+			{Feed: NewStoriesFeed, Profiles: []int64{1, 2}},
+			{Feed: TopStoriesFeed, Profiles: []int64{2, 3}},
+		},
+		stories: []FeedStory{
+			// story 10 is in both feeds, profile 2 is subscribed to both
+			{Feed: NewStoriesFeed, StoryID: "10", ScheduledFeeds: nil},
+			{Feed: TopStoriesFeed, StoryID: "10", ScheduledFeeds: nil},
+			// story 20 was scheduled in the new feed and then reached the top feed
+			{Feed: TopStoriesFeed, StoryID: "20", ScheduledFeeds: []string{NewStoriesFeed}},
+		},
+		scheduled: nil,
+	}
+
+	analysis := &fakeScout{tasks: nil}
+
+	scheduler := NewScheduler(storage, analysis, 10, 0, 0, 0, noopHeartbeat{}, zerolog.Nop())
+
+	if err := scheduler.scheduleStories(context.Background()); err != nil {
+		t.Fatalf("schedule stories: %v", err)
+	}
+
+	type storyProfile struct {
+		storyID   string
+		profileID int64
+	}
+
+	got := make([]storyProfile, 0, len(analysis.tasks))
+
+	for _, task := range analysis.tasks {
+		got = append(got, storyProfile{storyID: task.Parameters.SourceID, profileID: task.Parameters.ProfileID})
+	}
+
+	want := []storyProfile{
+		{storyID: "10", profileID: 1},
+		{storyID: "10", profileID: 2},
+		{storyID: "10", profileID: 3},
+		{storyID: "20", profileID: 3},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("tasks = %+v, want %+v", got, want)
+	}
+
+	if !reflect.DeepEqual(storage.scheduled, storage.stories) {
+		t.Errorf("scheduled stories = %+v, want all feed stories", storage.scheduled)
+	}
+}
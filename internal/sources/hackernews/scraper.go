@@ -0,0 +1,240 @@
+package hackernews
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/rishenco/scout/internal/metrics"
+	"github.com/rishenco/scout/internal/sources"
+	"github.com/rishenco/scout/internal/tracing"
+)
+
+// heartbeat reports iterations of a loop to the health registry.
+type heartbeat interface {
+	Beat()
+	Fail(err error)
+}
+
+type scraperStorage interface {
+	InsertStories(ctx context.Context, feed string, stories []Story) error
+	// CheckPresence returns ids of stories already linked to the feed.
+	CheckPresence(ctx context.Context, feed string, storyIDs []string) (presentStories map[string]struct{}, err error)
+	// AddStoriesToFeed links stories scraped from other feeds to the feed and returns ids of all stored stories.
+	AddStoriesToFeed(ctx context.Context, feed string, storyIDs []string) (storedStories map[string]struct{}, err error)
+	GetFeedsForScraping(ctx context.Context) (feeds []string, err error)
+}
+
+type scraperHackerNews interface {
+	GetStoryIDs(ctx context.Context, feed string) (storyIDs []string, err error)
+	GetStory(ctx context.Context, id string) (story Story, err error)
+}
+
+type Scraper struct {
+	hackerNews           scraperHackerNews
+	storage              scraperStorage
+	batchSize            int
+	timeout              time.Duration
+	errorTimeout         time.Duration
+	timeoutAfterFullScan time.Duration
+	heartbeat            heartbeat
+	logger               zerolog.Logger
+}
+
+// NewScraper creates a new Scraper instance.
+func NewScraper(
+	hackerNews scraperHackerNews,
+	storage scraperStorage,
+	batchSize int,
+	timeout time.Duration,
+	errorTimeout time.Duration,
+	timeoutAfterFullScan time.Duration,
+	heartbeat heartbeat,
+	logger zerolog.Logger,
+) *Scraper {
+	return &Scraper{
+		hackerNews:           hackerNews,
+		storage:              storage,
+		batchSize:            batchSize,
+		timeout:              timeout,
+		errorTimeout:         errorTimeout,
+		timeoutAfterFullScan: timeoutAfterFullScan,
+		heartbeat:            heartbeat,
+		logger:               logger,
+	}
+}
+
+// Start begins periodically reading stories from all feeds.
+func (s *Scraper) Start(ctx context.Context) error {
+	s.logger.Info().
+		Int("batch_size", s.batchSize).
+		Dur("timeout", s.timeout).
+		Dur("error_timeout", s.errorTimeout).
+		Dur("timeout_after_full_scan", s.timeoutAfterFullScan).
+		Msg("starting Hacker News reader")
+
+	// feed -> time when the feed can be scraped again
+	feedsAvailableAt := make(map[string]time.Time)
+
+	for {
+		if ctx.Err() != nil {
+			return fmt.Errorf("context error: %w", ctx.Err())
+		}
+
+		timeout := s.timeout
+
+		if err := s.scrape(ctx, feedsAvailableAt); err != nil {
+			s.logger.Error().Err(err).Msg("error updating feeds")
+
+			s.heartbeat.Fail(err)
+
+			timeout = s.errorTimeout
+		} else {
+			s.heartbeat.Beat()
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("context error: %w", ctx.Err())
+		case <-time.After(timeout):
+			continue
+		}
+	}
+}
+
+func (s *Scraper) scrape(ctx context.Context, feedsAvailableAt map[string]time.Time) (err error) {
+	ctx, span := tracing.Start(ctx, "hackernews.Scraper.scrape")
+	defer func() { tracing.End(span, err) }()
+
+	if err := s.syncFeeds(ctx, feedsAvailableAt); err != nil {
+		return fmt.Errorf("sync feeds: %w", err)
+	}
+
+	// looking for the first feed that can be scraped
+	var feed string
+
+	for feedCandidate, availableAt := range feedsAvailableAt {
+		if time.Now().Before(availableAt) {
+			continue
+		}
+
+		feed = feedCandidate
+
+		break
+	}
+
+	if feed == "" {
+		return nil
+	}
+
+	span.SetAttributes(attribute.String("scout.feed", feed))
+
+	storyIDs, err := s.hackerNews.GetStoryIDs(ctx, feed)
+	if err != nil {
+		return fmt.Errorf("get story ids: %w", err)
+	}
+
+	metrics.ScraperPagesFetched.WithLabelValues(sources.HackerNewsSource, feed).Inc()
+
+	presentStories, err := s.storage.CheckPresence(ctx, feed, storyIDs)
+	if err != nil {
+		return fmt.Errorf("check presence: %w", err)
+	}
+
+	notPresentStoryIDs := lo.Filter(storyIDs, func(id string, _ int) bool {
+		_, isPresent := presentStories[id]
+
+		return !isPresent
+	})
+
+	if len(notPresentStoryIDs) <= s.batchSize {
+		// the whole feed is going to be processed in this iteration => cooldown
+		s.logger.Info().
+			Str("feed", feed).
+			Int("new_stories", len(notPresentStoryIDs)).
+			Msg("feed is fully scanned - cooldown")
+
+		feedsAvailableAt[feed] = time.Now().Add(s.timeoutAfterFullScan)
+	} else {
+		notPresentStoryIDs = notPresentStoryIDs[:s.batchSize]
+	}
+
+	// stories seen in other feeds are already stored, they only have to be linked to this feed
+	storedStories, err := s.storage.AddStoriesToFeed(ctx, feed, notPresentStoryIDs)
+	if err != nil {
+		return fmt.Errorf("add stories to feed: %w", err)
+	}
+
+	stories := make([]Story, 0, len(notPresentStoryIDs))
+
+	for _, storyID := range notPresentStoryIDs {
+		if _, ok := storedStories[storyID]; ok {
+			s.logger.Info().Str("feed", feed).Str("story_id", storyID).Msg("linked story to feed")
+
+			continue
+		}
+
+		story, err := s.hackerNews.GetStory(ctx, storyID)
+		if err != nil {
+			return fmt.Errorf("get story %s: %w", storyID, err)
+		}
+
+		if story.Dead || story.Deleted {
+			continue
+		}
+
+		stories = append(stories, story)
+	}
+
+	if err := s.storage.InsertStories(ctx, feed, stories); err != nil {
+		return fmt.Errorf("insert: %w", err)
+	}
+
+	metrics.ScraperItemsInserted.WithLabelValues(sources.HackerNewsSource, feed).Add(float64(len(stories)))
+
+	for _, story := range stories {
+		s.logger.Info().Str("feed", feed).Str("story_id", story.ID).Msg("scraped story")
+	}
+
+	return nil
+}
+
+// syncFeeds loads all required feeds from the storage, adds present feeds and removes not present feeds.
+func (s *Scraper) syncFeeds(ctx context.Context, feedsAvailableAt map[string]time.Time) error {
+	feeds, err := s.storage.GetFeedsForScraping(ctx)
+	if err != nil {
+		return fmt.Errorf("get feeds: %w", err)
+	}
+
+	feedsIndex := lo.SliceToMap(feeds, func(feed string) (string, struct{}) {
+		return feed, struct{}{}
+	})
+
+	for feed := range feedsIndex {
+		if _, ok := feedsAvailableAt[feed]; ok {
+			continue
+		}
+
+		if !IsValidFeed(feed) {
+			s.logger.Warn().Str("feed", feed).Msg("unknown feed")
+
+			continue
+		}
+
+		feedsAvailableAt[feed] = time.Now()
+	}
+
+	for feed := range feedsAvailableAt {
+		if _, ok := feedsIndex[feed]; ok {
+			continue
+		}
+
+		delete(feedsAvailableAt, feed)
+	}
+
+	return nil
+}
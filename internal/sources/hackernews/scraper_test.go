@@ -0,0 +1,224 @@
+package hackernews
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// fakeHackerNews serves stories from memory.
+type fakeHackerNews struct {
+	feeds   map[string][]string
+	stories map[string]StoryAndComments
+}
+
+func (f *fakeHackerNews) GetStoryIDs(_ context.Context, feed string) ([]string, error) {
+	return f.feeds[feed], nil
+}
+
+func (f *fakeHackerNews) GetStory(_ context.Context, id string) (Story, error) {
+	story, ok := f.stories[id]
+	if !ok {
+		return Story{}, fmt.Errorf("story %s not found", id)
+	}
+
+	return story.Story, nil
+}
+
+func (f *fakeHackerNews) GetStoryAndComments(_ context.Context, id string) (StoryAndComments, error) {
+	story, ok := f.stories[id]
+	if !ok {
+		return StoryAndComments{}, fmt.Errorf("story %s not found", id)
+	}
+
+	return story, nil
+}
+
+// fakeStorage keeps scraped and enriched stories in memory.
+type fakeStorage struct {
+	mu       sync.Mutex
+	feeds    []string
+	inserted map[string][]Story
+	// stored are ids of stored stories, storyFeeds are ids of stories linked to each feed
+	stored     map[string]struct{}
+	storyFeeds map[string]map[string]struct{}
+	pending    []string
+	enriched   []StoryAndComments
+}
+
+func (s *fakeStorage) InsertStories(_ context.Context, feed string, stories []Story) error {
+	if s.inserted == nil {
+		s.inserted = make(map[string][]Story)
+	}
+
+	s.inserted[feed] = append(s.inserted[feed], stories...)
+
+	for _, story := range stories {
+		s.link(feed, story.ID)
+	}
+
+	return nil
+}
+
+func (s *fakeStorage) CheckPresence(_ context.Context, feed string, storyIDs []string) (map[string]struct{}, error) {
+	present := make(map[string]struct{})
+
+	for _, id := range storyIDs {
+		if _, ok := s.storyFeeds[feed][id]; ok {
+			present[id] = struct{}{}
+		}
+	}
+
+	return present, nil
+}
+
+func (s *fakeStorage) AddStoriesToFeed(_ context.Context, feed string, storyIDs []string) (map[string]struct{}, error) {
+	stored := make(map[string]struct{})
+
+	for _, id := range storyIDs {
+		if _, ok := s.stored[id]; ok {
+			stored[id] = struct{}{}
+
+			s.link(feed, id)
+		}
+	}
+
+	return stored, nil
+}
+
+func (s *fakeStorage) link(feed string, storyID string) {
+	if s.stored == nil {
+		s.stored = make(map[string]struct{})
+	}
+
+	if s.storyFeeds == nil {
+		s.storyFeeds = make(map[string]map[string]struct{})
+	}
+
+	if s.storyFeeds[feed] == nil {
+		s.storyFeeds[feed] = make(map[string]struct{})
+	}
+
+	s.stored[storyID] = struct{}{}
+	s.storyFeeds[feed][storyID] = struct{}{}
+}
+
+func (s *fakeStorage) GetFeedsForScraping(context.Context) ([]string, error) {
+	return s.feeds, nil
+}
+
+func (s *fakeStorage) GetStoriesForEnrichment(context.Context, time.Time, int) ([]string, error) {
+	return s.pending, nil
+}
+
+func (s *fakeStorage) EnrichStories(_ context.Context, stories []StoryAndComments) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.enriched = append(s.enriched, stories...)
+
+	return nil
+}
+
+type noopHeartbeat struct{}
+
+func (noopHeartbeat) Beat()      {}
+func (noopHeartbeat) Fail(error) {}
+
+func newFakeHackerNews() *fakeHackerNews {
+	return &fakeHackerNews{
+		feeds: map[string][]string{
+			TopStoriesFeed: {"1", "2", "3", "4"},
+			NewStoriesFeed: {"2", "5"},
+		},
+		stories: map[string]StoryAndComments{
+			"1": {Story: Story{ID: "1", Title: "present"}},
+			"2": {Story: Story{ID: "2", Title: "new"}, Comments: []Comment{{ID: "20", Text: "comment"}}},
+			"3": {Story: Story{ID: "3", Title: "dead", Dead: true}},
+			"4": {Story: Story{ID: "4", Title: "deleted", Deleted: true}},
+			"5": {Story: Story{ID: "5", Title: "newest"}},
+		},
+	}
+}
+
+func TestScraper_Scrape(t *testing.T) {
+	storage := &fakeStorage{feeds: []string{TopStoriesFeed, "unknown"}}
+	storage.link(TopStoriesFeed, "1")
+
+	scraper := NewScraper(newFakeHackerNews(), storage, 10, 0, 0, time.Hour, noopHeartbeat{}, zerolog.Nop())
+
+	feedsAvailableAt := make(map[string]time.Time)
+
+	if err := scraper.scrape(context.Background(), feedsAvailableAt); err != nil {
+		t.Fatalf("scrape: %v", err)
+	}
+
+	if _, ok := feedsAvailableAt["unknown"]; ok {
+		t.Error("unknown feed must not be scraped")
+	}
+
+	// present stories are skipped, dead and deleted stories are not inserted
+	inserted := storage.inserted[TopStoriesFeed]
+	if len(inserted) != 1 || inserted[0].ID != "2" {
+		t.Errorf("inserted stories = %+v, want only story 2", inserted)
+	}
+
+	// the whole feed fits into the batch, so the feed is cooled down
+	if !feedsAvailableAt[TopStoriesFeed].After(time.Now()) {
+		t.Error("fully scanned feed must be cooled down")
+	}
+}
+
+func TestScraper_ScrapeBatch(t *testing.T) {
+	storage := &fakeStorage{feeds: []string{TopStoriesFeed}}
+
+	scraper := NewScraper(newFakeHackerNews(), storage, 2, 0, 0, time.Hour, noopHeartbeat{}, zerolog.Nop())
+
+	feedsAvailableAt := make(map[string]time.Time)
+
+	if err := scraper.scrape(context.Background(), feedsAvailableAt); err != nil {
+		t.Fatalf("scrape: %v", err)
+	}
+
+	inserted := storage.inserted[TopStoriesFeed]
+	if len(inserted) != 2 || inserted[0].ID != "1" || inserted[1].ID != "2" {
+		t.Errorf("inserted stories = %+v, want stories 1 and 2", inserted)
+	}
+
+	// the rest of the feed is scraped in the next iteration
+	if feedsAvailableAt[TopStoriesFeed].After(time.Now()) {
+		t.Error("partially scanned feed must not be cooled down")
+	}
+}
+
+func TestScraper_ScrapeLinksStoriesOfOtherFeeds(t *testing.T) {
+	storage := &fakeStorage{feeds: []string{NewStoriesFeed}}
+	// story 2 was scraped from the top feed before
+	storage.link(TopStoriesFeed, "2")
+
+	scraper := NewScraper(newFakeHackerNews(), storage, 10, 0, 0, time.Hour, noopHeartbeat{}, zerolog.Nop())
+
+	if err := scraper.scrape(context.Background(), make(map[string]time.Time)); err != nil {
+		t.Fatalf("scrape: %v", err)
+	}
+
+	// stored stories are linked without fetching them again
+	inserted := storage.inserted[NewStoriesFeed]
+	if len(inserted) != 1 || inserted[0].ID != "5" {
+		t.Errorf("inserted stories = %+v, want only story 5", inserted)
+	}
+
+	for _, id := range []string{"2", "5"} {
+		if _, ok := storage.storyFeeds[NewStoriesFeed][id]; !ok {
+			t.Errorf("story %s is not linked to the new feed", id)
+		}
+	}
+
+	if _, ok := storage.storyFeeds[TopStoriesFeed]["2"]; !ok {
+		t.Error("story 2 must stay linked to the top feed")
+	}
+}
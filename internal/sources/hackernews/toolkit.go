@@ -0,0 +1,233 @@
+package hackernews
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"github.com/samber/lo"
+
+	"github.com/rishenco/scout/internal/sources"
+	"github.com/rishenco/scout/pkg/models"
+)
+
+type toolkitStorage interface {
+	GetRawStories(ctx context.Context, storyIDs []string) ([]RawStoryAndComments, error)
+	GetStories(ctx context.Context, storyIDs []string) ([]StoryAndComments, error)
+	GetAllFeedSettings(ctx context.Context) ([]FeedSettings, error)
+	GetAllFeedSettingsWithProfileID(ctx context.Context, profileID int64) ([]FeedSettings, error)
+	AddProfilesToFeed(ctx context.Context, feed string, profileIDs []int64) error
+	RemoveProfilesFromFeed(ctx context.Context, feed string, profileIDs []int64) error
+	RemoveProfileFromAllFeedSettings(ctx context.Context, profileID int64) error
+	// GetScheduledStoryIDsFromFeeds returns a list of ids of scheduled stories from feeds.
+	//
+	// feeds - feeds to get story IDs for
+	//
+	// days - how many days to go back in time to analyze. If nil, analyze all stories.
+	//
+	// limit - how many stories to analyze. If nil, analyze all stories.
+	GetScheduledStoryIDsFromFeeds(ctx context.Context, feeds []string, days *int, limit *int) ([]string, error)
+}
+
+type exampleStorage interface {
+	// GetFewShotExamples returns tagged detections of a profile chosen as examples for analyzing a story.
+	GetFewShotExamples(
+		ctx context.Context,
+		profileID int64,
+		source string,
+		sourceID string,
+		settings models.FewShotSettings,
+	) ([]models.FewShotExample, error)
+}
+
+type analyzer interface {
+	Analyze(ctx context.Context, input AnalysisInput, profileSettings models.ProfileSettings) (models.Detection, error)
+}
+
+type Toolkit struct {
+	storage        toolkitStorage
+	exampleStorage exampleStorage
+	analyzer       analyzer
+	logger         zerolog.Logger
+}
+
+func NewToolkit(
+	storage toolkitStorage,
+	exampleStorage exampleStorage,
+	analyzer analyzer,
+	logger zerolog.Logger,
+) *Toolkit {
+	return &Toolkit{
+		storage:        storage,
+		exampleStorage: exampleStorage,
+		analyzer:       analyzer,
+		logger:         logger,
+	}
+}
+
+func (t *Toolkit) Analyze(
+	ctx context.Context,
+	storyID string,
+	profileSettings models.ProfileSettings,
+) (models.Detection, error) {
+	stories, err := t.storage.GetStories(ctx, []string{storyID})
+	if err != nil {
+		return models.Detection{}, fmt.Errorf("get hacker news story: %w", err)
+	}
+
+	if len(stories) == 0 {
+		return models.Detection{}, models.NewPermanentAnalysisError(
+			models.SourceItemNotFoundReason,
+			errors.New("story not found"),
+		)
+	}
+
+	input := AnalysisInput{
+		Story:    stories[0],
+		Examples: nil,
+	}
+
+	if profileSettings.FewShot != nil {
+		input.Examples, err = t.getExamples(ctx, storyID, profileSettings.ProfileID, *profileSettings.FewShot)
+		if err != nil {
+			return models.Detection{}, fmt.Errorf("get examples: %w", err)
+		}
+	}
+
+	detection, err := t.analyzer.Analyze(ctx, input, profileSettings)
+	if err != nil {
+		return models.Detection{}, fmt.Errorf("analyze story: %w", err)
+	}
+
+	return detection, nil
+}
+
+// getExamples returns tagged stories of a profile to include in the analyzer input of a story.
+// Examples whose stories are no longer stored are skipped.
+func (t *Toolkit) getExamples(
+	ctx context.Context,
+	storyID string,
+	profileID int64,
+	settings models.FewShotSettings,
+) ([]Example, error) {
+	fewShotExamples, err := t.exampleStorage.GetFewShotExamples(
+		ctx,
+		profileID,
+		sources.HackerNewsSource,
+		storyID,
+		settings,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get few-shot examples: %w", err)
+	}
+
+	if len(fewShotExamples) == 0 {
+		return nil, nil
+	}
+
+	stories, err := t.storage.GetStories(ctx, lo.Map(fewShotExamples, func(example models.FewShotExample, _ int) string {
+		return example.SourceID
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("get example stories: %w", err)
+	}
+
+	storiesByID := lo.KeyBy(stories, func(story StoryAndComments) string {
+		return story.ID()
+	})
+
+	examples := make([]Example, 0, len(fewShotExamples))
+
+	for _, fewShotExample := range fewShotExamples {
+		story, ok := storiesByID[fewShotExample.SourceID]
+		if !ok {
+			continue
+		}
+
+		examples = append(examples, Example{
+			DetectionID: fewShotExample.DetectionID,
+			Story:       story,
+			IsRelevant:  fewShotExample.IsRelevant,
+		})
+	}
+
+	return examples, nil
+}
+
+func (t *Toolkit) GetSourcePosts(ctx context.Context, ids []string) ([]models.SourcePost, error) {
+	rawStories, err := t.storage.GetRawStories(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("get raw stories: %w", err)
+	}
+
+	posts := make([]models.SourcePost, 0, len(rawStories))
+
+	for _, rawStory := range rawStories {
+		if len(rawStory.Data) == 0 {
+			continue
+		}
+
+		posts = append(posts, models.SourcePost{
+			SourceID: rawStory.StoryID,
+			JSON:     rawStory.Data,
+		})
+	}
+
+	return posts, nil
+}
+
+func (t *Toolkit) GetAllFeedSettings(ctx context.Context) ([]FeedSettings, error) {
+	return t.storage.GetAllFeedSettings(ctx)
+}
+
+func (t *Toolkit) GetAllFeedSettingsWithProfileID(ctx context.Context, profileID int64) ([]FeedSettings, error) {
+	return t.storage.GetAllFeedSettingsWithProfileID(ctx, profileID)
+}
+
+func (t *Toolkit) AddProfilesToFeed(ctx context.Context, feed string, profileIDs []int64) error {
+	if !IsValidFeed(feed) {
+		return fmt.Errorf("unknown feed: %s", feed)
+	}
+
+	return t.storage.AddProfilesToFeed(ctx, feed, profileIDs)
+}
+
+func (t *Toolkit) RemoveProfilesFromFeed(ctx context.Context, feed string, profileIDs []int64) error {
+	return t.storage.RemoveProfilesFromFeed(ctx, feed, profileIDs)
+}
+
+func (t *Toolkit) GetScheduledSourceIDs(
+	ctx context.Context,
+	profileIDs []int64,
+	days *int,
+	limit *int,
+) ([]string, error) {
+	sourceIDs := make(map[string]struct{})
+
+	for _, profileID := range profileIDs {
+		feedsSettings, err := t.storage.GetAllFeedSettingsWithProfileID(ctx, profileID)
+		if err != nil {
+			return nil, fmt.Errorf("get feed settings: %w", err)
+		}
+
+		feeds := lo.Map(feedsSettings, func(settings FeedSettings, _ int) string {
+			return settings.Feed
+		})
+
+		storyIDs, err := t.storage.GetScheduledStoryIDsFromFeeds(ctx, feeds, days, limit)
+		if err != nil {
+			return nil, fmt.Errorf("get story IDs from feeds: %w", err)
+		}
+
+		for _, storyID := range storyIDs {
+			sourceIDs[storyID] = struct{}{}
+		}
+	}
+
+	return lo.Keys(sourceIDs), nil
+}
+
+func (t *Toolkit) DeleteProfile(ctx context.Context, profileID int64) error {
+	return t.storage.RemoveProfileFromAllFeedSettings(ctx, profileID)
+}
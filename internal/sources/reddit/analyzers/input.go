@@ -0,0 +1,118 @@
+package analyzers
+
+import (
+	"sort"
+
+	"github.com/rishenco/scout/internal/llm"
+	"github.com/rishenco/scout/internal/sources/reddit"
+	"github.com/rishenco/scout/pkg/models"
+)
+
+// prepareInput returns a function preparing analyzer inputs of posts.
+func prepareInput(maxCommentsPerPost int) llm.PrepareInput[reddit.AnalysisInput] {
+	return func(input reddit.AnalysisInput, profileSettings models.ProfileSettings) llm.Input {
+		inputObject, exampleIDs := prepareInputObject(profileSettings, input, maxCommentsPerPost)
+
+		return llm.Input{
+			Source:     input.Post.Source(),
+			SourceID:   input.Post.ID(),
+			Object:     inputObject,
+			ExampleIDs: exampleIDs,
+		}
+	}
+}
+
+// prepareInputObject returns the analyzer input of a post and ids of the examples included in it.
+func prepareInputObject(
+	profileSettings models.ProfileSettings,
+	input reddit.AnalysisInput,
+	maxCommentsPerPost int,
+) (inputObject redditInputObject, exampleIDs []int64) {
+	inputObject = redditInputObject{
+		Post:                prepareInputPostObject(input.Post),
+		Comments:            prepareInputComments(input.Post, maxCommentsPerPost),
+		RelevancyFilter:     profileSettings.RelevancyFilter,
+		ExtractedProperties: profileSettings.ExtractedProperties,
+		Examples:            nil,
+	}
+
+	if profileSettings.FewShot != nil {
+		inputObject.Examples, exampleIDs = prepareInputExamples(
+			input.Examples,
+			profileSettings.FewShot.MaxTokens,
+			maxCommentsPerPost,
+		)
+	}
+
+	return inputObject, exampleIDs
+}
+
+// prepareInputExamples converts examples in their order while they fit into the token budget.
+// Examples that don't fit into the rest of the budget are skipped.
+func prepareInputExamples(
+	examples []reddit.Example,
+	maxTokens int,
+	maxCommentsPerPost int,
+) (inputExamples []redditInputExampleObject, exampleIDs []int64) {
+	tokens := 0
+
+	for _, example := range examples {
+		inputExample := redditInputExampleObject{
+			Post:       prepareInputPostObject(example.Post),
+			Comments:   prepareInputComments(example.Post, maxCommentsPerPost),
+			IsRelevant: example.IsRelevant,
+		}
+
+		exampleTokens := estimateExampleTokens(inputExample)
+		if tokens+exampleTokens > maxTokens {
+			continue
+		}
+
+		tokens += exampleTokens
+
+		inputExamples = append(inputExamples, inputExample)
+		exampleIDs = append(exampleIDs, example.DetectionID)
+	}
+
+	return inputExamples, exampleIDs
+}
+
+func estimateExampleTokens(example redditInputExampleObject) int {
+	parts := []string{example.Post.Title, example.Post.Body, example.Post.Link}
+
+	for _, comment := range example.Comments {
+		parts = append(parts, comment.Comment)
+	}
+
+	return llm.EstimateTokens(parts...)
+}
+
+func prepareInputPostObject(post reddit.PostAndComments) redditInputPostObject {
+	return redditInputPostObject{
+		Title: post.Post.Title,
+		Body:  post.Post.Body,
+		Score: post.Post.Score,
+		Link:  post.Post.URL,
+	}
+}
+
+// prepareInputComments returns the top scored comments of a post.
+func prepareInputComments(post reddit.PostAndComments, maxCommentsPerPost int) []redditInputCommentObject {
+	// Sort comments by score in descending order
+	sort.Slice(post.Comments, func(i, j int) bool {
+		return post.Comments[i].Score > post.Comments[j].Score
+	})
+
+	comments := make([]redditInputCommentObject, 0)
+
+	commentsCount := min(len(post.Comments), maxCommentsPerPost)
+
+	for _, comment := range post.Comments[:commentsCount] {
+		comments = append(comments, redditInputCommentObject{
+			Comment: comment.Body,
+			Score:   comment.Score,
+		})
+	}
+
+	return comments
+}
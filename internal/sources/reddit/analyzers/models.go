@@ -1,10 +1,5 @@
 package analyzers
 
-type searchResult struct {
-	IsRelevant bool              `json:"is_relevant"`
-	Properties map[string]string `json:"properties"`
-}
-
 type redditInputPostObject struct {
 	Title string `json:"title"`
 	Body  string `json:"body"`
@@ -22,4 +17,12 @@ type redditInputObject struct {
 	Comments            []redditInputCommentObject `json:"comments"`
 	RelevancyFilter     string                     `json:"relevancy_filter"`
 	ExtractedProperties map[string]string          `json:"extracted_props"`
+	Examples            []redditInputExampleObject `json:"examples,omitempty"`
+}
+
+// redditInputExampleObject is a human-tagged post with its correct relevance.
+type redditInputExampleObject struct {
+	Post       redditInputPostObject      `json:"post"`
+	Comments   []redditInputCommentObject `json:"comments"`
+	IsRelevant bool                       `json:"is_relevant"`
 }
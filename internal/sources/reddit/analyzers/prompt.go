@@ -11,6 +11,7 @@ You are given a Reddit post, a relevancy filter, and a list of properties to ext
 Relevancy filter is a comprehensive description that outlines the context, objectives, and detailed requirements the post must satisfy to be considered relevant.
 Extracted properties are the pieces of information that you must extract from the post (do not rely on the property name, use its definition as an instruction for the extraction). All properties must be present in the output.
 Your task is to match the provided Reddit post against the relevancy filter and if the post is relevant you must extract corresponding properties from the post.
+Examples, if present, are other Reddit posts with their relevance confirmed by a human. Use them to understand how the relevancy filter must be applied, but never extract properties from the examples.
 You must output the extracted information precisely as described in the <output-format> section.
 </instructions>
 
@@ -31,7 +32,14 @@ Your input is a Reddit post provided as a JSON object structured as follows:
     "extracted_properties": {
         "property1_name": "Property 1 description",
         "property2_name": "Property 2 description"
-    }
+    },
+    "examples": [ // optional
+        {
+            "post": { "title": "Example post's title", "body": "Example post's body", "score": 42, "link": "Example post's link" },
+            "comments": [{ "comment": "Comment text", "score": "Comment score" }],
+            "is_relevant": true // correct relevance of the example post
+        }
+    ]
 }
 </input-format>
 
@@ -39,10 +47,12 @@ Your input is a Reddit post provided as a JSON object structured as follows:
 You must output the results as a JSON object in the following structure:
 {
     "is_relevant": true/false, // true if the post is relevant according to the relevancy filter, otherwise false
+    "confidence": 0.8, // your confidence in the relevance decision from 0 (a guess) to 1 (certain)
+    "rationale": "Short rationale", // one or two sentences explaining why the post is relevant or not
     "properties": {
         "[property1_name]": "Extracted property 1 data",
         "[property2_name]": "Extracted property 2 data"
-    }
+    } // each property value must match its type from the response schema (string, number, boolean, list or null)
 }
 </output-format>
 `
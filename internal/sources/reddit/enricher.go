@@ -8,6 +8,12 @@ import (
 
 	"github.com/rs/zerolog"
 	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/rishenco/scout/internal/metrics"
+	"github.com/rishenco/scout/internal/sources"
+	"github.com/rishenco/scout/internal/tracing"
 )
 
 type enricherStorage interface {
@@ -28,6 +34,7 @@ type Enricher struct {
 	errorTimeout  time.Duration
 	retries       int
 	workersAmount int
+	heartbeat     heartbeat
 	logger        zerolog.Logger
 }
 
@@ -40,6 +47,7 @@ func NewEnricher(
 	errorTimeout time.Duration,
 	retries int,
 	workersAmount int,
+	heartbeat heartbeat,
 	logger zerolog.Logger,
 ) *Enricher {
 	return &Enricher{
@@ -51,6 +59,7 @@ func NewEnricher(
 		errorTimeout:  errorTimeout,
 		retries:       retries,
 		workersAmount: workersAmount,
+		heartbeat:     heartbeat,
 		logger:        logger,
 	}
 }
@@ -66,7 +75,11 @@ func (e *Enricher) Start(ctx context.Context) error {
 		if err := e.enrichPosts(ctx); err != nil {
 			e.logger.Error().Err(err).Msg("error enriching posts")
 
+			e.heartbeat.Fail(err)
+
 			timeout = e.errorTimeout
+		} else {
+			e.heartbeat.Beat()
 		}
 
 		select {
@@ -112,7 +125,11 @@ func (e *Enricher) enrichPosts(ctx context.Context) error {
 
 	posts := lo.ChannelToSlice(postsChan)
 
-	if err := e.savePosts(ctx, posts); err != nil {
+	err = e.savePosts(ctx, posts)
+
+	metrics.EnrichedItems.WithLabelValues(sources.RedditSource, metrics.Result(err)).Add(float64(len(posts)))
+
+	if err != nil {
 		return fmt.Errorf("save posts: %w", err)
 	}
 
@@ -135,6 +152,8 @@ func (e *Enricher) postLoaderWorker(ctx context.Context, postIDsChan <-chan stri
 			if err != nil {
 				e.logger.Error().Err(err).Msg("error loading post")
 
+				metrics.EnrichedItems.WithLabelValues(sources.RedditSource, metrics.FailureResult).Inc()
+
 				continue
 			}
 
@@ -146,6 +165,12 @@ func (e *Enricher) postLoaderWorker(ctx context.Context, postIDsChan <-chan stri
 }
 
 func (e *Enricher) loadPost(ctx context.Context, postID string) (PostAndComments, error) {
+	ctx, span := tracing.Start(
+		ctx,
+		"reddit.Enricher.loadPost",
+		trace.WithAttributes(attribute.String("scout.source_id", postID)),
+	)
+
 	var result PostAndComments
 
 	err := e.retry(
@@ -164,6 +189,8 @@ func (e *Enricher) loadPost(ctx context.Context, postID string) (PostAndComments
 		e.errorTimeout,
 	)
 
+	tracing.End(span, err)
+
 	if err != nil {
 		return PostAndComments{}, fmt.Errorf("load post: %w", err)
 	}
@@ -19,6 +19,49 @@ type SubredditSettings struct {
 	Subreddit string  `json:"subreddit"`
 }
 
+// SubredditScrapeState is a persisted pagination state of the scraper for a subreddit.
+type SubredditScrapeState struct {
+	Subreddit string
+	// Next is reddit's pagination token of the next page. Empty means scraping from the first page.
+	Next string
+	// AvailableAt is a time when the next page can be scraped.
+	AvailableAt time.Time
+	// LastScrapedAt is a time of the last successfully scraped page. Nil if the subreddit was never scraped.
+	LastScrapedAt *time.Time
+	// LastFullScanAt is a time when all available posts of the subreddit were scanned. Nil if it never happened.
+	LastFullScanAt *time.Time
+	LastError      *string
+	LastErrorAt    *time.Time
+}
+
+// NewSubredditScrapeState returns a state of a subreddit that was never scraped.
+func NewSubredditScrapeState(subreddit string) SubredditScrapeState {
+	return SubredditScrapeState{
+		Subreddit:      subreddit,
+		Next:           "",
+		AvailableAt:    time.Now(),
+		LastScrapedAt:  nil,
+		LastFullScanAt: nil,
+		LastError:      nil,
+		LastErrorAt:    nil,
+	}
+}
+
+// AnalysisInput is a post to analyze with examples for few-shot prompting.
+type AnalysisInput struct {
+	Post PostAndComments
+	// Examples are human-tagged posts of the profile. Empty if few-shot prompting is disabled.
+	Examples []Example
+}
+
+// Example is a human-tagged post with its confirmed relevance.
+type Example struct {
+	// DetectionID is an id of the tagged detection of the post.
+	DetectionID int64
+	Post        PostAndComments
+	IsRelevant  bool
+}
+
 // All models below are almost exact copies of the original models from the reddit library.
 // We need them to avoid troubles with the json marshalling/unmarshalling.
 
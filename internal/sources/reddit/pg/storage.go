@@ -531,3 +531,129 @@ func (s *Storage) GetScheduledPostIDsFromSubreddits(
 
 	return postIDs, nil
 }
+
+// GetSubredditScrapeStates returns persisted scrape states of subreddits.
+// Subreddits that were never scraped are missing in the result.
+func (s *Storage) GetSubredditScrapeStates(
+	ctx context.Context,
+	subreddits []string,
+) ([]reddit.SubredditScrapeState, error) {
+	query := `
+		SELECT subreddit, next_page, available_at, last_scraped_at, last_full_scan_at, last_error, last_error_at
+		FROM reddit.subreddit_scrape_state
+		WHERE subreddit = ANY($1)
+	`
+
+	rows, err := s.pool.Query(ctx, query, subreddits)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var states []reddit.SubredditScrapeState
+
+	for rows.Next() {
+		var state reddit.SubredditScrapeState
+
+		err := rows.Scan(
+			&state.Subreddit,
+			&state.Next,
+			&state.AvailableAt,
+			&state.LastScrapedAt,
+			&state.LastFullScanAt,
+			&state.LastError,
+			&state.LastErrorAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		states = append(states, state)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return states, nil
+}
+
+// GetAllSubredditScrapeStates returns scrape states of all subreddits with settings.
+// Subreddits that were never scraped get a state of NewSubredditScrapeState.
+func (s *Storage) GetAllSubredditScrapeStates(ctx context.Context) ([]reddit.SubredditScrapeState, error) {
+	query := `
+		SELECT ss.subreddit, COALESCE(st.next_page, ''), COALESCE(st.available_at, NOW()),
+			st.last_scraped_at, st.last_full_scan_at, st.last_error, st.last_error_at
+		FROM reddit.subreddit_settings ss
+		LEFT JOIN reddit.subreddit_scrape_state st ON st.subreddit = ss.subreddit
+		ORDER BY ss.subreddit
+	`
+
+	rows, err := s.pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var states []reddit.SubredditScrapeState
+
+	for rows.Next() {
+		var state reddit.SubredditScrapeState
+
+		err := rows.Scan(
+			&state.Subreddit,
+			&state.Next,
+			&state.AvailableAt,
+			&state.LastScrapedAt,
+			&state.LastFullScanAt,
+			&state.LastError,
+			&state.LastErrorAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		states = append(states, state)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return states, nil
+}
+
+func (s *Storage) SaveSubredditScrapeState(ctx context.Context, state reddit.SubredditScrapeState) error {
+	query := `
+		INSERT INTO reddit.subreddit_scrape_state (
+			subreddit, next_page, available_at, last_scraped_at, last_full_scan_at, last_error, last_error_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW())
+		ON CONFLICT (subreddit)
+		DO UPDATE SET
+			next_page = EXCLUDED.next_page,
+			available_at = EXCLUDED.available_at,
+			last_scraped_at = EXCLUDED.last_scraped_at,
+			last_full_scan_at = EXCLUDED.last_full_scan_at,
+			last_error = EXCLUDED.last_error,
+			last_error_at = EXCLUDED.last_error_at,
+			updated_at = NOW()
+	`
+
+	_, err := s.pool.Exec(
+		ctx,
+		query,
+		state.Subreddit,
+		state.Next,
+		state.AvailableAt,
+		state.LastScrapedAt,
+		state.LastFullScanAt,
+		state.LastError,
+		state.LastErrorAt,
+	)
+	if err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
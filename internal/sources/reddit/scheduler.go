@@ -8,7 +8,9 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/samber/lo"
 
+	"github.com/rishenco/scout/internal/metrics"
 	"github.com/rishenco/scout/internal/sources"
+	"github.com/rishenco/scout/internal/tracing"
 	"github.com/rishenco/scout/pkg/models"
 )
 
@@ -29,6 +31,7 @@ type Scheduler struct {
 	minScore     int
 	timeout      time.Duration
 	errorTimeout time.Duration
+	heartbeat    heartbeat
 	logger       zerolog.Logger
 }
 
@@ -39,6 +42,7 @@ func NewScheduler(
 	minScore int,
 	timeout time.Duration,
 	errorTimeout time.Duration,
+	heartbeat heartbeat,
 	logger zerolog.Logger,
 ) *Scheduler {
 	return &Scheduler{
@@ -48,6 +52,7 @@ func NewScheduler(
 		minScore:     minScore,
 		timeout:      timeout,
 		errorTimeout: errorTimeout,
+		heartbeat:    heartbeat,
 		logger:       logger,
 	}
 }
@@ -66,13 +71,20 @@ func (s *Scheduler) Start(ctx context.Context) error {
 					Err(err).
 					Msg("schedule posts")
 
+				s.heartbeat.Fail(err)
+
 				timeout = s.errorTimeout
+			} else {
+				s.heartbeat.Beat()
 			}
 		}
 	}
 }
 
-func (s *Scheduler) schedulePosts(ctx context.Context) error {
+func (s *Scheduler) schedulePosts(ctx context.Context) (err error) {
+	ctx, span := tracing.Start(ctx, "reddit.Scheduler.schedulePosts")
+	defer func() { tracing.End(span, err) }()
+
 	redditPosts, err := s.storage.GetPostsForScheduling(ctx, s.batchSize, s.minScore)
 	if err != nil {
 		return fmt.Errorf("get posts for scheduling: %w", err)
@@ -126,6 +138,8 @@ func (s *Scheduler) schedulePosts(ctx context.Context) error {
 		return fmt.Errorf("schedule analysis: %w", err)
 	}
 
+	metrics.ScheduledTasks.WithLabelValues(sources.RedditSource).Add(float64(len(tasks)))
+
 	postIDs := lo.Map(redditPosts, func(post PostAndComments, _ int) string {
 		return post.Post.ID
 	})
@@ -2,19 +2,33 @@ package reddit
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/rs/zerolog"
 	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/rishenco/scout/internal/metrics"
+	"github.com/rishenco/scout/internal/sources"
+	"github.com/rishenco/scout/internal/tracing"
 )
 
 const maxPostsPerRequest = 100
 
+// heartbeat reports iterations of a loop to the health registry.
+type heartbeat interface {
+	Beat()
+	Fail(err error)
+}
+
 type scraperStorage interface {
 	InsertPosts(ctx context.Context, posts []Post) error
 	CheckPresence(ctx context.Context, postIDs []string) (presentPosts map[string]struct{}, err error)
 	GetSubredditsForScraping(ctx context.Context) (subreddits []string, err error)
+	GetSubredditScrapeStates(ctx context.Context, subreddits []string) ([]SubredditScrapeState, error)
+	SaveSubredditScrapeState(ctx context.Context, state SubredditScrapeState) error
 }
 
 type scraperReddit interface {
@@ -28,6 +42,7 @@ type Scraper struct {
 	errorTimeout                  time.Duration
 	timeoutAfterFullScan          time.Duration
 	forceAtLeastOneExhaustingScan bool
+	heartbeat                     heartbeat
 	logger                        zerolog.Logger
 }
 
@@ -39,6 +54,7 @@ func NewScraper(
 	errorTimeout time.Duration,
 	timeoutAfterFullScan time.Duration,
 	forceAtLeastOneExhaustingScan bool,
+	heartbeat heartbeat,
 	logger zerolog.Logger,
 ) *Scraper {
 	return &Scraper{
@@ -48,6 +64,7 @@ func NewScraper(
 		errorTimeout:                  errorTimeout,
 		timeoutAfterFullScan:          timeoutAfterFullScan,
 		forceAtLeastOneExhaustingScan: forceAtLeastOneExhaustingScan,
+		heartbeat:                     heartbeat,
 		logger:                        logger,
 	}
 }
@@ -72,7 +89,11 @@ func (s *Scraper) Start(ctx context.Context) error {
 		if err := s.scrape(ctx, paginator); err != nil {
 			s.logger.Error().Err(err).Msg("error updating subreddits")
 
+			s.heartbeat.Fail(err)
+
 			timeout = s.errorTimeout
+		} else {
+			s.heartbeat.Beat()
 		}
 
 		select {
@@ -84,7 +105,10 @@ func (s *Scraper) Start(ctx context.Context) error {
 	}
 }
 
-func (s *Scraper) scrape(ctx context.Context, paginator *paginator) error {
+func (s *Scraper) scrape(ctx context.Context, paginator *paginator) (err error) {
+	ctx, span := tracing.Start(ctx, "reddit.Scraper.scrape")
+	defer func() { tracing.End(span, err) }()
+
 	if err := s.syncSubreddits(ctx, paginator); err != nil {
 		return fmt.Errorf("sync subreddits: %w", err)
 	}
@@ -92,8 +116,8 @@ func (s *Scraper) scrape(ctx context.Context, paginator *paginator) error {
 	// looking for the first subreddit that can be scraped
 	var subreddit string
 
-	for subredditCandidate, pagination := range paginator.subreddits {
-		if time.Now().Before(pagination.availableAt) {
+	for subredditCandidate, state := range paginator.subreddits {
+		if time.Now().Before(state.AvailableAt) {
 			continue
 		}
 
@@ -107,32 +131,54 @@ func (s *Scraper) scrape(ctx context.Context, paginator *paginator) error {
 		return nil
 	}
 
-	// loading the next page of the subreddit
+	span.SetAttributes(attribute.String("scout.subreddit", subreddit))
+
+	state, scrapeErr := s.scrapeSubreddit(ctx, paginator.subreddits[subreddit])
+	if scrapeErr != nil {
+		state.LastError = lo.ToPtr(scrapeErr.Error())
+		state.LastErrorAt = lo.ToPtr(time.Now())
+	}
+
+	paginator.subreddits[subreddit] = state
+
+	if err := s.storage.SaveSubredditScrapeState(ctx, state); err != nil {
+		return errors.Join(scrapeErr, fmt.Errorf("save scrape state: %w", err))
+	}
+
+	return scrapeErr
+}
+
+// scrapeSubreddit loads the next page of a subreddit and returns the updated state of the subreddit.
+// On error the state is returned unchanged.
+func (s *Scraper) scrapeSubreddit(ctx context.Context, state SubredditScrapeState) (SubredditScrapeState, error) {
+	subreddit := state.Subreddit
 
 	redditPosts, nextPage, err := s.reddit.GetPosts(
 		ctx,
 		subreddit,
-		paginator.subreddits[subreddit].next,
+		state.Next,
 		maxPostsPerRequest,
 	)
 	if err != nil {
-		return fmt.Errorf("get posts: %w", err)
+		return state, fmt.Errorf("get posts: %w", err)
 	}
 
+	metrics.ScraperPagesFetched.WithLabelValues(sources.RedditSource, subreddit).Inc()
+
+	now := time.Now()
+
 	if len(redditPosts) == 0 {
 		// no posts, meaning we've reached the end of the subreddit (at least the end of posts available from API)
 		s.logger.Info().
 			Str("subreddit", subreddit).
 			Msg("no posts, meaning we've exhausted the subreddit")
 
-		paginator.subreddits[subreddit] = subredditPagination{
-			next:        "",
-			availableAt: time.Now().Add(s.timeoutAfterFullScan),
-		}
+		state.Next = ""
+		state.AvailableAt = now.Add(s.timeoutAfterFullScan)
+		state.LastScrapedAt = &now
+		state.LastFullScanAt = &now
 
-		paginator.alreadyFullyScanned[subreddit] = struct{}{}
-
-		return nil
+		return state, nil
 	}
 
 	ids := lo.Map(redditPosts, func(post Post, _ int) string {
@@ -141,7 +187,7 @@ func (s *Scraper) scrape(ctx context.Context, paginator *paginator) error {
 
 	presentPosts, err := s.storage.CheckPresence(ctx, ids)
 	if err != nil {
-		return fmt.Errorf("are processed: %w", err)
+		return state, fmt.Errorf("are processed: %w", err)
 	}
 
 	notPresentPosts := lo.Filter(redditPosts, func(post Post, _ int) bool {
@@ -153,42 +199,42 @@ func (s *Scraper) scrape(ctx context.Context, paginator *paginator) error {
 	if len(notPresentPosts) == 0 {
 		// we've reached the page we've processed => we can stop
 
-		_, fullyScanned := paginator.alreadyFullyScanned[subreddit]
+		fullyScanned := state.LastFullScanAt != nil
 
 		if fullyScanned || !s.forceAtLeastOneExhaustingScan {
 			s.logger.Info().
 				Str("subreddit", subreddit).
 				Msg("we've reached the page without new posts - cooldown and scanning from the beginning")
 
-			paginator.subreddits[subreddit] = subredditPagination{
-				next:        "",
-				availableAt: time.Now().Add(s.timeoutAfterFullScan),
-			}
+			state.Next = ""
+			state.AvailableAt = now.Add(s.timeoutAfterFullScan)
+			state.LastScrapedAt = &now
 
-			return nil
+			return state, nil
 		}
 
 		// if at least one exhausting scan is required we have to continue scraping
 	}
 
 	if err := s.storage.InsertPosts(ctx, notPresentPosts); err != nil {
-		return fmt.Errorf("insert: %w", err)
+		return state, fmt.Errorf("insert: %w", err)
 	}
 
-	paginator.subreddits[subreddit] = subredditPagination{
-		next:        nextPage,
-		availableAt: time.Now(),
-	}
+	metrics.ScraperItemsInserted.WithLabelValues(sources.RedditSource, subreddit).Add(float64(len(notPresentPosts)))
+
+	state.Next = nextPage
+	state.AvailableAt = now
+	state.LastScrapedAt = &now
 
 	for _, post := range notPresentPosts {
 		s.logger.Info().Str("post_id", post.ID).Msg("scraped post")
 	}
 
-	return nil
+	return state, nil
 }
 
 // syncSubreddits loads all required subreddits from the storage, adds present subreddits
-// and removes not present subreddits.
+// with their persisted scrape states and removes not present subreddits.
 func (s *Scraper) syncSubreddits(ctx context.Context, p *paginator) error {
 	subreddits, err := s.storage.GetSubredditsForScraping(ctx)
 	if err != nil {
@@ -199,14 +245,29 @@ func (s *Scraper) syncSubreddits(ctx context.Context, p *paginator) error {
 		return subreddit, struct{}{}
 	})
 
-	for subreddit := range subredditsIndex {
-		if _, ok := p.subreddits[subreddit]; ok {
-			continue
+	newSubreddits := lo.Filter(subreddits, func(subreddit string, _ int) bool {
+		_, ok := p.subreddits[subreddit]
+
+		return !ok
+	})
+
+	if len(newSubreddits) > 0 {
+		states, err := s.storage.GetSubredditScrapeStates(ctx, newSubreddits)
+		if err != nil {
+			return fmt.Errorf("get scrape states: %w", err)
 		}
 
-		p.subreddits[subreddit] = subredditPagination{
-			next:        "",
-			availableAt: time.Now(),
+		statesIndex := lo.SliceToMap(states, func(state SubredditScrapeState) (string, SubredditScrapeState) {
+			return state.Subreddit, state
+		})
+
+		for _, subreddit := range newSubreddits {
+			state, ok := statesIndex[subreddit]
+			if !ok {
+				state = NewSubredditScrapeState(subreddit)
+			}
+
+			p.subreddits[subreddit] = state
 		}
 	}
 
@@ -223,20 +284,11 @@ func (s *Scraper) syncSubreddits(ctx context.Context, p *paginator) error {
 
 func newPaginator() *paginator {
 	return &paginator{
-		subreddits:          make(map[string]subredditPagination),
-		alreadyFullyScanned: make(map[string]struct{}),
+		subreddits: make(map[string]SubredditScrapeState),
 	}
 }
 
 type paginator struct {
-	subreddits map[string]subredditPagination
-	// needed to ensure at least one exhausting scan
-	alreadyFullyScanned map[string]struct{}
-}
-
-type subredditPagination struct {
-	// reddit's pagination token
-	next string
-	// time when the next page can be scraped
-	availableAt time.Time
+	// scrape states of subreddits, LastFullScanAt is needed to ensure at least one exhausting scan
+	subreddits map[string]SubredditScrapeState
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/samber/lo"
 
+	"github.com/rishenco/scout/internal/sources"
 	"github.com/rishenco/scout/pkg/models"
 )
 
@@ -19,6 +20,7 @@ type toolkitStorage interface {
 	AddProfilesToSubreddit(ctx context.Context, subreddit string, profileIDs []int64) error
 	RemoveProfilesFromSubreddit(ctx context.Context, subreddit string, profileIDs []int64) error
 	RemoveProfileFromAllSubredditSettings(ctx context.Context, profileID int64) error
+	GetAllSubredditScrapeStates(ctx context.Context) ([]SubredditScrapeState, error)
 	// GetPostIDsWithSubreddits returns a list of ids of posts from subreddits.
 	//
 	// subreddits - subreddits to get post IDs for
@@ -29,21 +31,39 @@ type toolkitStorage interface {
 	GetScheduledPostIDsFromSubreddits(ctx context.Context, subreddits []string, days *int, limit *int) ([]string, error)
 }
 
+type exampleStorage interface {
+	// GetFewShotExamples returns tagged detections of a profile chosen as examples for analyzing a post.
+	GetFewShotExamples(
+		ctx context.Context,
+		profileID int64,
+		source string,
+		sourceID string,
+		settings models.FewShotSettings,
+	) ([]models.FewShotExample, error)
+}
+
 type analyzer interface {
-	Analyze(ctx context.Context, post PostAndComments, profileSettings models.ProfileSettings) (models.Detection, error)
+	Analyze(ctx context.Context, input AnalysisInput, profileSettings models.ProfileSettings) (models.Detection, error)
 }
 
 type Toolkit struct {
-	storage  toolkitStorage
-	analyzer analyzer
-	logger   zerolog.Logger
+	storage        toolkitStorage
+	exampleStorage exampleStorage
+	analyzer       analyzer
+	logger         zerolog.Logger
 }
 
-func NewToolkit(storage toolkitStorage, analyzer analyzer, logger zerolog.Logger) *Toolkit {
+func NewToolkit(
+	storage toolkitStorage,
+	exampleStorage exampleStorage,
+	analyzer analyzer,
+	logger zerolog.Logger,
+) *Toolkit {
 	return &Toolkit{
-		storage:  storage,
-		analyzer: analyzer,
-		logger:   logger,
+		storage:        storage,
+		exampleStorage: exampleStorage,
+		analyzer:       analyzer,
+		logger:         logger,
 	}
 }
 
@@ -58,10 +78,25 @@ func (t *Toolkit) Analyze(
 	}
 
 	if len(posts) == 0 {
-		return models.Detection{}, errors.New("post not found")
+		return models.Detection{}, models.NewPermanentAnalysisError(
+			models.SourceItemNotFoundReason,
+			errors.New("post not found"),
+		)
+	}
+
+	input := AnalysisInput{
+		Post:     posts[0],
+		Examples: nil,
 	}
 
-	detection, err := t.analyzer.Analyze(ctx, posts[0], profileSettings)
+	if profileSettings.FewShot != nil {
+		input.Examples, err = t.getExamples(ctx, postID, profileSettings.ProfileID, *profileSettings.FewShot)
+		if err != nil {
+			return models.Detection{}, fmt.Errorf("get examples: %w", err)
+		}
+	}
+
+	detection, err := t.analyzer.Analyze(ctx, input, profileSettings)
 	if err != nil {
 		return models.Detection{}, fmt.Errorf("analyze post: %w", err)
 	}
@@ -69,6 +104,52 @@ func (t *Toolkit) Analyze(
 	return detection, nil
 }
 
+// getExamples returns tagged posts of a profile to include in the analyzer input of a post.
+// Examples whose posts are no longer stored are skipped.
+func (t *Toolkit) getExamples(
+	ctx context.Context,
+	postID string,
+	profileID int64,
+	settings models.FewShotSettings,
+) ([]Example, error) {
+	fewShotExamples, err := t.exampleStorage.GetFewShotExamples(ctx, profileID, sources.RedditSource, postID, settings)
+	if err != nil {
+		return nil, fmt.Errorf("get few-shot examples: %w", err)
+	}
+
+	if len(fewShotExamples) == 0 {
+		return nil, nil
+	}
+
+	posts, err := t.storage.GetPosts(ctx, lo.Map(fewShotExamples, func(example models.FewShotExample, _ int) string {
+		return example.SourceID
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("get example posts: %w", err)
+	}
+
+	postsByID := lo.KeyBy(posts, func(post PostAndComments) string {
+		return post.ID()
+	})
+
+	examples := make([]Example, 0, len(fewShotExamples))
+
+	for _, fewShotExample := range fewShotExamples {
+		post, ok := postsByID[fewShotExample.SourceID]
+		if !ok {
+			continue
+		}
+
+		examples = append(examples, Example{
+			DetectionID: fewShotExample.DetectionID,
+			Post:        post,
+			IsRelevant:  fewShotExample.IsRelevant,
+		})
+	}
+
+	return examples, nil
+}
+
 func (t *Toolkit) GetSourcePosts(ctx context.Context, ids []string) ([]models.SourcePost, error) {
 	rawPosts, err := t.storage.GetRawPosts(ctx, ids)
 	if err != nil {
@@ -112,6 +193,11 @@ func (t *Toolkit) RemoveProfilesFromSubreddit(ctx context.Context, subreddit str
 	return t.storage.RemoveProfilesFromSubreddit(ctx, subreddit, profileIDs)
 }
 
+// GetAllSubredditScrapeStates returns scrape progress of all subreddits.
+func (t *Toolkit) GetAllSubredditScrapeStates(ctx context.Context) ([]SubredditScrapeState, error) {
+	return t.storage.GetAllSubredditScrapeStates(ctx)
+}
+
 func (t *Toolkit) GetScheduledSourceIDs(
 	ctx context.Context,
 	profileIDs []int64,
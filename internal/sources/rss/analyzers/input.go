@@ -0,0 +1,99 @@
+package analyzers
+
+import (
+	"github.com/rishenco/scout/internal/llm"
+	"github.com/rishenco/scout/internal/sources/rss"
+	"github.com/rishenco/scout/pkg/models"
+)
+
+// prepareInput returns a function preparing analyzer inputs of feed entries.
+func prepareInput(maxContentLength int) llm.PrepareInput[rss.AnalysisInput] {
+	return func(input rss.AnalysisInput, profileSettings models.ProfileSettings) llm.Input {
+		inputObject, exampleIDs := prepareInputObject(profileSettings, input, maxContentLength)
+
+		return llm.Input{
+			Source:     input.Entry.Source(),
+			SourceID:   input.Entry.ID,
+			Object:     inputObject,
+			ExampleIDs: exampleIDs,
+		}
+	}
+}
+
+// prepareInputObject returns the analyzer input of an entry and ids of the examples included in it.
+func prepareInputObject(
+	profileSettings models.ProfileSettings,
+	input rss.AnalysisInput,
+	maxContentLength int,
+) (inputObject rssInputObject, exampleIDs []int64) {
+	inputObject = rssInputObject{
+		Entry:               prepareInputEntryObject(input.Entry, maxContentLength),
+		RelevancyFilter:     profileSettings.RelevancyFilter,
+		ExtractedProperties: profileSettings.ExtractedProperties,
+		Examples:            nil,
+	}
+
+	if profileSettings.FewShot != nil {
+		inputObject.Examples, exampleIDs = prepareInputExamples(
+			input.Examples,
+			profileSettings.FewShot.MaxTokens,
+			maxContentLength,
+		)
+	}
+
+	return inputObject, exampleIDs
+}
+
+// prepareInputExamples converts examples in their order while they fit into the token budget.
+// Examples that don't fit into the rest of the budget are skipped.
+func prepareInputExamples(
+	examples []rss.Example,
+	maxTokens int,
+	maxContentLength int,
+) (inputExamples []rssInputExampleObject, exampleIDs []int64) {
+	tokens := 0
+
+	for _, example := range examples {
+		inputExample := rssInputExampleObject{
+			Entry:      prepareInputEntryObject(example.Entry, maxContentLength),
+			IsRelevant: example.IsRelevant,
+		}
+
+		exampleTokens := llm.EstimateTokens(
+			inputExample.Entry.Title,
+			inputExample.Entry.Content,
+			inputExample.Entry.Link,
+		)
+		if tokens+exampleTokens > maxTokens {
+			continue
+		}
+
+		tokens += exampleTokens
+
+		inputExamples = append(inputExamples, inputExample)
+		exampleIDs = append(exampleIDs, example.DetectionID)
+	}
+
+	return inputExamples, exampleIDs
+}
+
+func prepareInputEntryObject(entry rss.Entry, maxContentLength int) rssInputEntryObject {
+	content := entry.Content
+	if content == "" {
+		content = entry.Description
+	}
+
+	// feeds with full-text content may be huge, only the beginning of the entry is analyzed
+	if maxContentLength > 0 && len([]rune(content)) > maxContentLength {
+		content = string([]rune(content)[:maxContentLength])
+	}
+
+	return rssInputEntryObject{
+		Feed:       entry.FeedTitle,
+		Title:      entry.Title,
+		Content:    content,
+		Author:     entry.Author,
+		Categories: entry.Categories,
+		Link:       entry.Link,
+	}
+}
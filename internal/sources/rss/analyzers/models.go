@@ -0,0 +1,23 @@
+package analyzers
+
+type rssInputEntryObject struct {
+	Feed       string   `json:"feed"`
+	Title      string   `json:"title"`
+	Content    string   `json:"content"`
+	Author     string   `json:"author"`
+	Categories []string `json:"categories"`
+	Link       string   `json:"link"`
+}
+
+type rssInputObject struct {
+	Entry               rssInputEntryObject     `json:"entry"`
+	RelevancyFilter     string                  `json:"relevancy_filter"`
+	ExtractedProperties map[string]string       `json:"extracted_props"`
+	Examples            []rssInputExampleObject `json:"examples,omitempty"`
+}
+
+// rssInputExampleObject is a human-tagged entry with its correct relevance.
+type rssInputExampleObject struct {
+	Entry      rssInputEntryObject `json:"entry"`
+	IsRelevant bool                `json:"is_relevant"`
+}
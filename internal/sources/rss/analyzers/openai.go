@@ -0,0 +1,39 @@
+package analyzers
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+
+	"github.com/rishenco/scout/internal/llm"
+	"github.com/rishenco/scout/internal/openai"
+	"github.com/rishenco/scout/internal/sources/rss"
+)
+
+type chatCompletionsClient interface {
+	CreateChatCompletion(
+		ctx context.Context,
+		request openai.ChatCompletionRequest,
+	) (openai.ChatCompletionResponse, error)
+}
+
+// NewOpenAICompatible creates an analyzer of feed entries using any server implementing OpenAI Chat Completions API
+// with JSON schema response format (OpenAI, llama.cpp, Ollama, vLLM, etc.).
+func NewOpenAICompatible(
+	client chatCompletionsClient,
+	settings llm.OpenAISettings,
+	requestsLog requestsLog,
+	limiter limiter,
+	maxContentLength int,
+	logger zerolog.Logger,
+) *llm.OpenAIAnalyzer[rss.AnalysisInput] {
+	return llm.NewOpenAIAnalyzer(
+		client,
+		settings,
+		Prompt,
+		prepareInput(maxContentLength),
+		requestsLog,
+		limiter,
+		logger,
+	)
+}
@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/rs/zerolog"
+	"github.com/samber/lo"
+
+	"github.com/rishenco/scout/internal/sources/rss"
+)
+
+type requestsLog interface {
+	Save(ctx context.Context, requestType string, request any, response any) error
+}
+
+const (
+	requestTimeout = 30 * time.Second
+	userAgent      = "scout/1.0"
+)
+
+// Client loads and parses RSS and Atom feeds.
+type Client struct {
+	parser      *gofeed.Parser
+	requestsLog requestsLog
+
+	logger zerolog.Logger
+}
+
+func New(requestsLog requestsLog, logger zerolog.Logger) *Client {
+	parser := gofeed.NewParser()
+	parser.Client = &http.Client{Timeout: requestTimeout}
+	parser.UserAgent = userAgent
+
+	return &Client{
+		parser:      parser,
+		requestsLog: requestsLog,
+		logger:      logger,
+	}
+}
+
+// GetEntries loads a feed and returns its entries in the order they are listed in the feed.
+func (c *Client) GetEntries(ctx context.Context, feedURL string) ([]rss.Entry, error) {
+	feed, err := c.parser.ParseURLWithContext(feedURL, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("parse feed: %w", err)
+	}
+
+	entries := EntriesFromFeed(feedURL, feed)
+
+	err = c.requestsLog.Save(
+		ctx,
+		"get_entries",
+		map[string]any{
+			"feed_url": feedURL,
+		},
+		entries,
+	)
+	if err != nil {
+		c.logger.Error().Err(err).Msg("failed to save request log")
+	}
+
+	return entries, nil
+}
+
+// EntriesFromFeed converts a parsed feed to entries.
+//
+// Items without GUID fall back to their link, items with neither GUID nor link are skipped.
+func EntriesFromFeed(feedURL string, feed *gofeed.Feed) []rss.Entry {
+	entries := make([]rss.Entry, 0, len(feed.Items))
+
+	for _, item := range feed.Items {
+		if item == nil {
+			continue
+		}
+
+		guid := lo.CoalesceOrEmpty(item.GUID, item.Link)
+		if guid == "" {
+			continue
+		}
+
+		published := item.PublishedParsed
+		if published == nil {
+			published = item.UpdatedParsed
+		}
+
+		var author string
+		if len(item.Authors) > 0 && item.Authors[0] != nil {
+			author = item.Authors[0].Name
+		}
+
+		entries = append(entries, rss.Entry{
+			ID:          rss.EntryID(feedURL, guid),
+			GUID:        guid,
+			FeedURL:     feedURL,
+			FeedTitle:   feed.Title,
+			Title:       item.Title,
+			Link:        item.Link,
+			Description: item.Description,
+			Content:     item.Content,
+			Author:      author,
+			Categories:  item.Categories,
+			Published:   published,
+		})
+	}
+
+	return entries
+}
@@ -0,0 +1,217 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/rs/zerolog"
+
+	"github.com/rishenco/scout/internal/sources/rss"
+)
+
+type noopRequestsLog struct{}
+
+func (noopRequestsLog) Save(context.Context, string, any, any) error {
+	return nil
+}
+
+func parseFixture(t *testing.T, name string) *gofeed.Feed {
+	t.Helper()
+
+	file, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("open fixture: %v", err)
+	}
+
+	defer func() { _ = file.Close() }()
+
+	feed, err := gofeed.NewParser().Parse(file)
+	if err != nil {
+		t.Fatalf("parse fixture: %v", err)
+	}
+
+	return feed
+}
+
+func timePtr(t *testing.T, value string) *time.Time {
+	t.Helper()
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		t.Fatalf("parse time: %v", err)
+	}
+
+	return &parsed
+}
+
+func TestEntriesFromFeed(t *testing.T) {
+	const feedURL = "https://example.com/feed"
+
+	tests := []struct {
+		name    string
+		fixture string
+		want    []rss.Entry
+	}{
+		{
+			name:    "rss 2.0",
+			fixture: "rss2.xml",
+			want: []rss.Entry{
+				{
+					ID:          rss.EntryID(feedURL, "urn:example:1"),
+					GUID:        "urn:example:1",
+					FeedURL:     feedURL,
+					FeedTitle:   "Example Blog",
+					Title:       "Entry with GUID",
+					Link:        "https://blog.example.com/guid",
+					Description: "Short description",
+					Content:     "<p>Full content</p>",
+					Author:      "Alice",
+					Categories:  []string{"go", "rss"},
+					Published:   timePtr(t, "2023-11-14T22:13:20Z"),
+				},
+				{
+					// GUID falls back to the link
+					ID:          rss.EntryID(feedURL, "https://blog.example.com/link"),
+					GUID:        "https://blog.example.com/link",
+					FeedURL:     feedURL,
+					FeedTitle:   "Example Blog",
+					Title:       "Entry without GUID",
+					Link:        "https://blog.example.com/link",
+					Description: "Falls back to the link",
+					Content:     "",
+					Author:      "",
+					Categories:  nil,
+					Published:   timePtr(t, "2023-11-15T10:00:00Z"),
+				},
+				// the entry with neither GUID nor link is skipped
+			},
+		},
+		{
+			name:    "atom",
+			fixture: "atom.xml",
+			want: []rss.Entry{
+				{
+					ID:          rss.EntryID(feedURL, "urn:example:atom:1"),
+					GUID:        "urn:example:atom:1",
+					FeedURL:     feedURL,
+					FeedTitle:   "Example Atom Feed",
+					Title:       "Published entry",
+					Link:        "https://atom.example.com/1",
+					Description: "Summary of the entry",
+					Content:     "<p>Content of the entry</p>",
+					Author:      "Bob",
+					Categories:  nil,
+					Published:   timePtr(t, "2023-11-14T22:13:20Z"),
+				},
+				{
+					// entries without a published date fall back to the updated date
+					ID:          rss.EntryID(feedURL, "urn:example:atom:2"),
+					GUID:        "urn:example:atom:2",
+					FeedURL:     feedURL,
+					FeedTitle:   "Example Atom Feed",
+					Title:       "Updated only entry",
+					Link:        "https://atom.example.com/2",
+					Description: "Entry without a published date",
+					Content:     "",
+					Author:      "",
+					Categories:  nil,
+					Published:   timePtr(t, "2023-11-16T12:00:00Z"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := EntriesFromFeed(feedURL, parseFixture(t, tt.fixture))
+
+			if len(entries) != len(tt.want) {
+				t.Fatalf("entries = %d, want %d: %+v", len(entries), len(tt.want), entries)
+			}
+
+			for i := range entries {
+				if !entries[i].Published.Equal(*tt.want[i].Published) {
+					t.Errorf("entry %d published = %v, want %v", i, entries[i].Published, tt.want[i].Published)
+				}
+
+				entries[i].Published = tt.want[i].Published
+
+				if !reflect.DeepEqual(entries[i], tt.want[i]) {
+					t.Errorf("entry %d = %+v, want %+v", i, entries[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestEntriesFromFeed_EntryIDStability(t *testing.T) {
+	const (
+		feedURL      = "https://example.com/feed"
+		otherFeedURL = "https://example.org/feed"
+	)
+
+	tests := []struct {
+		name    string
+		feedURL string
+		sameIDs bool
+	}{
+		{name: "same feed", feedURL: feedURL, sameIDs: true},
+		{name: "other feed", feedURL: otherFeedURL, sameIDs: false},
+	}
+
+	entries := EntriesFromFeed(feedURL, parseFixture(t, "rss2.xml"))
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the feed is parsed again as it is on the next scrape
+			reparsed := EntriesFromFeed(tt.feedURL, parseFixture(t, "rss2.xml"))
+
+			if len(reparsed) != len(entries) {
+				t.Fatalf("entries = %d, want %d", len(reparsed), len(entries))
+			}
+
+			for i := range entries {
+				if reparsed[i].GUID != entries[i].GUID {
+					t.Errorf("entry %d guid = %q, want %q", i, reparsed[i].GUID, entries[i].GUID)
+				}
+
+				if sameID := reparsed[i].ID == entries[i].ID; sameID != tt.sameIDs {
+					t.Errorf("entry %d: same id = %t, want %t", i, sameID, tt.sameIDs)
+				}
+			}
+		})
+	}
+}
+
+func TestClient_GetEntries(t *testing.T) {
+	fixture, err := os.ReadFile(filepath.Join("testdata", "atom.xml"))
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/atom+xml")
+		_, _ = w.Write(fixture)
+	}))
+	t.Cleanup(server.Close)
+
+	entries, err := New(noopRequestsLog{}, zerolog.Nop()).GetEntries(context.Background(), server.URL)
+	if err != nil {
+		t.Fatalf("get entries: %v", err)
+	}
+
+	if len(entries) != 2 {
+		t.Fatalf("entries = %d, want 2", len(entries))
+	}
+
+	if entries[0].FeedURL != server.URL || entries[0].ID != rss.EntryID(server.URL, entries[0].GUID) {
+		t.Errorf("unexpected entry: %+v", entries[0])
+	}
+}
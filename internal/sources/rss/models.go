@@ -0,0 +1,96 @@
+package rss
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/rishenco/scout/internal/sources"
+)
+
+var ErrInvalidFeedURL = errors.New("invalid feed url")
+
+// ValidateFeedURL checks that a feed URL is an absolute http(s) URL.
+func ValidateFeedURL(feedURL string) error {
+	parsedURL, err := url.Parse(feedURL)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidFeedURL, err)
+	}
+
+	if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+		return fmt.Errorf("%w: unsupported scheme %q", ErrInvalidFeedURL, parsedURL.Scheme)
+	}
+
+	if parsedURL.Host == "" {
+		return fmt.Errorf("%w: empty host", ErrInvalidFeedURL)
+	}
+
+	return nil
+}
+
+// EntryID builds a stable entry identifier from a feed URL and an entry GUID.
+//
+// GUIDs are only guaranteed to be unique within a single feed, so the feed URL is a part of the identifier.
+func EntryID(feedURL string, guid string) string {
+	hash := sha256.Sum256([]byte(feedURL + "\n" + guid))
+
+	return hex.EncodeToString(hash[:16])
+}
+
+type RawEntry struct {
+	Data    []byte `json:"data"`
+	EntryID string `json:"entry_id"`
+}
+
+type FeedSettings struct {
+	Profiles []int64 `json:"profiles"`
+	FeedURL  string  `json:"feed_url"`
+}
+
+// FeedEntry is an entry scraped from a feed.
+type FeedEntry struct {
+	FeedURL string `json:"feed_url"`
+	EntryID string `json:"entry_id"`
+}
+
+// Entry is an item of an RSS feed or an entry of an Atom feed.
+type Entry struct {
+	ID   string `json:"id"`
+	GUID string `json:"guid"`
+
+	FeedURL   string `json:"feed_url"`
+	FeedTitle string `json:"feed_title,omitempty"`
+
+	Title string `json:"title,omitempty"`
+	Link  string `json:"link,omitempty"`
+	// Description is a summary of the entry. May contain HTML.
+	Description string `json:"description,omitempty"`
+	// Content is a full content of the entry. May contain HTML. Empty if the feed provides only descriptions.
+	Content    string   `json:"content,omitempty"`
+	Author     string   `json:"author,omitempty"`
+	Categories []string `json:"categories,omitempty"`
+
+	Published *time.Time `json:"published_at,omitempty"`
+}
+
+func (e Entry) Source() string {
+	return sources.RSSSource
+}
+
+// AnalysisInput is an entry to analyze with examples for few-shot prompting.
+type AnalysisInput struct {
+	Entry Entry
+	// Examples are human-tagged entries of the profile. Empty if few-shot prompting is disabled.
+	Examples []Example
+}
+
+// Example is a human-tagged entry with its confirmed relevance.
+type Example struct {
+	// DetectionID is an id of the tagged detection of the entry.
+	DetectionID int64
+	Entry       Entry
+	IsRelevant  bool
+}
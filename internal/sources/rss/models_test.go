@@ -0,0 +1,48 @@
+package rss
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateFeedURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		feedURL string
+		valid   bool
+	}{
+		{name: "https", feedURL: "https://blog.example.com/feed.xml", valid: true},
+		{name: "http with port", feedURL: "http://localhost:8080/rss", valid: true},
+		{name: "unsupported scheme", feedURL: "ftp://example.com/feed", valid: false},
+		{name: "no scheme", feedURL: "example.com/feed", valid: false},
+		{name: "empty host", feedURL: "https:///feed", valid: false},
+		{name: "malformed", feedURL: "https://exa mple.com/%zz", valid: false},
+		{name: "empty", feedURL: "", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateFeedURL(tt.feedURL)
+
+			if tt.valid && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			if !tt.valid && !errors.Is(err, ErrInvalidFeedURL) {
+				t.Errorf("error = %v, want %v", err, ErrInvalidFeedURL)
+			}
+		})
+	}
+}
+
+func TestEntryID(t *testing.T) {
+	// stored entries are looked up by this value, so the format must not change
+	if got, want := EntryID("https://example.com/feed", "guid-1"), "7ade495aefccc1a8ac6b6c9c65414694"; got != want {
+		t.Errorf("EntryID() = %s, want %s", got, want)
+	}
+
+	// the separator keeps the feed URL and the GUID apart
+	if EntryID("https://example.com/feed", "1") == EntryID("https://example.com/feed1", "") {
+		t.Error("entry ids of different entries are equal")
+	}
+}
@@ -0,0 +1,427 @@
+package pg
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+
+	"github.com/rishenco/scout/internal/sources/rss"
+	"github.com/rishenco/scout/internal/tools"
+)
+
+type Storage struct {
+	pool   *pgxpool.Pool
+	logger zerolog.Logger
+}
+
+func NewStorage(pool *pgxpool.Pool, logger zerolog.Logger) *Storage {
+	return &Storage{
+		pool:   pool,
+		logger: logger,
+	}
+}
+
+func (s *Storage) InsertEntries(ctx context.Context, entries []rss.Entry) (inserted int, err error) {
+	if len(entries) == 0 {
+		return 0, nil
+	}
+
+	insertQuery := tools.Psq().
+		Insert("rss.entries").
+		Columns(
+			"entry_id",
+			"feed_url",
+			"guid",
+			"entry_json",
+			"entry_published_at",
+		).
+		Suffix("ON CONFLICT (feed_url, guid) DO NOTHING")
+
+	for _, entry := range entries {
+		marshalledEntry, err := json.Marshal(entry)
+		if err != nil {
+			return 0, fmt.Errorf("marshal entry: %w", err)
+		}
+
+		publishedAt := time.Now()
+		if entry.Published != nil {
+			publishedAt = *entry.Published
+		}
+
+		insertQuery = insertQuery.Values(
+			entry.ID,
+			entry.FeedURL,
+			entry.GUID,
+			marshalledEntry,
+			publishedAt,
+		)
+	}
+
+	query, args, err := insertQuery.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("to sql: %w", err)
+	}
+
+	tag, err := s.pool.Exec(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("exec: %w", err)
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
+func (s *Storage) MarkEntriesAsScheduled(ctx context.Context, entryIDs []string) error {
+	updateQuery := `
+		UPDATE rss.entries
+		SET is_scheduled = true,
+			scheduled_at = now()
+		WHERE entry_id = ANY($1)
+	`
+
+	if _, err := s.pool.Exec(ctx, updateQuery, entryIDs); err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Storage) GetEntriesForScheduling(
+	ctx context.Context,
+	batchSize int,
+) (entries []rss.FeedEntry, err error) {
+	query := `
+		SELECT feed_url, entry_id
+		FROM rss.entries
+		WHERE NOT is_scheduled
+		ORDER BY entry_published_at
+		LIMIT $1
+	`
+
+	rows, err := s.pool.Query(ctx, query, batchSize)
+	if err != nil {
+		return nil, fmt.Errorf("select: %w", err)
+	}
+
+	defer rows.Close()
+
+	entries = make([]rss.FeedEntry, 0)
+
+	for rows.Next() {
+		var entry rss.FeedEntry
+
+		if err := rows.Scan(&entry.FeedURL, &entry.EntryID); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return entries, nil
+}
+
+func (s *Storage) GetRawEntries(ctx context.Context, entryIDs []string) (entries []rss.RawEntry, err error) {
+	query := `
+		SELECT entry_id, entry_json
+		FROM rss.entries
+		WHERE entry_id = ANY($1)
+	`
+
+	rows, err := s.pool.Query(ctx, query, entryIDs)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+
+	defer rows.Close()
+
+	entries = make([]rss.RawEntry, 0)
+
+	for rows.Next() {
+		var rawEntry rss.RawEntry
+
+		if err := rows.Scan(&rawEntry.EntryID, &rawEntry.Data); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		entries = append(entries, rawEntry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return entries, nil
+}
+
+func (s *Storage) GetEntries(ctx context.Context, entryIDs []string) (entries []rss.Entry, err error) {
+	rawEntries, err := s.GetRawEntries(ctx, entryIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get raw entries: %w", err)
+	}
+
+	entries = make([]rss.Entry, 0, len(rawEntries))
+
+	for _, rawEntry := range rawEntries {
+		var entry rss.Entry
+		if err := json.Unmarshal(rawEntry.Data, &entry); err != nil {
+			return nil, fmt.Errorf("unmarshal: %w", err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func (s *Storage) GetFeedsSettings(
+	ctx context.Context,
+	feedURLs []string,
+) (feedsSettings []rss.FeedSettings, err error) {
+	query := `
+		SELECT feed_url, profiles
+		FROM rss.feed_settings
+		WHERE feed_url = ANY($1)
+	`
+
+	rows, err := s.pool.Query(ctx, query, feedURLs)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var settings rss.FeedSettings
+
+		if err := rows.Scan(&settings.FeedURL, &settings.Profiles); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		feedsSettings = append(feedsSettings, settings)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return feedsSettings, nil
+}
+
+func (s *Storage) GetFeedsForScraping(ctx context.Context) (feedURLs []string, err error) {
+	query := `
+		SELECT DISTINCT feed_url
+		FROM rss.feed_settings
+		WHERE cardinality(profiles) > 0
+	`
+
+	rows, err := s.pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var feedURL string
+
+		if err := rows.Scan(&feedURL); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		feedURLs = append(feedURLs, feedURL)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return feedURLs, nil
+}
+
+func (s *Storage) GetAllFeedSettings(ctx context.Context) ([]rss.FeedSettings, error) {
+	query := `
+		SELECT feed_url, profiles
+		FROM rss.feed_settings
+		ORDER BY feed_url
+	`
+
+	rows, err := s.pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var settings []rss.FeedSettings
+
+	for rows.Next() {
+		var setting rss.FeedSettings
+
+		if err := rows.Scan(&setting.FeedURL, &setting.Profiles); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		settings = append(settings, setting)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return settings, nil
+}
+
+func (s *Storage) GetAllFeedSettingsWithProfileID(
+	ctx context.Context,
+	profileID int64,
+) ([]rss.FeedSettings, error) {
+	query := `
+		SELECT feed_url, profiles
+		FROM rss.feed_settings
+		WHERE $1 = ANY(profiles)
+		ORDER BY feed_url
+	`
+
+	rows, err := s.pool.Query(ctx, query, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	var settings []rss.FeedSettings
+
+	for rows.Next() {
+		var setting rss.FeedSettings
+
+		if err := rows.Scan(&setting.FeedURL, &setting.Profiles); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		settings = append(settings, setting)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return settings, nil
+}
+
+func (s *Storage) AddProfilesToFeed(ctx context.Context, feedURL string, profileIDs []int64) error {
+	query := `
+		INSERT INTO rss.feed_settings (feed_url, profiles)
+		VALUES ($1, $2)
+		ON CONFLICT (feed_url)
+		DO UPDATE SET profiles = (
+			SELECT ARRAY(
+				SELECT DISTINCT unnest(rss.feed_settings.profiles || EXCLUDED.profiles)
+			)
+		)
+	`
+
+	if _, err := s.pool.Exec(ctx, query, feedURL, profileIDs); err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Storage) RemoveProfilesFromFeed(ctx context.Context, feedURL string, profileIDs []int64) error {
+	query := `
+		UPDATE rss.feed_settings
+		SET profiles = COALESCE((
+			SELECT array_agg(p)
+			FROM unnest(profiles) AS p
+			WHERE p != ALL($2)
+		), '{}')
+		WHERE feed_url = $1
+	`
+
+	if _, err := s.pool.Exec(ctx, query, feedURL, profileIDs); err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Storage) RemoveProfileFromAllFeedSettings(ctx context.Context, profileID int64) error {
+	query := `
+		UPDATE rss.feed_settings
+		SET profiles = COALESCE((
+			SELECT array_agg(p)
+			FROM unnest(profiles) AS p
+			WHERE p != $1
+		), '{}')
+	`
+
+	if _, err := s.pool.Exec(ctx, query, profileID); err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Storage) GetScheduledEntryIDsFromFeeds(
+	ctx context.Context,
+	feedURLs []string,
+	days *int,
+	limit *int,
+) ([]string, error) {
+	psq := tools.Psq().
+		Select("entry_id").
+		From("rss.entries").
+		Where(sq.Eq{"feed_url": feedURLs}).
+		Where(sq.Eq{"is_scheduled": true}).
+		OrderBy("entry_published_at")
+
+	if days != nil {
+		cutoffDate := time.Now().AddDate(0, 0, -*days)
+
+		psq = psq.Where(sq.Gt{"entry_published_at": cutoffDate})
+	}
+
+	if limit != nil {
+		limitValue := *limit
+		limitValue = max(0, limitValue)
+
+		//nolint:gosec // limit value can't overflow uint64
+		psq = psq.Limit(uint64(limitValue))
+	}
+
+	query, args, err := psq.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("to sql: %w", err)
+	}
+
+	rows, err := s.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+
+	defer rows.Close()
+
+	entryIDs := make([]string, 0)
+
+	for rows.Next() {
+		var entryID string
+
+		if err := rows.Scan(&entryID); err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+
+		entryIDs = append(entryIDs, entryID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return entryIDs, nil
+}
@@ -0,0 +1,144 @@
+package rss
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/samber/lo"
+
+	"github.com/rishenco/scout/internal/metrics"
+	"github.com/rishenco/scout/internal/sources"
+	"github.com/rishenco/scout/internal/tracing"
+	"github.com/rishenco/scout/pkg/models"
+)
+
+type schedulerStorage interface {
+	GetFeedsSettings(ctx context.Context, feedURLs []string) (feedsSettings []FeedSettings, err error)
+	GetEntriesForScheduling(ctx context.Context, batchSize int) (entries []FeedEntry, err error)
+	MarkEntriesAsScheduled(ctx context.Context, entryIDs []string) error
+}
+
+type scout interface {
+	ScheduleAnalysis(ctx context.Context, tasks []models.AnalysisTask) error
+}
+
+type Scheduler struct {
+	storage      schedulerStorage
+	scout        scout
+	batchSize    int
+	timeout      time.Duration
+	errorTimeout time.Duration
+	heartbeat    heartbeat
+	logger       zerolog.Logger
+}
+
+func NewScheduler(
+	storage schedulerStorage,
+	scout scout,
+	batchSize int,
+	timeout time.Duration,
+	errorTimeout time.Duration,
+	heartbeat heartbeat,
+	logger zerolog.Logger,
+) *Scheduler {
+	return &Scheduler{
+		storage:      storage,
+		scout:        scout,
+		batchSize:    batchSize,
+		timeout:      timeout,
+		errorTimeout: errorTimeout,
+		heartbeat:    heartbeat,
+		logger:       logger,
+	}
+}
+
+func (s *Scheduler) Start(ctx context.Context) error {
+	timeout := s.timeout
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(timeout):
+			timeout = s.timeout
+			if err := s.scheduleEntries(ctx); err != nil {
+				s.logger.Error().
+					Err(err).
+					Msg("schedule entries")
+
+				s.heartbeat.Fail(err)
+
+				timeout = s.errorTimeout
+			} else {
+				s.heartbeat.Beat()
+			}
+		}
+	}
+}
+
+func (s *Scheduler) scheduleEntries(ctx context.Context) (err error) {
+	ctx, span := tracing.Start(ctx, "rss.Scheduler.scheduleEntries")
+	defer func() { tracing.End(span, err) }()
+
+	entries, err := s.storage.GetEntriesForScheduling(ctx, s.batchSize)
+	if err != nil {
+		return fmt.Errorf("get entries for scheduling: %w", err)
+	}
+
+	feedURLs := lo.Uniq(lo.Map(entries, func(entry FeedEntry, _ int) string {
+		return entry.FeedURL
+	}))
+
+	feedsSettings, err := s.storage.GetFeedsSettings(ctx, feedURLs)
+	if err != nil {
+		return fmt.Errorf("get feeds settings: %w", err)
+	}
+
+	feedSettingsIndex := lo.SliceToMap(feedsSettings, func(setting FeedSettings) (string, FeedSettings) {
+		return setting.FeedURL, setting
+	})
+
+	tasks := make([]models.AnalysisTask, 0)
+
+	for _, entry := range entries {
+		feedSettings, ok := feedSettingsIndex[entry.FeedURL]
+		if !ok {
+			s.logger.Warn().
+				Str("feed_url", entry.FeedURL).
+				Msg("feed settings not found")
+
+			continue
+		}
+
+		for _, profileID := range feedSettings.Profiles {
+			tasks = append(tasks, models.AnalysisTask{
+				Type: models.ScheduledTaskType,
+				Parameters: models.AnalysisParameters{
+					SourceID:  entry.EntryID,
+					ProfileID: profileID,
+
+					Source:     sources.RSSSource,
+					ShouldSave: true,
+				},
+			})
+		}
+	}
+
+	if err := s.scout.ScheduleAnalysis(ctx, tasks); err != nil {
+		return fmt.Errorf("schedule analysis: %w", err)
+	}
+
+	metrics.ScheduledTasks.WithLabelValues(sources.RSSSource).Add(float64(len(tasks)))
+
+	entryIDs := lo.Map(entries, func(entry FeedEntry, _ int) string {
+		return entry.EntryID
+	})
+
+	if err := s.storage.MarkEntriesAsScheduled(ctx, entryIDs); err != nil {
+		return fmt.Errorf("mark entries as scheduled: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package rss
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/rishenco/scout/internal/metrics"
+	"github.com/rishenco/scout/internal/sources"
+	"github.com/rishenco/scout/internal/tracing"
+)
+
+// heartbeat reports iterations of a loop to the health registry.
+type heartbeat interface {
+	Beat()
+	Fail(err error)
+}
+
+type scraperStorage interface {
+	// InsertEntries saves new entries of a feed. Entries that are already present are skipped.
+	InsertEntries(ctx context.Context, entries []Entry) (inserted int, err error)
+	GetFeedsForScraping(ctx context.Context) (feedURLs []string, err error)
+}
+
+type scraperFeeds interface {
+	GetEntries(ctx context.Context, feedURL string) (entries []Entry, err error)
+}
+
+// Scraper periodically loads all subscribed feeds and saves their new entries.
+type Scraper struct {
+	feeds           scraperFeeds
+	storage         scraperStorage
+	timeout         time.Duration
+	errorTimeout    time.Duration
+	refreshInterval time.Duration
+	heartbeat       heartbeat
+	logger          zerolog.Logger
+}
+
+// NewScraper creates a new Scraper instance.
+func NewScraper(
+	feeds scraperFeeds,
+	storage scraperStorage,
+	timeout time.Duration,
+	errorTimeout time.Duration,
+	refreshInterval time.Duration,
+	heartbeat heartbeat,
+	logger zerolog.Logger,
+) *Scraper {
+	return &Scraper{
+		feeds:           feeds,
+		storage:         storage,
+		timeout:         timeout,
+		errorTimeout:    errorTimeout,
+		refreshInterval: refreshInterval,
+		heartbeat:       heartbeat,
+		logger:          logger,
+	}
+}
+
+// Start begins periodically reading entries from all feeds.
+func (s *Scraper) Start(ctx context.Context) error {
+	s.logger.Info().
+		Dur("timeout", s.timeout).
+		Dur("error_timeout", s.errorTimeout).
+		Dur("refresh_interval", s.refreshInterval).
+		Msg("starting feeds reader")
+
+	// feed url -> time when the feed can be scraped again
+	feedsAvailableAt := make(map[string]time.Time)
+
+	for {
+		if ctx.Err() != nil {
+			return fmt.Errorf("context error: %w", ctx.Err())
+		}
+
+		timeout := s.timeout
+
+		if err := s.scrape(ctx, feedsAvailableAt); err != nil {
+			s.logger.Error().Err(err).Msg("error updating feeds")
+
+			s.heartbeat.Fail(err)
+
+			timeout = s.errorTimeout
+		} else {
+			s.heartbeat.Beat()
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("context error: %w", ctx.Err())
+		case <-time.After(timeout):
+			continue
+		}
+	}
+}
+
+func (s *Scraper) scrape(ctx context.Context, feedsAvailableAt map[string]time.Time) (err error) {
+	ctx, span := tracing.Start(ctx, "rss.Scraper.scrape")
+	defer func() { tracing.End(span, err) }()
+
+	if err := s.syncFeeds(ctx, feedsAvailableAt); err != nil {
+		return fmt.Errorf("sync feeds: %w", err)
+	}
+
+	// looking for the first feed that can be scraped
+	var feedURL string
+
+	for feedCandidate, availableAt := range feedsAvailableAt {
+		if time.Now().Before(availableAt) {
+			continue
+		}
+
+		feedURL = feedCandidate
+
+		break
+	}
+
+	if feedURL == "" {
+		return nil
+	}
+
+	span.SetAttributes(attribute.String("scout.feed", feedURL))
+
+	// the feed is postponed before loading, so a broken feed does not block the others
+	feedsAvailableAt[feedURL] = time.Now().Add(s.refreshInterval)
+
+	entries, err := s.feeds.GetEntries(ctx, feedURL)
+	if err != nil {
+		return fmt.Errorf("get entries of %s: %w", feedURL, err)
+	}
+
+	metrics.ScraperPagesFetched.WithLabelValues(sources.RSSSource, feedURL).Inc()
+
+	inserted, err := s.storage.InsertEntries(ctx, entries)
+	if err != nil {
+		return fmt.Errorf("insert: %w", err)
+	}
+
+	metrics.ScraperItemsInserted.WithLabelValues(sources.RSSSource, feedURL).Add(float64(inserted))
+
+	s.logger.Info().
+		Str("feed_url", feedURL).
+		Int("entries", len(entries)).
+		Int("new_entries", inserted).
+		Msg("scraped feed")
+
+	return nil
+}
+
+// syncFeeds loads all required feeds from the storage, adds present feeds and removes not present feeds.
+func (s *Scraper) syncFeeds(ctx context.Context, feedsAvailableAt map[string]time.Time) error {
+	feedURLs, err := s.storage.GetFeedsForScraping(ctx)
+	if err != nil {
+		return fmt.Errorf("get feeds: %w", err)
+	}
+
+	feedsIndex := lo.SliceToMap(feedURLs, func(feedURL string) (string, struct{}) {
+		return feedURL, struct{}{}
+	})
+
+	for feedURL := range feedsIndex {
+		if _, ok := feedsAvailableAt[feedURL]; ok {
+			continue
+		}
+
+		feedsAvailableAt[feedURL] = time.Now()
+	}
+
+	for feedURL := range feedsAvailableAt {
+		if _, ok := feedsIndex[feedURL]; ok {
+			continue
+		}
+
+		delete(feedsAvailableAt, feedURL)
+	}
+
+	return nil
+}
@@ -0,0 +1,223 @@
+package rss
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"github.com/samber/lo"
+
+	"github.com/rishenco/scout/internal/sources"
+	"github.com/rishenco/scout/pkg/models"
+)
+
+type toolkitStorage interface {
+	GetRawEntries(ctx context.Context, entryIDs []string) ([]RawEntry, error)
+	GetEntries(ctx context.Context, entryIDs []string) ([]Entry, error)
+	GetAllFeedSettings(ctx context.Context) ([]FeedSettings, error)
+	GetAllFeedSettingsWithProfileID(ctx context.Context, profileID int64) ([]FeedSettings, error)
+	AddProfilesToFeed(ctx context.Context, feedURL string, profileIDs []int64) error
+	RemoveProfilesFromFeed(ctx context.Context, feedURL string, profileIDs []int64) error
+	RemoveProfileFromAllFeedSettings(ctx context.Context, profileID int64) error
+	// GetScheduledEntryIDsFromFeeds returns a list of ids of scheduled entries from feeds.
+	//
+	// feedURLs - feeds to get entry IDs for
+	//
+	// days - how many days to go back in time to analyze. If nil, analyze all entries.
+	//
+	// limit - how many entries to analyze. If nil, analyze all entries.
+	GetScheduledEntryIDsFromFeeds(ctx context.Context, feedURLs []string, days *int, limit *int) ([]string, error)
+}
+
+type exampleStorage interface {
+	// GetFewShotExamples returns tagged detections of a profile chosen as examples for analyzing an entry.
+	GetFewShotExamples(
+		ctx context.Context,
+		profileID int64,
+		source string,
+		sourceID string,
+		settings models.FewShotSettings,
+	) ([]models.FewShotExample, error)
+}
+
+type analyzer interface {
+	Analyze(ctx context.Context, input AnalysisInput, profileSettings models.ProfileSettings) (models.Detection, error)
+}
+
+type Toolkit struct {
+	storage        toolkitStorage
+	exampleStorage exampleStorage
+	analyzer       analyzer
+	logger         zerolog.Logger
+}
+
+func NewToolkit(
+	storage toolkitStorage,
+	exampleStorage exampleStorage,
+	analyzer analyzer,
+	logger zerolog.Logger,
+) *Toolkit {
+	return &Toolkit{
+		storage:        storage,
+		exampleStorage: exampleStorage,
+		analyzer:       analyzer,
+		logger:         logger,
+	}
+}
+
+func (t *Toolkit) Analyze(
+	ctx context.Context,
+	entryID string,
+	profileSettings models.ProfileSettings,
+) (models.Detection, error) {
+	entries, err := t.storage.GetEntries(ctx, []string{entryID})
+	if err != nil {
+		return models.Detection{}, fmt.Errorf("get feed entry: %w", err)
+	}
+
+	if len(entries) == 0 {
+		return models.Detection{}, models.NewPermanentAnalysisError(
+			models.SourceItemNotFoundReason,
+			errors.New("entry not found"),
+		)
+	}
+
+	input := AnalysisInput{
+		Entry:    entries[0],
+		Examples: nil,
+	}
+
+	if profileSettings.FewShot != nil {
+		input.Examples, err = t.getExamples(ctx, entryID, profileSettings.ProfileID, *profileSettings.FewShot)
+		if err != nil {
+			return models.Detection{}, fmt.Errorf("get examples: %w", err)
+		}
+	}
+
+	detection, err := t.analyzer.Analyze(ctx, input, profileSettings)
+	if err != nil {
+		return models.Detection{}, fmt.Errorf("analyze entry: %w", err)
+	}
+
+	return detection, nil
+}
+
+// getExamples returns tagged entries of a profile to include in the analyzer input of an entry.
+// Examples whose entries are no longer stored are skipped.
+func (t *Toolkit) getExamples(
+	ctx context.Context,
+	entryID string,
+	profileID int64,
+	settings models.FewShotSettings,
+) ([]Example, error) {
+	fewShotExamples, err := t.exampleStorage.GetFewShotExamples(ctx, profileID, sources.RSSSource, entryID, settings)
+	if err != nil {
+		return nil, fmt.Errorf("get few-shot examples: %w", err)
+	}
+
+	if len(fewShotExamples) == 0 {
+		return nil, nil
+	}
+
+	entries, err := t.storage.GetEntries(ctx, lo.Map(fewShotExamples, func(example models.FewShotExample, _ int) string {
+		return example.SourceID
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("get example entries: %w", err)
+	}
+
+	entriesByID := lo.KeyBy(entries, func(entry Entry) string {
+		return entry.ID
+	})
+
+	examples := make([]Example, 0, len(fewShotExamples))
+
+	for _, fewShotExample := range fewShotExamples {
+		entry, ok := entriesByID[fewShotExample.SourceID]
+		if !ok {
+			continue
+		}
+
+		examples = append(examples, Example{
+			DetectionID: fewShotExample.DetectionID,
+			Entry:       entry,
+			IsRelevant:  fewShotExample.IsRelevant,
+		})
+	}
+
+	return examples, nil
+}
+
+func (t *Toolkit) GetSourcePosts(ctx context.Context, ids []string) ([]models.SourcePost, error) {
+	rawEntries, err := t.storage.GetRawEntries(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("get raw entries: %w", err)
+	}
+
+	posts := make([]models.SourcePost, 0, len(rawEntries))
+
+	for _, rawEntry := range rawEntries {
+		posts = append(posts, models.SourcePost{
+			SourceID: rawEntry.EntryID,
+			JSON:     rawEntry.Data,
+		})
+	}
+
+	return posts, nil
+}
+
+func (t *Toolkit) GetAllFeedSettings(ctx context.Context) ([]FeedSettings, error) {
+	return t.storage.GetAllFeedSettings(ctx)
+}
+
+func (t *Toolkit) GetAllFeedSettingsWithProfileID(ctx context.Context, profileID int64) ([]FeedSettings, error) {
+	return t.storage.GetAllFeedSettingsWithProfileID(ctx, profileID)
+}
+
+func (t *Toolkit) AddProfilesToFeed(ctx context.Context, feedURL string, profileIDs []int64) error {
+	if err := ValidateFeedURL(feedURL); err != nil {
+		return err
+	}
+
+	return t.storage.AddProfilesToFeed(ctx, feedURL, profileIDs)
+}
+
+func (t *Toolkit) RemoveProfilesFromFeed(ctx context.Context, feedURL string, profileIDs []int64) error {
+	return t.storage.RemoveProfilesFromFeed(ctx, feedURL, profileIDs)
+}
+
+func (t *Toolkit) GetScheduledSourceIDs(
+	ctx context.Context,
+	profileIDs []int64,
+	days *int,
+	limit *int,
+) ([]string, error) {
+	sourceIDs := make(map[string]struct{})
+
+	for _, profileID := range profileIDs {
+		feedsSettings, err := t.storage.GetAllFeedSettingsWithProfileID(ctx, profileID)
+		if err != nil {
+			return nil, fmt.Errorf("get feed settings: %w", err)
+		}
+
+		feedURLs := lo.Map(feedsSettings, func(settings FeedSettings, _ int) string {
+			return settings.FeedURL
+		})
+
+		entryIDs, err := t.storage.GetScheduledEntryIDsFromFeeds(ctx, feedURLs, days, limit)
+		if err != nil {
+			return nil, fmt.Errorf("get entry IDs from feeds: %w", err)
+		}
+
+		for _, entryID := range entryIDs {
+			sourceIDs[entryID] = struct{}{}
+		}
+	}
+
+	return lo.Keys(sourceIDs), nil
+}
+
+func (t *Toolkit) DeleteProfile(ctx context.Context, profileID int64) error {
+	return t.storage.RemoveProfileFromAllFeedSettings(ctx, profileID)
+}
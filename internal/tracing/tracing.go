@@ -0,0 +1,155 @@
+// Package tracing configures OpenTelemetry tracing of Scout and provides helpers to create spans.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// OTLPExporter exports spans to an OTLP/HTTP collector.
+	OTLPExporter = "otlp"
+	// StdoutExporter prints spans to stdout, it is useful for local testing.
+	StdoutExporter = "stdout"
+)
+
+const (
+	serviceName = "scout"
+	tracerName  = "github.com/rishenco/scout"
+
+	// DefaultSampleRatio is a share of sampled traces when the ratio is not configured.
+	DefaultSampleRatio = 1.0
+)
+
+// Settings configures tracing. Empty Exporter disables tracing.
+type Settings struct {
+	Exporter string
+	// Endpoint is an OTLP/HTTP endpoint URL, e.g. http://localhost:4318.
+	// If empty, OTEL_EXPORTER_OTLP_* environment variables are used.
+	Endpoint string
+	// SampleRatio is a share of traces to sample, from 0 to 1. Zero samples no traces.
+	SampleRatio float64
+}
+
+// Setup registers a global tracer provider and a trace context propagator.
+//
+// The returned shutdown function flushes pending spans and must be called before exit.
+func Setup(ctx context.Context, settings Settings) (shutdown func(ctx context.Context) error, err error) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	if settings.Exporter == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := newExporter(ctx, settings)
+	if err != nil {
+		return nil, fmt.Errorf("new exporter: %w", err)
+	}
+
+	res, err := resource.New(
+		ctx,
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(semconv.ServiceName(serviceName)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("new resource: %w", err)
+	}
+
+	// Ratios of 0 and below never sample, ratios of 1 and above always sample
+	sampler := sdktrace.TraceIDRatioBased(settings.SampleRatio)
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sampler)),
+	)
+
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+func newExporter(ctx context.Context, settings Settings) (sdktrace.SpanExporter, error) {
+	switch settings.Exporter {
+	case OTLPExporter:
+		var options []otlptracehttp.Option
+		if settings.Endpoint != "" {
+			options = append(options, otlptracehttp.WithEndpointURL(settings.Endpoint))
+		}
+
+		exporter, err := otlptracehttp.New(ctx, options...)
+		if err != nil {
+			return nil, fmt.Errorf("new otlp exporter: %w", err)
+		}
+
+		return exporter, nil
+	case StdoutExporter:
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+		if err != nil {
+			return nil, fmt.Errorf("new stdout exporter: %w", err)
+		}
+
+		return exporter, nil
+	default:
+		return nil, fmt.Errorf("unknown exporter: %s", settings.Exporter)
+	}
+}
+
+// Start starts a span of Scout's tracer.
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, opts...)
+}
+
+// End records an error of the span, if any, and ends the span.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// Inject returns the trace context of the context's span to persist it, e.g. with a task.
+//
+// Nil is returned if there is no span.
+func Inject(ctx context.Context) map[string]string {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return nil
+	}
+
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+
+	return carrier
+}
+
+// Link returns a link to a span of a persisted trace context.
+//
+// False is returned if the trace context is empty or invalid.
+func Link(traceContext map[string]string) (trace.Link, bool) {
+	if len(traceContext) == 0 {
+		return trace.Link{}, false
+	}
+
+	ctx := otel.GetTextMapPropagator().Extract(context.Background(), propagation.MapCarrier(traceContext))
+
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		return trace.Link{}, false
+	}
+
+	return trace.Link{SpanContext: spanContext}, true
+}
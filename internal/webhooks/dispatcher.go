@@ -0,0 +1,256 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/samber/lo"
+
+	"github.com/rishenco/scout/internal/metrics"
+	"github.com/rishenco/scout/pkg/models"
+)
+
+const (
+	// SignatureHeader contains "sha256=" followed by a hex encoded HMAC-SHA256 of "<timestamp>.<body>".
+	SignatureHeader = "X-Scout-Signature"
+	// TimestampHeader contains a unix timestamp of the request, it is a part of the signed message.
+	TimestampHeader = "X-Scout-Timestamp"
+	// EventHeader contains a type of the event.
+	EventHeader = "X-Scout-Event"
+	// DeliveryHeader contains an id of the outbox event, it is the same for all attempts.
+	DeliveryHeader = "X-Scout-Delivery"
+
+	userAgent = "scout-webhooks/1.0"
+	// maxLoggedResponseLength limits the length of a response body saved to the delivery log.
+	maxLoggedResponseLength = 512
+)
+
+// heartbeat reports iterations of a loop to the health registry.
+type heartbeat interface {
+	Beat()
+	Fail(err error)
+}
+
+type outbox interface {
+	Claim(ctx context.Context, lease time.Duration) (delivery models.WebhookDelivery, anyDelivery bool, err error)
+	RecordResult(ctx context.Context, result models.WebhookDeliveryResult) error
+}
+
+// Dispatcher delivers events of the webhook outbox.
+//
+// Failed deliveries are retried with exponential backoff until the max attempts are reached.
+type Dispatcher struct {
+	outbox          outbox
+	client          *http.Client
+	requestTimeout  time.Duration
+	minBackoff      time.Duration
+	maxBackoff      time.Duration
+	maxAttempts     int
+	timeout         time.Duration
+	errorTimeout    time.Duration
+	noEventsTimeout time.Duration
+	workers         int
+	heartbeat       heartbeat
+	logger          zerolog.Logger
+}
+
+func NewDispatcher(
+	outbox outbox,
+	requestTimeout time.Duration,
+	minBackoff time.Duration,
+	maxBackoff time.Duration,
+	maxAttempts int,
+	timeout time.Duration,
+	errorTimeout time.Duration,
+	noEventsTimeout time.Duration,
+	workers int,
+	heartbeat heartbeat,
+	logger zerolog.Logger,
+) *Dispatcher {
+	return &Dispatcher{
+		outbox:          outbox,
+		client:          &http.Client{Timeout: requestTimeout},
+		requestTimeout:  requestTimeout,
+		minBackoff:      minBackoff,
+		maxBackoff:      maxBackoff,
+		maxAttempts:     maxAttempts,
+		timeout:         timeout,
+		errorTimeout:    errorTimeout,
+		noEventsTimeout: noEventsTimeout,
+		workers:         workers,
+		heartbeat:       heartbeat,
+		logger:          logger,
+	}
+}
+
+func (d *Dispatcher) Start(ctx context.Context) {
+	wg := new(sync.WaitGroup)
+
+	d.logger.Info().
+		Int("workers", d.workers).
+		Msg("starting webhook dispatcher")
+
+	for range d.workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			d.deliveryLoop(ctx)
+		}()
+	}
+
+	wg.Wait()
+}
+
+func (d *Dispatcher) deliveryLoop(ctx context.Context) {
+	timeout := d.timeout
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(timeout):
+			timeout = d.timeout
+
+			anyDelivery, err := d.deliverNext(ctx)
+			if err != nil {
+				d.logger.Error().Err(err).Msg("deliver webhook event")
+
+				d.heartbeat.Fail(err)
+
+				timeout = d.errorTimeout
+
+				continue
+			}
+
+			d.heartbeat.Beat()
+
+			if !anyDelivery {
+				timeout = d.noEventsTimeout
+			}
+		}
+	}
+}
+
+func (d *Dispatcher) deliverNext(ctx context.Context) (anyDelivery bool, err error) {
+	// Lease the event for longer than a request may take, so it's not claimed twice
+	//nolint:mnd // twice the request timeout
+	delivery, anyDelivery, err := d.outbox.Claim(ctx, 2*d.requestTimeout)
+	if err != nil {
+		return false, fmt.Errorf("claim webhook event: %w", err)
+	}
+
+	if !anyDelivery {
+		return false, nil
+	}
+
+	logger := d.logger.With().
+		Int64("delivery_id", delivery.ID).
+		Int64("webhook_id", delivery.WebhookID).
+		Int("attempt", delivery.Attempt).
+		Logger()
+
+	startedAt := time.Now()
+
+	statusCode, deliveryErr := d.send(ctx, delivery)
+
+	metrics.WebhookDeliveries.WithLabelValues(metrics.Result(deliveryErr)).Inc()
+
+	result := models.WebhookDeliveryResult{
+		DeliveryID:    delivery.ID,
+		WebhookID:     delivery.WebhookID,
+		Attempt:       delivery.Attempt,
+		StatusCode:    statusCode,
+		Error:         nil,
+		Duration:      time.Since(startedAt),
+		NextAttemptAt: nil,
+	}
+
+	if deliveryErr != nil {
+		result.Error = lo.ToPtr(deliveryErr.Error())
+
+		if delivery.Attempt < d.maxAttempts {
+			result.NextAttemptAt = lo.ToPtr(time.Now().Add(d.backoff(delivery.Attempt)))
+		}
+
+		logger.Warn().
+			Err(deliveryErr).
+			Bool("will_retry", result.NextAttemptAt != nil).
+			Msg("webhook delivery failed")
+	} else {
+		logger.Info().Msg("webhook event delivered")
+	}
+
+	if err := d.outbox.RecordResult(ctx, result); err != nil {
+		return true, fmt.Errorf("record delivery result: %w", err)
+	}
+
+	return true, nil
+}
+
+// send POSTs the signed payload to the webhook. Any non-2xx response is an error.
+func (d *Dispatcher) send(ctx context.Context, delivery models.WebhookDelivery) (statusCode *int, err error) {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", userAgent)
+	request.Header.Set(EventHeader, delivery.Event)
+	request.Header.Set(DeliveryHeader, strconv.FormatInt(delivery.ID, 10))
+	request.Header.Set(TimestampHeader, timestamp)
+	request.Header.Set(SignatureHeader, Sign(delivery.Secret, timestamp, delivery.Payload))
+
+	response, err := d.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("do request: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		body, _ := io.ReadAll(io.LimitReader(response.Body, maxLoggedResponseLength))
+
+		return &response.StatusCode, fmt.Errorf("unexpected status code %d: %s", response.StatusCode, body)
+	}
+
+	return &response.StatusCode, nil
+}
+
+// backoff returns a delay before the next attempt after a given failed attempt.
+// The delay doubles with each attempt starting from the min backoff and is capped by the max backoff.
+func (d *Dispatcher) backoff(attempt int) time.Duration {
+	backoff := d.minBackoff
+
+	for i := 1; i < attempt && backoff < d.maxBackoff; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, d.maxBackoff)
+}
+
+// Sign returns a value of the signature header for a payload sent at a given timestamp.
+//
+// Receivers should compute the same value with the webhook secret and compare it
+// with the header using a constant time comparison.
+func Sign(secret string, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,201 @@
+package webhooks
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/rishenco/scout/pkg/models"
+)
+
+func TestSign(t *testing.T) {
+	// receivers compute the same value, so the format must not change
+	got := Sign("whsec_test", "1700000000", []byte(`{"event":"detection.relevant"}`))
+	want := "sha256=4cfb6a393bf6d7bfa4d9100145adbf744e15ce46da4fd2c45d528cf8d5fcbf23"
+
+	if got != want {
+		t.Errorf("Sign() = %s, want %s", got, want)
+	}
+}
+
+// fakeOutbox serves a single delivery and records its result.
+type fakeOutbox struct {
+	delivery *models.WebhookDelivery
+	results  []models.WebhookDeliveryResult
+}
+
+func (o *fakeOutbox) Claim(context.Context, time.Duration) (models.WebhookDelivery, bool, error) {
+	if o.delivery == nil {
+		return models.WebhookDelivery{}, false, nil
+	}
+
+	delivery := *o.delivery
+	o.delivery = nil
+
+	return delivery, true, nil
+}
+
+func (o *fakeOutbox) RecordResult(_ context.Context, result models.WebhookDeliveryResult) error {
+	o.results = append(o.results, result)
+
+	return nil
+}
+
+type noopHeartbeat struct{}
+
+func (noopHeartbeat) Beat()      {}
+func (noopHeartbeat) Fail(error) {}
+
+func newTestDispatcher(outbox outbox) *Dispatcher {
+	return NewDispatcher(outbox, time.Second, time.Minute, time.Hour, 3, 0, 0, 0, 1, noopHeartbeat{}, zerolog.Nop())
+}
+
+func TestDispatcher_DeliverNext(t *testing.T) {
+	payload := []byte(`{"event":"detection.relevant"}`)
+
+	tests := []struct {
+		name          string
+		status        int
+		attempt       int
+		wantError     bool
+		wantNextAfter time.Duration
+		wantRetry     bool
+	}{
+		{name: "delivered", status: http.StatusNoContent, attempt: 1, wantError: false, wantRetry: false},
+		{
+			name:          "server error is retried",
+			status:        http.StatusInternalServerError,
+			attempt:       1,
+			wantError:     true,
+			wantNextAfter: time.Minute,
+			wantRetry:     true,
+		},
+		{
+			name:          "retry backoff doubles",
+			status:        http.StatusBadGateway,
+			attempt:       2,
+			wantError:     true,
+			wantNextAfter: 2 * time.Minute,
+			wantRetry:     true,
+		},
+		{
+			name:      "last attempt gives up",
+			status:    http.StatusInternalServerError,
+			attempt:   3,
+			wantError: true,
+			wantRetry: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var request *http.Request
+
+			var body []byte
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				request = r
+				body, _ = io.ReadAll(r.Body)
+
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			outbox := &fakeOutbox{
+				delivery: &models.WebhookDelivery{
+					ID:        10,
+					WebhookID: 20,
+					URL:       server.URL,
+					Secret:    "whsec_test",
+					Event:     "detection.relevant",
+					Payload:   payload,
+					Attempt:   tt.attempt,
+				},
+				results: nil,
+			}
+
+			startedAt := time.Now()
+
+			anyDelivery, err := newTestDispatcher(outbox).deliverNext(context.Background())
+			if err != nil || !anyDelivery {
+				t.Fatalf("deliver next: any delivery = %t, err = %v", anyDelivery, err)
+			}
+
+			if string(body) != string(payload) {
+				t.Errorf("body = %s, want %s", body, payload)
+			}
+
+			timestamp := request.Header.Get(TimestampHeader)
+			if got, want := request.Header.Get(SignatureHeader), Sign("whsec_test", timestamp, payload); got != want {
+				t.Errorf("signature = %s, want %s", got, want)
+			}
+
+			if got := request.Header.Get(DeliveryHeader); got != "10" {
+				t.Errorf("delivery header = %s, want 10", got)
+			}
+
+			if len(outbox.results) != 1 {
+				t.Fatalf("results = %+v, want a single result", outbox.results)
+			}
+
+			result := outbox.results[0]
+
+			if result.DeliveryID != 10 || result.Attempt != tt.attempt {
+				t.Errorf("result = %+v, want delivery 10 attempt %d", result, tt.attempt)
+			}
+
+			if result.StatusCode == nil || *result.StatusCode != tt.status {
+				t.Errorf("status code = %v, want %d", result.StatusCode, tt.status)
+			}
+
+			if (result.Error != nil) != tt.wantError {
+				t.Errorf("error = %v, want error %t", result.Error, tt.wantError)
+			}
+
+			if (result.NextAttemptAt != nil) != tt.wantRetry {
+				t.Fatalf("next attempt at = %v, want retry %t", result.NextAttemptAt, tt.wantRetry)
+			}
+
+			if tt.wantRetry {
+				delay := result.NextAttemptAt.Sub(startedAt)
+				if delay < tt.wantNextAfter || delay > tt.wantNextAfter+time.Minute/2 {
+					t.Errorf("next attempt in %s, want %s", delay, tt.wantNextAfter)
+				}
+			}
+		})
+	}
+}
+
+func TestDispatcher_DeliverNextNoEvents(t *testing.T) {
+	outbox := &fakeOutbox{delivery: nil, results: nil}
+
+	anyDelivery, err := newTestDispatcher(outbox).deliverNext(context.Background())
+	if err != nil || anyDelivery {
+		t.Errorf("deliver next: any delivery = %t, err = %v, want no delivery", anyDelivery, err)
+	}
+}
+
+func TestDispatcher_Backoff(t *testing.T) {
+	dispatcher := newTestDispatcher(&fakeOutbox{delivery: nil, results: nil})
+
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{attempt: 1, want: time.Minute},
+		{attempt: 2, want: 2 * time.Minute},
+		{attempt: 4, want: 8 * time.Minute},
+		{attempt: 10, want: time.Hour},
+	}
+
+	for _, tt := range tests {
+		if got := dispatcher.backoff(tt.attempt); got != tt.want {
+			t.Errorf("backoff(%d) = %s, want %s", tt.attempt, got, tt.want)
+		}
+	}
+}
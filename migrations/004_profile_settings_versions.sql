@@ -0,0 +1,22 @@
+-- +goose Up
+
+-- Create append-only profile settings history table
+CREATE TABLE IF NOT EXISTS scout.profile_settings_versions (
+    profile_id BIGINT NOT NULL,
+    source VARCHAR(255) NULL,
+    version INT NOT NULL,
+    relevancy_filter VARCHAR(8192) NOT NULL,
+    extracted_properties JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    UNIQUE NULLS NOT DISTINCT (profile_id, source, version)
+);
+
+-- Preserve current settings as their versions
+INSERT INTO scout.profile_settings_versions (profile_id, source, version, relevancy_filter, extracted_properties, created_at)
+SELECT profile_id, source, version, relevancy_filter, extracted_properties, updated_at
+FROM scout.profile_settings
+ON CONFLICT DO NOTHING;
+
+-- +goose Down
+
+DROP TABLE IF EXISTS scout.profile_settings_versions;
@@ -0,0 +1,35 @@
+-- +goose Up
+
+-- Create hackernews schema
+CREATE SCHEMA IF NOT EXISTS hackernews;
+
+-- Create stories table for storing hacker news stories
+CREATE TABLE IF NOT EXISTS hackernews.stories (
+    id BIGSERIAL PRIMARY KEY,
+    story_id VARCHAR(255) NOT NULL UNIQUE,
+    feed VARCHAR(255) NOT NULL,
+    story_json JSONB NOT NULL,
+    enriched_story_json JSONB,
+    story_created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    row_created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    enriched_at TIMESTAMP WITH TIME ZONE,
+    scheduled_at TIMESTAMP WITH TIME ZONE,
+    is_enriched BOOLEAN NOT NULL DEFAULT FALSE,
+    is_scheduled BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX IF NOT EXISTS idx_stories_feed ON hackernews.stories (feed);
+
+-- Create table for feed settings
+CREATE TABLE IF NOT EXISTS hackernews.feed_settings (
+    id SERIAL PRIMARY KEY,
+    feed VARCHAR(255) NOT NULL UNIQUE,
+    profiles BIGINT[] NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS hackernews.feed_settings;
+DROP TABLE IF EXISTS hackernews.stories;
+DROP SCHEMA IF EXISTS hackernews;
@@ -0,0 +1,32 @@
+-- +goose Up
+
+-- Create rss schema
+CREATE SCHEMA IF NOT EXISTS rss;
+
+-- Create entries table for storing RSS/Atom feed entries
+CREATE TABLE IF NOT EXISTS rss.entries (
+    id BIGSERIAL PRIMARY KEY,
+    entry_id VARCHAR(255) NOT NULL UNIQUE,
+    feed_url TEXT NOT NULL,
+    guid TEXT NOT NULL,
+    entry_json JSONB NOT NULL,
+    entry_published_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    row_created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    scheduled_at TIMESTAMP WITH TIME ZONE,
+    is_scheduled BOOLEAN NOT NULL DEFAULT FALSE,
+    UNIQUE (feed_url, guid)
+);
+
+-- Create table for feed settings
+CREATE TABLE IF NOT EXISTS rss.feed_settings (
+    id SERIAL PRIMARY KEY,
+    feed_url TEXT NOT NULL UNIQUE,
+    profiles BIGINT[] NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS rss.feed_settings;
+DROP TABLE IF EXISTS rss.entries;
+DROP SCHEMA IF EXISTS rss;
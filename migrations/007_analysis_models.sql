@@ -0,0 +1,14 @@
+-- +goose Up
+
+-- Model pinned by profile settings, NULL means the default analyzers chain
+ALTER TABLE scout.profile_settings ADD COLUMN IF NOT EXISTS model VARCHAR(255) NULL;
+ALTER TABLE scout.profile_settings_versions ADD COLUMN IF NOT EXISTS model VARCHAR(255) NULL;
+
+-- Model that produced a detection, NULL for detections made before models were recorded
+ALTER TABLE scout.detections ADD COLUMN IF NOT EXISTS model VARCHAR(255) NULL;
+
+-- +goose Down
+
+ALTER TABLE scout.detections DROP COLUMN IF EXISTS model;
+ALTER TABLE scout.profile_settings_versions DROP COLUMN IF EXISTS model;
+ALTER TABLE scout.profile_settings DROP COLUMN IF EXISTS model;
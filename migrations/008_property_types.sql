@@ -0,0 +1,10 @@
+-- +goose Up
+
+-- Types of extracted properties (property name -> {kind, enum, required}), untyped properties are optional strings
+ALTER TABLE scout.profile_settings ADD COLUMN IF NOT EXISTS property_types JSONB NOT NULL DEFAULT '{}';
+ALTER TABLE scout.profile_settings_versions ADD COLUMN IF NOT EXISTS property_types JSONB NOT NULL DEFAULT '{}';
+
+-- +goose Down
+
+ALTER TABLE scout.profile_settings_versions DROP COLUMN IF EXISTS property_types;
+ALTER TABLE scout.profile_settings DROP COLUMN IF EXISTS property_types;
@@ -0,0 +1,28 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+
+-- Indexes are created concurrently to not block detections writes on large tables
+
+-- Property equality filters (properties @> '{"key": value}')
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_detections_properties
+    ON scout.detections USING GIN (properties jsonb_path_ops);
+
+-- Sorting and keyset pagination by creation time
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_detections_created_at_id ON scout.detections (created_at, id);
+
+-- Filtering by profile, source and settings version
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_detections_profile_source_version
+    ON scout.detections (profile_id, source, settings_version);
+
+-- Properties used for sorting or range filters on large tables can be backed by expression indexes, e.g.:
+-- CREATE INDEX CONCURRENTLY idx_detections_idea_success
+--     ON scout.detections ((NULLIF(properties -> 'idea_success', 'null'::jsonb)), id);
+-- Queries compare against properties -> $n::text with the key passed as a parameter, so such an index
+-- only helps when the planner substitutes the parameter, i.e. with custom plans (the first executions
+-- of a prepared statement or plan_cache_mode = force_custom_plan), and never with a generic plan.
+
+-- +goose Down
+
+DROP INDEX CONCURRENTLY IF EXISTS scout.idx_detections_profile_source_version;
+DROP INDEX CONCURRENTLY IF EXISTS scout.idx_detections_created_at_id;
+DROP INDEX CONCURRENTLY IF EXISTS scout.idx_detections_properties;
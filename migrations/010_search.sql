@@ -0,0 +1,74 @@
+-- +goose Up
+
+-- Search vectors are indexed concurrently in 023_search_indexes.sql
+
+-- Full-text search over extracted property values (strings and numbers)
+ALTER TABLE scout.detections ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+    jsonb_to_tsvector('english', COALESCE(properties, '{}'::jsonb), '["string", "numeric"]')
+) STORED;
+
+-- Full-text search over reddit posts: title (A), body (B) and comment trees (C)
+ALTER TABLE reddit.posts ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', COALESCE(enriched_post_json -> 'post' ->> 'title', post_json ->> 'title', '')), 'A') ||
+    setweight(to_tsvector('english', COALESCE(enriched_post_json -> 'post' ->> 'selftext', post_json ->> 'selftext', '')), 'B') ||
+    setweight(jsonb_to_tsvector(
+        'english',
+        COALESCE(jsonb_path_query_array(enriched_post_json, 'lax $.comments.**.body'), '[]'::jsonb),
+        '["string"]'
+    ), 'C')
+) STORED;
+
+-- Full-text search over hacker news stories: title (A), text (B) and comment trees (C)
+ALTER TABLE hackernews.stories ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', COALESCE(story_json ->> 'title', '')), 'A') ||
+    setweight(to_tsvector('english', COALESCE(story_json ->> 'text', '')), 'B') ||
+    setweight(jsonb_to_tsvector(
+        'english',
+        COALESCE(jsonb_path_query_array(enriched_story_json, 'lax $.comments.**.text'), '[]'::jsonb),
+        '["string"]'
+    ), 'C')
+) STORED;
+
+-- Full-text search over RSS entries: title (A) and content (B)
+ALTER TABLE rss.entries ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', COALESCE(entry_json ->> 'title', '')), 'A') ||
+    setweight(to_tsvector('english', COALESCE(entry_json ->> 'content', entry_json ->> 'description', '')), 'B')
+) STORED;
+
+-- Searchable documents of all sources, joined to detections by (source, source_id)
+CREATE OR REPLACE VIEW scout.source_documents AS
+SELECT
+    'reddit' AS source,
+    p.post_id AS source_id,
+    COALESCE(p.enriched_post_json -> 'post' ->> 'title', p.post_json ->> 'title') AS title,
+    COALESCE(p.enriched_post_json -> 'post' ->> 'selftext', p.post_json ->> 'selftext') AS body,
+    p.search_vector
+FROM reddit.posts p
+UNION ALL
+SELECT
+    'hackernews' AS source,
+    s.story_id AS source_id,
+    s.story_json ->> 'title' AS title,
+    s.story_json ->> 'text' AS body,
+    s.search_vector
+FROM hackernews.stories s
+UNION ALL
+SELECT
+    'rss' AS source,
+    e.entry_id AS source_id,
+    e.entry_json ->> 'title' AS title,
+    COALESCE(e.entry_json ->> 'content', e.entry_json ->> 'description') AS body,
+    e.search_vector
+FROM rss.entries e;
+
+-- +goose Down
+
+DROP VIEW IF EXISTS scout.source_documents;
+
+ALTER TABLE rss.entries DROP COLUMN IF EXISTS search_vector;
+
+ALTER TABLE hackernews.stories DROP COLUMN IF EXISTS search_vector;
+
+ALTER TABLE reddit.posts DROP COLUMN IF EXISTS search_vector;
+
+ALTER TABLE scout.detections DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1,108 @@
+-- +goose Up
+
+-- Create webhook subscriptions table
+CREATE TABLE IF NOT EXISTS scout.webhooks (
+    id BIGSERIAL PRIMARY KEY,
+    profile_id BIGINT NOT NULL,
+    url VARCHAR(2048) NOT NULL,
+    secret VARCHAR(255) NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhooks_profile_id ON scout.webhooks (profile_id);
+
+-- Create webhook outbox table, events are added in the same transaction as detections
+CREATE TABLE IF NOT EXISTS scout.webhook_outbox (
+    id BIGSERIAL PRIMARY KEY,
+    webhook_id BIGINT NOT NULL,
+    detection_id BIGINT NOT NULL,
+    event VARCHAR(255) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(255) NOT NULL DEFAULT 'pending', -- pending / delivered / failed
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    delivered_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_outbox_pending ON scout.webhook_outbox (next_attempt_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_webhook_outbox_webhook_id ON scout.webhook_outbox (webhook_id);
+
+-- Create webhook delivery log table, one row per delivery attempt
+CREATE TABLE IF NOT EXISTS scout.webhook_deliveries (
+    id BIGSERIAL PRIMARY KEY,
+    outbox_id BIGINT NOT NULL,
+    webhook_id BIGINT NOT NULL,
+    attempt INT NOT NULL,
+    status_code INT,
+    error TEXT,
+    duration_ms BIGINT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_webhook_id ON scout.webhook_deliveries (webhook_id, id);
+
+-- Add links to source documents, they are used in webhook post summaries
+CREATE OR REPLACE VIEW scout.source_documents AS
+SELECT
+    'reddit' AS source,
+    p.post_id AS source_id,
+    COALESCE(p.enriched_post_json -> 'post' ->> 'title', p.post_json ->> 'title') AS title,
+    COALESCE(p.enriched_post_json -> 'post' ->> 'selftext', p.post_json ->> 'selftext') AS body,
+    p.search_vector,
+    'https://www.reddit.com' || (p.post_json ->> 'permalink') AS url
+FROM reddit.posts p
+UNION ALL
+SELECT
+    'hackernews' AS source,
+    s.story_id AS source_id,
+    s.story_json ->> 'title' AS title,
+    s.story_json ->> 'text' AS body,
+    s.search_vector,
+    'https://news.ycombinator.com/item?id=' || s.story_id AS url
+FROM hackernews.stories s
+UNION ALL
+SELECT
+    'rss' AS source,
+    e.entry_id AS source_id,
+    e.entry_json ->> 'title' AS title,
+    COALESCE(e.entry_json ->> 'content', e.entry_json ->> 'description') AS body,
+    e.search_vector,
+    e.entry_json ->> 'link' AS url
+FROM rss.entries e;
+
+-- +goose Down
+
+DROP VIEW IF EXISTS scout.source_documents;
+
+CREATE VIEW scout.source_documents AS
+SELECT
+    'reddit' AS source,
+    p.post_id AS source_id,
+    COALESCE(p.enriched_post_json -> 'post' ->> 'title', p.post_json ->> 'title') AS title,
+    COALESCE(p.enriched_post_json -> 'post' ->> 'selftext', p.post_json ->> 'selftext') AS body,
+    p.search_vector
+FROM reddit.posts p
+UNION ALL
+SELECT
+    'hackernews' AS source,
+    s.story_id AS source_id,
+    s.story_json ->> 'title' AS title,
+    s.story_json ->> 'text' AS body,
+    s.search_vector
+FROM hackernews.stories s
+UNION ALL
+SELECT
+    'rss' AS source,
+    e.entry_id AS source_id,
+    e.entry_json ->> 'title' AS title,
+    COALESCE(e.entry_json ->> 'content', e.entry_json ->> 'description') AS body,
+    e.search_vector
+FROM rss.entries e;
+
+DROP TABLE IF EXISTS scout.webhook_deliveries;
+DROP TABLE IF EXISTS scout.webhook_outbox;
+DROP TABLE IF EXISTS scout.webhooks;
@@ -0,0 +1,17 @@
+-- +goose Up
+
+-- Create table for scraper pagination state of subreddits, it survives restarts of the scraper
+CREATE TABLE IF NOT EXISTS reddit.subreddit_scrape_state (
+    subreddit VARCHAR(255) PRIMARY KEY,
+    next_page VARCHAR(255) NOT NULL DEFAULT '', -- reddit's pagination token, empty means the first page
+    available_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(), -- cooldown: the next page can't be scraped earlier
+    last_scraped_at TIMESTAMP WITH TIME ZONE,
+    last_full_scan_at TIMESTAMP WITH TIME ZONE, -- last time the subreddit was scanned until the end of available posts
+    last_error TEXT,
+    last_error_at TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS reddit.subreddit_scrape_state;
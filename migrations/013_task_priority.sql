@@ -0,0 +1,20 @@
+-- +goose Up
+
+-- Tasks with higher priority are claimed first, tasks with equal priority are claimed in order of creation
+ALTER TABLE scout.analysis_tasks ADD COLUMN IF NOT EXISTS priority INT NOT NULL DEFAULT 0;
+
+-- Existing manual tasks were created by jumpstarts
+UPDATE scout.analysis_tasks SET "type" = 'jumpstart' WHERE "type" = 'manual';
+UPDATE scout.analysis_tasks SET priority = 50 WHERE "type" = 'scheduled';
+UPDATE scout.analysis_tasks SET priority = 10 WHERE "type" = 'jumpstart';
+
+CREATE INDEX IF NOT EXISTS idx_analysis_tasks_claim ON scout.analysis_tasks (priority DESC, id)
+WHERE NOT is_claimed AND NOT is_committed AND NOT is_failed;
+
+-- +goose Down
+
+DROP INDEX IF EXISTS scout.idx_analysis_tasks_claim;
+
+UPDATE scout.analysis_tasks SET "type" = 'manual' WHERE "type" = 'jumpstart';
+
+ALTER TABLE scout.analysis_tasks DROP COLUMN IF EXISTS priority;
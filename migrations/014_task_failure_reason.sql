@@ -0,0 +1,10 @@
+-- +goose Up
+
+-- Reason code of a failed task: a permanent error or exceeded max attempts
+ALTER TABLE scout.analysis_tasks ADD COLUMN IF NOT EXISTS failure_reason VARCHAR(255);
+
+UPDATE scout.analysis_tasks SET failure_reason = 'max_attempts_exceeded' WHERE is_failed;
+
+-- +goose Down
+
+ALTER TABLE scout.analysis_tasks DROP COLUMN IF EXISTS failure_reason;
@@ -0,0 +1,8 @@
+-- +goose Up
+
+-- W3C trace context of the span that scheduled the task
+ALTER TABLE scout.analysis_tasks ADD COLUMN IF NOT EXISTS trace_context JSONB;
+
+-- +goose Down
+
+ALTER TABLE scout.analysis_tasks DROP COLUMN IF EXISTS trace_context;
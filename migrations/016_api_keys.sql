@@ -0,0 +1,16 @@
+-- +goose Up
+
+-- Keys of the HTTP API, only SHA-256 hashes of keys are stored
+CREATE TABLE IF NOT EXISTS scout.api_keys (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    prefix VARCHAR(255) NOT NULL,
+    key_hash VARCHAR(255) NOT NULL UNIQUE,
+    scopes TEXT[] NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    revoked_at TIMESTAMP WITH TIME ZONE
+);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS scout.api_keys;
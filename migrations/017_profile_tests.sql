@@ -0,0 +1,53 @@
+-- +goose Up
+
+-- Test cases of profiles: expected results of analyzing source posts
+CREATE TABLE IF NOT EXISTS scout.profile_test_cases (
+    id BIGSERIAL PRIMARY KEY,
+    profile_id BIGINT NOT NULL,
+    source VARCHAR(255) NOT NULL,
+    source_id VARCHAR(255) NOT NULL,
+    expected_relevant BOOLEAN NOT NULL,
+    expected_properties JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    UNIQUE (profile_id, source, source_id)
+);
+
+-- Runs of profile test suites, settings are a snapshot of the tested settings
+CREATE TABLE IF NOT EXISTS scout.profile_test_runs (
+    id BIGSERIAL PRIMARY KEY,
+    profile_id BIGINT NOT NULL,
+    settings JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_profile_test_runs_profile_id ON scout.profile_test_runs (profile_id, id);
+
+-- Results of test cases in runs, expected values are copied from test cases when the run starts
+CREATE TABLE IF NOT EXISTS scout.profile_test_results (
+    run_id BIGINT NOT NULL,
+    test_case_id BIGINT NOT NULL,
+    source VARCHAR(255) NOT NULL,
+    source_id VARCHAR(255) NOT NULL,
+    expected_relevant BOOLEAN NOT NULL,
+    expected_properties JSONB NOT NULL DEFAULT '{}',
+    is_relevant BOOLEAN,
+    properties JSONB,
+    model VARCHAR(255),
+    error TEXT,
+    mismatched_properties TEXT[] NOT NULL DEFAULT '{}',
+    properties_checked INT NOT NULL DEFAULT 0,
+    completed_at TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (run_id, source, source_id)
+);
+
+-- Test run of test_run tasks
+ALTER TABLE scout.analysis_tasks ADD COLUMN IF NOT EXISTS test_run_id BIGINT;
+
+-- +goose Down
+
+ALTER TABLE scout.analysis_tasks DROP COLUMN IF EXISTS test_run_id;
+
+DROP TABLE IF EXISTS scout.profile_test_results;
+DROP TABLE IF EXISTS scout.profile_test_runs;
+DROP TABLE IF EXISTS scout.profile_test_cases;
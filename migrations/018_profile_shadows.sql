@@ -0,0 +1,38 @@
+-- +goose Up
+
+-- Shadows of profiles: candidate settings evaluated on scheduled posts alongside the active settings
+CREATE TABLE IF NOT EXISTS scout.profile_shadows (
+    id BIGSERIAL PRIMARY KEY,
+    profile_id BIGINT NOT NULL,
+    source VARCHAR(255),
+    settings JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    stopped_at TIMESTAMP WITH TIME ZONE
+);
+
+-- A profile has at most one active shadow
+CREATE UNIQUE INDEX IF NOT EXISTS idx_profile_shadows_active
+    ON scout.profile_shadows (profile_id) WHERE stopped_at IS NULL;
+
+-- Detections of shadow settings, they are paired with production detections by profile and post
+CREATE TABLE IF NOT EXISTS scout.shadow_detections (
+    shadow_id BIGINT NOT NULL,
+    source VARCHAR(255) NOT NULL,
+    source_id VARCHAR(255) NOT NULL,
+    is_relevant BOOLEAN,
+    properties JSONB,
+    model VARCHAR(255),
+    error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (shadow_id, source, source_id)
+);
+
+-- Shadow of shadow tasks
+ALTER TABLE scout.analysis_tasks ADD COLUMN IF NOT EXISTS shadow_id BIGINT;
+
+-- +goose Down
+
+ALTER TABLE scout.analysis_tasks DROP COLUMN IF EXISTS shadow_id;
+
+DROP TABLE IF EXISTS scout.shadow_detections;
+DROP TABLE IF EXISTS scout.profile_shadows;
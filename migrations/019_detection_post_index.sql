@@ -0,0 +1,10 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+
+-- Looking up detections of a post by a profile, e.g. to pair shadow detections with production ones
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_detections_profile_source_post
+    ON scout.detections (profile_id, source, source_id);
+
+-- +goose Down
+
+DROP INDEX CONCURRENTLY IF EXISTS scout.idx_detections_profile_source_post;
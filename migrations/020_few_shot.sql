@@ -0,0 +1,14 @@
+-- +goose Up
+
+-- Few-shot prompting settings, NULL means zero-shot analysis
+ALTER TABLE scout.profile_settings ADD COLUMN IF NOT EXISTS few_shot JSONB NULL;
+ALTER TABLE scout.profile_settings_versions ADD COLUMN IF NOT EXISTS few_shot JSONB NULL;
+
+-- Ids of tagged detections included in the analyzer input as few-shot examples
+ALTER TABLE scout.detections ADD COLUMN IF NOT EXISTS example_ids BIGINT[] NOT NULL DEFAULT '{}';
+
+-- +goose Down
+
+ALTER TABLE scout.detections DROP COLUMN IF EXISTS example_ids;
+ALTER TABLE scout.profile_settings_versions DROP COLUMN IF EXISTS few_shot;
+ALTER TABLE scout.profile_settings DROP COLUMN IF EXISTS few_shot;
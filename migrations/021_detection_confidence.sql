@@ -0,0 +1,11 @@
+-- +goose Up
+
+-- Confidence of the model in the detected relevance and a short rationale of the decision,
+-- NULL for detections made before they were recorded
+ALTER TABLE scout.detections ADD COLUMN IF NOT EXISTS confidence DOUBLE PRECISION NULL;
+ALTER TABLE scout.detections ADD COLUMN IF NOT EXISTS rationale TEXT NULL;
+
+-- +goose Down
+
+ALTER TABLE scout.detections DROP COLUMN IF EXISTS rationale;
+ALTER TABLE scout.detections DROP COLUMN IF EXISTS confidence;
@@ -0,0 +1,10 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+
+-- Sorting, keyset pagination and range filters by confidence, matches the default descending order of detections
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_detections_confidence_desc_id
+    ON scout.detections (confidence DESC NULLS LAST, id DESC);
+
+-- +goose Down
+
+DROP INDEX CONCURRENTLY IF EXISTS scout.idx_detections_confidence_desc_id;
@@ -0,0 +1,21 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+
+-- Full-text search indexes over the search vectors added in 010_search.sql
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_detections_search_vector ON scout.detections USING GIN (search_vector);
+
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_posts_search_vector ON reddit.posts USING GIN (search_vector);
+
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_stories_search_vector ON hackernews.stories USING GIN (search_vector);
+
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_entries_search_vector ON rss.entries USING GIN (search_vector);
+
+-- +goose Down
+
+DROP INDEX CONCURRENTLY IF EXISTS rss.idx_entries_search_vector;
+
+DROP INDEX CONCURRENTLY IF EXISTS hackernews.idx_stories_search_vector;
+
+DROP INDEX CONCURRENTLY IF EXISTS reddit.idx_posts_search_vector;
+
+DROP INDEX CONCURRENTLY IF EXISTS scout.idx_detections_search_vector;
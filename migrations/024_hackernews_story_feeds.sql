@@ -0,0 +1,28 @@
+-- +goose Up
+
+-- Feeds each story was seen in. A story is stored once, but may appear in several feeds,
+-- and profiles of each feed are scheduled separately
+CREATE TABLE IF NOT EXISTS hackernews.story_feeds (
+    story_id VARCHAR(255) NOT NULL REFERENCES hackernews.stories (story_id) ON DELETE CASCADE,
+    feed VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    scheduled_at TIMESTAMP WITH TIME ZONE,
+    is_scheduled BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (story_id, feed)
+);
+
+CREATE INDEX IF NOT EXISTS idx_story_feeds_feed ON hackernews.story_feeds (feed);
+
+CREATE INDEX IF NOT EXISTS idx_story_feeds_not_scheduled ON hackernews.story_feeds (story_id) WHERE NOT is_scheduled;
+
+-- Stories scraped before were linked only to the feed they were first seen in
+INSERT INTO hackernews.story_feeds (story_id, feed, created_at, scheduled_at, is_scheduled)
+SELECT story_id, feed, row_created_at, scheduled_at, is_scheduled
+FROM hackernews.stories
+ON CONFLICT (story_id, feed) DO NOTHING;
+
+-- hackernews.stories.feed is kept as the feed the story was first seen in
+
+-- +goose Down
+
+DROP TABLE IF EXISTS hackernews.story_feeds;
@@ -0,0 +1,36 @@
+// Package migrations embeds goose migrations of the database schema.
+package migrations
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"strconv"
+	"strings"
+)
+
+//go:embed *.sql
+var files embed.FS
+
+// LatestVersion returns a version of the latest migration, i.e. a schema version expected by Scout.
+func LatestVersion() (int64, error) {
+	entries, err := fs.ReadDir(files, ".")
+	if err != nil {
+		return 0, fmt.Errorf("read dir: %w", err)
+	}
+
+	var latest int64
+
+	for _, entry := range entries {
+		prefix, _, _ := strings.Cut(entry.Name(), "_")
+
+		version, err := strconv.ParseInt(prefix, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("parse version of %s: %w", entry.Name(), err)
+		}
+
+		latest = max(latest, version)
+	}
+
+	return latest, nil
+}
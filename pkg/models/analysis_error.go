@@ -0,0 +1,59 @@
+package models
+
+import (
+	"time"
+)
+
+// AnalysisErrorKind defines how an analysis task is handled after an error.
+type AnalysisErrorKind string
+
+const (
+	// RetryableAnalysisError is a transient error, the task is retried with exponential backoff.
+	// Errors without a kind are retryable.
+	RetryableAnalysisError AnalysisErrorKind = "retryable"
+	// RateLimitedAnalysisError means a provider limited the request rate,
+	// the task is retried not earlier than the provider requested.
+	RateLimitedAnalysisError AnalysisErrorKind = "rate_limited"
+	// PermanentAnalysisError will not go away on retry, the task fails immediately.
+	PermanentAnalysisError AnalysisErrorKind = "permanent"
+)
+
+// Reason codes of failed analysis tasks.
+const (
+	SourceItemNotFoundReason      = "source_item_not_found"
+	ToolkitNotFoundReason         = "toolkit_not_found"
+	ProfileNotFoundReason         = "profile_not_found"
+	ProfileSettingsNotFoundReason = "profile_settings_not_found"
+	ModelNotConfiguredReason      = "model_not_configured"
+	InvalidLLMRequestReason       = "invalid_llm_request"
+	MaxAttemptsReason             = "max_attempts_exceeded"
+	TestRunNotFoundReason         = "test_run_not_found"
+	ShadowNotFoundReason          = "shadow_not_found"
+)
+
+// AnalysisError is a classified error of an analysis.
+type AnalysisError struct {
+	Kind AnalysisErrorKind
+	// Reason is a reason code of a permanent error.
+	Reason string
+	// RetryAfter is a delay requested by a rate-limited provider. Zero if unknown.
+	RetryAfter time.Duration
+	Err        error
+}
+
+func (e *AnalysisError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *AnalysisError) Unwrap() error {
+	return e.Err
+}
+
+// NewPermanentAnalysisError returns an error that fails the analysis task with a given reason code.
+func NewPermanentAnalysisError(reason string, err error) error {
+	return &AnalysisError{
+		Kind:   PermanentAnalysisError,
+		Reason: reason,
+		Err:    err,
+	}
+}
@@ -1,12 +1,57 @@
 package models
 
-import "time"
+import (
+	"errors"
+	"time"
+)
+
+// ErrInvalidAnalysisRequest is returned when posts explicitly requested for analysis are invalid.
+var ErrInvalidAnalysisRequest = errors.New("invalid analysis request")
+
+// MaxAnalysisRequestPosts is a maximum number of posts explicitly requested for analysis at once.
+const MaxAnalysisRequestPosts = 100
 
 const (
 	ScheduledTaskType = "scheduled"
+	// ManualTaskType analyzes a post explicitly requested for analysis of a profile, the detection is saved.
 	ManualTaskType    = "manual"
+	JumpstartTaskType = "jumpstart"
+	// TestRunTaskType analyzes a test case of a profile test run, the detection is not saved.
+	TestRunTaskType = "test_run"
+	// ShadowTaskType analyzes a scheduled post with candidate settings of a profile shadow, the detection is not saved.
+	ShadowTaskType = "shadow"
+)
+
+// Default priorities of task types. Tasks with higher priority are claimed first.
+const (
+	// ManualTaskPriority is a priority of posts explicitly requested for analysis, someone is waiting for them.
+	ManualTaskPriority = 100
+	// ScheduledTaskPriority is a priority of fresh posts of active profiles.
+	ScheduledTaskPriority = 50
+	// TestRunTaskPriority is a priority of test cases, someone is waiting for the test run report.
+	TestRunTaskPriority = 75
+	// ShadowTaskPriority is a priority of shadow analyses, they must not delay analyses of fresh posts.
+	ShadowTaskPriority = 30
+	// JumpstartTaskPriority is a priority of old posts analyzed by profile jumpstarts, they may come in thousands.
+	JumpstartTaskPriority = 10
 )
 
+// DefaultTaskPriority returns a default priority of a task type.
+func DefaultTaskPriority(taskType string) int {
+	switch taskType {
+	case ManualTaskType:
+		return ManualTaskPriority
+	case JumpstartTaskType:
+		return JumpstartTaskPriority
+	case TestRunTaskType:
+		return TestRunTaskPriority
+	case ShadowTaskType:
+		return ShadowTaskPriority
+	default:
+		return ScheduledTaskPriority
+	}
+}
+
 type AnalysisTask struct {
 	// ID is task id
 	//
@@ -15,9 +60,14 @@ type AnalysisTask struct {
 
 	// Type is a type of the task
 	//
-	// Examples: scheduled, manual
+	// Examples: scheduled, manual, jumpstart, test_run, shadow
 	Type string `json:"type"`
 
+	// Priority is a priority of the task, tasks with higher priority are claimed first
+	//
+	// Example: 50
+	Priority int `json:"priority"`
+
 	// Parameters is a set of parameters for the task
 	Parameters AnalysisParameters `json:"parameters"`
 
@@ -26,6 +76,10 @@ type AnalysisTask struct {
 
 	// CreatedAt is a timestamp of task creation
 	CreatedAt time.Time `json:"created_at"`
+
+	// TraceContext is a W3C trace context of the span that scheduled the task.
+	// Span of the task processing links to it.
+	TraceContext map[string]string `json:"trace_context,omitempty"`
 }
 
 type AnalysisParameters struct {
@@ -41,4 +95,62 @@ type AnalysisParameters struct {
 	Source string `json:"source"`
 	// ShouldSave is a flag that indicates if the results of analysis should be saved
 	ShouldSave bool `json:"should_save"`
+	// TestRunID is an identifier of a test run of test_run tasks, the post is analyzed with its settings
+	TestRunID *int64 `json:"test_run_id,omitempty"`
+	// ShadowID is an identifier of a profile shadow of shadow tasks, the post is analyzed with its candidate settings
+	ShadowID *int64 `json:"shadow_id,omitempty"`
+}
+
+// TaskPriorityUpdate changes priority of pending tasks of a profile.
+type TaskPriorityUpdate struct {
+	ProfileID int64
+	Priority  int
+	// Type limits the update to tasks of a type. Nil means all types.
+	Type *string
+	// Source limits the update to tasks of a source. Nil means all sources.
+	Source *string
+}
+
+// TaskStatus is a state of a task in the queue.
+type TaskStatus string
+
+const (
+	// TaskStatusPending means the task waits to be claimed.
+	TaskStatusPending TaskStatus = "pending"
+	// TaskStatusClaimed means the task is being processed.
+	TaskStatusClaimed TaskStatus = "claimed"
+	// TaskStatusFailed means the task exceeded max attempts or failed with a permanent error.
+	TaskStatusFailed TaskStatus = "failed"
+	// TaskStatusCommitted means the task is processed successfully.
+	TaskStatusCommitted TaskStatus = "committed"
+)
+
+// AnalysisTaskRecord is a task stored in the queue.
+type AnalysisTaskRecord struct {
+	AnalysisTask
+
+	Status      TaskStatus `json:"status"`
+	ClaimedAt   *time.Time `json:"claimed_at"`
+	CommittedAt *time.Time `json:"committed_at"`
+	FailedAt    *time.Time `json:"failed_at"`
+	// FailureReason is a reason code of a failed task.
+	FailureReason *string `json:"failure_reason"`
+}
+
+type TaskQuery struct {
+	// LastSeenID is the id of the last seen task, tasks are listed in descending order of ids.
+	LastSeenID *int64
+	Limit      int64
+	Status     *TaskStatus
+	ProfileID  *int64
+	Source     *string
+	Type       *string
+}
+
+// TaskSelector selects tasks for bulk actions, nil fields match any task.
+type TaskSelector struct {
+	TaskIDs   []int64
+	ProfileID *int64
+	Source    *string
+	Type      *string
 }
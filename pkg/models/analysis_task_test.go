@@ -0,0 +1,27 @@
+package models
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDefaultTaskPriority(t *testing.T) {
+	// tasks are claimed in this order when queued at the same time
+	claimOrder := []string{ManualTaskType, TestRunTaskType, ScheduledTaskType, ShadowTaskType, JumpstartTaskType}
+
+	// types are sorted from the reversed order, so equal priorities would keep them reversed
+	types := slices.Clone(claimOrder)
+	slices.Reverse(types)
+	slices.SortStableFunc(types, func(a string, b string) int {
+		return DefaultTaskPriority(b) - DefaultTaskPriority(a)
+	})
+
+	if !slices.Equal(types, claimOrder) {
+		t.Errorf("claim order = %v, want %v", types, claimOrder)
+	}
+
+	// tasks without a type are scheduled ones
+	if got := DefaultTaskPriority(""); got != ScheduledTaskPriority {
+		t.Errorf("DefaultTaskPriority(\"\") = %d, want %d", got, ScheduledTaskPriority)
+	}
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// ErrInvalidAPIKey is returned when an API key has malformed parameters.
+var ErrInvalidAPIKey = errors.New("invalid api key")
+
+// API scopes. Each API operation requires one of them.
+const (
+	// ReadDetectionsScope allows reading profiles, sources, detections and statistics.
+	ReadDetectionsScope = "detections:read"
+	// ManageProfilesScope allows changing profiles, their sources, webhooks and detection tags,
+	// and analyzing posts on demand.
+	ManageProfilesScope = "profiles:manage"
+	// AdminQueueScope allows inspecting and managing the analysis task queue and LLM limiters.
+	AdminQueueScope = "queue:admin"
+)
+
+// AllScopes lists all API scopes.
+func AllScopes() []string {
+	return []string{ReadDetectionsScope, ManageProfilesScope, AdminQueueScope}
+}
+
+// APIKey is a key of the HTTP API. Only a hash of the key is stored.
+type APIKey struct {
+	ID   int64
+	Name string
+	// Prefix is the beginning of the key, it helps to identify the key without revealing it.
+	Prefix    string
+	Scopes    []string
+	CreatedAt time.Time
+	RevokedAt *time.Time
+}
+
+// ValidateScopes checks that all scopes are known.
+func ValidateScopes(scopes []string) error {
+	if len(scopes) == 0 {
+		return fmt.Errorf("%w: at least one scope is required", ErrInvalidAPIKey)
+	}
+
+	for _, scope := range scopes {
+		if !slices.Contains(AllScopes(), scope) {
+			return fmt.Errorf("%w: unknown scope %q", ErrInvalidAPIKey, scope)
+		}
+	}
+
+	return nil
+}
@@ -1,11 +1,15 @@
 package models
 
 import (
+	"errors"
 	"time"
 
 	"github.com/rishenco/scout/pkg/nullable"
 )
 
+// ErrInvalidDetectionQuery is returned when a detection query has incompatible or malformed parameters.
+var ErrInvalidDetectionQuery = errors.New("invalid detection query")
+
 // DetectionOrder is an order of detections in the result of querying a detection storage.
 type DetectionOrder string
 
@@ -17,19 +21,46 @@ const (
 )
 
 type Detection struct {
-	IsRelevant bool              `json:"is_relevant"`
-	Properties map[string]string `json:"properties"`
+	IsRelevant bool `json:"is_relevant"`
+	// Confidence is a confidence of the model in the detected relevance from 0 to 1.
+	Confidence float64 `json:"confidence"`
+	// Rationale is a short explanation of the relevance decision.
+	Rationale string `json:"rationale"`
+	// Properties are extracted property values typed according to property kinds:
+	// string for string, enum and url, float64 for number, bool for boolean and []any of strings for list.
+	Properties map[string]any `json:"properties"`
+	// Model is a model that produced the detection.
+	Model string `json:"model"`
+	// ExampleIDs are ids of tagged detections included in the analyzer input as few-shot examples.
+	ExampleIDs []int64 `json:"example_ids"`
 }
 
 type DetectionRecord struct {
-	ID              int64             `json:"id"`
-	Source          string            `json:"source"`
-	SourceID        string            `json:"source_id"`
-	ProfileID       int64             `json:"profile_id"`
-	SettingsVersion int64             `json:"settings_version"`
-	IsRelevant      bool              `json:"is_relevant"`
-	Properties      map[string]string `json:"properties"`
-	CreatedAt       time.Time         `json:"created_at"`
+	ID              int64          `json:"id"`
+	Source          string         `json:"source"`
+	SourceID        string         `json:"source_id"`
+	ProfileID       int64          `json:"profile_id"`
+	SettingsVersion int64          `json:"settings_version"`
+	IsRelevant      bool           `json:"is_relevant"`
+	Properties      map[string]any `json:"properties"`
+	// Model is a model that produced the detection. Nil for detections made before models were recorded.
+	Model *string `json:"model"`
+	// Confidence and Rationale are nil for detections made before they were recorded.
+	Confidence *float64 `json:"confidence"`
+	Rationale  *string  `json:"rationale"`
+	// ExampleIDs are ids of tagged detections used as few-shot examples. Empty for zero-shot detections.
+	ExampleIDs []int64   `json:"example_ids"`
+	CreatedAt  time.Time `json:"created_at"`
+	// Search is a full-text search match of the detection. Nil if the detections were not searched.
+	Search *DetectionSearchMatch `json:"search,omitempty"`
+}
+
+// DetectionSearchMatch describes how a detection matched a full-text search query.
+type DetectionSearchMatch struct {
+	// Rank is a relevance of the detection and its source post to the query, higher is more relevant.
+	Rank float64 `json:"rank"`
+	// Snippet is a fragment of the post and property values with matches wrapped in <mark></mark>.
+	Snippet string `json:"snippet"`
 }
 
 type DetectionTags struct {
@@ -48,6 +79,41 @@ type DetectionQuery struct {
 	Limit  int64
 	Order  DetectionOrder
 	Filter *DetectionFilter
+	// Sort is a field to sort detections by in Order direction, ties are broken by id.
+	Sort DetectionSort
+	// Search is a full-text search query (web search syntax) over extracted property values and source posts.
+	//
+	// Example: "golang -rust \"code review\""
+	Search *string
+	// After is a keyset pagination cursor of the last seen detection. It works with any sort,
+	// while LastSeenID works only with sorting by id.
+	After *DetectionCursor
+}
+
+// DetectionSortField is a field to sort detections by.
+type DetectionSortField string
+
+const (
+	// DetectionSortByID sorts detections by their ids. It is the default sort.
+	DetectionSortByID DetectionSortField = ""
+	// DetectionSortByCreatedAt sorts detections by their creation time.
+	DetectionSortByCreatedAt DetectionSortField = "created_at"
+	// DetectionSortByProperty sorts detections by a value of an extracted property.
+	// Detections without the property come last.
+	DetectionSortByProperty DetectionSortField = "property"
+	// DetectionSortByRank sorts detections by their full-text search rank. Requires a search query.
+	DetectionSortByRank DetectionSortField = "rank"
+	// DetectionSortByConfidence sorts detections by their confidence.
+	// Detections without a confidence come last.
+	DetectionSortByConfidence DetectionSortField = "confidence"
+)
+
+type DetectionSort struct {
+	Field DetectionSortField
+	// Property is an extracted property to sort by if Field is DetectionSortByProperty.
+	//
+	// Example: "idea_success"
+	Property string
 }
 
 type DetectionFilter struct {
@@ -60,7 +126,32 @@ type DetectionFilter struct {
 	// Example: ["reddit", "linkedin"]
 	Sources    *[]string
 	IsRelevant *bool
-	Tags       DetectionTagsFilter
+	// MinConfidence and MaxConfidence match detections with a confidence in the range (inclusive).
+	// Detections without a confidence don't match any of them.
+	MinConfidence *float64
+	MaxConfidence *float64
+	Tags          DetectionTagsFilter
+	// Properties is a list of extracted property predicates, all of them must match.
+	Properties []PropertyFilter
+}
+
+// PropertyFilter is a set of predicates on an extracted property, all of the set predicates must match.
+//
+// Example: {key: "idea_success", gte: 5, lte: 8}
+type PropertyFilter struct {
+	Key string
+	// Equals matches a property equal to a typed value (e.g. true, 7 or "high"). Nil means no predicate.
+	Equals any
+	// Contains matches a property which text contains a substring (case-insensitive).
+	Contains *string
+	// Exists matches a present and non-null property if true and a missing or null property if false.
+	Exists *bool
+	// Gte matches a numeric property greater than or equal to a value.
+	Gte *float64
+	// Lte matches a numeric property less than or equal to a value.
+	Lte *float64
+	// Regex matches a property which text matches a POSIX regular expression.
+	Regex *string
 }
 
 type ProfileFilter struct {
@@ -0,0 +1,86 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// DetectionCursor is a position of a detection in a sorted list of detections used for keyset pagination.
+type DetectionCursor struct {
+	ID int64 `json:"id"`
+	// Value is a JSON encoded sort value of the detection. Nil when sorting by id or when the value is missing.
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// NewDetectionCursor returns a cursor of a detection record for a given sort.
+func NewDetectionCursor(record DetectionRecord, sort DetectionSort) (DetectionCursor, error) {
+	cursor := DetectionCursor{
+		ID:    record.ID,
+		Value: nil,
+	}
+
+	var value any
+
+	switch sort.Field {
+	case DetectionSortByID:
+		return cursor, nil
+	case DetectionSortByCreatedAt:
+		value = record.CreatedAt
+	case DetectionSortByProperty:
+		value = record.Properties[sort.Property]
+	case DetectionSortByRank:
+		if record.Search == nil {
+			return DetectionCursor{}, fmt.Errorf("%w: rank sort requires a search query", ErrInvalidDetectionQuery)
+		}
+
+		value = record.Search.Rank
+	case DetectionSortByConfidence:
+		if record.Confidence != nil {
+			value = *record.Confidence
+		}
+	default:
+		return DetectionCursor{}, fmt.Errorf("%w: unknown sort field %q", ErrInvalidDetectionQuery, sort.Field)
+	}
+
+	if value == nil {
+		return cursor, nil
+	}
+
+	valueJSON, err := json.Marshal(value)
+	if err != nil {
+		return DetectionCursor{}, fmt.Errorf("marshal sort value: %w", err)
+	}
+
+	cursor.Value = valueJSON
+
+	return cursor, nil
+}
+
+// Encode returns an opaque string representation of the cursor.
+func (c DetectionCursor) Encode() string {
+	//nolint:errchkjson // cursor consists of an integer and a raw JSON value
+	cursorJSON, _ := json.Marshal(c)
+
+	return base64.RawURLEncoding.EncodeToString(cursorJSON)
+}
+
+// ParseDetectionCursor parses a cursor encoded with DetectionCursor.Encode.
+func ParseDetectionCursor(encoded string) (DetectionCursor, error) {
+	cursorJSON, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return DetectionCursor{}, fmt.Errorf("%w: malformed cursor: %w", ErrInvalidDetectionQuery, err)
+	}
+
+	var cursor DetectionCursor
+
+	if err := json.Unmarshal(cursorJSON, &cursor); err != nil {
+		return DetectionCursor{}, fmt.Errorf("%w: malformed cursor: %w", ErrInvalidDetectionQuery, err)
+	}
+
+	if string(cursor.Value) == "null" {
+		cursor.Value = nil
+	}
+
+	return cursor, nil
+}
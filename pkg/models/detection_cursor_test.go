@@ -0,0 +1,113 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDetectionCursor_RoundTrip(t *testing.T) {
+	confidence := 0.75
+
+	record := DetectionRecord{
+		ID:         42,
+		Properties: map[string]any{"stars": 10, "level": "high", "empty": nil},
+		Confidence: &confidence,
+		CreatedAt:  time.Date(2025, time.January, 2, 3, 4, 5, 0, time.UTC),
+		Search:     &DetectionSearchMatch{Rank: 0.5, Snippet: ""},
+	}
+
+	tests := []struct {
+		name      string
+		record    DetectionRecord
+		sort      DetectionSort
+		wantValue json.RawMessage
+	}{
+		{name: "id", record: record, sort: DetectionSort{Field: DetectionSortByID}, wantValue: nil},
+		{
+			name:      "created at",
+			record:    record,
+			sort:      DetectionSort{Field: DetectionSortByCreatedAt},
+			wantValue: json.RawMessage(`"2025-01-02T03:04:05Z"`),
+		},
+		{
+			name:      "number property",
+			record:    record,
+			sort:      DetectionSort{Field: DetectionSortByProperty, Property: "stars"},
+			wantValue: json.RawMessage(`10`),
+		},
+		{
+			name:      "string property",
+			record:    record,
+			sort:      DetectionSort{Field: DetectionSortByProperty, Property: "level"},
+			wantValue: json.RawMessage(`"high"`),
+		},
+		{
+			name:      "null property",
+			record:    record,
+			sort:      DetectionSort{Field: DetectionSortByProperty, Property: "empty"},
+			wantValue: nil,
+		},
+		{
+			name:      "missing property",
+			record:    record,
+			sort:      DetectionSort{Field: DetectionSortByProperty, Property: "missing"},
+			wantValue: nil,
+		},
+		{name: "rank", record: record, sort: DetectionSort{Field: DetectionSortByRank}, wantValue: json.RawMessage(`0.5`)},
+		{
+			name:      "confidence",
+			record:    record,
+			sort:      DetectionSort{Field: DetectionSortByConfidence},
+			wantValue: json.RawMessage(`0.75`),
+		},
+		{
+			name:      "missing confidence",
+			record:    DetectionRecord{ID: 42},
+			sort:      DetectionSort{Field: DetectionSortByConfidence},
+			wantValue: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor, err := NewDetectionCursor(tt.record, tt.sort)
+			if err != nil {
+				t.Fatalf("new cursor: %v", err)
+			}
+
+			want := DetectionCursor{ID: 42, Value: tt.wantValue}
+			if !reflect.DeepEqual(cursor, want) {
+				t.Errorf("cursor = %+v, want %+v", cursor, want)
+			}
+
+			parsed, err := ParseDetectionCursor(cursor.Encode())
+			if err != nil {
+				t.Fatalf("parse cursor: %v", err)
+			}
+
+			if !reflect.DeepEqual(parsed, want) {
+				t.Errorf("parsed cursor = %+v, want %+v", parsed, want)
+			}
+		})
+	}
+}
+
+func TestNewDetectionCursor_InvalidSort(t *testing.T) {
+	for _, sort := range []DetectionSort{{Field: DetectionSortByRank}, {Field: "score"}} {
+		// the record was not searched, so it has no rank
+		if _, err := NewDetectionCursor(DetectionRecord{ID: 42}, sort); !errors.Is(err, ErrInvalidDetectionQuery) {
+			t.Errorf("sort %q: error = %v, want %v", sort.Field, err, ErrInvalidDetectionQuery)
+		}
+	}
+}
+
+func TestParseDetectionCursor_Malformed(t *testing.T) {
+	for _, encoded := range []string{"not base64!", "bm90IGpzb24"} {
+		if _, err := ParseDetectionCursor(encoded); !errors.Is(err, ErrInvalidDetectionQuery) {
+			t.Errorf("cursor %q: error = %v, want %v", encoded, err, ErrInvalidDetectionQuery)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package models
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// ErrInvalidFewShotSettings is returned when few-shot settings of a profile are invalid.
+var ErrInvalidFewShotSettings = errors.New("invalid few-shot settings")
+
+// MaxFewShotExamples is a maximum number of examples in the analyzer input.
+const MaxFewShotExamples = 50
+
+// FewShotStrategy defines how human-tagged detections are chosen as examples.
+type FewShotStrategy string
+
+const (
+	// BalancedFewShotStrategy chooses equal numbers of relevant and irrelevant examples, the most recently tagged first.
+	BalancedFewShotStrategy FewShotStrategy = "balanced"
+	// RecentCorrectionsFewShotStrategy chooses the most recently tagged detections with incorrectly detected relevance.
+	RecentCorrectionsFewShotStrategy FewShotStrategy = "recent_corrections"
+)
+
+// FewShotSettings enable few-shot prompting: human-tagged detections of the profile are included
+// in the analyzer input as examples with their confirmed relevance.
+type FewShotSettings struct {
+	// Examples is a maximum number of examples.
+	Examples int             `json:"examples"`
+	Strategy FewShotStrategy `json:"strategy"`
+	// MaxTokens is a token budget of examples in the analyzer input. Examples that don't fit are skipped.
+	MaxTokens int `json:"max_tokens"`
+}
+
+// ValidateFewShotSettings checks few-shot settings, nil settings disable few-shot prompting and are valid.
+func ValidateFewShotSettings(settings *FewShotSettings) error {
+	if settings == nil {
+		return nil
+	}
+
+	if settings.Examples < 1 || settings.Examples > MaxFewShotExamples {
+		return fmt.Errorf("%w: examples must be between 1 and %d", ErrInvalidFewShotSettings, MaxFewShotExamples)
+	}
+
+	switch settings.Strategy {
+	case BalancedFewShotStrategy, RecentCorrectionsFewShotStrategy:
+	default:
+		return fmt.Errorf("%w: unknown strategy %q", ErrInvalidFewShotSettings, settings.Strategy)
+	}
+
+	if settings.MaxTokens < 1 {
+		return fmt.Errorf("%w: max tokens must be positive", ErrInvalidFewShotSettings)
+	}
+
+	return nil
+}
+
+// FewShotExample is a human-tagged detection chosen as an example for few-shot prompting.
+type FewShotExample struct {
+	DetectionID int64
+	SourceID    string
+	// IsRelevant is the relevance confirmed by the tag: the detected relevance if it was tagged as correct
+	// and the opposite one otherwise.
+	IsRelevant bool
+}
+
+// FewShotCandidate is a human-tagged detection that may be chosen as an example.
+type FewShotCandidate struct {
+	FewShotExample
+
+	// Corrected is set if the tag says the relevance was detected incorrectly.
+	Corrected bool
+	TaggedAt  time.Time
+}
+
+// ChooseFewShotExamples chooses examples from candidates with a strategy of few-shot settings.
+// Examples are ordered by their priority, so the most important ones come first.
+//
+// Balanced examples alternate relevant and irrelevant ones, each class takes at most a half of examples.
+// Recent corrections are the most recently tagged detections with incorrectly detected relevance.
+func ChooseFewShotExamples(candidates []FewShotCandidate, settings FewShotSettings) ([]FewShotExample, error) {
+	candidates = slices.Clone(candidates)
+	slices.SortStableFunc(candidates, func(a FewShotCandidate, b FewShotCandidate) int {
+		return cmp.Or(b.TaggedAt.Compare(a.TaggedAt), cmp.Compare(b.DetectionID, a.DetectionID))
+	})
+
+	examples := make([]FewShotExample, 0, settings.Examples)
+
+	switch settings.Strategy {
+	case BalancedFewShotStrategy:
+		var relevant, irrelevant []FewShotExample
+
+		for _, candidate := range candidates {
+			if candidate.IsRelevant {
+				relevant = append(relevant, candidate.FewShotExample)
+			} else {
+				irrelevant = append(irrelevant, candidate.FewShotExample)
+			}
+		}
+
+		perClass := (settings.Examples + 1) / 2 //nolint:mnd // a half rounded up
+
+		for i := range min(perClass, max(len(relevant), len(irrelevant))) {
+			if i < len(relevant) {
+				examples = append(examples, relevant[i])
+			}
+
+			if i < len(irrelevant) {
+				examples = append(examples, irrelevant[i])
+			}
+		}
+	case RecentCorrectionsFewShotStrategy:
+		for _, candidate := range candidates {
+			if candidate.Corrected {
+				examples = append(examples, candidate.FewShotExample)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown strategy %q", ErrInvalidFewShotSettings, settings.Strategy)
+	}
+
+	return examples[:min(len(examples), settings.Examples)], nil
+}
@@ -0,0 +1,202 @@
+package models
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestChooseFewShotExamples(t *testing.T) {
+	taggedAt := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	candidate := func(id int64, isRelevant bool, corrected bool, hoursAgo int) FewShotCandidate {
+		return FewShotCandidate{
+			FewShotExample: FewShotExample{DetectionID: id, SourceID: "post", IsRelevant: isRelevant},
+			Corrected:      corrected,
+			TaggedAt:       taggedAt.Add(-time.Duration(hoursAgo) * time.Hour),
+		}
+	}
+
+	candidates := []FewShotCandidate{
+		candidate(1, true, false, 5),
+		candidate(2, true, true, 1),
+		candidate(3, false, false, 2),
+		candidate(4, true, false, 3),
+		candidate(5, false, true, 4),
+		candidate(6, true, true, 0),
+	}
+
+	onlyRelevant := []FewShotCandidate{
+		candidate(1, true, false, 2),
+		candidate(2, true, false, 1),
+		candidate(3, true, false, 0),
+	}
+
+	tests := []struct {
+		name       string
+		candidates []FewShotCandidate
+		settings   FewShotSettings
+		wantIDs    []int64
+	}{
+		{
+			name:       "balanced alternates classes",
+			candidates: candidates,
+			settings:   FewShotSettings{Examples: 4, Strategy: BalancedFewShotStrategy, MaxTokens: 1000},
+			wantIDs:    []int64{6, 3, 2, 5},
+		},
+		{
+			name:       "balanced odd number of examples",
+			candidates: candidates,
+			settings:   FewShotSettings{Examples: 3, Strategy: BalancedFewShotStrategy, MaxTokens: 1000},
+			wantIDs:    []int64{6, 3, 2},
+		},
+		{
+			name:       "balanced takes at most a half of one class",
+			candidates: onlyRelevant,
+			settings:   FewShotSettings{Examples: 4, Strategy: BalancedFewShotStrategy, MaxTokens: 1000},
+			wantIDs:    []int64{3, 2},
+		},
+		{
+			name:       "balanced with missing class rounds the half up",
+			candidates: onlyRelevant,
+			settings:   FewShotSettings{Examples: 3, Strategy: BalancedFewShotStrategy, MaxTokens: 1000},
+			wantIDs:    []int64{3, 2},
+		},
+		{
+			name:       "balanced more examples than candidates",
+			candidates: candidates,
+			settings:   FewShotSettings{Examples: 50, Strategy: BalancedFewShotStrategy, MaxTokens: 1000},
+			wantIDs:    []int64{6, 3, 2, 5, 4, 1},
+		},
+		{
+			name:       "recent corrections",
+			candidates: candidates,
+			settings:   FewShotSettings{Examples: 10, Strategy: RecentCorrectionsFewShotStrategy, MaxTokens: 1000},
+			wantIDs:    []int64{6, 2, 5},
+		},
+		{
+			name:       "recent corrections limited",
+			candidates: candidates,
+			settings:   FewShotSettings{Examples: 2, Strategy: RecentCorrectionsFewShotStrategy, MaxTokens: 1000},
+			wantIDs:    []int64{6, 2},
+		},
+		{
+			name:       "recent corrections without corrections",
+			candidates: onlyRelevant,
+			settings:   FewShotSettings{Examples: 2, Strategy: RecentCorrectionsFewShotStrategy, MaxTokens: 1000},
+			wantIDs:    []int64{},
+		},
+		{
+			name:       "no candidates",
+			candidates: nil,
+			settings:   FewShotSettings{Examples: 2, Strategy: BalancedFewShotStrategy, MaxTokens: 1000},
+			wantIDs:    []int64{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			examples, err := ChooseFewShotExamples(tt.candidates, tt.settings)
+			if err != nil {
+				t.Fatalf("choose examples: %v", err)
+			}
+
+			ids := make([]int64, 0, len(examples))
+			for _, example := range examples {
+				ids = append(ids, example.DetectionID)
+			}
+
+			if !reflect.DeepEqual(ids, tt.wantIDs) {
+				t.Errorf("examples = %v, want %v", ids, tt.wantIDs)
+			}
+		})
+	}
+}
+
+func TestChooseFewShotExamples_TieBreak(t *testing.T) {
+	taggedAt := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	candidates := []FewShotCandidate{
+		{
+			FewShotExample: FewShotExample{DetectionID: 1, SourceID: "a", IsRelevant: false},
+			Corrected:      true,
+			TaggedAt:       taggedAt,
+		},
+		{
+			FewShotExample: FewShotExample{DetectionID: 2, SourceID: "b", IsRelevant: false},
+			Corrected:      true,
+			TaggedAt:       taggedAt,
+		},
+	}
+
+	settings := FewShotSettings{Examples: 1, Strategy: RecentCorrectionsFewShotStrategy, MaxTokens: 1000}
+
+	// detections tagged at the same time are chosen from the latest one
+	examples, err := ChooseFewShotExamples(candidates, settings)
+	if err != nil || len(examples) != 1 || examples[0].DetectionID != 2 {
+		t.Errorf("examples = %+v, err = %v, want detection 2", examples, err)
+	}
+}
+
+func TestChooseFewShotExamples_UnknownStrategy(t *testing.T) {
+	settings := FewShotSettings{Examples: 1, Strategy: "random", MaxTokens: 1000}
+
+	if _, err := ChooseFewShotExamples(nil, settings); !errors.Is(err, ErrInvalidFewShotSettings) {
+		t.Errorf("error = %v, want %v", err, ErrInvalidFewShotSettings)
+	}
+}
+
+func TestValidateFewShotSettings(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings *FewShotSettings
+		valid    bool
+	}{
+		{name: "disabled", settings: nil, valid: true},
+		{
+			name:     "balanced",
+			settings: &FewShotSettings{Examples: 4, Strategy: BalancedFewShotStrategy, MaxTokens: 1000},
+			valid:    true,
+		},
+		{
+			name:     "max examples",
+			settings: &FewShotSettings{Examples: MaxFewShotExamples, Strategy: RecentCorrectionsFewShotStrategy, MaxTokens: 1},
+			valid:    true,
+		},
+		{
+			name:     "no examples",
+			settings: &FewShotSettings{Examples: 0, Strategy: BalancedFewShotStrategy, MaxTokens: 1000},
+			valid:    false,
+		},
+		{
+			name:     "too many examples",
+			settings: &FewShotSettings{Examples: MaxFewShotExamples + 1, Strategy: BalancedFewShotStrategy, MaxTokens: 1000},
+			valid:    false,
+		},
+		{
+			name:     "unknown strategy",
+			settings: &FewShotSettings{Examples: 4, Strategy: "random", MaxTokens: 1000},
+			valid:    false,
+		},
+		{
+			name:     "no token budget",
+			settings: &FewShotSettings{Examples: 4, Strategy: BalancedFewShotStrategy, MaxTokens: 0},
+			valid:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateFewShotSettings(tt.settings)
+
+			if tt.valid && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			if !tt.valid && !errors.Is(err, ErrInvalidFewShotSettings) {
+				t.Errorf("error = %v, want %v", err, ErrInvalidFewShotSettings)
+			}
+		})
+	}
+}
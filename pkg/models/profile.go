@@ -21,8 +21,33 @@ type ProfileSettings struct {
 	Version             int64             `json:"version"`
 	RelevancyFilter     string            `json:"relevancy_filter"`
 	ExtractedProperties map[string]string `json:"extracted_properties"`
-	CreatedAt           time.Time         `json:"created_at"`
-	UpdatedAt           time.Time         `json:"updated_at"`
+	// PropertyTypes are types of extracted properties. Properties without a type are optional strings.
+	PropertyTypes map[string]PropertyType `json:"property_types"`
+	// Model pins the analysis to a specific model. Nil means the default analyzers chain.
+	Model *string `json:"model"`
+	// FewShot enables few-shot prompting with human-tagged detections. Nil means zero-shot analysis.
+	FewShot   *FewShotSettings `json:"few_shot"`
+	CreatedAt time.Time        `json:"created_at"`
+	UpdatedAt time.Time        `json:"updated_at"`
+}
+
+// PropertyDefinitions returns definitions of all extracted properties.
+func (s ProfileSettings) PropertyDefinitions() map[string]PropertyDefinition {
+	definitions := make(map[string]PropertyDefinition, len(s.ExtractedProperties))
+
+	for property, description := range s.ExtractedProperties {
+		propertyType, ok := s.PropertyTypes[property]
+		if !ok {
+			propertyType = PropertyType{Kind: PropertyKindString, Enum: nil, Required: false}
+		}
+
+		definitions[property] = PropertyDefinition{
+			Description:  description,
+			PropertyType: propertyType,
+		}
+	}
+
+	return definitions
 }
 
 type ProfileUpdate struct {
@@ -39,4 +64,45 @@ type ProfileUpdate struct {
 type ProfileSettingsUpdate struct {
 	RelevancyFilter     *string
 	ExtractedProperties *map[string]string
+	// PropertyTypes replaces all property types if not nil
+	PropertyTypes *map[string]PropertyType
+	// If the value is set, null means that the model must be unpinned
+	Model nullable.Nullable[string]
+	// If the value is set, null means that few-shot prompting must be disabled
+	FewShot nullable.Nullable[FewShotSettings]
+}
+
+// ProfileSettingsVersion is an immutable snapshot of profile settings.
+type ProfileSettingsVersion struct {
+	ProfileID int64 `json:"profile_id"`
+	// Source is a source of settings. Nil means default settings.
+	Source              *string                 `json:"source"`
+	Version             int64                   `json:"version"`
+	RelevancyFilter     string                  `json:"relevancy_filter"`
+	ExtractedProperties map[string]string       `json:"extracted_properties"`
+	PropertyTypes       map[string]PropertyType `json:"property_types"`
+	Model               *string                 `json:"model"`
+	FewShot             *FewShotSettings        `json:"few_shot"`
+	CreatedAt           time.Time               `json:"created_at"`
+}
+
+// ProfileSettingsDiff describes changes between two versions of profile settings.
+type ProfileSettingsDiff struct {
+	From                   ProfileSettingsVersion `json:"from"`
+	To                     ProfileSettingsVersion `json:"to"`
+	RelevancyFilterChanged bool                   `json:"relevancy_filter_changed"`
+	ModelChanged           bool                   `json:"model_changed"`
+	FewShotChanged         bool                   `json:"few_shot_changed"`
+	PropertyTypesChanged   bool                   `json:"property_types_changed"`
+	// AddedProperties are properties present only in the newer version.
+	AddedProperties map[string]string `json:"added_properties"`
+	// RemovedProperties are properties present only in the older version.
+	RemovedProperties map[string]string `json:"removed_properties"`
+	// ChangedProperties are properties present in both versions with different definitions.
+	ChangedProperties map[string]PropertyDefinitionChange `json:"changed_properties"`
+}
+
+type PropertyDefinitionChange struct {
+	From string `json:"from"`
+	To   string `json:"to"`
 }
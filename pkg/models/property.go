@@ -0,0 +1,95 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ErrInvalidPropertyType is returned when a property type of profile settings is invalid.
+var ErrInvalidPropertyType = errors.New("invalid property type")
+
+// PropertyKind is a kind of value extracted for a property.
+type PropertyKind string
+
+const (
+	// PropertyKindString is a free-form text value. It is the kind of properties without an explicit type.
+	PropertyKindString PropertyKind = "string"
+	// PropertyKindEnum is a text value from a fixed list of allowed values.
+	PropertyKindEnum PropertyKind = "enum"
+	// PropertyKindNumber is a numeric value.
+	PropertyKindNumber PropertyKind = "number"
+	// PropertyKindBoolean is a true/false value.
+	PropertyKindBoolean PropertyKind = "boolean"
+	// PropertyKindList is a list of text values.
+	PropertyKindList PropertyKind = "list"
+	// PropertyKindURL is an absolute URL.
+	PropertyKindURL PropertyKind = "url"
+)
+
+var propertyKinds = []PropertyKind{
+	PropertyKindString,
+	PropertyKindEnum,
+	PropertyKindNumber,
+	PropertyKindBoolean,
+	PropertyKindList,
+	PropertyKindURL,
+}
+
+// PropertyType describes the value of an extracted property.
+type PropertyType struct {
+	Kind PropertyKind `json:"kind"`
+	// Enum is a list of allowed values of an enum property.
+	Enum []string `json:"enum,omitempty"`
+	// Required properties are always extracted, optional properties may be null.
+	Required bool `json:"required"`
+}
+
+// Validate checks that the property type is consistent.
+func (t PropertyType) Validate() error {
+	if !slices.Contains(propertyKinds, t.Kind) {
+		return fmt.Errorf("%w: unknown kind %q", ErrInvalidPropertyType, t.Kind)
+	}
+
+	if t.Kind == PropertyKindEnum && len(t.Enum) == 0 {
+		return fmt.Errorf("%w: enum property must have allowed values", ErrInvalidPropertyType)
+	}
+
+	if t.Kind != PropertyKindEnum && len(t.Enum) > 0 {
+		return fmt.Errorf("%w: allowed values are supported only by enum properties", ErrInvalidPropertyType)
+	}
+
+	return nil
+}
+
+// PropertyDefinition is a complete definition of an extracted property: its description (prompt) and its type.
+type PropertyDefinition struct {
+	Description string `json:"description"`
+	PropertyType
+}
+
+// ValidatePropertyTypes checks that every property type is valid.
+func ValidatePropertyTypes(propertyTypes map[string]PropertyType) error {
+	for property, propertyType := range propertyTypes {
+		if err := propertyType.Validate(); err != nil {
+			return fmt.Errorf("property %s: %w", property, err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateTypedProperties checks that every property type is valid and belongs to an extracted property.
+func ValidateTypedProperties(extractedProperties map[string]string, propertyTypes map[string]PropertyType) error {
+	if err := ValidatePropertyTypes(propertyTypes); err != nil {
+		return err
+	}
+
+	for property := range propertyTypes {
+		if _, ok := extractedProperties[property]; !ok {
+			return fmt.Errorf("%w: property %s is not extracted", ErrInvalidPropertyType, property)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package models
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestPropertyType_Validate(t *testing.T) {
+	tests := []struct {
+		name         string
+		propertyType PropertyType
+		valid        bool
+	}{
+		{name: "string", propertyType: PropertyType{Kind: PropertyKindString}, valid: true},
+		{name: "required number", propertyType: PropertyType{Kind: PropertyKindNumber, Required: true}, valid: true},
+		{name: "boolean", propertyType: PropertyType{Kind: PropertyKindBoolean}, valid: true},
+		{name: "list", propertyType: PropertyType{Kind: PropertyKindList}, valid: true},
+		{name: "url", propertyType: PropertyType{Kind: PropertyKindURL}, valid: true},
+		{name: "enum", propertyType: PropertyType{Kind: PropertyKindEnum, Enum: []string{"low", "high"}}, valid: true},
+		{name: "enum without values", propertyType: PropertyType{Kind: PropertyKindEnum}, valid: false},
+		{
+			name:         "values of a string",
+			propertyType: PropertyType{Kind: PropertyKindString, Enum: []string{"low"}},
+			valid:        false,
+		},
+		{name: "unknown kind", propertyType: PropertyType{Kind: "date"}, valid: false},
+		{name: "no kind", propertyType: PropertyType{}, valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.propertyType.Validate()
+
+			if tt.valid && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			if !tt.valid && !errors.Is(err, ErrInvalidPropertyType) {
+				t.Errorf("error = %v, want %v", err, ErrInvalidPropertyType)
+			}
+		})
+	}
+}
+
+func TestValidateTypedProperties(t *testing.T) {
+	extractedProperties := map[string]string{"stars": "Stars of the project", "summary": "Summary"}
+
+	tests := []struct {
+		name          string
+		propertyTypes map[string]PropertyType
+		valid         bool
+	}{
+		{name: "no types", propertyTypes: nil, valid: true},
+		{name: "typed property", propertyTypes: map[string]PropertyType{"stars": {Kind: PropertyKindNumber}}, valid: true},
+		{name: "invalid type", propertyTypes: map[string]PropertyType{"stars": {Kind: PropertyKindEnum}}, valid: false},
+		{name: "not extracted", propertyTypes: map[string]PropertyType{"language": {Kind: PropertyKindString}}, valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTypedProperties(extractedProperties, tt.propertyTypes)
+
+			if tt.valid && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			if !tt.valid && !errors.Is(err, ErrInvalidPropertyType) {
+				t.Errorf("error = %v, want %v", err, ErrInvalidPropertyType)
+			}
+		})
+	}
+}
+
+func TestProfileSettings_PropertyDefinitions(t *testing.T) {
+	settings := ProfileSettings{
+		ExtractedProperties: map[string]string{"stars": "Stars", "summary": "Summary"},
+		PropertyTypes:       map[string]PropertyType{"stars": {Kind: PropertyKindNumber, Enum: nil, Required: true}},
+	}
+
+	want := map[string]PropertyDefinition{
+		"stars": {Description: "Stars", PropertyType: PropertyType{Kind: PropertyKindNumber, Enum: nil, Required: true}},
+		// properties without a type are optional strings
+		"summary": {Description: "Summary", PropertyType: PropertyType{Kind: PropertyKindString, Enum: nil, Required: false}},
+	}
+
+	if got := settings.PropertyDefinitions(); !reflect.DeepEqual(got, want) {
+		t.Errorf("definitions = %+v, want %+v", got, want)
+	}
+}
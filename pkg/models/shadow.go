@@ -0,0 +1,122 @@
+package models
+
+import (
+	"errors"
+	"slices"
+	"time"
+)
+
+// ErrInvalidShadow is returned when a shadow can't be started.
+var ErrInvalidShadow = errors.New("invalid shadow")
+
+// Shadow evaluates candidate settings of a profile on live traffic: each post scheduled for the profile
+// is also analyzed with the candidate settings, and the results are compared with production detections.
+// Detections of the candidate settings are not saved, so they don't appear in the feed.
+type Shadow struct {
+	ID        int64
+	ProfileID int64
+	// Source limits the shadow to posts of a source. Nil means posts of all sources.
+	Source *string
+	// Settings are the candidate settings, they are used for posts of all sources.
+	Settings  ProfileSettings
+	Summary   ShadowSummary
+	CreatedAt time.Time
+	// StoppedAt is set when the shadow stops receiving new posts.
+	StoppedAt *time.Time
+}
+
+// Active reports whether new scheduled posts are analyzed with the shadow settings.
+func (s Shadow) Active() bool {
+	return s.StoppedAt == nil
+}
+
+// Covers reports whether posts of a source are analyzed with the shadow settings.
+func (s Shadow) Covers(source string) bool {
+	return s.Source == nil || *s.Source == source
+}
+
+// ShadowSummary aggregates results of a shadow.
+type ShadowSummary struct {
+	// Analyzed is a number of posts analyzed with the shadow settings.
+	Analyzed int64
+	// Failed is a number of posts that could not be analyzed with the shadow settings.
+	Failed int64
+	// Unpaired is a number of analyzed posts without a production detection yet.
+	Unpaired int64
+	// Agreed is a number of posts with the same relevance and properties in both detections.
+	Agreed                 int64
+	RelevanceDisagreements int64
+	PropertyDisagreements  int64
+	// ProductionRelevant and ShadowRelevant are numbers of paired posts detected as relevant by each settings.
+	ProductionRelevant int64
+	ShadowRelevant     int64
+}
+
+// ShadowDisagreementKind describes how a shadow detection differs from the production one.
+type ShadowDisagreementKind string
+
+const (
+	// RelevanceDisagreement means the post is relevant for only one of the settings.
+	RelevanceDisagreement ShadowDisagreementKind = "relevance"
+	// PropertiesDisagreement means the post is relevant for both settings but extracted properties differ.
+	PropertiesDisagreement ShadowDisagreementKind = "properties"
+)
+
+// ShadowResult is a result of analyzing a post with the shadow settings.
+type ShadowResult struct {
+	ShadowID int64
+	Source   string
+	SourceID string
+	// Detection is nil if the post could not be analyzed.
+	Detection *Detection
+	// Error is set if the post could not be analyzed.
+	Error *string
+	// ProductionDetection is the latest saved detection of the post by the profile, nil until it is saved.
+	ProductionDetection *DetectionRecord
+	CreatedAt           time.Time
+}
+
+// Disagreement compares the shadow detection with the production one.
+// Results without any of the detections don't disagree.
+func (r ShadowResult) Disagreement() (kind ShadowDisagreementKind, disagrees bool) {
+	if r.Detection == nil || r.ProductionDetection == nil {
+		return "", false
+	}
+
+	if r.Detection.IsRelevant != r.ProductionDetection.IsRelevant {
+		return RelevanceDisagreement, true
+	}
+
+	if r.Detection.IsRelevant && len(r.MismatchedProperties()) > 0 {
+		return PropertiesDisagreement, true
+	}
+
+	return "", false
+}
+
+// MismatchedProperties returns properties with different values in the shadow and production detections,
+// sorted by name. Properties extracted by only one of the settings are mismatched too.
+func (r ShadowResult) MismatchedProperties() []string {
+	mismatched := make([]string, 0)
+
+	if r.Detection == nil || r.ProductionDetection == nil {
+		return mismatched
+	}
+
+	for property, productionValue := range r.ProductionDetection.Properties {
+		shadowValue, ok := r.Detection.Properties[property]
+		if !ok || !jsonValuesEqual(productionValue, shadowValue) {
+			mismatched = append(mismatched, property)
+		}
+	}
+
+	for property := range r.Detection.Properties {
+		if _, ok := r.ProductionDetection.Properties[property]; !ok {
+			mismatched = append(mismatched, property)
+		}
+	}
+
+	slices.Sort(mismatched)
+
+	return mismatched
+}
@@ -0,0 +1,122 @@
+package models
+
+import (
+	"time"
+)
+
+type ProfileStatistics struct {
+	ProfileID int64 `json:"profile_id"`
+	// Detections is an aggregate of detections over all sources and settings versions.
+	Detections DetectionStatistics `json:"detections"`
+	// Sources is a breakdown of detections by source.
+	Sources []SourceStatistics `json:"sources"`
+	// Tasks is a state of the profile's analysis tasks queue.
+	Tasks TaskStatistics `json:"tasks"`
+}
+
+type SourceStatistics struct {
+	Source     string              `json:"source"`
+	Detections DetectionStatistics `json:"detections"`
+	// SettingsVersions is a breakdown of the source's detections by settings version.
+	SettingsVersions []SettingsVersionStatistics `json:"settings_versions"`
+}
+
+type SettingsVersionStatistics struct {
+	Source          string              `json:"source"`
+	SettingsVersion int64               `json:"settings_version"`
+	Detections      DetectionStatistics `json:"detections"`
+}
+
+type DetectionStatistics struct {
+	Total      int64 `json:"total"`
+	Relevant   int64 `json:"relevant"`
+	Irrelevant int64 `json:"irrelevant"`
+	// TaggedCorrect is an amount of detections tagged as correctly detected.
+	TaggedCorrect int64 `json:"tagged_correct"`
+	// TaggedWrong is an amount of detections tagged as wrongly detected.
+	TaggedWrong int64 `json:"tagged_wrong"`
+}
+
+// Add returns a sum of two detection statistics.
+func (s DetectionStatistics) Add(other DetectionStatistics) DetectionStatistics {
+	return DetectionStatistics{
+		Total:         s.Total + other.Total,
+		Relevant:      s.Relevant + other.Relevant,
+		Irrelevant:    s.Irrelevant + other.Irrelevant,
+		TaggedCorrect: s.TaggedCorrect + other.TaggedCorrect,
+		TaggedWrong:   s.TaggedWrong + other.TaggedWrong,
+	}
+}
+
+type TaskStatistics struct {
+	// Pending is an amount of tasks waiting to be claimed.
+	Pending int64 `json:"pending"`
+	// Claimed is an amount of tasks being processed right now.
+	Claimed int64 `json:"claimed"`
+	// Failed is an amount of tasks that exceeded max attempts.
+	Failed int64 `json:"failed"`
+	// Committed is an amount of successfully processed tasks.
+	Committed int64 `json:"committed"`
+}
+
+// ConfusionMatrix counts posts by detected and actual relevance. Relevant posts are positives.
+type ConfusionMatrix struct {
+	TruePositives  int64 `json:"true_positives"`
+	FalsePositives int64 `json:"false_positives"`
+	TrueNegatives  int64 `json:"true_negatives"`
+	FalseNegatives int64 `json:"false_negatives"`
+}
+
+// Precision is a share of relevant posts among posts detected as relevant. Nil if no posts were detected.
+func (m ConfusionMatrix) Precision() *float64 {
+	return ratio(m.TruePositives, m.TruePositives+m.FalsePositives)
+}
+
+// Recall is a share of relevant posts detected as relevant. Nil if there are no relevant posts.
+func (m ConfusionMatrix) Recall() *float64 {
+	return ratio(m.TruePositives, m.TruePositives+m.FalseNegatives)
+}
+
+// F1 is a harmonic mean of precision and recall.
+// Nil if there are no relevant posts and no posts were detected as relevant.
+func (m ConfusionMatrix) F1() *float64 {
+	return ratio(2*m.TruePositives, 2*m.TruePositives+m.FalsePositives+m.FalseNegatives) //nolint:mnd // F1 formula
+}
+
+// Accuracy is a share of posts with expected relevance. Nil if the matrix is empty.
+func (m ConfusionMatrix) Accuracy() *float64 {
+	return ratio(
+		m.TruePositives+m.TrueNegatives,
+		m.TruePositives+m.TrueNegatives+m.FalsePositives+m.FalseNegatives,
+	)
+}
+
+// AccuracyFilter selects detections of a profile and source for accuracy statistics.
+type AccuracyFilter struct {
+	ProfileID int64
+	Source    string
+	// Since and Until limit detection creation time, nil means no limit.
+	Since *time.Time
+	Until *time.Time
+}
+
+// SettingsVersionAccuracy is an accuracy of detections made with a settings version,
+// measured by relevancy tags: a detection tagged as correct is true, as wrong is false.
+type SettingsVersionAccuracy struct {
+	SettingsVersion int64 `json:"settings_version"`
+	// Detections is an amount of detections made with the version, including untagged ones.
+	Detections int64 `json:"detections"`
+	// Tagged is an amount of detections with a relevancy tag, they form the confusion matrix.
+	Tagged int64 `json:"tagged"`
+	ConfusionMatrix
+}
+
+func ratio(numerator int64, denominator int64) *float64 {
+	if denominator == 0 {
+		return nil
+	}
+
+	value := float64(numerator) / float64(denominator)
+
+	return &value
+}
@@ -0,0 +1,102 @@
+package models
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestConfusionMatrix(t *testing.T) {
+	ptr := func(value float64) *float64 { return &value }
+
+	tests := []struct {
+		name          string
+		matrix        ConfusionMatrix
+		wantPrecision *float64
+		wantRecall    *float64
+		wantF1        *float64
+		wantAccuracy  *float64
+	}{
+		{
+			name:          "empty",
+			matrix:        ConfusionMatrix{TruePositives: 0, FalsePositives: 0, TrueNegatives: 0, FalseNegatives: 0},
+			wantPrecision: nil,
+			wantRecall:    nil,
+			wantF1:        nil,
+			wantAccuracy:  nil,
+		},
+		{
+			name:          "no predicted positives",
+			matrix:        ConfusionMatrix{TruePositives: 0, FalsePositives: 0, TrueNegatives: 3, FalseNegatives: 1},
+			wantPrecision: nil,
+			wantRecall:    ptr(0),
+			wantF1:        ptr(0),
+			wantAccuracy:  ptr(0.75),
+		},
+		{
+			name:          "no actual positives",
+			matrix:        ConfusionMatrix{TruePositives: 0, FalsePositives: 1, TrueNegatives: 3, FalseNegatives: 0},
+			wantPrecision: ptr(0),
+			wantRecall:    nil,
+			wantF1:        ptr(0),
+			wantAccuracy:  ptr(0.75),
+		},
+		{
+			name:          "only negatives",
+			matrix:        ConfusionMatrix{TruePositives: 0, FalsePositives: 0, TrueNegatives: 4, FalseNegatives: 0},
+			wantPrecision: nil,
+			wantRecall:    nil,
+			wantF1:        nil,
+			wantAccuracy:  ptr(1),
+		},
+		{
+			name:          "normal",
+			matrix:        ConfusionMatrix{TruePositives: 6, FalsePositives: 2, TrueNegatives: 10, FalseNegatives: 2},
+			wantPrecision: ptr(0.75),
+			wantRecall:    ptr(0.75),
+			wantF1:        ptr(0.75),
+			wantAccuracy:  ptr(0.8),
+		},
+		{
+			name:          "precision and recall differ",
+			matrix:        ConfusionMatrix{TruePositives: 2, FalsePositives: 0, TrueNegatives: 0, FalseNegatives: 2},
+			wantPrecision: ptr(1),
+			wantRecall:    ptr(0.5),
+			wantF1:        ptr(2.0 / 3),
+			wantAccuracy:  ptr(0.5),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertRatio(t, "precision", tt.matrix.Precision(), tt.wantPrecision)
+			assertRatio(t, "recall", tt.matrix.Recall(), tt.wantRecall)
+			assertRatio(t, "f1", tt.matrix.F1(), tt.wantF1)
+			assertRatio(t, "accuracy", tt.matrix.Accuracy(), tt.wantAccuracy)
+		})
+	}
+}
+
+func TestTestRunSummary_PropertyAccuracy(t *testing.T) {
+	assertRatio(t, "property accuracy", TestRunSummary{PropertiesChecked: 0}.PropertyAccuracy(), nil)
+
+	summary := TestRunSummary{PropertiesChecked: 4, PropertiesMatched: 1}
+	want := 0.25
+
+	assertRatio(t, "property accuracy", summary.PropertyAccuracy(), &want)
+}
+
+func assertRatio(t *testing.T, name string, got *float64, want *float64) {
+	t.Helper()
+
+	if format(got) != format(want) {
+		t.Errorf("%s = %s, want %s", name, format(got), format(want))
+	}
+}
+
+func format(value *float64) string {
+	if value == nil {
+		return "nil"
+	}
+
+	return fmt.Sprintf("%.6f", *value)
+}
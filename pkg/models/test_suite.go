@@ -0,0 +1,316 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidTestCase is returned when a test case has malformed parameters.
+	ErrInvalidTestCase = errors.New("invalid test case")
+	// ErrInvalidTestRun is returned when a test run can't be started.
+	ErrInvalidTestRun = errors.New("invalid test run")
+)
+
+// TestCase is an expected result of analyzing a source post with a profile.
+type TestCase struct {
+	ID        int64
+	ProfileID int64
+	Source    string
+	SourceID  string
+	// ExpectedRelevant is the expected relevance of the post.
+	ExpectedRelevant bool
+	// ExpectedProperties are expected values of extracted properties typed as detection properties.
+	// Properties that are not listed are not checked.
+	ExpectedProperties map[string]any
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// Validate checks that the test case refers to a post.
+func (c TestCase) Validate() error {
+	if c.Source == "" || c.SourceID == "" {
+		return fmt.Errorf("%w: source and source id are required", ErrInvalidTestCase)
+	}
+
+	return nil
+}
+
+// MismatchedProperties returns expected properties with other actual values, sorted by name.
+//
+// Strings are compared ignoring case and surrounding spaces, other values must be equal.
+func (c TestCase) MismatchedProperties(actual map[string]any) []string {
+	mismatched := make([]string, 0)
+
+	for property, expected := range c.ExpectedProperties {
+		value, ok := actual[property]
+		if !ok || !propertyValuesEqual(expected, value) {
+			mismatched = append(mismatched, property)
+		}
+	}
+
+	slices.Sort(mismatched)
+
+	return mismatched
+}
+
+func propertyValuesEqual(expected any, actual any) bool {
+	expectedString, expectedIsString := expected.(string)
+	actualString, actualIsString := actual.(string)
+
+	if expectedIsString && actualIsString {
+		return strings.EqualFold(strings.TrimSpace(expectedString), strings.TrimSpace(actualString))
+	}
+
+	return jsonValuesEqual(expected, actual)
+}
+
+// jsonValuesEqual compares values in their JSON form, so e.g. []string and []any of the same strings are equal.
+func jsonValuesEqual(expected any, actual any) bool {
+	expectedJSON, err := json.Marshal(expected)
+	if err != nil {
+		return false
+	}
+
+	actualJSON, err := json.Marshal(actual)
+	if err != nil {
+		return false
+	}
+
+	var normalizedExpected, normalizedActual any
+
+	if err := json.Unmarshal(expectedJSON, &normalizedExpected); err != nil {
+		return false
+	}
+
+	if err := json.Unmarshal(actualJSON, &normalizedActual); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(normalizedExpected, normalizedActual)
+}
+
+// TestRunSettings are profile settings a test run analyzes posts with.
+type TestRunSettings struct {
+	// Draft is set when the settings were not saved to the profile, they are used for all sources.
+	Draft           bool                       `json:"draft"`
+	DefaultSettings *ProfileSettings           `json:"default_settings"`
+	SourcesSettings map[string]ProfileSettings `json:"sources_settings"`
+}
+
+// ForSource returns settings of a source, falling back to default settings.
+func (s TestRunSettings) ForSource(source string) (ProfileSettings, bool) {
+	if settings, ok := s.SourcesSettings[source]; ok {
+		return settings, true
+	}
+
+	if s.DefaultSettings != nil {
+		return *s.DefaultSettings, true
+	}
+
+	return ProfileSettings{}, false
+}
+
+// TestRunStatus is a state of a test run.
+type TestRunStatus string
+
+const (
+	// TestRunRunning means some test cases are not analyzed yet.
+	TestRunRunning TestRunStatus = "running"
+	// TestRunCompleted means all test cases are analyzed or failed.
+	TestRunCompleted TestRunStatus = "completed"
+)
+
+// TestRun is a run of a profile test suite against profile settings.
+type TestRun struct {
+	ID        int64
+	ProfileID int64
+	Settings  TestRunSettings
+	Summary   TestRunSummary
+	CreatedAt time.Time
+	// CompletedAt is set when all test cases are analyzed or failed.
+	CompletedAt *time.Time
+}
+
+// Status returns a state of the run.
+func (r TestRun) Status() TestRunStatus {
+	if r.Summary.Pending > 0 {
+		return TestRunRunning
+	}
+
+	return TestRunCompleted
+}
+
+// TestRunSummary aggregates results of a test run. Relevant posts are positives.
+type TestRunSummary struct {
+	Total   int64
+	Pending int64
+	// Failed is a number of test cases that could not be analyzed.
+	Failed int64
+	ConfusionMatrix
+	// PropertiesChecked is a number of expected property values of analyzed test cases.
+	PropertiesChecked int64
+	// PropertiesMatched is a number of expected property values equal to extracted ones.
+	PropertiesMatched int64
+	// Passed is a number of test cases with expected relevance and property values.
+	Passed int64
+}
+
+// PropertyAccuracy is a share of expected property values that were extracted. Nil if none were checked.
+func (s TestRunSummary) PropertyAccuracy() *float64 {
+	return ratio(s.PropertiesMatched, s.PropertiesChecked)
+}
+
+// TestResult is a result of a test case in a test run.
+//
+// Expected values are copied from the test case when the run starts, so results of old runs
+// are not affected by changes of the test suite.
+type TestResult struct {
+	RunID              int64
+	TestCaseID         int64
+	Source             string
+	SourceID           string
+	ExpectedRelevant   bool
+	ExpectedProperties map[string]any
+	// Detection is nil until the post is analyzed.
+	Detection *Detection
+	// Error is set if the post could not be analyzed.
+	Error *string
+	// MismatchedProperties are expected properties with other extracted values.
+	MismatchedProperties []string
+	CompletedAt          *time.Time
+}
+
+// Passed reports whether the post was analyzed with expected relevance and property values.
+func (r TestResult) Passed() bool {
+	return r.Detection != nil &&
+		r.Detection.IsRelevant == r.ExpectedRelevant &&
+		len(r.MismatchedProperties) == 0
+}
+
+// TestResultChangeKind describes how a result of a test case changed between two runs.
+type TestResultChangeKind string
+
+const (
+	// FixedTestResult passes in the target run and did not pass in the base run.
+	FixedTestResult TestResultChangeKind = "fixed"
+	// RegressedTestResult passed in the base run and does not pass in the target run.
+	RegressedTestResult TestResultChangeKind = "regressed"
+	// ChangedTestResult has the same outcome in both runs but another relevance or mismatched properties.
+	ChangedTestResult TestResultChangeKind = "changed"
+	// AddedTestResult is present only in the target run.
+	AddedTestResult TestResultChangeKind = "added"
+	// RemovedTestResult is present only in the base run.
+	RemovedTestResult TestResultChangeKind = "removed"
+)
+
+// TestResultChange is a difference of a test case result between two runs.
+type TestResultChange struct {
+	Source   string
+	SourceID string
+	Kind     TestResultChangeKind
+	// Base is nil for added test cases.
+	Base *TestResult
+	// Target is nil for removed test cases.
+	Target *TestResult
+}
+
+// TestRunDiff compares results of two test runs of a profile.
+type TestRunDiff struct {
+	Base   TestRun
+	Target TestRun
+	// Changes lists test cases with changed results, unchanged ones are omitted.
+	Changes []TestResultChange
+}
+
+// DiffTestResults compares results of two runs by their source posts.
+func DiffTestResults(base []TestResult, target []TestResult) []TestResultChange {
+	type resultKey struct {
+		source   string
+		sourceID string
+	}
+
+	baseResults := make(map[resultKey]TestResult, len(base))
+	for _, result := range base {
+		baseResults[resultKey{result.Source, result.SourceID}] = result
+	}
+
+	changes := make([]TestResultChange, 0)
+
+	for _, targetResult := range target {
+		key := resultKey{targetResult.Source, targetResult.SourceID}
+
+		baseResult, ok := baseResults[key]
+		if !ok {
+			changes = append(changes, TestResultChange{
+				Source:   targetResult.Source,
+				SourceID: targetResult.SourceID,
+				Kind:     AddedTestResult,
+				Base:     nil,
+				Target:   &targetResult,
+			})
+
+			continue
+		}
+
+		delete(baseResults, key)
+
+		kind, changed := compareTestResults(baseResult, targetResult)
+		if !changed {
+			continue
+		}
+
+		changes = append(changes, TestResultChange{
+			Source:   targetResult.Source,
+			SourceID: targetResult.SourceID,
+			Kind:     kind,
+			Base:     &baseResult,
+			Target:   &targetResult,
+		})
+	}
+
+	for _, baseResult := range base {
+		if _, ok := baseResults[resultKey{baseResult.Source, baseResult.SourceID}]; !ok {
+			continue
+		}
+
+		changes = append(changes, TestResultChange{
+			Source:   baseResult.Source,
+			SourceID: baseResult.SourceID,
+			Kind:     RemovedTestResult,
+			Base:     &baseResult,
+			Target:   nil,
+		})
+	}
+
+	return changes
+}
+
+func compareTestResults(base TestResult, target TestResult) (kind TestResultChangeKind, changed bool) {
+	switch {
+	case !base.Passed() && target.Passed():
+		return FixedTestResult, true
+	case base.Passed() && !target.Passed():
+		return RegressedTestResult, true
+	}
+
+	baseAnalyzed, targetAnalyzed := base.Detection != nil, target.Detection != nil
+	if baseAnalyzed != targetAnalyzed {
+		return ChangedTestResult, true
+	}
+
+	if baseAnalyzed && base.Detection.IsRelevant != target.Detection.IsRelevant {
+		return ChangedTestResult, true
+	}
+
+	if !slices.Equal(base.MismatchedProperties, target.MismatchedProperties) {
+		return ChangedTestResult, true
+	}
+
+	return "", false
+}
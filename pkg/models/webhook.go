@@ -0,0 +1,120 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// ErrInvalidWebhook is returned when a webhook subscription has malformed parameters.
+var ErrInvalidWebhook = errors.New("invalid webhook")
+
+// RelevantDetectionEvent is sent to webhooks when a relevant detection is saved.
+const RelevantDetectionEvent = "detection.relevant"
+
+// WebhookDeliveryStatus is a status of an outbox event.
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryPending means the event is waiting for its next delivery attempt.
+	WebhookDeliveryPending WebhookDeliveryStatus = "pending"
+	// WebhookDeliveryDelivered means the receiver acknowledged the event with a 2xx response.
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	// WebhookDeliveryFailed means all delivery attempts of the event failed.
+	WebhookDeliveryFailed WebhookDeliveryStatus = "failed"
+)
+
+// Webhook is a subscription of an HTTP endpoint to relevant detections of a profile.
+type Webhook struct {
+	ID        int64
+	ProfileID int64
+	URL       string
+	// Secret is a key used to sign payloads with HMAC-SHA256.
+	Secret    string
+	Active    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type WebhookUpdate struct {
+	WebhookID int64
+	URL       *string
+	Secret    *string
+	Active    *bool
+}
+
+// ValidateWebhookURL checks that a webhook URL is an absolute http(s) URL.
+func ValidateWebhookURL(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("%w: malformed url: %w", ErrInvalidWebhook, err)
+	}
+
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fmt.Errorf("%w: url scheme must be http or https", ErrInvalidWebhook)
+	}
+
+	if parsed.Host == "" {
+		return fmt.Errorf("%w: url must have a host", ErrInvalidWebhook)
+	}
+
+	return nil
+}
+
+// WebhookEvent is a payload POSTed to webhooks.
+type WebhookEvent struct {
+	Event     string          `json:"event"`
+	Detection DetectionRecord `json:"detection"`
+	Post      PostSummary     `json:"post"`
+}
+
+// PostSummary is a short description of a source post.
+type PostSummary struct {
+	Title string `json:"title"`
+	URL   string `json:"url"`
+	// Excerpt is a beginning of the post body.
+	Excerpt string `json:"excerpt"`
+}
+
+// WebhookDelivery is an outbox event claimed for a delivery attempt.
+type WebhookDelivery struct {
+	ID        int64
+	WebhookID int64
+	URL       string
+	Secret    string
+	Event     string
+	Payload   []byte
+	// Attempt is a number of the current attempt starting from 1.
+	Attempt int
+}
+
+// WebhookDeliveryResult is an outcome of a delivery attempt.
+type WebhookDeliveryResult struct {
+	DeliveryID int64
+	WebhookID  int64
+	Attempt    int
+	// StatusCode is a status code of the receiver's response. Nil if the request failed.
+	StatusCode *int
+	// Error is nil if the event is delivered.
+	Error    *string
+	Duration time.Duration
+	// NextAttemptAt is a time of the next attempt. Nil if there should be no more attempts.
+	NextAttemptAt *time.Time
+}
+
+// WebhookDeliveryLogEntry is a recorded delivery attempt.
+type WebhookDeliveryLogEntry struct {
+	ID          int64
+	OutboxID    int64
+	WebhookID   int64
+	DetectionID int64
+	Event       string
+	// Status is a current status of the outbox event.
+	Status     WebhookDeliveryStatus
+	Attempt    int
+	StatusCode *int
+	Error      *string
+	Duration   time.Duration
+	CreatedAt  time.Time
+}
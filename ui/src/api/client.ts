@@ -14,6 +14,10 @@ import {
   putApiDetectionsTags,
   postApiAnalyze,
   getApiStatisticsByProfileId,
+  getApiTasks,
+  postApiTasksRetry,
+  postApiTasksCancel,
+  postApiTasksPurge,
   client,
 } from './generated';
 
@@ -31,24 +35,48 @@ import type {
   DetectionListRequest,
   DetectionTagUpdateRequest,
   ProfileStatistics,
-  AnalysisTaskParameters
+  AnalysisTaskParameters,
+  AnalysisTask,
+  TaskFilter,
+  TaskSelector,
+  TaskCancelRequest
 } from './models';
 
+const API_KEY_STORAGE_KEY = 'scout.apiKey';
+
 // Configure the client
 client.setConfig({
   baseURL: 'http://localhost:5601',
+  headers: {
+    'X-API-Key': localStorage.getItem(API_KEY_STORAGE_KEY) ?? undefined,
+  },
 });
 
-// Set basic auth credentials
-export function setAuthCredentials(token: string) {
+// Set the API key sent with each request, it is kept in the local storage
+export function setApiKey(apiKey: string) {
+  localStorage.setItem(API_KEY_STORAGE_KEY, apiKey);
+
   client.setConfig({
     baseURL: 'http://localhost:5601',
     headers: {
-      'Authorization': `Bearer ${token}`,
+      'X-API-Key': apiKey,
     },
   });
 }
 
+// Ask for an API key when the API rejects the current one
+client.instance.interceptors.response.use(undefined, (error) => {
+  if (error?.response?.status === 401) {
+    const apiKey = window.prompt('Scout API key (create one with "scout apikeys create")');
+    if (apiKey) {
+      setApiKey(apiKey.trim());
+      window.location.reload();
+    }
+  }
+
+  return Promise.reject(error);
+});
+
 // Profiles API
 export const profilesApi = {
   // Get all profiles
@@ -378,11 +406,109 @@ export const sourcesApi = {
   },
 };
 
+// Tasks API
+export const tasksApi = {
+  // List tasks, newest first
+  async listTasks(params: { lastSeenId?: number; limit?: number; filter: TaskFilter }): Promise<AnalysisTask[]> {
+    try {
+      const response = await getApiTasks({
+        query: {
+          ...params.filter,
+          last_seen_id: params.lastSeenId,
+          limit: params.limit,
+        },
+      });
+
+      if (response.error) {
+        throw response.error;
+      }
+
+      if (!response.data) {
+        throw new Error('No data returned from API');
+      }
+
+      return response.data;
+    } catch (error) {
+      console.error('Error listing tasks:', error);
+      throw error;
+    }
+  },
+
+  // Return failed tasks to the queue
+  async retryFailedTasks(selector: TaskSelector): Promise<number> {
+    try {
+      const response = await postApiTasksRetry({
+        body: selector,
+      });
+
+      if (response.error) {
+        throw response.error;
+      }
+
+      if (!response.data) {
+        throw new Error('No data returned from API');
+      }
+
+      return response.data.affected_tasks;
+    } catch (error) {
+      console.error('Error retrying failed tasks:', error);
+      throw error;
+    }
+  },
+
+  // Remove pending tasks of a profile from the queue
+  async cancelPendingTasks(request: TaskCancelRequest): Promise<number> {
+    try {
+      const response = await postApiTasksCancel({
+        body: request,
+      });
+
+      if (response.error) {
+        throw response.error;
+      }
+
+      if (!response.data) {
+        throw new Error('No data returned from API');
+      }
+
+      return response.data.affected_tasks;
+    } catch (error) {
+      console.error(`Error canceling pending tasks of profile ${request.profile_id}:`, error);
+      throw error;
+    }
+  },
+
+  // Delete committed tasks older than a given number of days
+  async purgeCommittedTasks(olderThanDays: number): Promise<number> {
+    try {
+      const response = await postApiTasksPurge({
+        body: {
+          older_than_days: olderThanDays,
+        },
+      });
+
+      if (response.error) {
+        throw response.error;
+      }
+
+      if (!response.data) {
+        throw new Error('No data returned from API');
+      }
+
+      return response.data.affected_tasks;
+    } catch (error) {
+      console.error('Error purging committed tasks:', error);
+      throw error;
+    }
+  },
+};
+
 // Export a default client that includes all APIs
 export default {
-  setAuthCredentials,
+  setApiKey,
   profiles: profilesApi,
   detections: detectionsApi,
   analysis: analysisApi,
   subreddits: sourcesApi,
+  tasks: tasksApi,
 };
\ No newline at end of file
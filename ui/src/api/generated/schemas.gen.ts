@@ -332,14 +332,102 @@ export const SubredditSettingsSchema = {
 export const ProfileStatisticsSchema = {
     type: 'object',
     properties: {
-        manual_tasks: {
+        profile_id: {
+            type: 'integer'
+        },
+        detections: {
+            '$ref': '#/components/schemas/DetectionStatistics'
+        },
+        sources: {
+            type: 'array',
+            items: {
+                '$ref': '#/components/schemas/SourceStatistics'
+            }
+        },
+        tasks: {
+            '$ref': '#/components/schemas/TaskStatistics'
+        }
+    },
+    required: ['profile_id', 'detections', 'sources', 'tasks']
+} as const;
+
+export const SourceStatisticsSchema = {
+    type: 'object',
+    properties: {
+        source: {
+            type: 'string'
+        },
+        detections: {
+            '$ref': '#/components/schemas/DetectionStatistics'
+        },
+        settings_versions: {
+            type: 'array',
+            items: {
+                '$ref': '#/components/schemas/SettingsVersionStatistics'
+            }
+        }
+    },
+    required: ['source', 'detections', 'settings_versions']
+} as const;
+
+export const SettingsVersionStatisticsSchema = {
+    type: 'object',
+    properties: {
+        version: {
+            type: 'integer'
+        },
+        detections: {
+            '$ref': '#/components/schemas/DetectionStatistics'
+        }
+    },
+    required: ['version', 'detections']
+} as const;
+
+export const DetectionStatisticsSchema = {
+    type: 'object',
+    properties: {
+        total: {
+            type: 'integer'
+        },
+        relevant: {
             type: 'integer'
         },
-        auto_tasks: {
+        irrelevant: {
             type: 'integer'
+        },
+        tagged_correct: {
+            type: 'integer',
+            description: 'Amount of detections tagged as correctly detected.'
+        },
+        tagged_wrong: {
+            type: 'integer',
+            description: 'Amount of detections tagged as wrongly detected.'
+        }
+    },
+    required: ['total', 'relevant', 'irrelevant', 'tagged_correct', 'tagged_wrong']
+} as const;
+
+export const TaskStatisticsSchema = {
+    type: 'object',
+    properties: {
+        pending: {
+            type: 'integer',
+            description: 'Amount of tasks waiting to be claimed.'
+        },
+        claimed: {
+            type: 'integer',
+            description: 'Amount of tasks being processed right now.'
+        },
+        failed: {
+            type: 'integer',
+            description: 'Amount of tasks that exceeded max attempts.'
+        },
+        committed: {
+            type: 'integer',
+            description: 'Amount of successfully processed tasks.'
         }
     },
-    required: ['manual_tasks', 'auto_tasks']
+    required: ['pending', 'claimed', 'failed', 'committed']
 } as const;
 
 export const AnalysisTaskParametersSchema = {
@@ -1,7 +1,7 @@
 // This file is auto-generated by @hey-api/openapi-ts
 
 import { createClient, createConfig, type Options } from '@hey-api/client-axios';
-import type { GetApiProfilesError, GetApiProfilesResponse, PostApiProfilesData, PostApiProfilesError, PostApiProfilesResponse, GetApiProfilesByProfileIdData, GetApiProfilesByProfileIdError, GetApiProfilesByProfileIdResponse, PutApiProfilesByProfileIdData, PutApiProfilesByProfileIdError, PutApiProfilesByProfileIdResponse, DeleteApiProfilesByProfileIdData, DeleteApiProfilesByProfileIdError, DeleteApiProfilesByProfileIdResponse, PostApiProfilesByProfileIdJumpstartData, PostApiProfilesByProfileIdJumpstartError, PostApiProfilesByProfileIdJumpstartResponse, PostApiProfilesByProfileIdDryJumpstartData, PostApiProfilesByProfileIdDryJumpstartError, PostApiProfilesByProfileIdDryJumpstartResponse, PostApiDetectionsListData, PostApiDetectionsListError, PostApiDetectionsListResponse, PutApiDetectionsTagsData, PutApiDetectionsTagsError, PutApiDetectionsTagsResponse, PostApiAnalyzeData, PostApiAnalyzeError, PostApiAnalyzeResponse, GetApiSourcesRedditSubredditsError, GetApiSourcesRedditSubredditsResponse, PostApiSourcesRedditSubredditsBySubredditAddProfilesData, PostApiSourcesRedditSubredditsBySubredditAddProfilesError, PostApiSourcesRedditSubredditsBySubredditAddProfilesResponse, PostApiSourcesRedditSubredditsBySubredditRemoveProfilesData, PostApiSourcesRedditSubredditsBySubredditRemoveProfilesError, PostApiSourcesRedditSubredditsBySubredditRemoveProfilesResponse, GetApiSourcesRedditSubredditsWithProfileData, GetApiSourcesRedditSubredditsWithProfileError, GetApiSourcesRedditSubredditsWithProfileResponse, GetApiStatisticsByProfileIdData, GetApiStatisticsByProfileIdError, GetApiStatisticsByProfileIdResponse } from './types.gen';
+import type { GetApiProfilesError, GetApiProfilesResponse, PostApiProfilesData, PostApiProfilesError, PostApiProfilesResponse, GetApiProfilesByProfileIdData, GetApiProfilesByProfileIdError, GetApiProfilesByProfileIdResponse, PutApiProfilesByProfileIdData, PutApiProfilesByProfileIdError, PutApiProfilesByProfileIdResponse, DeleteApiProfilesByProfileIdData, DeleteApiProfilesByProfileIdError, DeleteApiProfilesByProfileIdResponse, PostApiProfilesByProfileIdJumpstartData, PostApiProfilesByProfileIdJumpstartError, PostApiProfilesByProfileIdJumpstartResponse, PostApiProfilesByProfileIdDryJumpstartData, PostApiProfilesByProfileIdDryJumpstartError, PostApiProfilesByProfileIdDryJumpstartResponse, PostApiDetectionsListData, PostApiDetectionsListError, PostApiDetectionsListResponse, PutApiDetectionsTagsData, PutApiDetectionsTagsError, PutApiDetectionsTagsResponse, PostApiAnalyzeData, PostApiAnalyzeError, PostApiAnalyzeResponse, GetApiSourcesRedditSubredditsError, GetApiSourcesRedditSubredditsResponse, PostApiSourcesRedditSubredditsBySubredditAddProfilesData, PostApiSourcesRedditSubredditsBySubredditAddProfilesError, PostApiSourcesRedditSubredditsBySubredditAddProfilesResponse, PostApiSourcesRedditSubredditsBySubredditRemoveProfilesData, PostApiSourcesRedditSubredditsBySubredditRemoveProfilesError, PostApiSourcesRedditSubredditsBySubredditRemoveProfilesResponse, GetApiSourcesRedditSubredditsWithProfileData, GetApiSourcesRedditSubredditsWithProfileError, GetApiSourcesRedditSubredditsWithProfileResponse, GetApiStatisticsByProfileIdData, GetApiStatisticsByProfileIdError, GetApiStatisticsByProfileIdResponse, GetApiTasksData, GetApiTasksError, GetApiTasksResponse, PostApiTasksRetryData, PostApiTasksRetryError, PostApiTasksRetryResponse, PostApiTasksCancelData, PostApiTasksCancelError, PostApiTasksCancelResponse, PostApiTasksPurgeData, PostApiTasksPurgeError, PostApiTasksPurgeResponse } from './types.gen';
 
 export const client = createClient(createConfig());
 
@@ -153,4 +153,44 @@ export const getApiStatisticsByProfileId = <ThrowOnError extends boolean = false
         ...options,
         url: '/api/statistics/{profileId}'
     });
+};
+
+/**
+ * List analysis tasks, newest first
+ */
+export const getApiTasks = <ThrowOnError extends boolean = false>(options?: Options<GetApiTasksData, ThrowOnError>) => {
+    return (options?.client ?? client).get<GetApiTasksResponse, GetApiTasksError, ThrowOnError>({
+        ...options,
+        url: '/api/tasks'
+    });
+};
+
+/**
+ * Retry failed tasks - reset their errors and return them to the queue
+ */
+export const postApiTasksRetry = <ThrowOnError extends boolean = false>(options: Options<PostApiTasksRetryData, ThrowOnError>) => {
+    return (options?.client ?? client).post<PostApiTasksRetryResponse, PostApiTasksRetryError, ThrowOnError>({
+        ...options,
+        url: '/api/tasks/retry'
+    });
+};
+
+/**
+ * Cancel pending tasks of a profile - remove them from the queue
+ */
+export const postApiTasksCancel = <ThrowOnError extends boolean = false>(options: Options<PostApiTasksCancelData, ThrowOnError>) => {
+    return (options?.client ?? client).post<PostApiTasksCancelResponse, PostApiTasksCancelError, ThrowOnError>({
+        ...options,
+        url: '/api/tasks/cancel'
+    });
+};
+
+/**
+ * Delete old committed tasks
+ */
+export const postApiTasksPurge = <ThrowOnError extends boolean = false>(options: Options<PostApiTasksPurgeData, ThrowOnError>) => {
+    return (options?.client ?? client).post<PostApiTasksPurgeResponse, PostApiTasksPurgeError, ThrowOnError>({
+        ...options,
+        url: '/api/tasks/purge'
+    });
 };
\ No newline at end of file
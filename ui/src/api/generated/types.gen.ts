@@ -22,6 +22,10 @@ export type ProfileSettings = {
     extracted_properties: {
         [key: string]: (string);
     };
+    /**
+     * Model pinned for the analysis. If omitted, the default analyzer chain is used.
+     */
+    model?: string;
     updated_at?: string;
     created_at?: string;
 };
@@ -41,6 +45,17 @@ export type ProfileJumpstartRequest = {
     limit?: number;
 };
 
+export type ProfileAnalyzeRequest = {
+    /**
+     * Source of the posts (reddit, hackernews or rss).
+     */
+    source: string;
+    /**
+     * Ids of the posts in the source, up to 100.
+     */
+    source_ids: Array<(string)>;
+};
+
 export type ProfileUpdate = {
     name?: string;
     active?: boolean;
@@ -55,19 +70,83 @@ export type ProfileSettingsUpdate = {
     extracted_properties?: {
         [key: string]: ((string) | null);
     };
+    /**
+     * Model to pin. Null unpins the model and restores the default analyzer chain.
+     */
+    model?: (string) | null;
 } | null;
 
 export type DetectionListRequest = {
+    /**
+     * Id of the last seen detection. Supported only with sorting by id, prefer `after`.
+     */
     last_seen_id?: number;
+    /**
+     * Cursor of the last seen detection (`cursor` of a listed detection) to get the next page.
+     */
+    after?: string;
     limit?: number;
     filter?: DetectionFilter;
+    /**
+     * Full-text search query (web search syntax: "quoted phrases", OR, -excluded) over extracted property values and source posts (title, body and comments).
+     */
+    q?: string;
+    /**
+     * Field to sort detections by, ties are broken by id. Defaults to `property` if `sort_property` is set, to `rank` if `q` is set, otherwise to `id`. Sorting by `rank` requires `q`. Detections without a confidence come last when sorting by `confidence`.
+     */
+    sort_by?: 'id' | 'created_at' | 'property' | 'rank' | 'confidence';
+    /**
+     * Extracted property to sort detections by. Detections without the property come last.
+     */
+    sort_property?: string;
+    order?: 'asc' | 'desc';
 };
 
 export type DetectionFilter = {
     profiles?: Array<ProfileFilter>;
     sources?: Array<(string)>;
     is_relevant?: boolean;
+    /**
+     * Minimum confidence (inclusive). Detections without a confidence don't match.
+     */
+    min_confidence?: number;
+    /**
+     * Maximum confidence (inclusive). Detections without a confidence don't match.
+     */
+    max_confidence?: number;
     tags?: DetectionTagsFilter;
+    /**
+     * Extracted property predicates, all of them must match.
+     */
+    properties?: Array<PropertyFilter>;
+};
+
+export type PropertyFilter = {
+    key: string;
+    /**
+     * Typed value the property must be equal to (e.g. true, 7 or "high").
+     */
+    equals?: unknown;
+    /**
+     * Substring the property text must contain (case-insensitive).
+     */
+    contains?: string;
+    /**
+     * Whether the property must be present and non-null.
+     */
+    exists?: boolean;
+    /**
+     * Minimum value of a numeric property.
+     */
+    gte?: number;
+    /**
+     * Maximum value of a numeric property.
+     */
+    lte?: number;
+    /**
+     * POSIX regular expression the property text must match.
+     */
+    regex?: string;
 };
 
 export type ProfileFilter = {
@@ -102,9 +181,24 @@ export type Detection = {
     profile_id: number;
     settings_version: number;
     is_relevant: boolean;
+    /**
+     * Confidence of the model in the detected relevance from 0 to 1. Missing for detections made before confidence was recorded.
+     */
+    confidence?: number;
+    /**
+     * Short explanation of the relevance decision.
+     */
+    rationale?: string;
+    /**
+     * Extracted property values typed according to property types.
+     */
     properties: {
-        [key: string]: (string);
+        [key: string]: unknown;
     };
+    /**
+     * Model that produced the detection.
+     */
+    model?: string;
     created_at: string;
 };
 
@@ -114,6 +208,25 @@ export type ListedDetection = {
         [key: string]: unknown;
     };
     tags?: DetectionTags;
+    /**
+     * Cursor of the detection to pass as `after` to get the next page.
+     */
+    cursor: string;
+    search?: DetectionSearchMatch;
+};
+
+/**
+ * Full-text search match of a detection, present only if `q` is set.
+ */
+export type DetectionSearchMatch = {
+    /**
+     * Relevance of the detection and its source post to the query, higher is more relevant.
+     */
+    rank: number;
+    /**
+     * Fragment of the post and property values with matches wrapped in <mark></mark>.
+     */
+    snippet: string;
 };
 
 export type AnalyzeRequest = {
@@ -123,6 +236,10 @@ export type AnalyzeRequest = {
     extracted_properties: {
         [key: string]: (string);
     };
+    /**
+     * Model to use. If omitted, the default analyzer chain is used.
+     */
+    model?: string;
 };
 
 export type SubredditSettings = {
@@ -131,8 +248,54 @@ export type SubredditSettings = {
 };
 
 export type ProfileStatistics = {
-    manual_tasks: number;
-    auto_tasks: number;
+    profile_id: number;
+    detections: DetectionStatistics;
+    sources: Array<SourceStatistics>;
+    tasks: TaskStatistics;
+};
+
+export type SourceStatistics = {
+    source: string;
+    detections: DetectionStatistics;
+    settings_versions: Array<SettingsVersionStatistics>;
+};
+
+export type SettingsVersionStatistics = {
+    version: number;
+    detections: DetectionStatistics;
+};
+
+export type DetectionStatistics = {
+    total: number;
+    relevant: number;
+    irrelevant: number;
+    /**
+     * Amount of detections tagged as correctly detected.
+     */
+    tagged_correct: number;
+    /**
+     * Amount of detections tagged as wrongly detected.
+     */
+    tagged_wrong: number;
+};
+
+export type TaskStatistics = {
+    /**
+     * Amount of tasks waiting to be claimed.
+     */
+    pending: number;
+    /**
+     * Amount of tasks being processed right now.
+     */
+    claimed: number;
+    /**
+     * Amount of tasks that exceeded max attempts.
+     */
+    failed: number;
+    /**
+     * Amount of successfully processed tasks.
+     */
+    committed: number;
 };
 
 export type AnalysisTaskParameters = {
@@ -142,6 +305,67 @@ export type AnalysisTaskParameters = {
     should_save: boolean;
 };
 
+export type TaskStatus = 'pending' | 'claimed' | 'failed' | 'committed';
+
+export type AnalysisTask = {
+    id: number;
+    type: string;
+    priority: number;
+    status: TaskStatus;
+    source: string;
+    source_id: string;
+    profile_id: number;
+    should_save: boolean;
+    /**
+     * Errors of previous processing attempts.
+     */
+    errors?: Array<(string)>;
+    created_at?: string;
+    claimed_at?: string;
+    committed_at?: string;
+    failed_at?: string;
+    /**
+     * Reason code of a failed task: max_attempts_exceeded or a code of a permanent error (source_item_not_found, toolkit_not_found, profile_not_found, profile_settings_not_found, model_not_configured, invalid_llm_request).
+     */
+    failure_reason?: string;
+};
+
+/**
+ * Selects tasks for a bulk action. Omitted fields match any task.
+ */
+export type TaskSelector = {
+    task_ids?: Array<(number)>;
+    profile_id?: number;
+    source?: string;
+    type?: string;
+};
+
+export type TaskCancelRequest = {
+    profile_id: number;
+    /**
+     * Cancel only tasks of a source. If omitted, tasks of all sources are canceled.
+     */
+    source?: string;
+    /**
+     * Cancel only tasks of a type. If omitted, tasks of all types are canceled.
+     */
+    type?: string;
+};
+
+export type TaskPurgeRequest = {
+    /**
+     * Delete tasks committed more than this number of days ago.
+     */
+    older_than_days: number;
+};
+
+export type TaskActionResult = {
+    /**
+     * Number of tasks affected by the action.
+     */
+    affected_tasks: number;
+};
+
 export type GetApiProfilesResponse = (Array<Profile>);
 
 export type GetApiProfilesError = (unknown | Error);
@@ -198,6 +422,17 @@ export type PostApiProfilesByProfileIdJumpstartResponse = (void);
 
 export type PostApiProfilesByProfileIdJumpstartError = (unknown | Error);
 
+export type PostApiProfilesByProfileIdAnalyzeData = {
+    body: ProfileAnalyzeRequest;
+    path: {
+        profileId: number;
+    };
+};
+
+export type PostApiProfilesByProfileIdAnalyzeResponse = (void);
+
+export type PostApiProfilesByProfileIdAnalyzeError = (unknown | Error);
+
 export type PostApiProfilesByProfileIdDryJumpstartData = {
     body: ProfileJumpstartRequest;
     path: {
@@ -281,4 +516,43 @@ export type GetApiStatisticsByProfileIdData = {
 
 export type GetApiStatisticsByProfileIdResponse = (ProfileStatistics);
 
-export type GetApiStatisticsByProfileIdError = (unknown | Error);
\ No newline at end of file
+export type GetApiStatisticsByProfileIdError = (unknown | Error);
+
+export type GetApiTasksData = {
+    query?: {
+        last_seen_id?: number;
+        limit?: number;
+        profile_id?: number;
+        source?: string;
+        status?: TaskStatus;
+        type?: string;
+    };
+};
+
+export type GetApiTasksResponse = (Array<AnalysisTask>);
+
+export type GetApiTasksError = (unknown | Error);
+
+export type PostApiTasksRetryData = {
+    body: TaskSelector;
+};
+
+export type PostApiTasksRetryResponse = (TaskActionResult);
+
+export type PostApiTasksRetryError = (unknown | Error);
+
+export type PostApiTasksCancelData = {
+    body: TaskCancelRequest;
+};
+
+export type PostApiTasksCancelResponse = (TaskActionResult);
+
+export type PostApiTasksCancelError = (unknown | Error);
+
+export type PostApiTasksPurgeData = {
+    body: TaskPurgeRequest;
+};
+
+export type PostApiTasksPurgeResponse = (TaskActionResult);
+
+export type PostApiTasksPurgeError = (unknown | Error);
\ No newline at end of file
@@ -13,7 +13,11 @@ import type {
   AnalyzeRequest,
   SubredditSettings,
   ProfileStatistics,
-  AnalysisTaskParameters
+  AnalysisTaskParameters,
+  AnalysisTask,
+  TaskFilter,
+  TaskSelector,
+  TaskCancelRequest
 } from './models';
 
 // Profiles
@@ -288,4 +292,61 @@ export function useDryJumpstartCount() {
       return tasks.length;
     },
   });
-} 
\ No newline at end of file
+} 
+
+const TASK_PAGE_SIZE = 50;
+
+// Tasks
+export function useInfiniteTasks(filter: TaskFilter) {
+  return useInfiniteQuery<AnalysisTask[], Error>({
+    queryKey: ['tasks', filter],
+    queryFn: ({ pageParam }) => {
+      return apiClient.tasks.listTasks({
+        lastSeenId: pageParam as number | undefined,
+        limit: TASK_PAGE_SIZE,
+        filter
+      });
+    },
+    initialPageParam: undefined as number | undefined,
+    getNextPageParam: (lastPage) => {
+      if (lastPage.length < TASK_PAGE_SIZE) {
+        return undefined;
+      }
+
+      return lastPage[lastPage.length - 1]?.id;
+    },
+  });
+}
+
+export function useRetryFailedTasks() {
+  const queryClient = useQueryClient();
+  return useMutation<number, Error, TaskSelector>({
+    mutationFn: (selector) => apiClient.tasks.retryFailedTasks(selector),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: ['tasks'] });
+      queryClient.invalidateQueries({ queryKey: ['statistics'] });
+    },
+  });
+}
+
+export function useCancelPendingTasks() {
+  const queryClient = useQueryClient();
+  return useMutation<number, Error, TaskCancelRequest>({
+    mutationFn: (request) => apiClient.tasks.cancelPendingTasks(request),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: ['tasks'] });
+      queryClient.invalidateQueries({ queryKey: ['statistics'] });
+    },
+  });
+}
+
+export function usePurgeCommittedTasks() {
+  const queryClient = useQueryClient();
+  return useMutation<number, Error, number>({
+    mutationFn: (olderThanDays) => apiClient.tasks.purgeCommittedTasks(olderThanDays),
+    onSuccess: () => {
+      queryClient.invalidateQueries({ queryKey: ['tasks'] });
+      queryClient.invalidateQueries({ queryKey: ['statistics'] });
+    },
+  });
+}
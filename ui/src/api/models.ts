@@ -48,7 +48,9 @@ export interface Detection {
     source_id: string;
     profile_id: number;
     is_relevant: boolean;
-    properties: Record<string, string>;
+    confidence?: number;
+    rationale?: string;
+    properties: Record<string, unknown>;
     created_at: string;
 }
 
@@ -74,6 +76,8 @@ export interface DetectionFilter {
     profiles?: ProfileFilter[];
     sources?: string[];
     is_relevant?: boolean;
+    min_confidence?: number;
+    max_confidence?: number;
     tags?: DetectionTagsFilter;
 }
 
@@ -120,8 +124,36 @@ export interface SubredditProfilesRequest {
 
 // Statistics-related models
 export interface ProfileStatistics {
-    manual_tasks: number;
-    auto_tasks: number;
+    profile_id: number;
+    detections: DetectionStatistics;
+    sources: SourceStatistics[];
+    tasks: TaskStatistics;
+}
+
+export interface SourceStatistics {
+    source: string;
+    detections: DetectionStatistics;
+    settings_versions: SettingsVersionStatistics[];
+}
+
+export interface SettingsVersionStatistics {
+    version: number;
+    detections: DetectionStatistics;
+}
+
+export interface DetectionStatistics {
+    total: number;
+    relevant: number;
+    irrelevant: number;
+    tagged_correct: number;
+    tagged_wrong: number;
+}
+
+export interface TaskStatistics {
+    pending: number;
+    claimed: number;
+    failed: number;
+    committed: number;
 }
 
 // Analysis task parameters for dry jumpstart
@@ -132,6 +164,50 @@ export interface AnalysisTaskParameters {
     should_save: boolean;
 }
 
+// Status of an analysis task in the queue
+export type TaskStatus = 'pending' | 'claimed' | 'failed' | 'committed';
+
+// Analysis task in the queue
+export interface AnalysisTask {
+    id: number;
+    type: string;
+    priority: number;
+    status: TaskStatus;
+    source: string;
+    source_id: string;
+    profile_id: number;
+    should_save: boolean;
+    errors?: string[];
+    created_at?: string;
+    claimed_at?: string;
+    committed_at?: string;
+    failed_at?: string;
+    failure_reason?: string;         // Reason code of a failed task
+}
+
+// Filter for the task list
+export interface TaskFilter {
+    status?: TaskStatus;
+    profile_id?: number;
+    source?: string;
+    type?: string;
+}
+
+// Selects tasks for a bulk action, omitted fields match any task
+export interface TaskSelector {
+    task_ids?: number[];
+    profile_id?: number;
+    source?: string;
+    type?: string;
+}
+
+// Selects pending tasks of a profile to cancel
+export interface TaskCancelRequest {
+    profile_id: number;
+    source?: string;
+    type?: string;
+}
+
 // Represents the top-level API response
 export interface RedditPostAndComments {
     post: RedditPost;
@@ -4,6 +4,10 @@ import { Button } from '@/components/ui/button'
 import { RefreshCw } from 'lucide-react'
 import type { DetectionFilter } from '@/api/models'
 
+// Confidence bounds of the low and high confidence filters
+const LOW_CONFIDENCE = 0.5
+const HIGH_CONFIDENCE = 0.8
+
 interface DetectionFilterProps {
   filter: DetectionFilter
   onFilterChange: (filter: DetectionFilter) => void
@@ -14,6 +18,8 @@ interface DetectionFilterProps {
 export function DetectionFilter({ filter, onFilterChange, onRefresh, isRefreshing }: DetectionFilterProps) {
   const isRelevantKey = filter.is_relevant === undefined ? 'all' : filter.is_relevant ? 'relevant' : 'irrelevant'
 
+  const confidenceKey = filter.max_confidence !== undefined ? 'low' : filter.min_confidence !== undefined ? 'high' : 'all'
+
   const handleRelevancyChange = (value: string) => {
     onFilterChange({
       ...filter,
@@ -21,6 +27,14 @@ export function DetectionFilter({ filter, onFilterChange, onRefresh, isRefreshin
     })
   }
 
+  const handleConfidenceChange = (value: string) => {
+    onFilterChange({
+      ...filter,
+      min_confidence: value === 'high' ? HIGH_CONFIDENCE : undefined,
+      max_confidence: value === 'low' ? LOW_CONFIDENCE : undefined,
+    })
+  }
+
   return (
     <div className="flex flex-col md:flex-row gap-4 p-4 bg-card rounded-lg border shadow-sm">
       <div className="flex flex-col gap-1.5">
@@ -39,6 +53,23 @@ export function DetectionFilter({ filter, onFilterChange, onRefresh, isRefreshin
           </SelectContent>
         </Select>
       </div>
+
+      <div className="flex flex-col gap-1.5">
+        <Label htmlFor="confidence-filter">Confidence</Label>
+        <Select
+          value={confidenceKey}
+          onValueChange={handleConfidenceChange}
+        >
+          <SelectTrigger id="confidence-filter" className="w-[180px]">
+            <SelectValue placeholder="Filter by confidence" />
+          </SelectTrigger>
+          <SelectContent>
+            <SelectItem value="all">Any Confidence</SelectItem>
+            <SelectItem value="low">Low (≤ {LOW_CONFIDENCE})</SelectItem>
+            <SelectItem value="high">High (≥ {HIGH_CONFIDENCE})</SelectItem>
+          </SelectContent>
+        </Select>
+      </div>
       
       {onRefresh && (
         <div className="flex flex-col gap-1.5">
@@ -2,10 +2,25 @@ import { Card, CardContent } from "@/components/ui/card"
 import { renderMarkdown, type TextSegment } from "@/utils/renderMarkdown"
 
 type ExtractedPropertiesProps = {
-  properties: Record<string, string>
+  properties: Record<string, unknown>
   className?: string
 }
 
+/**
+ * Formats a typed property value (string, number, boolean, list or null) as text
+ */
+function formatPropertyValue(value: unknown): string {
+  if (value === null || value === undefined) {
+    return "—"
+  }
+
+  if (Array.isArray(value)) {
+    return value.map(formatPropertyValue).join(", ")
+  }
+
+  return String(value)
+}
+
 /**
  * Component to visualize properties extracted from a post
  */
@@ -24,7 +39,7 @@ export function ExtractedProperties({ properties, className }: ExtractedProperti
               <div className="flex gap-2">
                 <h3 className="font-medium text-sm">{key}:</h3>
                 <h2 className="text-sm text-muted-foreground whitespace-pre-wrap">
-                  {renderMarkdown(value).map((segment: TextSegment, index: number) =>
+                  {renderMarkdown(formatPropertyValue(value)).map((segment: TextSegment, index: number) =>
                     segment.type === 'link' && segment.href ? (
                       <a
                         key={index}
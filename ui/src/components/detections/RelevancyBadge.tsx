@@ -10,14 +10,17 @@ import {
 interface RelevancyBadgeProps {
     isRelevant: boolean;
     compact?: boolean;
+    confidence?: number;
+    rationale?: string;
 }
 
-export function RelevancyBadge({ isRelevant, compact = false }: RelevancyBadgeProps) {
+export function RelevancyBadge({ isRelevant, compact = false, confidence, rationale }: RelevancyBadgeProps) {
     const text = compact ? (isRelevant ? 'R' : 'IR') : (isRelevant ? 'Relevant' : 'Irrelevant');
     const colorClasses = isRelevant
         ? "bg-green-100 text-green-800 dark:bg-green-950 dark:text-green-300 border-transparent"
         : "bg-red-100 text-red-800 dark:bg-red-950 dark:text-red-300 border-transparent";
-    const tooltipText = isRelevant ? 'Post classified as relevant' : 'Post classified as irrelevant';
+    const tooltipText = (isRelevant ? 'Post classified as relevant' : 'Post classified as irrelevant') +
+        (confidence !== undefined ? ` (confidence ${Math.round(confidence * 100)}%)` : '');
 
     return (
         <TooltipProvider>
@@ -29,6 +32,7 @@ export function RelevancyBadge({ isRelevant, compact = false }: RelevancyBadgePr
                 </TooltipTrigger>
                 <TooltipContent>
                     <p>{tooltipText}</p>
+                    {rationale && <p className="max-w-xs text-muted-foreground">{rationale}</p>}
                 </TooltipContent>
             </Tooltip>
         </TooltipProvider>
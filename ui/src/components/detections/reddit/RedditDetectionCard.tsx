@@ -34,7 +34,11 @@ export function RedditDetectionCard({
               <h2 className="text-xl font-semibold mt-1">{redditPost.post.title}</h2>
             </div>
             <div className="flex items-center gap-2 absolute top-2 right-2">
-              <RelevancyBadge isRelevant={listedDetection.detection.is_relevant} />
+              <RelevancyBadge
+                isRelevant={listedDetection.detection.is_relevant}
+                confidence={listedDetection.detection.confidence}
+                rationale={listedDetection.detection.rationale}
+              />
               <div onClick={(e) => e.stopPropagation()}>
                 <DetectionReaction
                   listedDetection={listedDetection}
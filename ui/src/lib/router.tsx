@@ -3,6 +3,7 @@ import Home from '@/pages/Home'
 import ProfileDetectionList from '@/pages/ProfileDetectionList'
 import NewProfile from '@/pages/NewProfile'
 import EditProfile from '@/pages/EditProfile'
+import Tasks from '@/pages/Tasks'
 
 function RootLayout() {
   return (
@@ -32,6 +33,10 @@ export const router = createBrowserRouter([
         path: '/profiles/:profileId/edit',
         element: <EditProfile />,
       },
+      {
+        path: '/tasks',
+        element: <Tasks />,
+      },
     ],
   },
 ]) 
\ No newline at end of file
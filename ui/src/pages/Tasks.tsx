@@ -0,0 +1,285 @@
+import { Link } from 'react-router-dom'
+import { useState } from 'react'
+import { toast } from 'sonner'
+import { ArrowLeft, RefreshCw, RotateCcw, XCircle, Trash2 } from 'lucide-react'
+import { Button } from '@/components/ui/button'
+import { Badge } from '@/components/ui/badge'
+import { Input } from '@/components/ui/input'
+import { Label } from '@/components/ui/label'
+import { Select, SelectContent, SelectItem, SelectTrigger, SelectValue } from '@/components/ui/select'
+import { Table, TableBody, TableCell, TableHead, TableHeader, TableRow } from '@/components/ui/table'
+import {
+  useProfiles,
+  useInfiniteTasks,
+  useRetryFailedTasks,
+  useCancelPendingTasks,
+  usePurgeCommittedTasks
+} from '@/api/hooks'
+import type { TaskFilter, TaskStatus } from '@/api/models'
+
+const TASK_TYPES = ['scheduled', 'manual', 'jumpstart', 'test_run', 'shadow']
+
+const STATUS_VARIANTS: Record<TaskStatus, 'default' | 'secondary' | 'destructive' | 'outline'> = {
+  pending: 'outline',
+  claimed: 'secondary',
+  failed: 'destructive',
+  committed: 'default',
+}
+
+function formatTime(value?: string) {
+  return value ? new Date(value).toLocaleString() : '—'
+}
+
+export default function Tasks() {
+  const [filter, setFilter] = useState<TaskFilter>({})
+  const [purgeDays, setPurgeDays] = useState('30')
+  const { data: profiles = [] } = useProfiles()
+  const {
+    data,
+    isLoading,
+    error,
+    refetch,
+    isRefetching,
+    fetchNextPage,
+    hasNextPage,
+    isFetchingNextPage
+  } = useInfiniteTasks(filter)
+  const retryMutation = useRetryFailedTasks()
+  const cancelMutation = useCancelPendingTasks()
+  const purgeMutation = usePurgeCommittedTasks()
+
+  const tasks = data?.pages.flat() ?? []
+  const profileNames = new Map(profiles.map((profile) => [profile.id, profile.name]))
+
+  const handleRetry = () => {
+    retryMutation.mutate(
+      { profile_id: filter.profile_id, source: filter.source, type: filter.type },
+      {
+        onSuccess: (affected) => toast.success(`${affected} failed tasks returned to the queue`),
+        onError: (error) => toast.error(`Failed to retry tasks: ${error.message}`),
+      }
+    )
+  }
+
+  const handleCancel = () => {
+    if (filter.profile_id === undefined) {
+      return
+    }
+
+    cancelMutation.mutate(
+      { profile_id: filter.profile_id, source: filter.source, type: filter.type },
+      {
+        onSuccess: (affected) => toast.success(`${affected} pending tasks canceled`),
+        onError: (error) => toast.error(`Failed to cancel tasks: ${error.message}`),
+      }
+    )
+  }
+
+  const handlePurge = () => {
+    const days = parseInt(purgeDays)
+    if (isNaN(days) || days < 0) {
+      toast.error('Number of days must be a non-negative integer')
+      return
+    }
+
+    purgeMutation.mutate(days, {
+      onSuccess: (affected) => toast.success(`${affected} committed tasks deleted`),
+      onError: (error) => toast.error(`Failed to purge tasks: ${error.message}`),
+    })
+  }
+
+  return (
+    <div className="container py-8 max-w-7xl">
+      <div className="flex items-center justify-between mb-8">
+        <div className="flex items-center gap-4">
+          <Button variant="ghost" size="icon" asChild>
+            <Link to="/">
+              <ArrowLeft className="h-4 w-4" />
+            </Link>
+          </Button>
+          <h1 className="text-4xl font-bold">Task Queue</h1>
+        </div>
+      </div>
+
+      <div className="flex flex-col md:flex-row flex-wrap gap-4 p-4 mb-6 bg-card rounded-lg border shadow-sm">
+        <div className="flex flex-col gap-1.5">
+          <Label htmlFor="status-filter">Status</Label>
+          <Select
+            value={filter.status ?? 'all'}
+            onValueChange={(value) => setFilter({
+              ...filter,
+              status: value === 'all' ? undefined : value as TaskStatus
+            })}
+          >
+            <SelectTrigger id="status-filter" className="w-[160px]">
+              <SelectValue placeholder="Filter by status" />
+            </SelectTrigger>
+            <SelectContent>
+              <SelectItem value="all">All Statuses</SelectItem>
+              <SelectItem value="pending">Pending</SelectItem>
+              <SelectItem value="claimed">Claimed</SelectItem>
+              <SelectItem value="failed">Failed</SelectItem>
+              <SelectItem value="committed">Committed</SelectItem>
+            </SelectContent>
+          </Select>
+        </div>
+
+        <div className="flex flex-col gap-1.5">
+          <Label htmlFor="profile-filter">Profile</Label>
+          <Select
+            value={filter.profile_id?.toString() ?? 'all'}
+            onValueChange={(value) => setFilter({
+              ...filter,
+              profile_id: value === 'all' ? undefined : parseInt(value)
+            })}
+          >
+            <SelectTrigger id="profile-filter" className="w-[200px]">
+              <SelectValue placeholder="Filter by profile" />
+            </SelectTrigger>
+            <SelectContent>
+              <SelectItem value="all">All Profiles</SelectItem>
+              {profiles.map((profile) => (
+                <SelectItem key={profile.id} value={profile.id.toString()}>
+                  {profile.name}
+                </SelectItem>
+              ))}
+            </SelectContent>
+          </Select>
+        </div>
+
+        <div className="flex flex-col gap-1.5">
+          <Label htmlFor="type-filter">Type</Label>
+          <Select
+            value={filter.type ?? 'all'}
+            onValueChange={(value) => setFilter({
+              ...filter,
+              type: value === 'all' ? undefined : value
+            })}
+          >
+            <SelectTrigger id="type-filter" className="w-[160px]">
+              <SelectValue placeholder="Filter by type" />
+            </SelectTrigger>
+            <SelectContent>
+              <SelectItem value="all">All Types</SelectItem>
+              {TASK_TYPES.map((type) => (
+                <SelectItem key={type} value={type}>{type}</SelectItem>
+              ))}
+            </SelectContent>
+          </Select>
+        </div>
+
+        <div className="flex flex-col gap-1.5">
+          <Label htmlFor="source-filter">Source</Label>
+          <Input
+            id="source-filter"
+            className="w-[160px]"
+            placeholder="Any source"
+            value={filter.source ?? ''}
+            onChange={(e) => setFilter({ ...filter, source: e.target.value || undefined })}
+          />
+        </div>
+
+        <div className="flex flex-col gap-1.5">
+          <Label>&nbsp;</Label>
+          <Button
+            variant="outline"
+            onClick={() => refetch()}
+            disabled={isRefetching}
+            className="flex items-center gap-2"
+          >
+            <RefreshCw className={`h-4 w-4 ${isRefetching ? 'animate-spin' : ''}`} />
+            Refresh
+          </Button>
+        </div>
+      </div>
+
+      <div className="flex flex-col md:flex-row flex-wrap items-end gap-4 mb-6">
+        <Button variant="outline" onClick={handleRetry} disabled={retryMutation.isPending}>
+          <RotateCcw className="mr-2 h-4 w-4" />
+          Retry Failed
+        </Button>
+        <Button
+          variant="outline"
+          onClick={handleCancel}
+          disabled={filter.profile_id === undefined || cancelMutation.isPending}
+          title={filter.profile_id === undefined ? 'Select a profile to cancel its pending tasks' : undefined}
+        >
+          <XCircle className="mr-2 h-4 w-4" />
+          Cancel Pending
+        </Button>
+        <div className="flex items-end gap-2">
+          <div className="flex flex-col gap-1.5">
+            <Label htmlFor="purge-days">Committed more than (days) ago</Label>
+            <Input
+              id="purge-days"
+              type="number"
+              min={0}
+              className="w-[120px]"
+              value={purgeDays}
+              onChange={(e) => setPurgeDays(e.target.value)}
+            />
+          </div>
+          <Button variant="destructive" onClick={handlePurge} disabled={purgeMutation.isPending}>
+            <Trash2 className="mr-2 h-4 w-4" />
+            Purge Committed
+          </Button>
+        </div>
+      </div>
+
+      {error ? (
+        <div className="text-destructive">Failed to load tasks: {error.message}</div>
+      ) : isLoading ? (
+        <div className="text-muted-foreground">Loading tasks...</div>
+      ) : tasks.length === 0 ? (
+        <div className="text-muted-foreground">No tasks found</div>
+      ) : (
+        <div className="rounded-md border">
+          <Table>
+            <TableHeader>
+              <TableRow>
+                <TableHead>ID</TableHead>
+                <TableHead>Status</TableHead>
+                <TableHead>Type</TableHead>
+                <TableHead>Priority</TableHead>
+                <TableHead>Profile</TableHead>
+                <TableHead>Source</TableHead>
+                <TableHead>Created</TableHead>
+                <TableHead>Errors</TableHead>
+              </TableRow>
+            </TableHeader>
+            <TableBody>
+              {tasks.map((task) => (
+                <TableRow key={task.id}>
+                  <TableCell>{task.id}</TableCell>
+                  <TableCell>
+                    <Badge variant={STATUS_VARIANTS[task.status]}>{task.status}</Badge>
+                    {task.failure_reason && (
+                      <div className="mt-1 text-xs text-muted-foreground">{task.failure_reason}</div>
+                    )}
+                  </TableCell>
+                  <TableCell>{task.type}</TableCell>
+                  <TableCell>{task.priority}</TableCell>
+                  <TableCell>{profileNames.get(task.profile_id) ?? task.profile_id}</TableCell>
+                  <TableCell>{task.source}/{task.source_id}</TableCell>
+                  <TableCell>{formatTime(task.created_at)}</TableCell>
+                  <TableCell className="max-w-md whitespace-normal text-xs text-muted-foreground">
+                    {task.errors?.length ? task.errors[task.errors.length - 1] : '—'}
+                    {task.errors && task.errors.length > 1 && ` (+${task.errors.length - 1} more)`}
+                  </TableCell>
+                </TableRow>
+              ))}
+            </TableBody>
+          </Table>
+        </div>
+      )}
+
+      {hasNextPage && (
+        <div className="flex justify-center mt-6">
+          <Button variant="outline" onClick={() => fetchNextPage()} disabled={isFetchingNextPage}>
+            {isFetchingNextPage ? 'Loading...' : 'Load More'}
+          </Button>
+        </div>
+      )}
+    </div>
+  )
+}